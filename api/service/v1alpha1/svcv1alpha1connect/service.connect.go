@@ -97,6 +97,12 @@ const (
 	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
 	// AbortPromotion RPC.
 	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
+	// KargoServiceListArchivedRecordsProcedure is the fully-qualified name of the KargoService's
+	// ListArchivedRecords RPC.
+	KargoServiceListArchivedRecordsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListArchivedRecords"
+	// KargoServiceGetArchivedRecordProcedure is the fully-qualified name of the KargoService's
+	// GetArchivedRecord RPC.
+	KargoServiceGetArchivedRecordProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetArchivedRecord"
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
@@ -310,6 +316,8 @@ var (
 	kargoServiceGetPromotionMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("GetPromotion")
 	kargoServiceWatchPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotion")
 	kargoServiceAbortPromotionMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("AbortPromotion")
+	kargoServiceListArchivedRecordsMethodDescriptor           = kargoServiceServiceDescriptor.Methods().ByName("ListArchivedRecords")
+	kargoServiceGetArchivedRecordMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("GetArchivedRecord")
 	kargoServiceDeleteProjectMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("DeleteProject")
 	kargoServiceGetProjectMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("GetProject")
 	kargoServiceListProjectsMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("ListProjects")
@@ -427,6 +435,10 @@ type KargoServiceClient interface {
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
 	// AbortPromotion cancels a running promotion process.
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	// ListArchivedRecords retrieves Promotions and Freight archived by the garbage collector, optionally filtered by kind, stage, or freight.
+	ListArchivedRecords(context.Context, *connect.Request[v1alpha1.ListArchivedRecordsRequest]) (*connect.Response[v1alpha1.ListArchivedRecordsResponse], error)
+	// GetArchivedRecord retrieves a Promotion or Freight archived by the garbage collector.
+	GetArchivedRecord(context.Context, *connect.Request[v1alpha1.GetArchivedRecordRequest]) (*connect.Response[v1alpha1.GetArchivedRecordResponse], error)
 	// DeleteProject removes a project and all associated resources.
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	// GetProject retrieves details of a specific project.
@@ -720,6 +732,18 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listArchivedRecords: connect.NewClient[v1alpha1.ListArchivedRecordsRequest, v1alpha1.ListArchivedRecordsResponse](
+			httpClient,
+			baseURL+KargoServiceListArchivedRecordsProcedure,
+			connect.WithSchema(kargoServiceListArchivedRecordsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getArchivedRecord: connect.NewClient[v1alpha1.GetArchivedRecordRequest, v1alpha1.GetArchivedRecordResponse](
+			httpClient,
+			baseURL+KargoServiceGetArchivedRecordProcedure,
+			connect.WithSchema(kargoServiceGetArchivedRecordMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteProjectProcedure,
@@ -1138,6 +1162,8 @@ type kargoServiceClient struct {
 	getPromotion                  *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion                *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
 	abortPromotion                *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
+	listArchivedRecords           *connect.Client[v1alpha1.ListArchivedRecordsRequest, v1alpha1.ListArchivedRecordsResponse]
+	getArchivedRecord             *connect.Client[v1alpha1.GetArchivedRecordRequest, v1alpha1.GetArchivedRecordResponse]
 	deleteProject                 *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
 	getProject                    *connect.Client[v1alpha1.GetProjectRequest, v1alpha1.GetProjectResponse]
 	listProjects                  *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
@@ -1321,6 +1347,16 @@ func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Re
 	return c.abortPromotion.CallUnary(ctx, req)
 }
 
+// ListArchivedRecords calls akuity.io.kargo.service.v1alpha1.KargoService.ListArchivedRecords.
+func (c *kargoServiceClient) ListArchivedRecords(ctx context.Context, req *connect.Request[v1alpha1.ListArchivedRecordsRequest]) (*connect.Response[v1alpha1.ListArchivedRecordsResponse], error) {
+	return c.listArchivedRecords.CallUnary(ctx, req)
+}
+
+// GetArchivedRecord calls akuity.io.kargo.service.v1alpha1.KargoService.GetArchivedRecord.
+func (c *kargoServiceClient) GetArchivedRecord(ctx context.Context, req *connect.Request[v1alpha1.GetArchivedRecordRequest]) (*connect.Response[v1alpha1.GetArchivedRecordResponse], error) {
+	return c.getArchivedRecord.CallUnary(ctx, req)
+}
+
 // DeleteProject calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject.
 func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -1707,6 +1743,10 @@ type KargoServiceHandler interface {
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
 	// AbortPromotion cancels a running promotion process.
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	// ListArchivedRecords retrieves Promotions and Freight archived by the garbage collector, optionally filtered by kind, stage, or freight.
+	ListArchivedRecords(context.Context, *connect.Request[v1alpha1.ListArchivedRecordsRequest]) (*connect.Response[v1alpha1.ListArchivedRecordsResponse], error)
+	// GetArchivedRecord retrieves a Promotion or Freight archived by the garbage collector.
+	GetArchivedRecord(context.Context, *connect.Request[v1alpha1.GetArchivedRecordRequest]) (*connect.Response[v1alpha1.GetArchivedRecordResponse], error)
 	// DeleteProject removes a project and all associated resources.
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	// GetProject retrieves details of a specific project.
@@ -1996,6 +2036,18 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceListArchivedRecordsHandler := connect.NewUnaryHandler(
+		KargoServiceListArchivedRecordsProcedure,
+		svc.ListArchivedRecords,
+		connect.WithSchema(kargoServiceListArchivedRecordsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceGetArchivedRecordHandler := connect.NewUnaryHandler(
+		KargoServiceGetArchivedRecordProcedure,
+		svc.GetArchivedRecord,
+		connect.WithSchema(kargoServiceGetArchivedRecordMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -2434,6 +2486,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceAbortPromotionProcedure:
 			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceListArchivedRecordsProcedure:
+			kargoServiceListArchivedRecordsHandler.ServeHTTP(w, r)
+		case KargoServiceGetArchivedRecordProcedure:
+			kargoServiceGetArchivedRecordHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceGetProjectProcedure:
@@ -2665,6 +2721,14 @@ func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ListArchivedRecords(context.Context, *connect.Request[v1alpha1.ListArchivedRecordsRequest]) (*connect.Response[v1alpha1.ListArchivedRecordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListArchivedRecords is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) GetArchivedRecord(context.Context, *connect.Request[v1alpha1.GetArchivedRecordRequest]) (*connect.Response[v1alpha1.GetArchivedRecordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetArchivedRecord is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
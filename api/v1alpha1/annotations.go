@@ -66,6 +66,68 @@ const (
 	// contains resources that should not be deleted.
 	AnnotationKeyKeepNamespace = "kargo.akuity.io/keep-namespace"
 
+	// AnnotationKeyNotified is an annotation key that is set on an Event by the
+	// Kargo management controller once notifications of the Event have been
+	// delivered to all applicable destinations. Its presence prevents the same
+	// Event from being delivered more than once.
+	AnnotationKeyNotified = "kargo.akuity.io/notified"
+
+	// AnnotationKeyNotificationDeliveries is an annotation key that is set on an
+	// Event by the Kargo management controller while notifications of the Event
+	// are being delivered. Its value is a JSON object recording the progress of
+	// delivery to each applicable destination, so that failed deliveries can be
+	// retried without blocking the delivery of notifications of other Events.
+	AnnotationKeyNotificationDeliveries = "kargo.akuity.io/notification-deliveries"
+
+	// AnnotationKeyPromotionCalendarOverride is an annotation key that can be
+	// set on a Promotion to allow it to be created despite a PromotionCalendar
+	// that would otherwise deny it (i.e. to "break glass"). The value of the
+	// annotation must be a non-empty explanation of why the override is needed.
+	AnnotationKeyPromotionCalendarOverride = "kargo.akuity.io/promotion-calendar-override"
+
+	// AnnotationKeyPromotionCalendarOverrideActor is an annotation key that is
+	// set on a Promotion by the Kargo control plane to record the actor that
+	// overrode a PromotionCalendar. It cannot be set by users.
+	AnnotationKeyPromotionCalendarOverrideActor = "kargo.akuity.io/promotion-calendar-override-actor"
+
+	// AnnotationKeyPromotionCalendarOverrideAuthorized is an annotation key that
+	// is set on a Promotion by the Kargo API server to indicate that it has
+	// already authorized the PromotionCalendar override on behalf of the user
+	// who requested it. It is only honored on requests from the Kargo control
+	// plane and cannot be set by users.
+	AnnotationKeyPromotionCalendarOverrideAuthorized = "kargo.akuity.io/promotion-calendar-override-authorized"
+
+	// AnnotationKeyRollbackReason is an annotation key that is set on a
+	// Promotion by the Kargo controller when it automatically rolls a Stage
+	// back from Freight that failed verification. The value of the annotation
+	// explains why the rollback was performed.
+	AnnotationKeyRollbackReason = "kargo.akuity.io/rollback-reason"
+
+	// AnnotationKeyImageCosignSigner is an annotation key that is set on an
+	// Image in Freight by the Kargo controller to record the signer of a cosign
+	// signature that the image was verified against. For keyless signatures,
+	// this is the identity to which the signing certificate was issued. For
+	// signatures created with a key pair, it is the SHA-256 fingerprint of the
+	// public key.
+	AnnotationKeyImageCosignSigner = "kargo.akuity.io/cosign-signer"
+
+	// AnnotationKeyImageCosignIssuer is an annotation key that is set on an
+	// Image in Freight by the Kargo controller to record the OIDC issuer that
+	// authenticated the signer of a keyless cosign signature that the image was
+	// verified against.
+	AnnotationKeyImageCosignIssuer = "kargo.akuity.io/cosign-issuer"
+
+	// AnnotationKeyImageAttestationPredicateType is an annotation key that is
+	// set on an Image in Freight by the Kargo controller to record the
+	// predicate type of an in-toto attestation that the image was verified to
+	// have.
+	AnnotationKeyImageAttestationPredicateType = "kargo.akuity.io/attestation-predicate-type"
+
+	// AnnotationKeyImageNotationSigner is an annotation key that is set on an
+	// Image in Freight by the Kargo controller to record the subject of the
+	// certificate of a Notation signature that the image was verified against.
+	AnnotationKeyImageNotationSigner = "kargo.akuity.io/notation-signer"
+
 	// AnnotationValueTrue is the value used to indicate that an annotation
 	// is set to true.
 	AnnotationValueTrue = "true"
@@ -12,12 +12,16 @@ const (
 	AnnotationKeyEventFreightCommits         = AnnotationKeyEventPrefix + "freight-commits"
 	AnnotationKeyEventFreightImages          = AnnotationKeyEventPrefix + "freight-images"
 	AnnotationKeyEventFreightCharts          = AnnotationKeyEventPrefix + "freight-charts"
+	AnnotationKeyEventFreightArtifacts       = AnnotationKeyEventPrefix + "freight-artifacts"
+	AnnotationKeyEventFreightObjects         = AnnotationKeyEventPrefix + "freight-objects"
 	AnnotationKeyEventStageName              = AnnotationKeyEventPrefix + "stage-name"
 	AnnotationKeyEventAnalysisRunName        = AnnotationKeyEventPrefix + "analysis-run-name"
 	AnnotationKeyEventVerificationPending    = AnnotationKeyEventPrefix + "verification-pending"
 	AnnotationKeyEventVerificationStartTime  = AnnotationKeyEventPrefix + "verification-start-time"
 	AnnotationKeyEventVerificationFinishTime = AnnotationKeyEventPrefix + "verification-finish-time"
 	AnnotationKeyEventApplications           = AnnotationKeyEventPrefix + "applications"
+	AnnotationKeyEventRolledBackFreightName  = AnnotationKeyEventPrefix + "rolled-back-freight-name"
+	AnnotationKeyEventRollbackReason         = AnnotationKeyEventPrefix + "rollback-reason"
 )
 
 const (
@@ -26,6 +30,7 @@ const (
 	EventTypePromotionFailed                 EventType = "PromotionFailed"
 	EventTypePromotionErrored                EventType = "PromotionErrored"
 	EventTypePromotionAborted                EventType = "PromotionAborted"
+	EventTypePromotionRollbackCreated        EventType = "PromotionRollbackCreated"
 	EventTypeFreightApproved                 EventType = "FreightApproved"
 	EventTypeFreightVerificationSucceeded    EventType = "FreightVerificationSucceeded"
 	EventTypeFreightVerificationFailed       EventType = "FreightVerificationFailed"
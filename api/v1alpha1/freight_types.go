@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -40,6 +41,11 @@ type Freight struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,5,rep,name=charts"`
+	// Artifacts describes specific versions of specific OCI artifacts.
+	Artifacts []OCIArtifact `json:"artifacts,omitempty" protobuf:"bytes,10,rep,name=artifacts"`
+	// Objects describes specific versions of specific objects in S3-compatible
+	// buckets.
+	Objects []BucketObject `json:"objects,omitempty" protobuf:"bytes,11,rep,name=objects"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
 }
@@ -67,6 +73,9 @@ type GitCommit struct {
 	Author string `json:"author,omitempty" protobuf:"bytes,7,opt,name=author"`
 	// Committer is the person who committed the commit.
 	Committer string `json:"committer,omitempty" protobuf:"bytes,8,opt,name=committer"`
+	// Signer is the identity of the trusted signer of the commit, or of the tag
+	// that resolved to it, if its signature was verified.
+	Signer string `json:"signer,omitempty" protobuf:"bytes,9,opt,name=signer"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -84,7 +93,8 @@ func (g *GitCommit) DeepEquals(other *GitCommit) bool {
 		g.Tag == other.Tag &&
 		g.Message == other.Message &&
 		g.Author == other.Author &&
-		g.Committer == other.Committer
+		g.Committer == other.Committer &&
+		g.Signer == other.Signer
 }
 
 // Equals returns a bool indicating whether two GitCommits are equivalent.
@@ -111,6 +121,15 @@ type FreightStatus struct {
 	// might wish to promote a piece of Freight to a given Stage without
 	// transiting the entire pipeline.
 	ApprovedFor map[string]ApprovedStage `json:"approvedFor,omitempty" protobuf:"bytes,2,rep,name=approvedFor" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Approvals records, by Stage name, the individual approvals this Freight
+	// has received. For a Stage with an ApprovalPolicy, the Freight is only
+	// added to ApprovedFor once these approvals satisfy the policy.
+	Approvals map[string]StageApprovals `json:"approvals,omitempty" protobuf:"bytes,5,rep,name=approvals" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// RolledBackFrom describes the Stages that have been automatically rolled
+	// back from this Freight after it failed verification in them. A Stage is
+	// never automatically rolled back from the same Freight twice, nor
+	// automatically promoted to Freight it has been rolled back from.
+	RolledBackFrom map[string]RolledBackStage `json:"rolledBackFrom,omitempty" protobuf:"bytes,6,rep,name=rolledBackFrom" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Metadata is a map of arbitrary metadata associated with the Freight.
 	// This is useful for storing additional information about the Freight
 	// or Promotion that can be shared across steps or stages.
@@ -233,6 +252,61 @@ func (f *FreightStatus) AddApprovedStage(stage string, approvedAt time.Time) {
 	}
 }
 
+// IsRolledBackFrom returns whether the specified Stage has been automatically
+// rolled back from the Freight.
+func (f *Freight) IsRolledBackFrom(stage string) bool {
+	// NB: This method exists for convenience. It doesn't require the caller to
+	// know anything about the Freight status' internal data structure.
+	_, rolledBack := f.Status.RolledBackFrom[stage]
+	return rolledBack
+}
+
+// AddRolledBackStage updates the Freight status to reflect that the specified
+// Stage has been automatically rolled back from the Freight by the specified
+// Promotion.
+func (f *FreightStatus) AddRolledBackStage(
+	stage string,
+	promotion string,
+	rolledBackAt time.Time,
+) {
+	if _, rolledBack := f.RolledBackFrom[stage]; !rolledBack {
+		record := RolledBackStage{
+			RolledBackAt: &metav1.Time{Time: rolledBackAt},
+			Promotion:    promotion,
+		}
+		if f.RolledBackFrom == nil {
+			f.RolledBackFrom = map[string]RolledBackStage{stage: record}
+		}
+		f.RolledBackFrom[stage] = record
+	}
+}
+
+// GetApprovals returns the individual approvals the Freight has received for
+// the specified Stage.
+func (f *Freight) GetApprovals(stage string) []Approval {
+	return f.Status.Approvals[stage].Approvals
+}
+
+// IsApprovedBy returns whether the specified actor has approved the Freight
+// for the specified Stage.
+func (f *Freight) IsApprovedBy(stage, actor string) bool {
+	return slices.ContainsFunc(
+		f.GetApprovals(stage),
+		func(a Approval) bool { return a.Actor == actor },
+	)
+}
+
+// AddApproval updates the Freight status to record an individual approval of
+// the Freight for the specified Stage.
+func (f *FreightStatus) AddApproval(stage string, approval Approval) {
+	if f.Approvals == nil {
+		f.Approvals = make(map[string]StageApprovals)
+	}
+	record := f.Approvals[stage]
+	record.Approvals = append(record.Approvals, approval)
+	f.Approvals[stage] = record
+}
+
 // UpsertMetadata inserts or updates the given key in Freight status Metadata
 func (f *FreightStatus) UpsertMetadata(key string, data any) error {
 	if len(f.Metadata) == 0 {
@@ -294,6 +368,38 @@ type ApprovedStage struct {
 	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,1,opt,name=approvedAt"`
 }
 
+// RolledBackStage describes a Stage that has been automatically rolled back
+// from Freight.
+type RolledBackStage struct {
+	// RolledBackAt is the time at which the Stage was rolled back from the
+	// Freight.
+	RolledBackAt *metav1.Time `json:"rolledBackAt,omitempty" protobuf:"bytes,1,opt,name=rolledBackAt"`
+	// Promotion is the name of the Promotion that rolled the Stage back to
+	// previously verified Freight.
+	Promotion string `json:"promotion,omitempty" protobuf:"bytes,2,opt,name=promotion"`
+}
+
+// StageApprovals describes the individual approvals that Freight has received
+// for a Stage.
+type StageApprovals struct {
+	// Approvals is the list of individual approvals, in the order in which they
+	// were given.
+	Approvals []Approval `json:"approvals,omitempty" protobuf:"bytes,1,rep,name=approvals"`
+}
+
+// Approval describes an individual approval of Freight for a Stage.
+type Approval struct {
+	// Actor identifies who gave the approval.
+	Actor string `json:"actor" protobuf:"bytes,1,opt,name=actor"`
+	// Groups is the list of groups the actor was a member of when they gave
+	// the approval.
+	//
+	// +optional
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,2,rep,name=groups"`
+	// ApprovedAt is the time at which the approval was given.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,3,opt,name=approvedAt"`
+}
+
 // +kubebuilder:object:root=true
 
 // FreightList is a list of Freight resources.
@@ -122,6 +122,20 @@ func TestGitCommitDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "signers differ",
+			a: &GitCommit{
+				RepoURL: "fake-url",
+				ID:      "fake-commit-id",
+				Signer:  "foo",
+			},
+			b: &GitCommit{
+				RepoURL: "fake-url",
+				ID:      "fake-commit-id",
+				Signer:  "bar",
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &GitCommit{
@@ -132,6 +146,7 @@ func TestGitCommitDeepEquals(t *testing.T) {
 				Message:   "fake-message",
 				Author:    "fake-author",
 				Committer: "fake-committer",
+				Signer:    "fake-signer",
 			},
 			b: &GitCommit{
 				RepoURL:   "fake-url",
@@ -141,6 +156,7 @@ func TestGitCommitDeepEquals(t *testing.T) {
 				Message:   "fake-message",
 				Author:    "fake-author",
 				Committer: "fake-committer",
+				Signer:    "fake-signer",
 			},
 			expectedResult: true,
 		},
@@ -247,6 +263,24 @@ func TestFreight_IsApprovedFor(t *testing.T) {
 	require.True(t, freight.IsApprovedFor(testStage))
 }
 
+func TestFreight_IsRolledBackFrom(t *testing.T) {
+	const testStage = "fake-stage"
+	freight := &Freight{}
+	require.False(t, freight.IsRolledBackFrom(testStage))
+	freight.Status.RolledBackFrom = map[string]RolledBackStage{testStage: {}}
+	require.True(t, freight.IsRolledBackFrom(testStage))
+}
+
+func TestFreight_IsApprovedBy(t *testing.T) {
+	const testStage = "fake-stage"
+	freight := &Freight{}
+	require.False(t, freight.IsApprovedBy(testStage, "kubernetes:jane"))
+	freight.Status.AddApproval(testStage, Approval{Actor: "kubernetes:jane"})
+	require.True(t, freight.IsApprovedBy(testStage, "kubernetes:jane"))
+	require.False(t, freight.IsApprovedBy(testStage, "kubernetes:john"))
+	require.False(t, freight.IsApprovedBy("other-stage", "kubernetes:jane"))
+}
+
 func TestFreight_GetLongestSoak(t *testing.T) {
 	testStage := "fake-stage"
 	testCases := []struct {
@@ -468,6 +502,49 @@ func TestFreightStatus_AddApprovedStage(t *testing.T) {
 	})
 }
 
+func TestFreightStatus_AddRolledBackStage(t *testing.T) {
+	const testStage = "fake-stage"
+	now := time.Now()
+	t.Run("already rolled back", func(t *testing.T) {
+		oldTime := now.Add(-time.Hour)
+		status := FreightStatus{
+			RolledBackFrom: map[string]RolledBackStage{
+				testStage: {
+					RolledBackAt: &metav1.Time{Time: oldTime},
+					Promotion:    "old-promotion",
+				},
+			},
+		}
+		status.AddRolledBackStage(testStage, "new-promotion", now)
+		record, rolledBack := status.RolledBackFrom[testStage]
+		require.True(t, rolledBack)
+		require.Equal(t, oldTime, record.RolledBackAt.Time)
+		require.Equal(t, "old-promotion", record.Promotion)
+	})
+	t.Run("not already rolled back", func(t *testing.T) {
+		status := FreightStatus{}
+		status.AddRolledBackStage(testStage, "fake-promotion", now)
+		require.NotNil(t, status.RolledBackFrom)
+		record, rolledBack := status.RolledBackFrom[testStage]
+		require.True(t, rolledBack)
+		require.Equal(t, now, record.RolledBackAt.Time)
+		require.Equal(t, "fake-promotion", record.Promotion)
+	})
+}
+
+func TestFreightStatus_AddApproval(t *testing.T) {
+	const testStage = "fake-stage"
+	status := FreightStatus{}
+	status.AddApproval(testStage, Approval{Actor: "kubernetes:jane"})
+	status.AddApproval(testStage, Approval{Actor: "kubernetes:john"})
+	require.Equal(
+		t,
+		[]Approval{{Actor: "kubernetes:jane"}, {Actor: "kubernetes:john"}},
+		status.Approvals[testStage].Approvals,
+	)
+	require.Empty(t, status.ApprovedFor)
+}
+
 func TestFreightStatus_UpsertMetadata(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -41,6 +41,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ProjectConfigList{},
 		&Promotion{},
 		&PromotionList{},
+		&PromotionPlan{},
+		&PromotionPlanList{},
 		&PromotionTask{},
 		&PromotionTaskList{},
 		&Warehouse{},
@@ -24,6 +24,11 @@ const (
 	// credentials. A Secret with this label value is expected to contain
 	// credentials for a container image registry.
 	LabelValueCredentialTypeImage = "image"
+	// LabelValueCredentialTypeBucket is the value for S3-compatible bucket
+	// credentials. A Secret with this label value is expected to contain an
+	// access key ID and secret access key (as its username and password) for
+	// an S3-compatible bucket.
+	LabelValueCredentialTypeBucket = "bucket"
 	// LabelValueCredentialTypeGeneric is the value for generic credentials.
 	// A Secret with this label can contain any type of credential, and is
 	// allowed to be managed through the Kargo API.
@@ -38,12 +43,26 @@ const (
 	// for a specific collection of Freight has this label set to the ID of the
 	// collection.
 	LabelKeyFreightCollection = "kargo.akuity.io/freight-collection"
+	// LabelKeyPromotionPlan is used to identify the PromotionPlan that a
+	// Promotion was created for.
+	LabelKeyPromotionPlan = "kargo.akuity.io/promotion-plan"
+	// LabelKeyRollbackFrom is used to identify a Promotion that was created to
+	// automatically roll a Stage back from failed Freight. Its value is the
+	// name of the Freight that failed verification.
+	LabelKeyRollbackFrom = "kargo.akuity.io/rollback-from"
 	// LabelKeyProject can be used to mark a namespace as a Project namespace
 	// by setting the value to "true". This allows Kargo to adopt a namespace
 	// that was created before the creation of the Project.
 	LabelKeyProject = "kargo.akuity.io/project"
 	// LabelKeyShard is used to identify the shard of a resource.
 	LabelKeyShard = "kargo.akuity.io/shard"
+	// LabelKeyVerificationServiceAccount is used to mark a ServiceAccount as
+	// eligible to run the Jobs of Kargo-native verification checks by setting
+	// the value to "true". Job checks may only name ServiceAccounts that are
+	// labeled as such, so that the ability to edit a Stage does not confer the
+	// ability to run arbitrary workloads as any ServiceAccount in the Stage's
+	// namespace.
+	LabelKeyVerificationServiceAccount = "kargo.akuity.io/verification"
 
 	// LabelValueTrue is used to identify a label that has a value of "true".
 	LabelValueTrue = "true"
@@ -35,6 +35,36 @@ type ProjectConfigSpec struct {
 	// WebhookReceivers describes Project-specific webhook receivers used for
 	// processing events from various external platforms
 	WebhookReceivers []WebhookReceiverConfig `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// Notifications describes Project-specific destinations to which
+	// notifications of selected Kargo events are sent.
+	//
+	// +listType=map
+	// +listMapKey=name
+	Notifications []NotificationConfig `json:"notifications,omitempty" protobuf:"bytes,3,rep,name=notifications"`
+	// CommitStatuses describes whether and how the outcomes of Promotions are
+	// reported to Git providers as statuses of the commits referenced by the
+	// Freight being promoted. If not specified, no statuses are reported.
+	//
+	// +optional
+	CommitStatuses *CommitStatusConfig `json:"commitStatuses,omitempty" protobuf:"bytes,4,opt,name=commitStatuses"`
+	// PromotionCalendars describes when Freight may or may not be promoted to
+	// selected Stages within the Project. All PromotionCalendars selecting a
+	// Stage apply to it, in addition to any PromotionCalendar defined by the
+	// Stage itself.
+	//
+	// +optional
+	PromotionCalendars []PromotionCalendarPolicy `json:"promotionCalendars,omitempty" protobuf:"bytes,5,rep,name=promotionCalendars"`
+	// GarbageCollection overrides the garbage collector's retention of
+	// Promotions and Freight within the Project. Any setting not specified
+	// falls back to the garbage collector's own configuration.
+	//
+	// +optional
+	GarbageCollection *GarbageCollectionPolicy `json:"garbageCollection,omitempty" protobuf:"bytes,6,opt,name=garbageCollection"`
+	// PromotionExecution describes where the steps of Promotions within the
+	// Project are executed. If not specified, the controller's default applies.
+	//
+	// +optional
+	PromotionExecution *PromotionExecutionConfig `json:"promotionExecution,omitempty" protobuf:"bytes,7,opt,name=promotionExecution"`
 }
 
 // ProjectConfigStatus describes the current status of a ProjectConfig.
@@ -58,6 +88,9 @@ type ProjectConfigStatus struct {
 	// WebhookReceivers describes the status of Project-specific webhook
 	// receivers.
 	WebhookReceivers []WebhookReceiverDetails `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// Notifications describes the status of the most recent delivery attempt
+	// for each of the Project's notification destinations.
+	Notifications []NotificationStatus `json:"notifications,omitempty" protobuf:"bytes,5,rep,name=notifications"`
 }
 
 // GetConditions implements the conditions.Getter interface.
@@ -94,6 +127,101 @@ type PromotionPolicy struct {
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty" protobuf:"varint,2,opt,name=autoPromotionEnabled"`
 }
 
+// PromotionCalendarPolicy applies recurring windows and ad-hoc freezes
+// governing when Freight may be promoted to selected Stages.
+type PromotionCalendarPolicy struct {
+	// StageSelector is a selector that matches the Stages to which this
+	// policy applies. If not specified, the policy applies to all Stages in
+	// the Project.
+	//
+	// +optional
+	StageSelector *PromotionPolicySelector `json:"stageSelector,omitempty" protobuf:"bytes,1,opt,name=stageSelector"`
+	// Windows is a list of recurring windows of time during which promotions
+	// are either allowed or denied.
+	//
+	// +optional
+	Windows []PromotionWindow `json:"windows,omitempty" protobuf:"bytes,2,rep,name=windows"`
+	// Freezes is a list of ad-hoc periods of time during which promotions are
+	// denied. e.g. For holidays or during incidents.
+	//
+	// +optional
+	Freezes []PromotionFreeze `json:"freezes,omitempty" protobuf:"bytes,3,rep,name=freezes"`
+}
+
+// GarbageCollectionPolicy describes how Promotions and Freight within a
+// Project are retained by the garbage collector.
+type GarbageCollectionPolicy struct {
+	// MaxRetainedPromotions is the ideal maximum number of Promotions OLDER than
+	// the oldest in a non-terminal phase (for each Stage) that may be spared by
+	// the garbage collector. The ACTUAL number of Promotions spared may exceed
+	// this ideal if some Promotions that would otherwise be deleted do not meet
+	// the minimum age criterion.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetainedPromotions *int32 `json:"maxRetainedPromotions,omitempty" protobuf:"varint,1,opt,name=maxRetainedPromotions"`
+	// MinPromotionDeletionAge is the minimum age Promotions must be before
+	// they are considered eligible for garbage collection.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	// +optional
+	MinPromotionDeletionAge *metav1.Duration `json:"minPromotionDeletionAge,omitempty" protobuf:"bytes,2,opt,name=minPromotionDeletionAge"`
+	// MaxRetainedFreight is the ideal maximum number of Freight OLDER than the
+	// oldest still in use (from each Warehouse) that may be spared by the
+	// garbage collector. The ACTUAL number of Freight spared may exceed this
+	// ideal if some Freight that would otherwise be deleted do not meet the
+	// minimum age criterion.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetainedFreight *int32 `json:"maxRetainedFreight,omitempty" protobuf:"varint,3,opt,name=maxRetainedFreight"`
+	// MinFreightDeletionAge is the minimum age Freight must be before it is
+	// considered eligible for garbage collection.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	// +optional
+	MinFreightDeletionAge *metav1.Duration `json:"minFreightDeletionAge,omitempty" protobuf:"bytes,4,opt,name=minFreightDeletionAge"`
+	// ProtectedStageSelector selects Stages by label. Freight that is currently
+	// in, was ever verified in, or is recorded in the Freight history of any
+	// selected Stage is never deleted by the garbage collector, regardless of
+	// its age. e.g. To retain every Freight that ever reached production for
+	// auditing purposes.
+	//
+	// +optional
+	ProtectedStageSelector *metav1.LabelSelector `json:"protectedStageSelector,omitempty" protobuf:"bytes,5,opt,name=protectedStageSelector"`
+}
+
+// PromotionExecutionMode is the mode in which the steps of a Promotion are
+// executed.
+//
+// +kubebuilder:validation:Enum=Controller;Pod
+type PromotionExecutionMode string
+
+const (
+	// PromotionExecutionModeController executes the steps of a Promotion within
+	// the Kargo controller.
+	PromotionExecutionModeController PromotionExecutionMode = "Controller"
+	// PromotionExecutionModePod executes the steps of a Promotion in a
+	// short-lived Pod in the Project namespace.
+	PromotionExecutionModePod PromotionExecutionMode = "Pod"
+)
+
+// PromotionExecutionConfig describes where the steps of Promotions within a
+// Project are executed.
+type PromotionExecutionConfig struct {
+	// Mode is the mode in which the steps of Promotions are executed. In
+	// "Controller" mode, steps are executed within the Kargo controller. In
+	// "Pod" mode, the steps of each Promotion are executed in a short-lived Pod
+	// in the Project namespace, isolating them from other Projects.
+	//
+	// +kubebuilder:validation:Required
+	Mode PromotionExecutionMode `json:"mode" protobuf:"bytes,1,opt,name=mode"`
+}
+
 // WebhookReceiverConfig describes the configuration for a single webhook
 // receiver.
 type WebhookReceiverConfig struct {
@@ -352,6 +480,147 @@ type WebhookReceiverDetails struct {
 	URL string `json:"url,omitempty" protobuf:"bytes,4,opt,name=url"`
 }
 
+// NotificationConfig describes a destination to which notifications of
+// selected Kargo events are sent.
+//
+// +kubebuilder:validation:XValidation:message="NotificationConfig must have exactly one of slack, teams, or webhook set",rule="(has(self.slack) ? 1 : 0) + (has(self.teams) ? 1 : 0) + (has(self.webhook) ? 1 : 0) == 1"
+type NotificationConfig struct {
+	// Name is the name of the notification destination.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// EventTypes is a list of the types of events (e.g. PromotionFailed or
+	// FreightVerificationFailed) for which notifications should be sent. If
+	// empty, notifications are sent for all Kargo events.
+	//
+	// +optional
+	EventTypes []EventType `json:"eventTypes,omitempty" protobuf:"bytes,2,rep,name=eventTypes,casttype=EventType"`
+	// Stages is a list of the names of Stages for which notifications should be
+	// sent. If empty, notifications are sent for events related to any Stage in
+	// the Project.
+	//
+	// +optional
+	Stages []string `json:"stages,omitempty" protobuf:"bytes,3,rep,name=stages"`
+	// MaxAttempts is the maximum number of attempts that will be made to deliver
+	// a single notification before giving up. If not specified, the default is
+	// 3.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +kubebuilder:default=3
+	// +optional
+	MaxAttempts int32 `json:"maxAttempts,omitempty" protobuf:"varint,4,opt,name=maxAttempts"`
+	// Slack contains the configuration for delivering notifications to a Slack
+	// incoming webhook.
+	Slack *SlackNotificationConfig `json:"slack,omitempty" protobuf:"bytes,5,opt,name=slack"`
+	// Teams contains the configuration for delivering notifications to a
+	// Microsoft Teams incoming webhook.
+	Teams *TeamsNotificationConfig `json:"teams,omitempty" protobuf:"bytes,6,opt,name=teams"`
+	// Webhook contains the configuration for delivering notifications to an
+	// arbitrary HTTP endpoint as JSON.
+	Webhook *WebhookNotificationConfig `json:"webhook,omitempty" protobuf:"bytes,7,opt,name=webhook"`
+}
+
+// SlackNotificationConfig describes the delivery of notifications to a Slack
+// incoming webhook.
+type SlackNotificationConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL of the Slack incoming webhook. For more information please refer
+	// to the Slack documentation:
+	//   https://api.slack.com/messaging/webhooks
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+	// Text is a template for the text of the message sent to Slack. It may
+	// contain expressions offset by ${{ and }}, which are evaluated against the
+	// event. If not specified, a message summarizing the event is sent.
+	//
+	// +optional
+	Text string `json:"text,omitempty" protobuf:"bytes,2,opt,name=text"`
+}
+
+// TeamsNotificationConfig describes the delivery of notifications to a
+// Microsoft Teams incoming webhook.
+type TeamsNotificationConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL of the Microsoft Teams incoming webhook. For more information
+	// please refer to the Microsoft Teams documentation:
+	//   https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/add-incoming-webhook
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+	// Text is a template for the text of the message sent to Microsoft Teams.
+	// It may contain expressions offset by ${{ and }}, which are evaluated
+	// against the event. If not specified, a message summarizing the event is
+	// sent.
+	//
+	// +optional
+	Text string `json:"text,omitempty" protobuf:"bytes,2,opt,name=text"`
+}
+
+// WebhookNotificationConfig describes the delivery of notifications to an
+// arbitrary HTTP endpoint as JSON.
+type WebhookNotificationConfig struct {
+	// SecretRef contains a reference to a Secret in the same namespace as the
+	// ProjectConfig.
+	//
+	// The Secret's data map is expected to contain a `url` key whose value is
+	// the URL to which notifications are POSTed. It may optionally contain an
+	// `authorization` key whose value is used verbatim as the value of the
+	// Authorization header of each request.
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+	// Body is a JSON template for the body of each request. Expressions offset
+	// by ${{ and }} may be used within values and are evaluated against the
+	// event. If not specified, the event itself is sent as JSON.
+	//
+	// +optional
+	Body string `json:"body,omitempty" protobuf:"bytes,2,opt,name=body"`
+	// InsecureSkipTLSVerify specifies whether certificate verification should
+	// be skipped when delivering notifications to the endpoint.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,3,opt,name=insecureSkipTLSVerify"`
+}
+
+// NotificationStatus describes the outcome of the most recent attempt to
+// deliver a notification to a single destination.
+type NotificationStatus struct {
+	// Name is the name of the notification destination.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// LastEventType is the type of the event most recently delivered (or
+	// attempted to be delivered) to the destination.
+	LastEventType EventType `json:"lastEventType,omitempty" protobuf:"bytes,2,opt,name=lastEventType,casttype=EventType"`
+	// LastEventID is the unique identifier of the event most recently delivered
+	// (or attempted to be delivered) to the destination.
+	LastEventID string `json:"lastEventID,omitempty" protobuf:"bytes,3,opt,name=lastEventID"`
+	// LastAttemptTime is the time of the most recent delivery attempt.
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty" protobuf:"bytes,4,opt,name=lastAttemptTime"`
+	// LastSuccessTime is the time of the most recent successful delivery.
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty" protobuf:"bytes,5,opt,name=lastSuccessTime"`
+	// Succeeded indicates whether the most recent delivery succeeded.
+	Succeeded bool `json:"succeeded" protobuf:"varint,6,opt,name=succeeded"`
+	// Attempts is the number of attempts made during the most recent delivery.
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,7,opt,name=attempts"`
+	// ConsecutiveFailures is the number of deliveries that have failed since
+	// the last successful delivery.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty" protobuf:"varint,8,opt,name=consecutiveFailures"`
+	// Message is a human-readable description of the outcome of the most
+	// recent delivery.
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
+}
+
 // PromotionPolicySelector is a selector that matches the resource to which
 // this policy applies. It can be used to match a specific resource by name or
 // to match a set of resources by label.
@@ -397,3 +666,38 @@ type ProjectConfigList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []ProjectConfig `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// CommitStatusConfig describes how the outcomes of Promotions are reported to
+// Git providers as statuses of the commits referenced by the Freight being
+// promoted.
+type CommitStatusConfig struct {
+	// Context is the prefix of the context (sometimes called a name or key)
+	// under which commit statuses are reported. The name of the Stage is
+	// appended to it, so that each Stage's status is reported separately. If
+	// not specified, the default is "kargo".
+	//
+	// +kubebuilder:validation:MaxLength=100
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`
+	// +optional
+	Context string `json:"context,omitempty" protobuf:"bytes,1,opt,name=context"`
+	// Stages is a list of the names of Stages for which commit statuses should
+	// be reported. If empty, statuses are reported for Promotions to any Stage
+	// in the Project.
+	//
+	// +optional
+	Stages []string `json:"stages,omitempty" protobuf:"bytes,2,rep,name=stages"`
+	// Deployments indicates whether, in addition to a commit status, the
+	// outcome of each Promotion should be recorded as a deployment of the
+	// commit to an environment named after the Stage. This is only supported
+	// by Git providers with a concept of deployments or environments, such as
+	// GitHub and GitLab.
+	//
+	// +optional
+	Deployments bool `json:"deployments,omitempty" protobuf:"varint,3,opt,name=deployments"`
+	// InsecureSkipTLSVerify indicates whether TLS verification should be
+	// skipped when communicating with Git providers' APIs. This is only
+	// advisable for self-hosted Git providers using self-signed certificates.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipTLSVerify"`
+}
@@ -0,0 +1,255 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PromotionPlanPhaseRunning denotes a PromotionPlan whose waves are
+	// actively being rolled out.
+	PromotionPlanPhaseRunning PromotionPlanPhase = "Running"
+	// PromotionPlanPhasePaused denotes a PromotionPlan that has stopped
+	// progressing because a Promotion or health gate in its current wave
+	// failed. A paused PromotionPlan resumes, retrying whatever failed, when a
+	// refresh is requested.
+	PromotionPlanPhasePaused PromotionPlanPhase = "Paused"
+	// PromotionPlanPhaseSucceeded denotes a PromotionPlan all of whose waves
+	// have completed successfully.
+	PromotionPlanPhaseSucceeded PromotionPlanPhase = "Succeeded"
+	// PromotionPlanPhaseFailed denotes a PromotionPlan that was abandoned
+	// because a Promotion or health gate in one of its waves failed.
+	PromotionPlanPhaseFailed PromotionPlanPhase = "Failed"
+)
+
+// PromotionPlanPhase is a high-level summary of the current state of a
+// PromotionPlan.
+type PromotionPlanPhase string
+
+// IsTerminal returns true if the PromotionPlanPhase is a terminal one.
+func (p *PromotionPlanPhase) IsTerminal() bool {
+	switch *p {
+	case PromotionPlanPhaseSucceeded, PromotionPlanPhaseFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	// PromotionWavePhasePending denotes a wave that has not been started yet.
+	PromotionWavePhasePending PromotionWavePhase = "Pending"
+	// PromotionWavePhasePromoting denotes a wave whose Promotions are being
+	// created and executed.
+	PromotionWavePhasePromoting PromotionWavePhase = "Promoting"
+	// PromotionWavePhaseGating denotes a wave whose Promotions have all
+	// succeeded and that is waiting for its health gate to pass.
+	PromotionWavePhaseGating PromotionWavePhase = "Gating"
+	// PromotionWavePhaseSoaking denotes a wave that is waiting for its soak
+	// time to elapse before the next wave is started.
+	PromotionWavePhaseSoaking PromotionWavePhase = "Soaking"
+	// PromotionWavePhaseSucceeded denotes a wave that has completed
+	// successfully.
+	PromotionWavePhaseSucceeded PromotionWavePhase = "Succeeded"
+	// PromotionWavePhaseFailed denotes a wave that was abandoned because one
+	// of its Promotions or its health gate failed.
+	PromotionWavePhaseFailed PromotionWavePhase = "Failed"
+)
+
+// PromotionWavePhase is a high-level summary of the current state of a single
+// wave of a PromotionPlan.
+type PromotionWavePhase string
+
+// PromotionPlanFailurePolicy describes how a PromotionPlan reacts to the
+// failure of a Promotion or health gate.
+//
+// +kubebuilder:validation:Enum=Pause;Abort
+type PromotionPlanFailurePolicy string
+
+const (
+	// PromotionPlanFailurePolicyPause causes a PromotionPlan to pause when a
+	// Promotion or health gate fails. Promotions that are already running are
+	// permitted to finish, but no new ones are started until the PromotionPlan
+	// is resumed by requesting a refresh.
+	PromotionPlanFailurePolicyPause PromotionPlanFailurePolicy = "Pause"
+	// PromotionPlanFailurePolicyAbort causes a PromotionPlan to fail when a
+	// Promotion or health gate fails. Any other Promotions in the same wave
+	// that are still running are aborted.
+	PromotionPlanFailurePolicyAbort PromotionPlanFailurePolicy = "Abort"
+)
+
+// +kubebuilder:resource:shortName={promoplan,promoplans}
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Freight,type=string,JSONPath=`.spec.freight`
+// +kubebuilder:printcolumn:name=Wave,type=integer,JSONPath=`.status.currentWave`
+// +kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PromotionPlan represents a request to promote a particular Freight into
+// several Stages in ordered waves, with health gates and soak time between
+// the waves.
+type PromotionPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec describes the waves in which the Freight is to be promoted.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="PromotionPlan spec is immutable",rule="self == oldSelf"
+	Spec PromotionPlanSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status describes the progress of the PromotionPlan.
+	Status PromotionPlanStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+func (p *PromotionPlan) GetStatus() *PromotionPlanStatus {
+	return &p.Status
+}
+
+// PromotionPlanSpec describes the waves in which a specific Freight is to be
+// promoted.
+type PromotionPlanSpec struct {
+	// Freight specifies the piece of Freight to be promoted into the Stages
+	// of every wave.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +akuity:test-kubebuilder-pattern=KubernetesName
+	Freight string `json:"freight" protobuf:"bytes,1,opt,name=freight"`
+	// Waves specifies the groups of Stages into which the Freight is to be
+	// promoted. Waves are rolled out one at a time, in the order in which they
+	// are listed. A wave is not started until the previous wave has succeeded.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Waves []PromotionWave `json:"waves" protobuf:"bytes,2,rep,name=waves"`
+	// FailurePolicy specifies how the PromotionPlan reacts to the failure of a
+	// Promotion or health gate. Pause (the default) stops the rollout until a
+	// refresh is requested. Abort fails the PromotionPlan and aborts any other
+	// running Promotions in the same wave.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Pause
+	FailurePolicy PromotionPlanFailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,3,opt,name=failurePolicy"`
+}
+
+// PromotionWave describes a group of Stages into which Freight is promoted in
+// parallel.
+type PromotionWave struct {
+	// Name is an optional name for the wave, used only for display purposes.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Stages specifies the names of the Stages into which the Freight is
+	// promoted as part of this wave. All Stages MUST be in the same namespace
+	// as the PromotionPlan, and no Stage may appear in more than one wave.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Stages []string `json:"stages" protobuf:"bytes,2,rep,name=stages"`
+	// MaxConcurrency is the maximum number of this wave's Promotions that may
+	// be running at once. Stages are promoted in the order in which they are
+	// listed. If unset or zero, all of the wave's Stages are promoted at once.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty" protobuf:"varint,3,opt,name=maxConcurrency"`
+	// HealthGate specifies conditions that every Stage in this wave must
+	// satisfy, after its Promotion has succeeded, before the wave is
+	// considered complete.
+	//
+	// +kubebuilder:validation:Optional
+	HealthGate *PromotionWaveHealthGate `json:"healthGate,omitempty" protobuf:"bytes,4,opt,name=healthGate"`
+	// SoakTime is the interval to wait after this wave has completed, and
+	// before the next wave is started.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	SoakTime *metav1.Duration `json:"soakTime,omitempty" protobuf:"bytes,5,opt,name=soakTime"`
+}
+
+// PromotionWaveHealthGate describes conditions that every Stage in a wave
+// must satisfy before the wave is considered complete.
+type PromotionWaveHealthGate struct {
+	// RequireHealthy specifies whether every Stage in the wave must report
+	// itself as Healthy.
+	RequireHealthy bool `json:"requireHealthy,omitempty" protobuf:"varint,1,opt,name=requireHealthy"`
+	// RequireVerified specifies whether the Freight must have been verified
+	// in every Stage in the wave.
+	RequireVerified bool `json:"requireVerified,omitempty" protobuf:"varint,2,opt,name=requireVerified"`
+	// Timeout is the maximum interval to wait for the gate to pass. If the
+	// gate has not passed by then, it is considered to have failed. If unset
+	// or zero, the gate waits indefinitely.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+}
+
+// PromotionPlanStatus describes the progress of a PromotionPlan.
+type PromotionPlanStatus struct {
+	// LastHandledRefresh holds the value of the most recent AnnotationKeyRefresh
+	// annotation that was handled by the controller. This field can be used to
+	// determine whether the request to refresh the resource has been handled.
+	// +optional
+	LastHandledRefresh string `json:"lastHandledRefresh,omitempty" protobuf:"bytes,1,opt,name=lastHandledRefresh"`
+	// Phase describes where the PromotionPlan currently is in its lifecycle.
+	Phase PromotionPlanPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Message is a display message about the PromotionPlan, including the
+	// reason it was paused or failed.
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// CurrentWave is the index of the wave that is currently being rolled out.
+	CurrentWave int32 `json:"currentWave" protobuf:"varint,4,opt,name=currentWave"`
+	// Waves describes the progress of each of the PromotionPlan's waves.
+	Waves []PromotionWaveStatus `json:"waves,omitempty" protobuf:"bytes,5,rep,name=waves"`
+	// FinishedAt is the time when the PromotionPlan reached a terminal phase.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+}
+
+// PromotionWaveStatus describes the progress of a single wave of a
+// PromotionPlan.
+type PromotionWaveStatus struct {
+	// Name is the name of the wave, if it has one.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Phase describes where the wave currently is in its lifecycle.
+	Phase PromotionWavePhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Stages describes the Promotion of the Freight into each of the wave's
+	// Stages.
+	Stages []PromotionPlanStageStatus `json:"stages,omitempty" protobuf:"bytes,3,rep,name=stages"`
+	// StartedAt is the time when the wave was started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
+	// PromotedAt is the time when all of the wave's Promotions had succeeded.
+	// The health gate's timeout is measured from this time.
+	PromotedAt *metav1.Time `json:"promotedAt,omitempty" protobuf:"bytes,5,opt,name=promotedAt"`
+	// GatePassedAt is the time when the wave's health gate passed. The soak
+	// time is measured from this time.
+	GatePassedAt *metav1.Time `json:"gatePassedAt,omitempty" protobuf:"bytes,6,opt,name=gatePassedAt"`
+	// FinishedAt is the time when the wave succeeded or failed.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
+}
+
+// PromotionPlanStageStatus describes the Promotion of a PromotionPlan's
+// Freight into a single Stage.
+type PromotionPlanStageStatus struct {
+	// Stage is the name of the Stage.
+	Stage string `json:"stage" protobuf:"bytes,1,opt,name=stage"`
+	// Promotion is the name of the most recent Promotion created for the
+	// Stage. It is empty if no Promotion has been created yet.
+	Promotion string `json:"promotion,omitempty" protobuf:"bytes,2,opt,name=promotion"`
+	// Phase is the last observed phase of the Promotion.
+	Phase PromotionPhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionPlanList contains a list of PromotionPlans.
+type PromotionPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []PromotionPlan `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -30,8 +30,28 @@ type PromotionTaskSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have uses set and must not reference another task",rule="has(self.uses) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have exactly one of uses or group set and must not reference another task",rule="(has(self.uses) ? !has(self.group) : has(self.group)) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step group cannot set if, continueOnError, retry, config or forEach",rule="!has(self.group) || !(has(self.if) || has(self.continueOnError) || has(self.retry) || has(self.config) || has(self.forEach))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step cannot set expansion",rule="!has(self.expansion)"
 	Steps []PromotionStep `json:"steps" protobuf:"bytes,2,rep,name=steps"`
+	// OnFailure specifies the directives to be executed, in order, if any of
+	// the Steps of a Promotion fail or error, or if the Promotion is aborted
+	// while its Steps are being executed. They are inflated into the OnFailure
+	// steps of a Promotion when it is built from a PromotionTemplate.
+	//
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have exactly one of uses or group set and must not reference another task",rule="(has(self.uses) ? !has(self.group) : has(self.group)) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step group cannot set if, continueOnError, retry, config or forEach",rule="!has(self.group) || !(has(self.if) || has(self.continueOnError) || has(self.retry) || has(self.config) || has(self.forEach))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step cannot set expansion",rule="!has(self.expansion)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,3,rep,name=onFailure"`
+	// Finally specifies the directives to be executed, in order, after the
+	// Steps and any OnFailure directives of a Promotion have been executed,
+	// regardless of their outcome. They are inflated into the Finally steps of
+	// a Promotion when it is built from a PromotionTemplate.
+	//
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step must have exactly one of uses or group set and must not reference another task",rule="(has(self.uses) ? !has(self.group) : has(self.group)) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step group cannot set if, continueOnError, retry, config or forEach",rule="!has(self.group) || !(has(self.if) || has(self.continueOnError) || has(self.retry) || has(self.config) || has(self.forEach))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTask step cannot set expansion",rule="!has(self.expansion)"
+	Finally []PromotionStep `json:"finally,omitempty" protobuf:"bytes,4,rep,name=finally"`
 }
 
 // +kubebuilder:object:root=true
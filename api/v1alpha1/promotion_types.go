@@ -135,6 +135,7 @@ func (s PromotionStepStatus) Compare(other PromotionStepStatus) int {
 // +kubebuilder:printcolumn:name=Stage,type=string,JSONPath=`.spec.stage`
 // +kubebuilder:printcolumn:name=Freight,type=string,JSONPath=`.spec.freight`
 // +kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Not Before",type=string,JSONPath=`.spec.notBefore`
 // +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Promotion represents a request to transition a particular Stage into a
@@ -189,6 +190,36 @@ type PromotionSpec struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
 	Steps []PromotionStep `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+	// OnFailure specifies the directives to be executed, in order, if any of
+	// the Steps fail or error, or if the Promotion is aborted while its Steps
+	// are being executed. The execution of these directives is recorded
+	// separately from the execution of the Steps.
+	//
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,6,rep,name=onFailure"`
+	// Finally specifies the directives to be executed, in order, after the
+	// Steps and any OnFailure directives have been executed, regardless of
+	// their outcome. The execution of these directives is recorded separately
+	// from the execution of the Steps.
+	//
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+	Finally []PromotionStep `json:"finally,omitempty" protobuf:"bytes,7,rep,name=finally"`
+	// NotBefore optionally specifies the earliest time at which the Promotion
+	// may start. Until then, the Promotion remains Pending and does not hold up
+	// any other Promotions to the same Stage. If unspecified, the Promotion may
+	// start as soon as the Stage is ready for it.
+	//
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty" protobuf:"bytes,5,opt,name=notBefore"`
+}
+
+// IsScheduledAfter returns true if the Promotion has not yet started and may
+// not start before a time later than the one provided.
+func (p *Promotion) IsScheduledAfter(t time.Time) bool {
+	return p.Spec.NotBefore != nil &&
+		p.Spec.NotBefore.After(t) &&
+		p.Status.Phase != PromotionPhaseRunning &&
+		!p.Status.Phase.IsTerminal()
 }
 
 // PromotionTaskReference describes a reference to a PromotionTask.
@@ -299,6 +330,99 @@ type PromotionStep struct {
 	// expressions in defining values at any level of this block.
 	// See https://docs.kargo.io/user-guide/reference-docs/expressions for details.
 	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,3,opt,name=config"`
+	// ForEach, if specified, expands this step into one step per item of a
+	// list when a Promotion is built from a PromotionTemplate. The item and its
+	// index are available to the expressions of each expanded step as `item`
+	// and `index`. ForEach may only be specified for steps that use a runner.
+	ForEach *PromotionStepForEach `json:"forEach,omitempty" protobuf:"bytes,9,opt,name=forEach"`
+	// Group, if specified, makes this step a group of steps that are executed
+	// concurrently. A group is expanded into its member steps when a Promotion
+	// is built from a PromotionTemplate. Group is mutually exclusive with Uses,
+	// Task, Config and ForEach.
+	Group *PromotionStepGroup `json:"group,omitempty" protobuf:"bytes,10,opt,name=group"`
+	// Expansion is set by Kargo on each step expanded from a step with a Group
+	// or ForEach. It is not meant to be specified by users.
+	//
+	// +optional
+	Expansion *PromotionStepExpansion `json:"expansion,omitempty" protobuf:"bytes,11,opt,name=expansion"`
+}
+
+// PromotionStepForEach describes how a step is expanded over a list of items.
+type PromotionStepForEach struct {
+	// Items is an expression that must evaluate to a list. The step is expanded
+	// into one step per item of the list. The expression has access to the
+	// Promotion's variables and context and to expression functions, but not to
+	// the outputs of other steps, as it is evaluated when the Promotion is built.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Items string `json:"items" protobuf:"bytes,1,opt,name=items"`
+	// Parallelism is the maximum number of expanded steps that are executed
+	// concurrently. If not specified, the expanded steps are executed one
+	// after the other.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Parallelism int64 `json:"parallelism,omitempty" protobuf:"varint,2,opt,name=parallelism"`
+}
+
+// PromotionStepGroup describes a group of steps that are executed
+// concurrently.
+type PromotionStepGroup struct {
+	// Steps are the members of the group.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Steps []PromotionStepGroupMember `json:"steps" protobuf:"bytes,1,rep,name=steps"`
+	// Parallelism is the maximum number of members of the group that are
+	// executed concurrently. If not specified, all members are executed
+	// concurrently.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Parallelism int64 `json:"parallelism,omitempty" protobuf:"varint,2,opt,name=parallelism"`
+}
+
+// PromotionStepGroupMember describes a step that is a member of a
+// PromotionStepGroup.
+type PromotionStepGroupMember struct {
+	// Uses identifies a runner that can execute this step.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Uses string `json:"uses" protobuf:"bytes,1,opt,name=uses"`
+	// As is the alias this step can be referred to as. If not specified, the
+	// alias of the group suffixed with the (1-based) index of the member is
+	// used.
+	As string `json:"as,omitempty" protobuf:"bytes,2,opt,name=as"`
+	// If is an optional expression that, if present, must evaluate to a boolean
+	// value. If the expression evaluates to false, the step will be skipped.
+	If string `json:"if,omitempty" protobuf:"bytes,3,opt,name=if"`
+	// ContinueOnError is a boolean value that, if set to true, will cause the
+	// Promotion to continue executing the next step even if this step fails.
+	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,4,opt,name=continueOnError"`
+	// Retry is the retry policy for this step.
+	Retry *PromotionStepRetry `json:"retry,omitempty" protobuf:"bytes,5,opt,name=retry"`
+	// Vars is a list of variables that can be referenced by expressions in
+	// the step's Config. The values override the values specified for the
+	// group.
+	Vars []ExpressionVariable `json:"vars,omitempty" protobuf:"bytes,6,rep,name=vars"`
+	// Config is opaque configuration for the step that is understood only by
+	// its runner.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,7,opt,name=config"`
+}
+
+// PromotionStepExpansion describes how a step was expanded from a step with a
+// Group or ForEach.
+type PromotionStepExpansion struct {
+	// Parent is the alias of the step this step was expanded from.
+	Parent string `json:"parent" protobuf:"bytes,1,opt,name=parent"`
+	// Parallelism is the maximum number of steps expanded from the same parent
+	// that are executed concurrently.
+	Parallelism int64 `json:"parallelism,omitempty" protobuf:"varint,2,opt,name=parallelism"`
+	// Index is the index of the item this step was expanded for, if it was
+	// expanded from a step with a ForEach.
+	Index *int64 `json:"index,omitempty" protobuf:"varint,3,opt,name=index"`
+	// Item is the item this step was expanded for, if it was expanded from a
+	// step with a ForEach.
+	Item *apiextensionsv1.JSON `json:"item,omitempty" protobuf:"bytes,4,opt,name=item"`
 }
 
 // GetAlias returns the As field, or a default value in the form of "step-<i>"
@@ -354,6 +478,30 @@ type PromotionStatus struct {
 	// State stores the state of the promotion process between reconciliation
 	// attempts.
 	State *apiextensionsv1.JSON `json:"state,omitempty" protobuf:"bytes,10,opt,name=state"`
+	// OnFailure describes the execution of the Promotion's OnFailure steps, if
+	// they have been executed.
+	OnFailure *PromotionStepSectionStatus `json:"onFailure,omitempty" protobuf:"bytes,13,opt,name=onFailure"`
+	// Finally describes the execution of the Promotion's Finally steps, if
+	// they have been executed.
+	Finally *PromotionStepSectionStatus `json:"finally,omitempty" protobuf:"bytes,14,opt,name=finally"`
+}
+
+// PromotionStepSectionStatus describes the execution of a section of a
+// Promotion's steps, such as its OnFailure or Finally steps, that is executed
+// separately from its main steps.
+type PromotionStepSectionStatus struct {
+	// Phase describes the outcome of the execution of the steps in the
+	// section. It is Running while the steps are being executed.
+	Phase PromotionPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase"`
+	// Message is a display message about the execution of the steps in the
+	// section.
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// CurrentStep is the index of the current step in the section being
+	// executed.
+	CurrentStep int64 `json:"currentStep,omitempty" protobuf:"varint,3,opt,name=currentStep"`
+	// StepExecutionMetadata tracks metadata pertaining to the execution of
+	// the individual steps in the section.
+	StepExecutionMetadata StepExecutionMetadataList `json:"stepExecutionMetadata,omitempty" protobuf:"bytes,4,rep,name=stepExecutionMetadata"`
 }
 
 // GetState returns the State field as unmarshalled YAML.
@@ -250,3 +250,54 @@ func TestStepExecutionMetadataList_HasFailures(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotion_IsScheduledAfter(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		promo     Promotion
+		scheduled bool
+	}{
+		{
+			name:      "not scheduled",
+			promo:     Promotion{},
+			scheduled: false,
+		},
+		{
+			name: "scheduled in the past",
+			promo: Promotion{
+				Spec: PromotionSpec{NotBefore: &metav1.Time{Time: now.Add(-time.Minute)}},
+			},
+			scheduled: false,
+		},
+		{
+			name: "scheduled in the future",
+			promo: Promotion{
+				Spec:   PromotionSpec{NotBefore: &metav1.Time{Time: now.Add(time.Minute)}},
+				Status: PromotionStatus{Phase: PromotionPhasePending},
+			},
+			scheduled: true,
+		},
+		{
+			name: "scheduled in the future but already running",
+			promo: Promotion{
+				Spec:   PromotionSpec{NotBefore: &metav1.Time{Time: now.Add(time.Minute)}},
+				Status: PromotionStatus{Phase: PromotionPhaseRunning},
+			},
+			scheduled: false,
+		},
+		{
+			name: "scheduled in the future but already aborted",
+			promo: Promotion{
+				Spec:   PromotionSpec{NotBefore: &metav1.Time{Time: now.Add(time.Minute)}},
+				Status: PromotionStatus{Phase: PromotionPhaseAborted},
+			},
+			scheduled: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.scheduled, tt.promo.IsScheduledAfter(now))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -226,6 +227,233 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// PromotionCalendar describes when Freight may or may not be promoted to
+	// the Stage. It is applied in ADDITION to any PromotionCalendars selecting
+	// the Stage at the ProjectConfig level.
+	//
+	// +optional
+	PromotionCalendar *PromotionCalendar `json:"promotionCalendar,omitempty" protobuf:"bytes,8,opt,name=promotionCalendar"`
+	// AutoRollback describes whether the Stage should automatically be rolled
+	// back to its previously verified Freight when verification of newly
+	// promoted Freight fails.
+	//
+	// +optional
+	AutoRollback *AutoRollback `json:"autoRollback,omitempty" protobuf:"bytes,9,opt,name=autoRollback"`
+	// ApprovalPolicy describes the approvals that Freight must receive before
+	// it is considered manually approved for the Stage. If not specified, a
+	// single approval from anyone permitted to promote to the Stage suffices.
+	//
+	// +optional
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty" protobuf:"bytes,10,opt,name=approvalPolicy"`
+}
+
+// AutoRollback describes automatic rollback of a Stage to its previously
+// verified Freight when verification of newly promoted Freight fails.
+//
+// To prevent a Stage from flapping between bad Freight, Freight is rolled
+// back from at most once per Stage, a Stage is never rolled back from Freight
+// that was itself promoted by a rollback, and Freight that a Stage has been
+// rolled back from is never auto-promoted to that Stage again.
+type AutoRollback struct {
+	// Enabled indicates whether automatic rollback is enabled for the Stage.
+	//
+	// +optional
+	Enabled bool `json:"enabled,omitempty" protobuf:"varint,1,opt,name=enabled"`
+}
+
+// ApprovalPolicy describes the approvals that Freight must receive before it
+// is considered manually approved for a Stage.
+type ApprovalPolicy struct {
+	// RequiredApprovals is the number of approvals from distinct approvers that
+	// Freight must receive. When Approvers are specified, each approval must
+	// also be attributable to a different one of them.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty" protobuf:"varint,1,opt,name=requiredApprovals"`
+	// Approvers is an optional list of users and groups whose approvals count
+	// towards RequiredApprovals. If not specified, approvals from anyone
+	// permitted to promote to the Stage count.
+	//
+	// +optional
+	Approvers []Approver `json:"approvers,omitempty" protobuf:"bytes,2,rep,name=approvers"`
+	// SeparationOfDuties, if true, prevents anyone from both approving Freight
+	// for the Stage and creating a Promotion of that Freight to the Stage,
+	// regardless of which they do first.
+	//
+	// +optional
+	SeparationOfDuties bool `json:"separationOfDuties,omitempty" protobuf:"varint,3,opt,name=separationOfDuties"`
+}
+
+// Approver identifies either a single user or a group of users whose approval
+// counts towards an ApprovalPolicy.
+//
+// +kubebuilder:validation:XValidation:message="Approver must have exactly one of user or group set",rule="has(self.user) ? !has(self.group) : has(self.group)"
+type Approver struct {
+	// User identifies a single user in the same format in which Kargo records
+	// actors. e.g. "email:jane@example.com" for a user of the Kargo API or
+	// "kubernetes:jane" for a user of the Kubernetes API.
+	//
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,1,opt,name=user"`
+	// Group is the name of a group. Any member of the group may approve on the
+	// group's behalf.
+	//
+	// +optional
+	Group string `json:"group,omitempty" protobuf:"bytes,2,opt,name=group"`
+}
+
+// Matches returns true if the provided Approval was given by the user or a
+// member of the group identified by the Approver.
+func (a Approver) Matches(approval Approval) bool {
+	if a.User != "" {
+		return approval.Actor == a.User
+	}
+	return a.Group != "" && slices.Contains(approval.Groups, a.Group)
+}
+
+// IsEligible returns true if the provided Approval counts towards the policy.
+func (p *ApprovalPolicy) IsEligible(approval Approval) bool {
+	if len(p.Approvers) == 0 {
+		return true
+	}
+	for _, approver := range p.Approvers {
+		if approver.Matches(approval) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSatisfiedBy returns true if the provided Approvals satisfy the policy.
+// Approvals are only counted once per distinct actor and, when Approvers are
+// specified, once per Approver.
+func (p *ApprovalPolicy) IsSatisfiedBy(approvals []Approval) bool {
+	required := max(int(p.RequiredApprovals), 1)
+	actors := make([]Approval, 0, len(approvals))
+	for _, approval := range approvals {
+		if !p.IsEligible(approval) || slices.ContainsFunc(
+			actors,
+			func(a Approval) bool { return a.Actor == approval.Actor },
+		) {
+			continue
+		}
+		actors = append(actors, approval)
+	}
+	if len(p.Approvers) == 0 {
+		return len(actors) >= required
+	}
+	// Find the largest number of approvals that can each be attributed to a
+	// different Approver. This is a maximum bipartite matching, found using
+	// augmenting paths.
+	actorFor := make([]int, len(p.Approvers))
+	for i := range actorFor {
+		actorFor[i] = -1
+	}
+	var augment func(actor int, visited []bool) bool
+	augment = func(actor int, visited []bool) bool {
+		for j, approver := range p.Approvers {
+			if visited[j] || !approver.Matches(actors[actor]) {
+				continue
+			}
+			visited[j] = true
+			if actorFor[j] == -1 || augment(actorFor[j], visited) {
+				actorFor[j] = actor
+				return true
+			}
+		}
+		return false
+	}
+	var matched int
+	for i := range actors {
+		if augment(i, make([]bool, len(p.Approvers))) {
+			if matched++; matched >= required {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PromotionCalendar describes when Freight may or may not be promoted to a
+// Stage. Freight may be promoted to a Stage only if no freeze is in effect, no
+// "Deny" window is open, and, if any "Allow" windows are defined, at least one
+// of them is open.
+type PromotionCalendar struct {
+	// Windows is a list of recurring windows of time during which promotions
+	// are either allowed or denied.
+	//
+	// +optional
+	Windows []PromotionWindow `json:"windows,omitempty" protobuf:"bytes,1,rep,name=windows"`
+	// Freezes is a list of ad-hoc periods of time during which promotions are
+	// denied. e.g. For holidays or during incidents.
+	//
+	// +optional
+	Freezes []PromotionFreeze `json:"freezes,omitempty" protobuf:"bytes,2,rep,name=freezes"`
+}
+
+// PromotionWindowKind specifies whether promotions are allowed or denied
+// while a PromotionWindow is open.
+//
+// +kubebuilder:validation:Enum={Allow,Deny}
+type PromotionWindowKind string
+
+const (
+	// PromotionWindowKindAllow represents a window during which promotions are
+	// allowed. When any such windows are defined, promotions are denied outside
+	// of them.
+	PromotionWindowKindAllow PromotionWindowKind = "Allow"
+	// PromotionWindowKindDeny represents a window during which promotions are
+	// denied.
+	PromotionWindowKindDeny PromotionWindowKind = "Deny"
+)
+
+// PromotionWindow describes a recurring window of time during which
+// promotions are either allowed or denied.
+type PromotionWindow struct {
+	// Kind specifies whether promotions are allowed or denied while the window
+	// is open.
+	//
+	// +kubebuilder:validation:Required
+	Kind PromotionWindowKind `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Schedule is a standard, five field cron expression (e.g. "0 9 * * MON-FRI")
+	// describing when the window opens.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is how long the window remains open each time it opens.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// TimeZone is the name of the IANA time zone (e.g. "America/New_York") in
+	// which the Schedule is interpreted. If not specified, the default is UTC.
+	//
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,4,opt,name=timeZone"`
+}
+
+// PromotionFreeze describes an ad-hoc period of time during which promotions
+// are denied.
+type PromotionFreeze struct {
+	// Reason is a human-readable explanation of the freeze. It is included in
+	// the message returned to anyone attempting a promotion during the freeze.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Reason string `json:"reason" protobuf:"bytes,1,opt,name=reason"`
+	// Start is the time at which the freeze begins. If not specified, the
+	// freeze is in effect immediately.
+	//
+	// +optional
+	Start *metav1.Time `json:"start,omitempty" protobuf:"bytes,2,opt,name=start"`
+	// End is the time at which the freeze ends.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
 }
 
 // FreightRequest expresses a Stage's need for Freight having originated from a
@@ -373,10 +601,35 @@ type PromotionTemplateSpec struct {
 	// are listed in this field.
 	//
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses or task set",rule="(has(self.uses) ? !has(self.task) : has(self.task))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task or group set",rule="[has(self.uses), has(self.task), has(self.group)].filter(x, x).size() == 1"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step group cannot set if, continueOnError, retry, config or forEach",rule="!has(self.group) || !(has(self.if) || has(self.continueOnError) || has(self.retry) || has(self.config) || has(self.forEach))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with forEach must have uses set",rule="!has(self.forEach) || has(self.uses)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step cannot set expansion",rule="!has(self.expansion)"
 	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// OnFailure specifies the directives to be executed, in order, if any of
+	// the Steps fail or error, or if a Promotion is aborted while its Steps are
+	// being executed.
+	//
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task or group set",rule="[has(self.uses), has(self.task), has(self.group)].filter(x, x).size() == 1"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step group cannot set if, continueOnError, retry, config or forEach",rule="!has(self.group) || !(has(self.if) || has(self.continueOnError) || has(self.retry) || has(self.config) || has(self.forEach))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with forEach must have uses set",rule="!has(self.forEach) || has(self.uses)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step cannot set expansion",rule="!has(self.expansion)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,3,rep,name=onFailure"`
+	// Finally specifies the directives to be executed, in order, after the
+	// Steps and any OnFailure directives have been executed, regardless of
+	// their outcome.
+	//
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task or group set",rule="[has(self.uses), has(self.task), has(self.group)].filter(x, x).size() == 1"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step group cannot set if, continueOnError, retry, config or forEach",rule="!has(self.group) || !(has(self.if) || has(self.continueOnError) || has(self.retry) || has(self.config) || has(self.forEach))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with forEach must have uses set",rule="!has(self.forEach) || has(self.uses)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step cannot set expansion",rule="!has(self.expansion)"
+	Finally []PromotionStep `json:"finally,omitempty" protobuf:"bytes,4,rep,name=finally"`
 }
 
 // StageStatus describes a Stages's current and recent Freight, health, and
@@ -487,6 +740,11 @@ type FreightReference struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,4,rep,name=charts"`
+	// Artifacts describes specific versions of specific OCI artifacts.
+	Artifacts []OCIArtifact `json:"artifacts,omitempty" protobuf:"bytes,9,rep,name=artifacts"`
+	// Objects describes specific versions of specific objects in S3-compatible
+	// buckets.
+	Objects []BucketObject `json:"objects,omitempty" protobuf:"bytes,10,rep,name=objects"`
 }
 
 // FreightCollection is a collection of FreightReferences, each of which
@@ -658,6 +916,60 @@ func (c *Chart) DeepEquals(other *Chart) bool {
 		c.Version == other.Version
 }
 
+// OCIArtifact describes a specific version of an arbitrary OCI artifact.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag identifies a specific version of the artifact in the repository
+	// specified by RepoURL.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest identifies a specific version of the artifact in the repository
+	// specified by RepoURL. This is a more precise identifier than Tag.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// Annotations is a map of arbitrary metadata for the artifact.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided OCIArtifact. I.e., all fields must be equal.
+func (a *OCIArtifact) DeepEquals(other *OCIArtifact) bool {
+	if a == nil && other == nil {
+		return true
+	}
+	if a == nil || other == nil {
+		return false
+	}
+	return a.RepoURL == other.RepoURL &&
+		a.Tag == other.Tag &&
+		a.Digest == other.Digest &&
+		maps.Equal(a.Annotations, other.Annotations)
+}
+
+// BucketObject describes a specific version of an object in an S3-compatible
+// bucket.
+type BucketObject struct {
+	// URL describes the bucket in which the object can be found.
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Key is the key of the object within the bucket specified by URL.
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+	// ETag identifies a specific version of the object's content.
+	ETag string `json:"etag,omitempty" protobuf:"bytes,3,opt,name=etag"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided BucketObject. I.e., all fields must be equal.
+func (o *BucketObject) DeepEquals(other *BucketObject) bool {
+	if o == nil && other == nil {
+		return true
+	}
+	if o == nil || other == nil {
+		return false
+	}
+	return o.URL == other.URL &&
+		o.Key == other.Key &&
+		o.ETag == other.ETag
+}
+
 // Health describes the health of a Stage.
 type Health struct {
 	// Status describes the health of the Stage.
@@ -728,7 +1040,9 @@ func (r *PromotionReference) GetHealthChecks() []HealthCheckStep {
 }
 
 // Verification describes how to verify that a Promotion has been successful
-// using Argo Rollouts AnalysisTemplates.
+// using either Argo Rollouts AnalysisTemplates or Kargo-native Checks.
+//
+// +kubebuilder:validation:XValidation:message="analysisTemplates and checks are mutually exclusive",rule="!(has(self.analysisTemplates) && size(self.analysisTemplates) > 0 && has(self.checks) && size(self.checks) > 0)"
 type Verification struct {
 	// AnalysisTemplates is a list of AnalysisTemplates from which AnalysisRuns
 	// should be created to verify a Stage's current Freight is fit to be promoted
@@ -739,6 +1053,221 @@ type Verification struct {
 	AnalysisRunMetadata *AnalysisRunMetadata `json:"analysisRunMetadata,omitempty" protobuf:"bytes,2,opt,name=analysisRunMetadata"`
 	// Args lists arguments that should be added to all AnalysisRuns.
 	Args []AnalysisRunArgument `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	// Checks is a list of checks, performed by Kargo itself, that verify a
+	// Stage's current Freight is fit to be promoted downstream. Unlike
+	// AnalysisTemplates, Checks do not require Argo Rollouts.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Checks []VerificationCheck `json:"checks,omitempty" protobuf:"bytes,4,rep,name=checks"`
+}
+
+// HasChecks returns whether the Verification is performed using Kargo-native
+// Checks.
+func (v *Verification) HasChecks() bool {
+	return v != nil && len(v.Checks) > 0
+}
+
+// VerificationCheck describes a single Kargo-native verification check.
+// Exactly one of Job, HTTP, or Prometheus must be specified.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of job, http, or prometheus must be specified",rule="[has(self.job), has(self.http), has(self.prometheus)].filter(x, x).size() == 1"
+type VerificationCheck struct {
+	// Name uniquely identifies the check among all checks of the Stage.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Job describes a Kubernetes Job to run. The check succeeds if the Job
+	// completes successfully and fails if the Job fails.
+	//
+	// +kubebuilder:validation:Optional
+	Job *JobCheck `json:"job,omitempty" protobuf:"bytes,2,opt,name=job"`
+	// HTTP describes an HTTP endpoint to poll.
+	//
+	// +kubebuilder:validation:Optional
+	HTTP *HTTPCheck `json:"http,omitempty" protobuf:"bytes,3,opt,name=http"`
+	// Prometheus describes a Prometheus query to evaluate.
+	//
+	// +kubebuilder:validation:Optional
+	Prometheus *PrometheusCheck `json:"prometheus,omitempty" protobuf:"bytes,4,opt,name=prometheus"`
+	// Interval is the time to wait between measurements taken by an HTTP or
+	// Prometheus check. Ignored for Job checks.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +kubebuilder:default="30s"
+	// +akuity:test-kubebuilder-pattern=Duration
+	Interval metav1.Duration `json:"interval,omitempty" protobuf:"bytes,5,opt,name=interval"`
+	// Count is the number of successful measurements an HTTP or Prometheus
+	// check must take before it succeeds. Ignored for Job checks.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	Count int32 `json:"count,omitempty" protobuf:"varint,6,opt,name=count"`
+	// FailureLimit is the number of failed measurements an HTTP or Prometheus
+	// check tolerates. The check fails once this limit is exceeded. Ignored for
+	// Job checks.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	FailureLimit int32 `json:"failureLimit,omitempty" protobuf:"varint,7,opt,name=failureLimit"`
+}
+
+// JobCheck describes a Kubernetes Job that is run in the Stage's namespace to
+// verify the Stage's current Freight.
+type JobCheck struct {
+	// Image is the container image the Job runs.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+	// Command overrides the entrypoint of the image.
+	//
+	// +kubebuilder:validation:Optional
+	Command []string `json:"command,omitempty" protobuf:"bytes,2,rep,name=command"`
+	// Args are the arguments passed to the entrypoint.
+	//
+	// +kubebuilder:validation:Optional
+	Args []string `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	// Env is a list of environment variables to set in the container.
+	//
+	// +kubebuilder:validation:Optional
+	Env []corev1.EnvVar `json:"env,omitempty" protobuf:"bytes,4,rep,name=env"`
+	// ServiceAccountName is the name of the ServiceAccount, in the Stage's
+	// namespace, to run the Job as. The ServiceAccount must be labeled with
+	// kargo.akuity.io/verification: "true". If not specified, the Job runs as
+	// the namespace's default ServiceAccount, but without a token for
+	// accessing the Kubernetes API.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	ServiceAccountName string `json:"serviceAccountName,omitempty" protobuf:"bytes,5,opt,name=serviceAccountName"`
+	// Timeout is the maximum time the Job may run before it is considered to
+	// have failed.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +kubebuilder:default="10m"
+	// +akuity:test-kubebuilder-pattern=Duration
+	Timeout metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,6,opt,name=timeout"`
+}
+
+// HTTPCheck describes an HTTP endpoint that is polled to verify the Stage's
+// current Freight.
+//
+// A measurement succeeds if SuccessExpression evaluates to true and fails if
+// FailureExpression evaluates to true. If neither is specified, a measurement
+// succeeds if the response has a 2xx status code and fails otherwise. If only
+// one is specified, a measurement fails whenever it is not successful or
+// succeeds whenever it has not failed, respectively. If both are specified
+// and neither evaluates to true, the check is Inconclusive.
+type HTTPCheck struct {
+	// URL is the URL to send requests to.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method is the HTTP method of each request. Defaults to GET.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=GET;HEAD;POST
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers is a list of headers to include in each request.
+	//
+	// +kubebuilder:validation:Optional
+	Headers []HTTPCheckHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Body is the body of each request.
+	//
+	// +kubebuilder:validation:Optional
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+	// InsecureSkipTLSVerify specifies whether certificate verification should
+	// be skipped.
+	//
+	// +kubebuilder:validation:Optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,5,opt,name=insecureSkipTLSVerify"`
+	// SuccessExpression is an expression that is evaluated against each
+	// response to determine whether the measurement succeeded. The response is
+	// available as `response`, with fields `status`, `headers`, and `body`.
+	//
+	// +kubebuilder:validation:Optional
+	SuccessExpression string `json:"successExpression,omitempty" protobuf:"bytes,6,opt,name=successExpression"`
+	// FailureExpression is an expression that is evaluated against each
+	// response to determine whether the measurement failed. The response is
+	// available as `response`, with fields `status`, `headers`, and `body`.
+	//
+	// +kubebuilder:validation:Optional
+	FailureExpression string `json:"failureExpression,omitempty" protobuf:"bytes,7,opt,name=failureExpression"`
+}
+
+// HTTPCheckHeader describes a header to include in the requests of an
+// HTTPCheck.
+//
+// +kubebuilder:validation:XValidation:message="value and valueFrom are mutually exclusive",rule="!(has(self.value) && has(self.valueFrom))"
+type HTTPCheckHeader struct {
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the header. Mutually exclusive with ValueFrom.
+	//
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// ValueFrom references a key of a Secret, in the Stage's namespace, whose
+	// value is used as the value of the header. Mutually exclusive with Value.
+	//
+	// +kubebuilder:validation:Optional
+	ValueFrom *corev1.SecretKeySelector `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
+}
+
+// PrometheusCheck describes a Prometheus query that is evaluated to verify the
+// Stage's current Freight.
+//
+// The result of the query is available to SuccessExpression and
+// FailureExpression as `result`, a list containing the value of each sample
+// of an instant vector, or the single value of a scalar.
+type PrometheusCheck struct {
+	// Address is the base URL of the Prometheus HTTP API, e.g.
+	// http://prometheus.monitoring.svc:9090.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://.+$`
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Query is the PromQL query to evaluate. It must yield an instant vector or
+	// a scalar.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+	// SuccessExpression is an expression that is evaluated against the result
+	// of the query to determine whether the measurement succeeded.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SuccessExpression string `json:"successExpression" protobuf:"bytes,3,opt,name=successExpression"`
+	// FailureExpression is an expression that is evaluated against the result
+	// of the query to determine whether the measurement failed. If not
+	// specified, every measurement that does not succeed fails.
+	//
+	// +kubebuilder:validation:Optional
+	FailureExpression string `json:"failureExpression,omitempty" protobuf:"bytes,4,opt,name=failureExpression"`
+	// Headers is a list of headers to include in each request, e.g. for
+	// authentication.
+	//
+	// +kubebuilder:validation:Optional
+	Headers []HTTPCheckHeader `json:"headers,omitempty" protobuf:"bytes,5,rep,name=headers"`
+	// InsecureSkipTLSVerify specifies whether certificate verification should
+	// be skipped.
+	//
+	// +kubebuilder:validation:Optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,6,opt,name=insecureSkipTLSVerify"`
 }
 
 // AnalysisTemplateReference is a reference to an AnalysisTemplate.
@@ -800,6 +1329,9 @@ type VerificationInfo struct {
 	AnalysisRun *AnalysisRunReference `json:"analysisRun,omitempty" protobuf:"bytes,3,opt,name=analysisRun"`
 	// FinishTime is the time at which the Verification process finished.
 	FinishTime *metav1.Time `json:"finishTime,omitempty" protobuf:"bytes,6,opt,name=finishTime"`
+	// Checks describes the state of each Kargo-native check that implements
+	// the Verification process.
+	Checks []VerificationCheckStatus `json:"checks,omitempty" protobuf:"bytes,8,rep,name=checks"`
 }
 
 // HasAnalysisRun returns a bool indicating whether the VerificationInfo has an
@@ -808,6 +1340,33 @@ func (v *VerificationInfo) HasAnalysisRun() bool {
 	return v != nil && v.AnalysisRun != nil
 }
 
+// HasChecks returns a bool indicating whether the VerificationInfo is
+// implemented by Kargo-native checks.
+func (v *VerificationInfo) HasChecks() bool {
+	return v != nil && len(v.Checks) > 0
+}
+
+// VerificationCheckStatus describes the state of a single Kargo-native
+// verification check.
+type VerificationCheckStatus struct {
+	// Name is the name of the check.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase is the current phase of the check.
+	Phase VerificationPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Message may contain additional information about why the check is in
+	// its current phase.
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Successful is the number of successful measurements taken by the check.
+	Successful int32 `json:"successful,omitempty" protobuf:"varint,4,opt,name=successful"`
+	// Failed is the number of failed measurements taken by the check.
+	Failed int32 `json:"failed,omitempty" protobuf:"varint,5,opt,name=failed"`
+	// LastMeasurementTime is the time at which the check last took a
+	// measurement.
+	LastMeasurementTime *metav1.Time `json:"lastMeasurementTime,omitempty" protobuf:"bytes,6,opt,name=lastMeasurementTime"`
+	// JobName is the name of the Job run by a Job check.
+	JobName string `json:"jobName,omitempty" protobuf:"bytes,7,opt,name=jobName"`
+}
+
 type VerificationInfoStack []VerificationInfo
 
 // Current returns the VerificationInfo at the top of the stack.
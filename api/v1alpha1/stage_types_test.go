@@ -315,6 +315,142 @@ func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
 	}
 }
 
+func TestApprovalPolicy_IsEligible(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   *ApprovalPolicy
+		approval Approval
+		expected bool
+	}{
+		{
+			name:     "no approvers",
+			policy:   &ApprovalPolicy{},
+			approval: Approval{Actor: "kubernetes:jane"},
+			expected: true,
+		},
+		{
+			name: "user approver matches",
+			policy: &ApprovalPolicy{
+				Approvers: []Approver{{User: "kubernetes:jane"}},
+			},
+			approval: Approval{Actor: "kubernetes:jane"},
+			expected: true,
+		},
+		{
+			name: "group approver matches",
+			policy: &ApprovalPolicy{
+				Approvers: []Approver{{Group: "qa"}},
+			},
+			approval: Approval{Actor: "kubernetes:jane", Groups: []string{"dev", "qa"}},
+			expected: true,
+		},
+		{
+			name: "no approver matches",
+			policy: &ApprovalPolicy{
+				Approvers: []Approver{{User: "kubernetes:john"}, {Group: "qa"}},
+			},
+			approval: Approval{Actor: "kubernetes:jane", Groups: []string{"dev"}},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.policy.IsEligible(testCase.approval))
+		})
+	}
+}
+
+func TestApprovalPolicy_IsSatisfiedBy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    *ApprovalPolicy
+		approvals []Approval
+		expected  bool
+	}{
+		{
+			name:     "no approvals",
+			policy:   &ApprovalPolicy{RequiredApprovals: 1},
+			expected: false,
+		},
+		{
+			name:      "zero required approvals treated as one",
+			policy:    &ApprovalPolicy{},
+			approvals: []Approval{{Actor: "kubernetes:jane"}},
+			expected:  true,
+		},
+		{
+			name:   "not enough distinct actors",
+			policy: &ApprovalPolicy{RequiredApprovals: 2},
+			approvals: []Approval{
+				{Actor: "kubernetes:jane"},
+				{Actor: "kubernetes:jane"},
+			},
+			expected: false,
+		},
+		{
+			name:   "enough distinct actors",
+			policy: &ApprovalPolicy{RequiredApprovals: 2},
+			approvals: []Approval{
+				{Actor: "kubernetes:jane"},
+				{Actor: "kubernetes:john"},
+			},
+			expected: true,
+		},
+		{
+			name: "ineligible approvals are not counted",
+			policy: &ApprovalPolicy{
+				RequiredApprovals: 2,
+				Approvers: []Approver{
+					{User: "kubernetes:jane"},
+					{User: "kubernetes:john"},
+				},
+			},
+			approvals: []Approval{
+				{Actor: "kubernetes:jane"},
+				{Actor: "kubernetes:mallory"},
+			},
+			expected: false,
+		},
+		{
+			name: "one approval per group",
+			policy: &ApprovalPolicy{
+				RequiredApprovals: 2,
+				Approvers: []Approver{
+					{Group: "qa"},
+					{Group: "security"},
+				},
+			},
+			approvals: []Approval{
+				{Actor: "kubernetes:jane", Groups: []string{"qa"}},
+				{Actor: "kubernetes:john", Groups: []string{"qa"}},
+			},
+			expected: false,
+		},
+		{
+			name: "approvals attributed to different approvers",
+			policy: &ApprovalPolicy{
+				RequiredApprovals: 2,
+				Approvers: []Approver{
+					{Group: "qa"},
+					{Group: "security"},
+				},
+			},
+			approvals: []Approval{
+				// Jane could approve on behalf of either group, so her approval
+				// must be attributed to security for John's to count for qa.
+				{Actor: "kubernetes:jane", Groups: []string{"qa", "security"}},
+				{Actor: "kubernetes:john", Groups: []string{"qa"}},
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.policy.IsSatisfiedBy(testCase.approvals))
+		})
+	}
+}
+
 func TestFreightCollectionIncludes(t *testing.T) {
 	const testFreight = "test-freight"
 	testCases := []struct {
@@ -26,6 +26,15 @@ const (
 	ImageSelectionStrategySemVer      ImageSelectionStrategy = "SemVer"
 )
 
+// +kubebuilder:validation:Enum={Lexical,NewestModified,SemVer}
+type ObjectSelectionStrategy string
+
+const (
+	ObjectSelectionStrategyLexical        ObjectSelectionStrategy = "Lexical"
+	ObjectSelectionStrategyNewestModified ObjectSelectionStrategy = "NewestModified"
+	ObjectSelectionStrategySemVer         ObjectSelectionStrategy = "SemVer"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name=Shard,type=string,JSONPath=`.spec.shard`
@@ -137,7 +146,8 @@ type FreightCreationCriteria struct {
 }
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
-// container image repository, or a Helm chart repository.
+// container image repository, a Helm chart repository, a repository of
+// arbitrary OCI artifacts, or an S3-compatible bucket.
 type RepoSubscription struct {
 	// Git describes a subscriptions to a Git repository.
 	Git *GitSubscription `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
@@ -145,6 +155,12 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+	// OCI describes a subscription to a repository of arbitrary OCI artifacts,
+	// such as rendered manifests, Terraform modules, or WASM plugins.
+	OCI *OCISubscription `json:"oci,omitempty" protobuf:"bytes,4,opt,name=oci"`
+	// Bucket describes a subscription to objects stored under a common prefix
+	// in an S3-compatible bucket, such as versioned build output.
+	Bucket *BucketSubscription `json:"bucket,omitempty" protobuf:"bytes,5,opt,name=bucket"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
@@ -342,6 +358,30 @@ type GitSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+	// SignatureVerification optionally requires that selected commits, or the
+	// tags that resolve to them, carry a valid GPG or SSH signature from a
+	// trusted signer. Commits and tags that do not are skipped.
+	//
+	// +kubebuilder:validation:Optional
+	SignatureVerification *GitSignatureVerification `json:"signatureVerification,omitempty" protobuf:"bytes,15,opt,name=signatureVerification"`
+}
+
+// GitSignatureVerification describes how the signatures of commits or tags
+// discovered by a GitSubscription must be verified.
+type GitSignatureVerification struct {
+	// TrustedKeysSecretName is the name of a Secret in the Project namespace
+	// whose entries are the public keys of trusted signers. Each entry may
+	// contain one or more ASCII-armored GPG public keys or SSH public keys in
+	// authorized_keys format. The name of an entry identifies the signer of
+	// signatures created using its SSH keys, while signatures created using GPG
+	// keys are identified by the user ID of the key.
+	//
+	// For selection strategies that select tags, the signature of an annotated
+	// tag is verified. The signature of the commit referenced by a lightweight
+	// tag is verified instead.
+	//
+	// +kubebuilder:validation:MinLength=1
+	TrustedKeysSecretName string `json:"trustedKeysSecretName" protobuf:"bytes,1,opt,name=trustedKeysSecretName"`
 }
 
 // ImageSubscription defines a subscription to an image repository.
@@ -441,6 +481,33 @@ type ImageSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	IgnoreTagsRegexes []string `json:"ignoreTagsRegexes,omitempty" protobuf:"bytes,14,rep,name=ignoreTagsRegexes"`
+	// ExpressionFilter is an expression that can optionally be used to limit
+	// the images that are considered in determining the newest version of an
+	// image based on their metadata. The filter is applied after the
+	// AllowTagsRegexes, IgnoreTagsRegexes, Constraint, and Platform fields.
+	//
+	// The expression should be a valid expr-lang expression that evaluates to
+	// true or false. When the expression evaluates to true, the image is
+	// included in the set that is considered. When the expression evaluates to
+	// false, the image is excluded.
+	//
+	// The following variables are available to the expression:
+	//   - `tag`: The tag of the image.
+	//   - `digest`: The digest of the image.
+	//   - `createdAt`: The creation time of the image, if known.
+	//   - `annotations`: The annotations of the image's manifest (or index).
+	//   - `labels`: The labels of the image's config. Only available for
+	//     single-platform images or when the Platform field is specified.
+	//
+	// Because the filter requires metadata to be retrieved for every image
+	// that is considered, image metadata is cached by digest so that repeated
+	// discoveries do not retrieve it again.
+	//
+	// Refer to the expr-lang documentation for more details on syntax and
+	// capabilities of the expression language: https://expr-lang.org.
+	//
+	// +kubebuilder:validation:Optional
+	ExpressionFilter string `json:"expressionFilter,omitempty" protobuf:"bytes,15,opt,name=expressionFilter"`
 
 	// Platform is a string of the form <os>/<arch> that limits the tags that can
 	// be considered when searching for new versions of an image. This field is
@@ -467,6 +534,121 @@ type ImageSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+	// Verification optionally specifies how the signatures and attestations of
+	// discovered images must be verified. Images that cannot be verified are
+	// excluded from discovery. Because verification takes place after images
+	// have been selected, fewer images than the DiscoveryLimit may be
+	// discovered when some cannot be verified.
+	//
+	// +kubebuilder:validation:Optional
+	Verification *ImageVerification `json:"verification,omitempty" protobuf:"bytes,16,opt,name=verification"`
+}
+
+// ImageVerification describes how the signatures and attestations of images
+// must be verified. When more than one method is specified, an image must
+// satisfy all of them.
+//
+// +kubebuilder:validation:XValidation:message="at least one of cosign or notation must be set",rule="has(self.cosign) || has(self.notation)"
+type ImageVerification struct {
+	// Cosign specifies how images must be verified using cosign signatures
+	// and, optionally, attestations.
+	//
+	// +kubebuilder:validation:Optional
+	Cosign *CosignVerification `json:"cosign,omitempty" protobuf:"bytes,1,opt,name=cosign"`
+	// Notation specifies how images must be verified using Notation
+	// signatures.
+	//
+	// +kubebuilder:validation:Optional
+	Notation *NotationVerification `json:"notation,omitempty" protobuf:"bytes,2,opt,name=notation"`
+}
+
+// CosignVerification describes how images must be verified using cosign
+// signatures, which may have been created using either a key pair or keyless
+// signing.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of publicKey or keyless must be set",rule="has(self.publicKey) != has(self.keyless)"
+type CosignVerification struct {
+	// PublicKey is a PEM-encoded public key with which images must have been
+	// signed.
+	//
+	// +kubebuilder:validation:Optional
+	PublicKey string `json:"publicKey,omitempty" protobuf:"bytes,1,opt,name=publicKey"`
+	// Keyless specifies the identity with which images must have been signed
+	// using keyless signing.
+	//
+	// +kubebuilder:validation:Optional
+	Keyless *CosignKeylessVerification `json:"keyless,omitempty" protobuf:"bytes,2,opt,name=keyless"`
+	// Attestation optionally specifies an in-toto attestation that images must
+	// have, signed in the same manner as the images themselves.
+	//
+	// +kubebuilder:validation:Optional
+	Attestation *CosignAttestationVerification `json:"attestation,omitempty" protobuf:"bytes,3,opt,name=attestation"`
+}
+
+// CosignKeylessVerification describes the identity with which images must
+// have been signed using keyless signing.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of identity or identityRegex must be set",rule="has(self.identity) != has(self.identityRegex)"
+type CosignKeylessVerification struct {
+	// FulcioRoots is a PEM-encoded bundle of the root and any intermediate
+	// certificates of the Fulcio certificate authority that issued the
+	// signing certificates.
+	//
+	// +kubebuilder:validation:MinLength=1
+	FulcioRoots string `json:"fulcioRoots" protobuf:"bytes,1,opt,name=fulcioRoots"`
+	// Identity is the identity (e.g. an email address or a workflow URI) to
+	// which the signing certificate must have been issued.
+	//
+	// +kubebuilder:validation:Optional
+	Identity string `json:"identity,omitempty" protobuf:"bytes,2,opt,name=identity"`
+	// IdentityRegex is a regular expression that the identity to which the
+	// signing certificate was issued must match.
+	//
+	// +kubebuilder:validation:Optional
+	IdentityRegex string `json:"identityRegex,omitempty" protobuf:"bytes,3,opt,name=identityRegex"`
+	// Issuer is the OIDC issuer that must have authenticated the identity
+	// to which the signing certificate was issued (e.g.
+	// https://token.actions.githubusercontent.com).
+	//
+	// +kubebuilder:validation:MinLength=1
+	Issuer string `json:"issuer" protobuf:"bytes,4,opt,name=issuer"`
+	// RekorPublicKey is an optional PEM-encoded public key of a Rekor
+	// transparency log. Signatures must be accompanied by proof of their
+	// inclusion in that log, and the signing certificates must have been valid
+	// at the time of inclusion. When not specified, the public key of the
+	// public-good Sigstore instance (https://rekor.sigstore.dev) is used.
+	//
+	// +kubebuilder:validation:Optional
+	RekorPublicKey string `json:"rekorPublicKey,omitempty" protobuf:"bytes,5,opt,name=rekorPublicKey"`
+}
+
+// CosignAttestationVerification describes an in-toto attestation that images
+// must have.
+type CosignAttestationVerification struct {
+	// PredicateType is the predicate type of the attestation (e.g.
+	// https://slsa.dev/provenance/v1).
+	//
+	// +kubebuilder:validation:MinLength=1
+	PredicateType string `json:"predicateType" protobuf:"bytes,1,opt,name=predicateType"`
+}
+
+// NotationVerification describes a Notation trust policy that images must
+// satisfy. Signatures are verified at the "strict" verification level using
+// a trust store of type "ca".
+type NotationVerification struct {
+	// TrustStore is a PEM-encoded bundle of the root certificates of the
+	// certificate authorities trusted to have issued signing certificates.
+	//
+	// +kubebuilder:validation:MinLength=1
+	TrustStore string `json:"trustStore" protobuf:"bytes,1,opt,name=trustStore"`
+	// TrustedIdentities is a list of the identities trusted to have signed
+	// images, using the same format as a Notation trust policy, e.g.
+	// "x509.subject: C=US, ST=WA, O=example.com". The value "*" trusts any
+	// identity whose certificate was issued by a certificate authority in the
+	// TrustStore.
+	//
+	// +kubebuilder:validation:MinItems=1
+	TrustedIdentities []string `json:"trustedIdentities" protobuf:"bytes,2,rep,name=trustedIdentities"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
@@ -510,6 +692,171 @@ type ChartSubscription struct {
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,4,opt,name=discoveryLimit"`
 }
 
+// OCISubscription defines a subscription to a repository of arbitrary OCI
+// artifacts in an OCI registry.
+//
+// +kubebuilder:validation:XValidation:message="If artifactSelectionStrategy is Digest, constraint must be set",rule="!(self.artifactSelectionStrategy == 'Digest') || has(self.constraint)"
+type OCISubscription struct {
+	// RepoURL specifies the URL of the artifact repository to subscribe to. The
+	// value in this field MUST NOT include a tag. This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	// +akuity:test-kubebuilder-pattern=ImageRepoURL
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// ArtifactSelectionStrategy specifies the rules for how to identify the
+	// newest version of the artifact specified by the RepoURL field. This field
+	// is optional. When left unspecified, the field is implicitly treated as if
+	// its value were "SemVer". The accepted values and their semantics are
+	// identical to those of an ImageSubscription's ImageSelectionStrategy field.
+	//
+	// +kubebuilder:default=SemVer
+	ArtifactSelectionStrategy ImageSelectionStrategy `json:"artifactSelectionStrategy,omitempty" protobuf:"bytes,2,opt,name=artifactSelectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. A "strict" semver tag is one containing ALL of major, minor,
+	// and patch version components. This is enabled by default, but only has any
+	// effect when the ArtifactSelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,3,opt,name=strictSemvers"`
+	// Constraint specifies ArtifactSelectionStrategy-specific constraints on
+	// what new artifact revisions are permissible. Acceptable values for this
+	// field vary contextually by ArtifactSelectionStrategy in the same manner as
+	// they do for an ImageSubscription's Constraint field.
+	//
+	// +kubebuilder:validation:Optional
+	Constraint string `json:"constraint,omitempty" protobuf:"bytes,4,opt,name=constraint"`
+	// AllowTagsRegexes is a list of regular expressions that can optionally be
+	// used to limit the tags that are considered in determining the newest
+	// revision of an artifact. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowTagsRegexes []string `json:"allowTagsRegexes,omitempty" protobuf:"bytes,5,rep,name=allowTagsRegexes"`
+	// IgnoreTagsRegexes is a list of regular expressions that can optionally be
+	// used to exclude tags from consideration when determining the newest
+	// revision of an artifact. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreTagsRegexes []string `json:"ignoreTagsRegexes,omitempty" protobuf:"bytes,6,rep,name=ignoreTagsRegexes"`
+	// ArtifactType optionally limits the artifacts that are considered to those
+	// of a specific type. The type of an artifact is the value of its manifest's
+	// artifactType field or, if that is not set, the media type of its config
+	// descriptor (e.g. "application/vnd.cncf.helm.config.v1+json"). When left
+	// unspecified, artifacts of any type are considered.
+	//
+	// +kubebuilder:validation:Optional
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,7,opt,name=artifactType"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,8,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of artifact references
+	// that can be discovered for this subscription. The limit is applied after
+	// filtering artifacts based on the AllowTagsRegexes, IgnoreTagsRegexes, and
+	// ArtifactType fields. When left unspecified, the field is implicitly
+	// treated as if its value were "20". The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+}
+
+// BucketSubscription defines a subscription to objects stored in an
+// S3-compatible bucket. Objects are listed using the S3 ListObjectsV2 API,
+// which is implemented by AWS S3 as well as by most S3-compatible object
+// stores, such as MinIO, Ceph, Cloudflare R2, and Google Cloud Storage (in
+// interoperability mode).
+type BucketSubscription struct {
+	// URL specifies the path-style URL of the bucket to subscribe to. e.g.
+	// "https://s3.us-west-2.amazonaws.com/my-bucket" or
+	// "http://minio.example.com:9000/my-bucket". This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^https?://[^/\s]+/[^/\s]+/?$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Prefix optionally limits the objects that are considered to those having
+	// keys beginning with the specified value. e.g. "builds/my-app/".
+	//
+	// +kubebuilder:validation:Optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,2,opt,name=prefix"`
+	// Region is the region to use when signing requests to the bucket. This
+	// field is optional. When left unspecified, the field is implicitly treated
+	// as if its value were "us-east-1", which is also the value expected by
+	// most S3-compatible object stores.
+	//
+	// +kubebuilder:validation:Optional
+	Region string `json:"region,omitempty" protobuf:"bytes,3,opt,name=region"`
+	// ObjectSelectionStrategy specifies the rules for how to identify the newest
+	// object of interest in the bucket. This field is optional. When left
+	// unspecified, the field is implicitly treated as if its value were
+	// "SemVer".
+	//
+	// Accepted values:
+	//
+	// - "SemVer": Selects the object with the semantically greatest version.
+	//   The version of an object is derived from its key using the VersionRegex
+	//   field. The Constraint field can optionally be used to narrow the set of
+	//   objects eligible for selection.
+	//
+	// - "Lexical": Selects the object with the lexically greatest key. This is
+	//   useful when keys embed a date or timestamp.
+	//
+	// - "NewestModified": Selects the most recently modified object.
+	//
+	// +kubebuilder:default=SemVer
+	ObjectSelectionStrategy ObjectSelectionStrategy `json:"objectSelectionStrategy,omitempty" protobuf:"bytes,4,opt,name=objectSelectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semantic versions should be
+	// considered. A "strict" semantic version is one containing ALL of major,
+	// minor, and patch version components. This is enabled by default, but only
+	// has any effect when the ObjectSelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,5,opt,name=strictSemvers"`
+	// Constraint specifies semantic version constraints on which objects are
+	// permissible. This field is optional and only has any effect when the
+	// ObjectSelectionStrategy is SemVer.
+	// More info: https://github.com/masterminds/semver#checking-version-constraints
+	//
+	// +kubebuilder:validation:Optional
+	Constraint string `json:"constraint,omitempty" protobuf:"bytes,6,opt,name=constraint"`
+	// VersionRegex is a regular expression containing exactly one capture group
+	// that is used to extract a version from an object's key (with the Prefix
+	// removed). e.g. "^my-app-(.+)\\.tar\\.gz$". Objects whose keys do not match
+	// are ignored. When left unspecified, the key, with the Prefix removed, is
+	// used as the version. This field only has any effect when the
+	// ObjectSelectionStrategy is SemVer.
+	//
+	// +kubebuilder:validation:Optional
+	VersionRegex string `json:"versionRegex,omitempty" protobuf:"bytes,7,opt,name=versionRegex"`
+	// AllowKeysRegexes is a list of regular expressions that can optionally be
+	// used to limit the object keys that are considered in determining the
+	// newest object. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowKeysRegexes []string `json:"allowKeysRegexes,omitempty" protobuf:"bytes,8,rep,name=allowKeysRegexes"`
+	// IgnoreKeysRegexes is a list of regular expressions that can optionally be
+	// used to exclude object keys from consideration when determining the newest
+	// object. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreKeysRegexes []string `json:"ignoreKeysRegexes,omitempty" protobuf:"bytes,9,rep,name=ignoreKeysRegexes"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the bucket. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,10,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of objects that can be
+	// discovered for this subscription. The limit is applied after filtering
+	// objects based on the AllowKeysRegexes, IgnoreKeysRegexes, VersionRegex,
+	// and Constraint fields. When left unspecified, the field is implicitly
+	// treated as if its value were "20". The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,11,opt,name=discoveryLimit"`
+}
+
 // WarehouseStatus describes a Warehouse's most recently observed state.
 type WarehouseStatus struct {
 	// Conditions contains the last observations of the Warehouse's current
@@ -566,6 +913,16 @@ type DiscoveredArtifacts struct {
 	//
 	// +optional
 	Charts []ChartDiscoveryResult `json:"charts,omitempty" protobuf:"bytes,3,rep,name=charts"`
+	// OCI holds the OCI artifact references discovered by the Warehouse for the
+	// OCI subscriptions.
+	//
+	// +optional
+	OCI []OCIDiscoveryResult `json:"oci,omitempty" protobuf:"bytes,5,rep,name=oci"`
+	// Buckets holds the objects discovered by the Warehouse for the bucket
+	// subscriptions.
+	//
+	// +optional
+	Buckets []BucketDiscoveryResult `json:"buckets,omitempty" protobuf:"bytes,6,rep,name=buckets"`
 }
 
 // GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -609,6 +966,9 @@ type DiscoveredCommit struct {
 	// CreatorDate is the commit creation date as specified by the commit, or
 	// the tagger date if the commit belongs to an annotated tag.
 	CreatorDate *metav1.Time `json:"creatorDate,omitempty" protobuf:"bytes,7,opt,name=creatorDate"`
+	// Signer is the identity of the trusted signer of the commit or tag. It is
+	// only populated when the GitSubscription requires signature verification.
+	Signer string `json:"signer,omitempty" protobuf:"bytes,8,opt,name=signer"`
 }
 
 // ImageDiscoveryResult represents the result of an image discovery operation
@@ -679,6 +1039,64 @@ type ChartDiscoveryResult struct {
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
 }
 
+// OCIDiscoveryResult represents the result of an artifact discovery operation
+// for an OCISubscription.
+type OCIDiscoveryResult struct {
+	// RepoURL is the repository URL of the artifact, as specified in the
+	// OCISubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// ArtifactType is the artifact type constraint of the OCISubscription for
+	// which references were discovered. This field is optional, and only
+	// populated if the OCISubscription specifies an ArtifactType.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,2,opt,name=artifactType"`
+	// References is a list of artifact references discovered by the Warehouse
+	// for the OCISubscription. An empty list indicates that the discovery
+	// operation was successful, but no artifacts matching the OCISubscription
+	// criteria were found.
+	//
+	// +optional
+	References []DiscoveredImageReference `json:"references" protobuf:"bytes,3,rep,name=references"`
+}
+
+// BucketDiscoveryResult represents the result of an object discovery operation
+// for a BucketSubscription.
+type BucketDiscoveryResult struct {
+	// URL is the URL of the bucket, as specified in the BucketSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Prefix is the key prefix of the BucketSubscription for which objects were
+	// discovered. This field is optional, and only populated if the
+	// BucketSubscription specifies a Prefix.
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,2,opt,name=prefix"`
+	// Objects is a list of objects discovered by the Warehouse for the
+	// BucketSubscription. An empty list indicates that the discovery operation
+	// was successful, but no objects matching the BucketSubscription criteria
+	// were found.
+	//
+	// +optional
+	Objects []DiscoveredObject `json:"objects" protobuf:"bytes,3,rep,name=objects"`
+}
+
+// DiscoveredObject represents an object discovered by a Warehouse for a
+// BucketSubscription.
+type DiscoveredObject struct {
+	// Key is the key of the object.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// ETag is the entity tag of the object, which identifies a specific version
+	// of the object's content.
+	ETag string `json:"etag,omitempty" protobuf:"bytes,2,opt,name=etag"`
+	// Version is the version extracted from the object's key. This field is
+	// only populated when the ObjectSelectionStrategy is SemVer.
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// LastModified is the time the object was last modified.
+	LastModified *metav1.Time `json:"lastModified,omitempty" protobuf:"bytes,4,opt,name=lastModified"`
+}
+
 // +kubebuilder:object:root=true
 
 // WarehouseList is a list of Warehouse resources.
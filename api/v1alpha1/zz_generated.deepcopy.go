@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -84,6 +85,50 @@ func (in *AnalysisTemplateReference) DeepCopy() *AnalysisTemplateReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalPolicy) DeepCopyInto(out *ApprovalPolicy) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]Approver, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalPolicy.
+func (in *ApprovalPolicy) DeepCopy() *ApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovedStage) DeepCopyInto(out *ApprovedStage) {
 	*out = *in
@@ -103,6 +148,21 @@ func (in *ApprovedStage) DeepCopy() *ApprovedStage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approver) DeepCopyInto(out *Approver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approver.
+func (in *Approver) DeepCopy() *Approver {
+	if in == nil {
+		return nil
+	}
+	out := new(Approver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppHealthStatus) DeepCopyInto(out *ArgoCDAppHealthStatus) {
 	*out = *in
@@ -186,6 +246,21 @@ func (in *AutoPromotionOptions) DeepCopy() *AutoPromotionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoRollback) DeepCopyInto(out *AutoRollback) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoRollback.
+func (in *AutoRollback) DeepCopy() *AutoRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureWebhookReceiverConfig) DeepCopyInto(out *AzureWebhookReceiverConfig) {
 	*out = *in
@@ -218,6 +293,68 @@ func (in *BitbucketWebhookReceiverConfig) DeepCopy() *BitbucketWebhookReceiverCo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketDiscoveryResult) DeepCopyInto(out *BucketDiscoveryResult) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]DiscoveredObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketDiscoveryResult.
+func (in *BucketDiscoveryResult) DeepCopy() *BucketDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketObject) DeepCopyInto(out *BucketObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketObject.
+func (in *BucketObject) DeepCopy() *BucketObject {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketSubscription) DeepCopyInto(out *BucketSubscription) {
+	*out = *in
+	if in.AllowKeysRegexes != nil {
+		in, out := &in.AllowKeysRegexes, &out.AllowKeysRegexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreKeysRegexes != nil {
+		in, out := &in.IgnoreKeysRegexes, &out.IgnoreKeysRegexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSubscription.
+func (in *BucketSubscription) DeepCopy() *BucketSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -434,6 +571,81 @@ func (in *ClusterPromotionTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommitStatusConfig) DeepCopyInto(out *CommitStatusConfig) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommitStatusConfig.
+func (in *CommitStatusConfig) DeepCopy() *CommitStatusConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CommitStatusConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignAttestationVerification) DeepCopyInto(out *CosignAttestationVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignAttestationVerification.
+func (in *CosignAttestationVerification) DeepCopy() *CosignAttestationVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignAttestationVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignKeylessVerification) DeepCopyInto(out *CosignKeylessVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignKeylessVerification.
+func (in *CosignKeylessVerification) DeepCopy() *CosignKeylessVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignKeylessVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignVerification) DeepCopyInto(out *CosignVerification) {
+	*out = *in
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(CosignKeylessVerification)
+		**out = **in
+	}
+	if in.Attestation != nil {
+		in, out := &in.Attestation, &out.Attestation
+		*out = new(CosignAttestationVerification)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignVerification.
+func (in *CosignVerification) DeepCopy() *CosignVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CurrentStage) DeepCopyInto(out *CurrentStage) {
 	*out = *in
@@ -478,6 +690,20 @@ func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = make([]OCIDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Buckets != nil {
+		in, out := &in.Buckets, &out.Buckets
+		*out = make([]BucketDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredArtifacts.
@@ -535,6 +761,25 @@ func (in *DiscoveredImageReference) DeepCopy() *DiscoveredImageReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredObject) DeepCopyInto(out *DiscoveredObject) {
+	*out = *in
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredObject.
+func (in *DiscoveredObject) DeepCopy() *DiscoveredObject {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DockerHubWebhookReceiverConfig) DeepCopyInto(out *DockerHubWebhookReceiverConfig) {
 	*out = *in
@@ -589,6 +834,18 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]OCIArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]BucketObject, len(*in))
+		copy(*out, *in)
+	}
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -747,6 +1004,18 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]OCIArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]BucketObject, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightReference.
@@ -830,6 +1099,20 @@ func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make(map[string]StageApprovals, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.RolledBackFrom != nil {
+		in, out := &in.RolledBackFrom, &out.RolledBackFrom
+		*out = make(map[string]RolledBackStage, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]apiextensionsv1.JSON, len(*in))
@@ -849,6 +1132,46 @@ func (in *FreightStatus) DeepCopy() *FreightStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionPolicy) DeepCopyInto(out *GarbageCollectionPolicy) {
+	*out = *in
+	if in.MaxRetainedPromotions != nil {
+		in, out := &in.MaxRetainedPromotions, &out.MaxRetainedPromotions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinPromotionDeletionAge != nil {
+		in, out := &in.MinPromotionDeletionAge, &out.MinPromotionDeletionAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetainedFreight != nil {
+		in, out := &in.MaxRetainedFreight, &out.MaxRetainedFreight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinFreightDeletionAge != nil {
+		in, out := &in.MinFreightDeletionAge, &out.MinFreightDeletionAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProtectedStageSelector != nil {
+		in, out := &in.ProtectedStageSelector, &out.ProtectedStageSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionPolicy.
+func (in *GarbageCollectionPolicy) DeepCopy() *GarbageCollectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitCommit) DeepCopyInto(out *GitCommit) {
 	*out = *in
@@ -918,6 +1241,21 @@ func (in *GitLabWebhookReceiverConfig) DeepCopy() *GitLabWebhookReceiverConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSignatureVerification) DeepCopyInto(out *GitSignatureVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSignatureVerification.
+func (in *GitSignatureVerification) DeepCopy() *GitSignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 	*out = *in
@@ -946,6 +1284,11 @@ func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(GitSignatureVerification)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -974,6 +1317,48 @@ func (in *GiteaWebhookReceiverConfig) DeepCopy() *GiteaWebhookReceiverConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCheck) DeepCopyInto(out *HTTPCheck) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPCheckHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCheck.
+func (in *HTTPCheck) DeepCopy() *HTTPCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCheckHeader) DeepCopyInto(out *HTTPCheckHeader) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCheckHeader.
+func (in *HTTPCheckHeader) DeepCopy() *HTTPCheckHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCheckHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HarborWebhookReceiverConfig) DeepCopyInto(out *HarborWebhookReceiverConfig) {
 	*out = *in
@@ -1117,7 +1502,12 @@ func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
 func (in *ImageSubscription) DeepCopy() *ImageSubscription {
@@ -1129,6 +1519,216 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(CosignVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notation != nil {
+		in, out := &in.Notation, &out.Notation
+		*out = new(NotationVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobCheck) DeepCopyInto(out *JobCheck) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobCheck.
+func (in *JobCheck) DeepCopy() *JobCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(JobCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotationVerification) DeepCopyInto(out *NotationVerification) {
+	*out = *in
+	if in.TrustedIdentities != nil {
+		in, out := &in.TrustedIdentities, &out.TrustedIdentities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotationVerification.
+func (in *NotationVerification) DeepCopy() *NotationVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(NotationVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationConfig) DeepCopyInto(out *NotificationConfig) {
+	*out = *in
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]EventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackNotificationConfig)
+		**out = **in
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = new(TeamsNotificationConfig)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookNotificationConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationConfig.
+func (in *NotificationConfig) DeepCopy() *NotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationStatus) DeepCopyInto(out *NotificationStatus) {
+	*out = *in
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessTime != nil {
+		in, out := &in.LastSuccessTime, &out.LastSuccessTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationStatus.
+func (in *NotificationStatus) DeepCopy() *NotificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIDiscoveryResult) DeepCopyInto(out *OCIDiscoveryResult) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]DiscoveredImageReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIDiscoveryResult.
+func (in *OCIDiscoveryResult) DeepCopy() *OCIDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISubscription) DeepCopyInto(out *OCISubscription) {
+	*out = *in
+	if in.AllowTagsRegexes != nil {
+		in, out := &in.AllowTagsRegexes, &out.AllowTagsRegexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IgnoreTagsRegexes != nil {
+		in, out := &in.IgnoreTagsRegexes, &out.IgnoreTagsRegexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISubscription.
+func (in *OCISubscription) DeepCopy() *OCISubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -1231,6 +1831,35 @@ func (in *ProjectConfigSpec) DeepCopyInto(out *ProjectConfigSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CommitStatuses != nil {
+		in, out := &in.CommitStatuses, &out.CommitStatuses
+		*out = new(CommitStatusConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromotionCalendars != nil {
+		in, out := &in.PromotionCalendars, &out.PromotionCalendars
+		*out = make([]PromotionCalendarPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromotionExecution != nil {
+		in, out := &in.PromotionExecution, &out.PromotionExecution
+		*out = new(PromotionExecutionConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigSpec.
@@ -1258,6 +1887,13 @@ func (in *ProjectConfigStatus) DeepCopyInto(out *ProjectConfigStatus) {
 		*out = make([]WebhookReceiverDetails, len(*in))
 		copy(*out, *in)
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectConfigStatus.
@@ -1346,6 +1982,28 @@ func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusCheck) DeepCopyInto(out *PrometheusCheck) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPCheckHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusCheck.
+func (in *PrometheusCheck) DeepCopy() *PrometheusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Promotion) DeepCopyInto(out *Promotion) {
 	*out = *in
@@ -1373,6 +2031,100 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionCalendar) DeepCopyInto(out *PromotionCalendar) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Freezes != nil {
+		in, out := &in.Freezes, &out.Freezes
+		*out = make([]PromotionFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionCalendar.
+func (in *PromotionCalendar) DeepCopy() *PromotionCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionCalendarPolicy) DeepCopyInto(out *PromotionCalendarPolicy) {
+	*out = *in
+	if in.StageSelector != nil {
+		in, out := &in.StageSelector, &out.StageSelector
+		*out = new(PromotionPolicySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Freezes != nil {
+		in, out := &in.Freezes, &out.Freezes
+		*out = make([]PromotionFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionCalendarPolicy.
+func (in *PromotionCalendarPolicy) DeepCopy() *PromotionCalendarPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionCalendarPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionExecutionConfig) DeepCopyInto(out *PromotionExecutionConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionExecutionConfig.
+func (in *PromotionExecutionConfig) DeepCopy() *PromotionExecutionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionExecutionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionFreeze) DeepCopyInto(out *PromotionFreeze) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionFreeze.
+func (in *PromotionFreeze) DeepCopy() *PromotionFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -1405,6 +2157,128 @@ func (in *PromotionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPlan) DeepCopyInto(out *PromotionPlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPlan.
+func (in *PromotionPlan) DeepCopy() *PromotionPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPlanList) DeepCopyInto(out *PromotionPlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PromotionPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPlanList.
+func (in *PromotionPlanList) DeepCopy() *PromotionPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPlanSpec) DeepCopyInto(out *PromotionPlanSpec) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]PromotionWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPlanSpec.
+func (in *PromotionPlanSpec) DeepCopy() *PromotionPlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPlanStageStatus) DeepCopyInto(out *PromotionPlanStageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPlanStageStatus.
+func (in *PromotionPlanStageStatus) DeepCopy() *PromotionPlanStageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPlanStageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionPlanStatus) DeepCopyInto(out *PromotionPlanStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]PromotionWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPlanStatus.
+func (in *PromotionPlanStatus) DeepCopy() *PromotionPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
@@ -1489,6 +2363,24 @@ func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionSpec.
@@ -1529,38 +2421,155 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
-	if in.StepExecutionMetadata != nil {
-		in, out := &in.StepExecutionMetadata, &out.StepExecutionMetadata
-		*out = make(StepExecutionMetadataList, len(*in))
+	if in.StepExecutionMetadata != nil {
+		in, out := &in.StepExecutionMetadata, &out.StepExecutionMetadata
+		*out = make(StepExecutionMetadataList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(PromotionStepSectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = new(PromotionStepSectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
+func (in *PromotionStatus) DeepCopy() *PromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStep) DeepCopyInto(out *PromotionStep) {
+	*out = *in
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(PromotionTaskReference)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(PromotionStepRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make([]ExpressionVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForEach != nil {
+		in, out := &in.ForEach, &out.ForEach
+		*out = new(PromotionStepForEach)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(PromotionStepGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expansion != nil {
+		in, out := &in.Expansion, &out.Expansion
+		*out = new(PromotionStepExpansion)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStep.
+func (in *PromotionStep) DeepCopy() *PromotionStep {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepExpansion) DeepCopyInto(out *PromotionStepExpansion) {
+	*out = *in
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Item != nil {
+		in, out := &in.Item, &out.Item
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepExpansion.
+func (in *PromotionStepExpansion) DeepCopy() *PromotionStepExpansion {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepExpansion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepForEach) DeepCopyInto(out *PromotionStepForEach) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepForEach.
+func (in *PromotionStepForEach) DeepCopy() *PromotionStepForEach {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepForEach)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepGroup) DeepCopyInto(out *PromotionStepGroup) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStepGroupMember, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(apiextensionsv1.JSON)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
-func (in *PromotionStatus) DeepCopy() *PromotionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepGroup.
+func (in *PromotionStepGroup) DeepCopy() *PromotionStepGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(PromotionStatus)
+	out := new(PromotionStepGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromotionStep) DeepCopyInto(out *PromotionStep) {
+func (in *PromotionStepGroupMember) DeepCopyInto(out *PromotionStepGroupMember) {
 	*out = *in
-	if in.Task != nil {
-		in, out := &in.Task, &out.Task
-		*out = new(PromotionTaskReference)
-		**out = **in
-	}
 	if in.Retry != nil {
 		in, out := &in.Retry, &out.Retry
 		*out = new(PromotionStepRetry)
@@ -1578,12 +2587,12 @@ func (in *PromotionStep) DeepCopyInto(out *PromotionStep) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStep.
-func (in *PromotionStep) DeepCopy() *PromotionStep {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepGroupMember.
+func (in *PromotionStepGroupMember) DeepCopy() *PromotionStepGroupMember {
 	if in == nil {
 		return nil
 	}
-	out := new(PromotionStep)
+	out := new(PromotionStepGroupMember)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1608,6 +2617,28 @@ func (in *PromotionStepRetry) DeepCopy() *PromotionStepRetry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepSectionStatus) DeepCopyInto(out *PromotionStepSectionStatus) {
+	*out = *in
+	if in.StepExecutionMetadata != nil {
+		in, out := &in.StepExecutionMetadata, &out.StepExecutionMetadata
+		*out = make(StepExecutionMetadataList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepSectionStatus.
+func (in *PromotionStepSectionStatus) DeepCopy() *PromotionStepSectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepSectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionTask) DeepCopyInto(out *PromotionTask) {
 	*out = *in
@@ -1696,6 +2727,20 @@ func (in *PromotionTaskSpec) DeepCopyInto(out *PromotionTaskSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTaskSpec.
@@ -1739,6 +2784,20 @@ func (in *PromotionTemplateSpec) DeepCopyInto(out *PromotionTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = make([]PromotionStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateSpec.
@@ -1751,6 +2810,108 @@ func (in *PromotionTemplateSpec) DeepCopy() *PromotionTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWave) DeepCopyInto(out *PromotionWave) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthGate != nil {
+		in, out := &in.HealthGate, &out.HealthGate
+		*out = new(PromotionWaveHealthGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWave.
+func (in *PromotionWave) DeepCopy() *PromotionWave {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWaveHealthGate) DeepCopyInto(out *PromotionWaveHealthGate) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWaveHealthGate.
+func (in *PromotionWaveHealthGate) DeepCopy() *PromotionWaveHealthGate {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWaveHealthGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWaveStatus) DeepCopyInto(out *PromotionWaveStatus) {
+	*out = *in
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]PromotionPlanStageStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PromotedAt != nil {
+		in, out := &in.PromotedAt, &out.PromotedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.GatePassedAt != nil {
+		in, out := &in.GatePassedAt, &out.GatePassedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWaveStatus.
+func (in *PromotionWaveStatus) DeepCopy() *PromotionWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWindow) DeepCopyInto(out *PromotionWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWindow.
+func (in *PromotionWindow) DeepCopy() *PromotionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuayWebhookReceiverConfig) DeepCopyInto(out *QuayWebhookReceiverConfig) {
 	*out = *in
@@ -1785,6 +2946,16 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 		*out = new(ChartSubscription)
 		**out = **in
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCISubscription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(BucketSubscription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoSubscription.
@@ -1797,6 +2968,41 @@ func (in *RepoSubscription) DeepCopy() *RepoSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolledBackStage) DeepCopyInto(out *RolledBackStage) {
+	*out = *in
+	if in.RolledBackAt != nil {
+		in, out := &in.RolledBackAt, &out.RolledBackAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolledBackStage.
+func (in *RolledBackStage) DeepCopy() *RolledBackStage {
+	if in == nil {
+		return nil
+	}
+	out := new(RolledBackStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackNotificationConfig) DeepCopyInto(out *SlackNotificationConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackNotificationConfig.
+func (in *SlackNotificationConfig) DeepCopy() *SlackNotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackNotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -1824,6 +3030,28 @@ func (in *Stage) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageApprovals) DeepCopyInto(out *StageApprovals) {
+	*out = *in
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]Approval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageApprovals.
+func (in *StageApprovals) DeepCopy() *StageApprovals {
+	if in == nil {
+		return nil
+	}
+	out := new(StageApprovals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageList) DeepCopyInto(out *StageList) {
 	*out = *in
@@ -1881,6 +3109,21 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PromotionCalendar != nil {
+		in, out := &in.PromotionCalendar, &out.PromotionCalendar
+		*out = new(PromotionCalendar)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(AutoRollback)
+		**out = **in
+	}
+	if in.ApprovalPolicy != nil {
+		in, out := &in.ApprovalPolicy, &out.ApprovalPolicy
+		*out = new(ApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -2008,6 +3251,22 @@ func (in StepExecutionMetadataList) DeepCopy() StepExecutionMetadataList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsNotificationConfig) DeepCopyInto(out *TeamsNotificationConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsNotificationConfig.
+func (in *TeamsNotificationConfig) DeepCopy() *TeamsNotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsNotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Verification) DeepCopyInto(out *Verification) {
 	*out = *in
@@ -2026,6 +3285,13 @@ func (in *Verification) DeepCopyInto(out *Verification) {
 		*out = make([]AnalysisRunArgument, len(*in))
 		copy(*out, *in)
 	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]VerificationCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Verification.
@@ -2038,6 +3304,56 @@ func (in *Verification) DeepCopy() *Verification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationCheck) DeepCopyInto(out *VerificationCheck) {
+	*out = *in
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationCheck.
+func (in *VerificationCheck) DeepCopy() *VerificationCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationCheckStatus) DeepCopyInto(out *VerificationCheckStatus) {
+	*out = *in
+	if in.LastMeasurementTime != nil {
+		in, out := &in.LastMeasurementTime, &out.LastMeasurementTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationCheckStatus.
+func (in *VerificationCheckStatus) DeepCopy() *VerificationCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerificationInfo) DeepCopyInto(out *VerificationInfo) {
 	*out = *in
@@ -2054,6 +3370,13 @@ func (in *VerificationInfo) DeepCopyInto(out *VerificationInfo) {
 		in, out := &in.FinishTime, &out.FinishTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]VerificationCheckStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationInfo.
@@ -2241,6 +3564,22 @@ func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookNotificationConfig) DeepCopyInto(out *WebhookNotificationConfig) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookNotificationConfig.
+func (in *WebhookNotificationConfig) DeepCopy() *WebhookNotificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookNotificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookReceiverConfig) DeepCopyInto(out *WebhookReceiverConfig) {
 	*out = *in
@@ -8,6 +8,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/akuity/kargo/pkg/archive"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
@@ -129,14 +131,11 @@ func (o *apiOptions) run(ctx context.Context) error {
 		)
 	}
 
-	srv := server.NewServer(
-		serverCfg,
-		kubeClient,
-		rbac.NewKubernetesRolesDatabase(kubeClient),
-		rbac.NewKubernetesServiceAccountsDatabase(
-			kubeClient,
-			rbac.ServiceAccountDatabaseConfigFromEnv(),
-		),
+	cloudEventsSender, err := cloudevents.NewSenderFromEnv(ctx)
+	if err != nil {
+		return err
+	}
+	sender := cloudevents.WithSink(
 		k8sevent.NewEventSender(
 			event.NewRecorder(
 				ctx,
@@ -145,6 +144,27 @@ func (o *apiOptions) run(ctx context.Context) error {
 				"api",
 			),
 		),
+		cloudEventsSender,
+	)
+
+	var arch *archive.Archive
+	if archiveCfg := archive.ConfigFromEnv(); archiveCfg.URL != "" {
+		if arch, err = archive.New(archiveCfg); err != nil {
+			return fmt.Errorf("error initializing archive: %w", err)
+		}
+		o.Logger.Info("archived Promotions and Freight can be retrieved")
+	}
+
+	srv := server.NewServer(
+		serverCfg,
+		kubeClient,
+		rbac.NewKubernetesRolesDatabase(kubeClient),
+		rbac.NewKubernetesServiceAccountsDatabase(
+			kubeClient,
+			rbac.ServiceAccountDatabaseConfigFromEnv(),
+		),
+		sender,
+		arch,
 	)
 	l, err := net.Listen("tcp", fmt.Sprintf("%s:%s", o.BindAddress, o.Port))
 	if err != nil {
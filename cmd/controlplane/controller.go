@@ -7,9 +7,11 @@ import (
 	"sync"
 
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,17 +24,20 @@ import (
 	libargocd "github.com/akuity/kargo/pkg/argocd"
 	"github.com/akuity/kargo/pkg/controller"
 	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/promotionplans"
 	"github.com/akuity/kargo/pkg/controller/promotions"
 	"github.com/akuity/kargo/pkg/controller/stages"
 	"github.com/akuity/kargo/pkg/controller/warehouses"
 	"github.com/akuity/kargo/pkg/credentials"
 	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	"github.com/akuity/kargo/pkg/health"
 	healthCheckers "github.com/akuity/kargo/pkg/health/checker/builtin"
 	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/os"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/runner/plugin"
 	"github.com/akuity/kargo/pkg/server/kubernetes"
 	"github.com/akuity/kargo/pkg/types"
 	versionpkg "github.com/akuity/kargo/pkg/x/version"
@@ -140,12 +145,30 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		credsdb.DatabaseConfigFromEnv(),
 	)
 
+	pluginSteps, err := plugin.Register(
+		logging.ContextWithLogger(ctx, o.Logger),
+		promotion.DefaultStepRunnerRegistry,
+		plugin.ConfigFromEnv(),
+	)
+	if err != nil {
+		return fmt.Errorf("error registering step plugins: %w", err)
+	}
+
+	// A single CloudEvents sender, and therefore a single queue, is shared by
+	// all reconcilers.
+	cloudEventsSender, err := cloudevents.NewSenderFromEnv(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing CloudEvents sender: %w", err)
+	}
+
 	if err := o.setupReconcilers(
 		ctx,
 		kargoMgr,
 		argocdMgr,
 		credentialsDB,
+		cloudEventsSender,
 		stagesReconcilerCfg,
+		pluginSteps,
 	); err != nil {
 		return fmt.Errorf("error setting up reconcilers: %w", err)
 	}
@@ -178,6 +201,12 @@ func (o *controllerOptions) setupKargoManager(
 			err,
 		)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes batch API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, nil, stagesReconcilerCfg, fmt.Errorf(
 			"error adding Kargo API to Kargo controller manager scheme: %w",
@@ -219,11 +248,12 @@ func (o *controllerOptions) setupKargoManager(
 	// We may or may not be able to distill stagesReconcilerCfg.ShardName and
 	// stagesReconcilerCfg.IsDefaultController down to a labels.Requirement. If
 	// we're able to do so, we'll build a labels.Selector from that requirement
-	// and use it to narrow the set of Stages and Promotions our client's internal
-	// cache needs to be concerned with watching. If we're unable to distill
-	// stagesReconcilerCfg.ShardName and stagesReconcilerCfg.IsDefaultController
-	// down to a labels.Requirement, then we have no choice but to let our
-	// client's internal cache watch all Stages and Promotions and the respective
+	// and use it to narrow the set of Stages, Promotions, and PromotionPlans our
+	// client's internal cache needs to be concerned with watching. If we're
+	// unable to distill stagesReconcilerCfg.ShardName and
+	// stagesReconcilerCfg.IsDefaultController down to a labels.Requirement, then
+	// we have no choice but to let our client's internal cache watch all Stages,
+	// Promotions, and PromotionPlans and the respective
 	// reconcilers for those types will, instead, need to apply a predicate to
 	// filter out resources for which they are not responsible.
 	cacheOpts := cache.Options{}
@@ -238,8 +268,9 @@ func (o *controllerOptions) setupKargoManager(
 	if shardReq != nil {
 		shardSelector := labels.NewSelector().Add(*shardReq)
 		cacheOpts.ByObject = map[client.Object]cache.ByObject{
-			&kargoapi.Stage{}:     {Label: shardSelector},
-			&kargoapi.Promotion{}: {Label: shardSelector},
+			&kargoapi.Stage{}:         {Label: shardSelector},
+			&kargoapi.Promotion{}:     {Label: shardSelector},
+			&kargoapi.PromotionPlan{}: {Label: shardSelector},
 		}
 	}
 
@@ -264,6 +295,15 @@ func (o *controllerOptions) setupKargoManager(
 						// ConfigMaps, but ConfigMaps have the potential to be quite large,
 						// so we prefer to not cache them.
 						&corev1.ConfigMap{},
+						// Jobs are only ever created and read by native verification
+						// checks and promotion Pods, which poll them infrequently.
+						// Caching them would mean watching every Job in the cluster.
+						&batchv1.Job{},
+						// ServiceAccounts are only ever read when a Job check is
+						// started, to confirm it may run as the ServiceAccount it
+						// names. The controller is not permitted to list or watch
+						// them.
+						&corev1.ServiceAccount{},
 					},
 				},
 			},
@@ -411,34 +451,50 @@ func (o *controllerOptions) setupReconcilers(
 	ctx context.Context,
 	kargoMgr, argocdMgr manager.Manager,
 	credentialsDB credentials.Database,
+	cloudEventsSender *cloudevents.Sender,
 	stagesReconcilerCfg stages.ReconcilerConfig,
+	pluginSteps []string,
 ) error {
 	var argoCDClient client.Client
 	if argocdMgr != nil {
 		argoCDClient = argocdMgr.GetClient()
 	}
 
-	healthCheckers.Initialize(argoCDClient)
+	healthCheckers.Initialize(kargoMgr.GetClient(), argoCDClient)
 
 	sharedIndexer := indexer.NewSharedFieldIndexer(kargoMgr.GetFieldIndexer())
 
 	if promotionsReconcilerCfg := promotions.ReconcilerConfigFromEnv(); promotionsReconcilerCfg.Enable {
+		promoEngine, err := o.newPromotionEngine(
+			kargoMgr,
+			argoCDClient,
+			credentialsDB,
+			pluginSteps,
+		)
+		if err != nil {
+			return fmt.Errorf("error initializing promotion engine: %w", err)
+		}
 		if err := promotions.SetupReconcilerWithManager(
 			ctx,
 			kargoMgr,
 			argocdMgr,
-			promotion.NewLocalEngine(
-				kargoMgr.GetClient(),
-				argoCDClient,
-				credentialsDB,
-				promotion.DefaultExprDataCacheFn,
-			),
+			promoEngine,
+			credentialsDB,
+			cloudEventsSender,
 			promotionsReconcilerCfg,
 		); err != nil {
 			return fmt.Errorf("error setting up Promotions reconciler: %w", err)
 		}
 	}
 
+	if err := promotionplans.SetupReconcilerWithManager(
+		ctx,
+		kargoMgr,
+		promotionplans.ReconcilerConfigFromEnv(),
+	); err != nil {
+		return fmt.Errorf("error setting up PromotionPlans reconciler: %w", err)
+	}
+
 	if err := stages.NewRegularStageReconciler(
 		stagesReconcilerCfg,
 		health.NewAggregatingChecker(),
@@ -447,6 +503,7 @@ func (o *controllerOptions) setupReconcilers(
 		kargoMgr,
 		argocdMgr,
 		sharedIndexer,
+		cloudEventsSender,
 	); err != nil {
 		return fmt.Errorf("error setting up regular Stages reconciler: %w", err)
 	}
@@ -455,6 +512,7 @@ func (o *controllerOptions) setupReconcilers(
 		ctx,
 		kargoMgr,
 		sharedIndexer,
+		cloudEventsSender,
 	); err != nil {
 		return fmt.Errorf("error setting up control flow Stages reconciler: %w", err)
 	}
@@ -471,6 +529,47 @@ func (o *controllerOptions) setupReconcilers(
 	return nil
 }
 
+// newPromotionEngine returns a promotion.Engine that executes the steps of
+// each Promotion either within the controller or in a dedicated Pod, as
+// selected by the Project's ProjectConfig or, by default, by the
+// PROMOTION_EXECUTION_MODE environment variable. Steps implemented by plugins
+// are served by the controller's sidecars, which promotion Pods cannot reach,
+// so Promotions that use them can only be executed within the controller.
+func (o *controllerOptions) newPromotionEngine(
+	kargoMgr manager.Manager,
+	argoCDClient client.Client,
+	credentialsDB credentials.Database,
+	pluginSteps []string,
+) (promotion.Engine, error) {
+	engines := map[kargoapi.PromotionExecutionMode]promotion.Engine{
+		kargoapi.PromotionExecutionModeController: promotion.NewLocalEngine(
+			kargoMgr.GetClient(),
+			argoCDClient,
+			credentialsDB,
+			promotion.DefaultExprDataCacheFn,
+		),
+	}
+	if podEngineCfg := promotion.PodEngineConfigFromEnv(); podEngineCfg.Image != "" {
+		podsClient, err := corev1client.NewForConfig(kargoMgr.GetConfig())
+		if err != nil {
+			return nil, fmt.Errorf("error initializing Kubernetes core API client: %w", err)
+		}
+		engines[kargoapi.PromotionExecutionModePod] = promotion.NewPodEngine(
+			podEngineCfg,
+			kargoMgr.GetClient(),
+			podsClient,
+			pluginSteps,
+		)
+	}
+	defaultMode := kargoapi.PromotionExecutionMode(
+		os.GetEnv("PROMOTION_EXECUTION_MODE", string(kargoapi.PromotionExecutionModeController)),
+	)
+	if _, ok := engines[defaultMode]; !ok {
+		return nil, fmt.Errorf("default promotion execution mode %q is not enabled", defaultMode)
+	}
+	return promotion.NewSelectingEngine(kargoMgr.GetClient(), defaultMode, engines), nil
+}
+
 func (o *controllerOptions) startManagers(ctx context.Context, kargoMgr, argocdMgr manager.Manager) error {
 	var (
 		errChan = make(chan error)
@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/archive"
 	"github.com/akuity/kargo/pkg/garbage"
 	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/logging"
@@ -81,6 +82,14 @@ func (o *garbageCollectorOptions) complete() {
 }
 
 func (o *garbageCollectorOptions) run(ctx context.Context) error {
+	var arch *archive.Archive
+	if archiveCfg := archive.ConfigFromEnv(); archiveCfg.URL != "" {
+		var err error
+		if arch, err = archive.New(archiveCfg); err != nil {
+			return fmt.Errorf("error initializing archive: %w", err)
+		}
+	}
+
 	mgr, err := o.setupManager(ctx)
 	if err != nil {
 		return fmt.Errorf("error setting up controller manager: %w", err)
@@ -100,7 +109,7 @@ func (o *garbageCollectorOptions) run(ctx context.Context) error {
 	}
 
 	cfg := garbage.CollectorConfigFromEnv()
-	return garbage.NewCollector(mgr.GetClient(), cfg).Run(ctx)
+	return garbage.NewCollector(mgr.GetClient(), cfg, arch).Run(ctx)
 }
 
 func (o *garbageCollectorOptions) setupManager(ctx context.Context) (manager.Manager, error) {
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/os"
@@ -27,6 +28,7 @@ import (
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/project"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/projectconfig"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/promotion"
+	"github.com/akuity/kargo/pkg/webhook/kubernetes/promotionplan"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/promotiontask"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/stage"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/warehouse"
@@ -140,13 +142,20 @@ func (o *kubernetesWebhooksServerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("index Stages by Freight: %w", err)
 	}
 
+	// A single CloudEvents sender, and therefore a single queue, is shared by
+	// all webhooks.
+	cloudEventsSender, err := cloudevents.NewSenderFromEnv(ctx)
+	if err != nil {
+		return fmt.Errorf("error initializing CloudEvents sender: %w", err)
+	}
+
 	if err = clusterconfig.SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("setup ClusterConfig webhook: %w", err)
 	}
 	if err = clusterpromotiontask.SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("setup ClusterPromotionTask webhook: %w", err)
 	}
-	if err = freight.SetupWebhookWithManager(ctx, webhookCfg, mgr); err != nil {
+	if err = freight.SetupWebhookWithManager(ctx, webhookCfg, mgr, cloudEventsSender); err != nil {
 		return fmt.Errorf("setup Freight webhook: %w", err)
 	}
 	if err = project.SetupWebhookWithManager(
@@ -158,9 +167,12 @@ func (o *kubernetesWebhooksServerOptions) run(ctx context.Context) error {
 	if err = projectconfig.SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("setup ProjectConfig webhook: %w", err)
 	}
-	if err = promotion.SetupWebhookWithManager(ctx, webhookCfg, mgr); err != nil {
+	if err = promotion.SetupWebhookWithManager(ctx, webhookCfg, mgr, cloudEventsSender); err != nil {
 		return fmt.Errorf("setup Promotion webhook: %w", err)
 	}
+	if err = promotionplan.SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("setup PromotionPlan webhook: %w", err)
+	}
 	if err = promotiontask.SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("setup PromotionTask webhook: %w", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -18,6 +19,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/controller/management/clusterconfigs"
 	"github.com/akuity/kargo/pkg/controller/management/namespaces"
+	"github.com/akuity/kargo/pkg/controller/management/notifications"
 	"github.com/akuity/kargo/pkg/controller/management/projectconfigs"
 	"github.com/akuity/kargo/pkg/controller/management/projects"
 	"github.com/akuity/kargo/pkg/controller/management/serviceaccounts"
@@ -124,6 +126,14 @@ func (o *managementControllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error setting up ProjectConfigs reconciler: %w", err)
 	}
 
+	if err := notifications.SetupReconcilerWithManager(
+		ctx,
+		kargoMgr,
+		notifications.ReconcilerConfigFromEnv(),
+	); err != nil {
+		return fmt.Errorf("error setting up notifications reconciler: %w", err)
+	}
+
 	if o.ManageControllerRoleBindings {
 		if err := serviceaccounts.SetupReconcilerWithManager(
 			ctx,
@@ -176,6 +186,16 @@ func (o *managementControllerOptions) setupManager(ctx context.Context) (manager
 				BindAddress: o.MetricsBindAddress,
 			},
 			PprofBindAddress: o.PprofBindAddress,
+			Client: client.Options{
+				Cache: &client.CacheOptions{
+					DisableFor: []client.Object{
+						// Secrets are only ever read by the notifications reconciler,
+						// once per notification delivered. Caching them would mean
+						// watching every Secret in the cluster.
+						&corev1.Secret{},
+					},
+				},
+			},
 			Cache: cache.Options{
 				ByObject: map[client.Object]cache.ByObject{
 					&corev1.ServiceAccount{}: {
@@ -183,6 +203,14 @@ func (o *managementControllerOptions) setupManager(ctx context.Context) (manager
 							o.KargoNamespace: {},
 						},
 					},
+					// Only Events recorded by Kargo itself are of interest to the
+					// notifications reconciler.
+					&corev1.Event{}: {
+						Field: fields.OneTermEqualSelector(
+							"involvedObject.apiVersion",
+							kargoapi.GroupVersion.Identifier(),
+						),
+					},
 				},
 			},
 		},
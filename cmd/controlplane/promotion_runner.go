@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	stdos "os"
+	stdruntime "runtime"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/os"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/types"
+	versionpkg "github.com/akuity/kargo/pkg/x/version"
+)
+
+type promotionRunnerOptions struct {
+	KubeConfig string
+	QPS        float32
+	Burst      int
+
+	ArgoCDEnabled bool
+
+	InputPath string
+
+	Logger *logging.Logger
+}
+
+func newPromotionRunnerCommand() *cobra.Command {
+	_, format := getLogVars()
+	cmdOpts := &promotionRunnerOptions{
+		// During startup, we enforce use of an info-level logger to ensure that
+		// no important startup messages are missed.
+		Logger: logging.NewLoggerOrDie(logging.InfoLevel, format),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "promotion-runner",
+		DisableAutoGenTag: true,
+		SilenceErrors:     true,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			version := versionpkg.GetVersion()
+
+			cmdOpts.Logger.Info(
+				"Starting Kargo Promotion Runner",
+				"version", version.Version,
+				"commit", version.GitCommit,
+				"GOMAXPROCS", stdruntime.GOMAXPROCS(0),
+				"GOMEMLIMIT", os.GetEnv("GOMEMLIMIT", ""),
+			)
+
+			cmdOpts.complete()
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+func (o *promotionRunnerOptions) complete() {
+	o.KubeConfig = os.GetEnv("KUBECONFIG", "")
+	o.QPS = types.MustParseFloat32(os.GetEnv("KUBE_API_QPS", "50.0"))
+	o.Burst = types.MustParseInt(os.GetEnv("KUBE_API_BURST", "300"))
+
+	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "true"))
+
+	o.InputPath = os.GetEnv("PROMOTION_INPUT_PATH", promotion.PodInputPath)
+
+	logLevel, logFormat := getLogVars()
+
+	o.Logger = logging.NewLoggerOrDie(logLevel, logFormat)
+}
+
+func (o *promotionRunnerOptions) run(ctx context.Context) error {
+	ctx = logging.ContextWithLogger(ctx, o.Logger)
+
+	input, err := promotion.ReadPodInput(o.InputPath)
+	if err != nil {
+		return err
+	}
+
+	kargoClient, err := o.newClient(ctx, kargoapi.AddToScheme)
+	if err != nil {
+		return fmt.Errorf("error initializing Kargo client: %w", err)
+	}
+
+	// The ServiceAccount of a promotion Pod is only permitted to access
+	// Argo CD Applications if an operator has explicitly granted it access.
+	var argoCDClient client.Client
+	if o.ArgoCDEnabled {
+		if argoCDClient, err = o.newClient(ctx, argocd.AddToScheme); err != nil {
+			return fmt.Errorf("error initializing Argo CD client: %w", err)
+		}
+	}
+
+	// Credentials are only ever looked up in the Project namespace. The
+	// ServiceAccount of a promotion Pod has no access to global credentials.
+	credentialsDB := credsdb.NewDatabase(
+		kargoClient,
+		kargoClient,
+		credentials.DefaultProviderRegistry,
+		credsdb.DatabaseConfigFromEnv(),
+	)
+
+	o.Logger.Info(
+		"executing Promotion",
+		"namespace", input.Context.Project,
+		"promotion", input.Context.Promotion,
+		"steps", len(input.Steps),
+	)
+
+	return promotion.RunInPod(
+		ctx,
+		promotion.NewLocalEngine(
+			kargoClient,
+			argoCDClient,
+			credentialsDB,
+			promotion.DefaultExprDataCacheFn,
+		),
+		input,
+		stdos.Stdout,
+	)
+}
+
+// newClient returns an uncached client with a scheme composed of the
+// Kubernetes core API and the API added by the provided function. Promotion
+// Pods are short-lived and only have access to their own Project namespace,
+// so there is nothing to be gained from a cache.
+func (o *promotionRunnerOptions) newClient(
+	ctx context.Context,
+	addToScheme func(*runtime.Scheme) error,
+) (client.Client, error) {
+	restCfg, err := kubernetes.GetRestConfig(ctx, o.KubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+
+	scheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Kubernetes core API to scheme: %w", err)
+	}
+	if err = addToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding API to scheme: %w", err)
+	}
+	return client.New(restCfg, client.Options{Scheme: scheme})
+}
@@ -18,7 +18,7 @@ import (
 // GenerateFreightID deterministically calculates a piece of Freight's ID based
 // on its contents and returns it.
 func GenerateFreightID(f *kargoapi.Freight) string {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.Artifacts) + len(f.Objects)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		if commit.Tag != "" {
@@ -63,6 +63,23 @@ func GenerateFreightID(f *kargoapi.Freight) string {
 			),
 		)
 	}
+	for _, artifact := range f.Artifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, BOTH tag and digest are incorporated into the
+			// canonical representation of an OCI artifact. The "oci://" prefix
+			// prevents collisions with images from the same repository.
+			fmt.Sprintf("oci://%s:%s@%s", artifact.RepoURL, artifact.Tag, artifact.Digest),
+		)
+	}
+	for _, obj := range f.Objects {
+		artifacts = append(
+			artifacts,
+			// The ETag is incorporated so that an object overwritten in place
+			// (i.e. with the same key) is still recognized as new.
+			fmt.Sprintf("%s/%s@%s", strings.TrimSuffix(obj.URL, "/"), obj.Key, obj.ETag),
+		)
+	}
 	slices.Sort(artifacts)
 	return fmt.Sprintf(
 		"%x",
@@ -41,6 +41,18 @@ func TestGenerateFreightID(t *testing.T) {
 				Version: "fake-chart-version",
 			},
 		},
+		Artifacts: []kargoapi.OCIArtifact{
+			{
+				RepoURL: "fake-oci-repo",
+				Tag:     "fake-oci-tag",
+			},
+		},
+		Objects: []kargoapi.BucketObject{
+			{
+				URL: "https://fake-bucket",
+				Key: "fake-key",
+			},
+		},
 	}
 	id := GenerateFreightID(&freight)
 	expected := id
@@ -51,6 +63,12 @@ func TestGenerateFreightID(t *testing.T) {
 	// Changing anything should change the result
 	freight.Commits[0].ID = "a-different-fake-commit"
 	require.NotEqual(t, expected, GenerateFreightID(&freight))
+	expected = GenerateFreightID(&freight)
+	freight.Artifacts[0].Digest = "fake-oci-digest"
+	require.NotEqual(t, expected, GenerateFreightID(&freight))
+	expected = GenerateFreightID(&freight)
+	freight.Objects[0].ETag = "fake-etag"
+	require.NotEqual(t, expected, GenerateFreightID(&freight))
 }
 
 // TODO(krancour): If we move our actual indexers to this package, we can use
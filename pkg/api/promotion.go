@@ -14,6 +14,10 @@ import (
 	"github.com/akuity/kargo/pkg/server/user"
 )
 
+// PromotionAliasSeparator is the separator used in the Promotion step alias
+// to separate the task alias from the step alias.
+const PromotionAliasSeparator = "::"
+
 // GetPromotion returns a pointer to the Promotion resource specified by the
 // namespacedName argument. If no such resource is found, nil is returned
 // instead.
@@ -164,3 +168,32 @@ func IsCurrentStepRunning(promo *kargoapi.Promotion) bool {
 		int64(len(promo.Status.StepExecutionMetadata)) == promo.Status.CurrentStep+1 &&
 		promo.Status.StepExecutionMetadata[promo.Status.CurrentStep].Status == kargoapi.PromotionStepStatusRunning
 }
+
+// ValidateSeparationOfDuties returns an error if any of the provided
+// Promotions is a Promotion of the specified Freight to the specified Stage
+// that was created by the specified actor, who may therefore not also approve
+// the Freight for the Stage. Promotions of other Freight or to other Stages are
+// ignored, so callers may pass any superset of the relevant Promotions.
+func ValidateSeparationOfDuties(
+	promos []kargoapi.Promotion,
+	freight string,
+	stage string,
+	actor string,
+) error {
+	if actor == "" {
+		return nil
+	}
+	for _, promo := range promos {
+		if promo.Spec.Stage != stage || promo.Spec.Freight != freight {
+			continue
+		}
+		if promo.Annotations[kargoapi.AnnotationKeyCreateActor] == actor {
+			return fmt.Errorf(
+				"%q created Promotion %q of Freight %q to Stage %q and may not "+
+					"also approve it",
+				actor, promo.Name, freight, stage,
+			)
+		}
+	}
+	return nil
+}
@@ -396,3 +396,65 @@ func TestIsCurrentStepRunningRunning(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSeparationOfDuties(t *testing.T) {
+	newPromo := func(name, stage, freight, creator string) kargoapi.Promotion {
+		return kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyCreateActor: creator,
+				},
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   stage,
+				Freight: freight,
+			},
+		}
+	}
+	promos := []kargoapi.Promotion{
+		newPromo("other-stage", "other-stage", "fake-freight", "admin:alice"),
+		newPromo("other-freight", "fake-stage", "other-freight", "admin:alice"),
+		newPromo("by-bob", "fake-stage", "fake-freight", "admin:bob"),
+	}
+
+	tests := []struct {
+		name       string
+		actor      string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:  "actor did not create a relevant Promotion",
+			actor: "admin:alice",
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:  "actor created a relevant Promotion",
+			actor: "admin:bob",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					`"admin:bob" created Promotion "by-bob" of Freight "fake-freight" `+
+						`to Stage "fake-stage" and may not also approve it`,
+				)
+			},
+		},
+		{
+			name: "actor is unknown",
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.assertions(
+				t,
+				ValidateSeparationOfDuties(promos, "fake-freight", "fake-stage", tt.actor),
+			)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/bucket"
+)
+
+const (
+	// KindPromotion is the kind of archived Promotions.
+	KindPromotion = "Promotion"
+	// KindFreight is the kind of archived Freight.
+	KindFreight = "Freight"
+
+	// dateFormat is the format of the date partition of a record's key.
+	dateFormat = "2006-01-02"
+
+	// indexName is the name of the object holding the index of a date
+	// partition. It cannot collide with the name of a record, as the names of
+	// Kubernetes resources cannot begin with an underscore.
+	indexName = "_index.yaml"
+)
+
+// kindDirs maps each supported kind to the name of the partition beneath
+// which records of that kind are stored.
+var kindDirs = map[string]string{
+	KindPromotion: "promotions",
+	KindFreight:   "freight",
+}
+
+// ErrNotFound is returned when a requested record does not exist in the
+// archive.
+var ErrNotFound = errors.New("archived record not found")
+
+// Config is configuration for an Archive.
+type Config struct {
+	// URL specifies where records are archived. It may be a path-style URL of
+	// an S3-compatible bucket (e.g. https://s3.us-west-2.amazonaws.com/bucket),
+	// a file:// URL, or a filesystem path. When empty, archival is disabled.
+	URL string `envconfig:"ARCHIVE_URL"`
+	// Region is the region used for signing requests to an S3-compatible
+	// bucket.
+	Region string `envconfig:"ARCHIVE_REGION"`
+	// InsecureSkipTLSVerify specifies whether TLS verification should be
+	// skipped when communicating with an S3-compatible bucket.
+	InsecureSkipTLSVerify bool `envconfig:"ARCHIVE_INSECURE_SKIP_TLS_VERIFY" default:"false"`
+	// AccessKeyID is the access key ID used for signing requests to an
+	// S3-compatible bucket. Requests are not signed if this is empty.
+	AccessKeyID string `envconfig:"ARCHIVE_ACCESS_KEY_ID"`
+	// SecretAccessKey is the secret access key used for signing requests to an
+	// S3-compatible bucket.
+	SecretAccessKey string `envconfig:"ARCHIVE_SECRET_ACCESS_KEY"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Record describes a single archived resource.
+type Record struct {
+	// Project is the Project the resource belonged to.
+	Project string `json:"project"`
+	// Kind is the kind of the resource. It is one of KindPromotion or
+	// KindFreight.
+	Kind string `json:"kind"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Date is the creation date of the resource, in UTC, formatted as
+	// YYYY-MM-DD.
+	Date string `json:"date"`
+	// Key is the key under which the record is stored in the archive.
+	Key string `json:"key"`
+	// Stages are the names of the Stages the resource relates to. For a
+	// Promotion, this is the Stage it targeted. For Freight, these are the
+	// Stages it was in or was verified in at the time it was archived.
+	Stages []string `json:"stages,omitempty"`
+	// Freight is the name of the Freight the resource relates to. For a
+	// Promotion, this is the Freight it promoted. For Freight, this is its own
+	// name.
+	Freight string `json:"freight"`
+}
+
+// index describes all records stored in a single date partition, so that
+// records can be listed without reading each of them.
+type index struct {
+	Records []Record `json:"records"`
+}
+
+// ListOptions filters the records returned by Archive.List.
+type ListOptions struct {
+	// Kind, if non-empty, limits results to records of the specified kind.
+	Kind string
+	// Stage, if non-empty, limits results to records relating to the specified
+	// Stage.
+	Stage string
+	// Freight, if non-empty, limits results to records relating to the
+	// specified Freight.
+	Freight string
+}
+
+// Archive persists Promotions and Freight, including their final status, so
+// that an audit trail survives their deletion from the cluster. Records are
+// stored as YAML documents under keys of the form
+// <project>/<promotions|freight>/<YYYY-MM-DD>/<name>.yaml. Each date partition
+// also holds an index of its records, which is read in place of the records
+// themselves when they are listed.
+//
+// The index of a date partition is updated by reading, modifying and writing
+// it. Records must therefore only be archived by a single writer at a time,
+// which the garbage collector, as the only writer, ensures.
+type Archive struct {
+	store store
+}
+
+// New returns an Archive backed by the location described by the provided
+// Config.
+func New(cfg Config) (*Archive, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("no archive URL specified")
+	}
+	u, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing archive URL %q: %w", cfg.URL, err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		var creds *bucket.Credentials
+		if cfg.AccessKeyID != "" {
+			creds = &bucket.Credentials{
+				AccessKeyID:     cfg.AccessKeyID,
+				SecretAccessKey: cfg.SecretAccessKey,
+			}
+		}
+		b, err := bucket.NewBucket(
+			cfg.URL,
+			cfg.Region,
+			cfg.InsecureSkipTLSVerify,
+			creds,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &Archive{store: &bucketStore{bucket: b}}, nil
+	case "file":
+		return &Archive{store: &fsStore{root: u.Path}}, nil
+	case "":
+		return &Archive{store: &fsStore{root: cfg.URL}}, nil
+	default:
+		return nil, fmt.Errorf(
+			"archive URL %q has unsupported scheme %q", cfg.URL, u.Scheme,
+		)
+	}
+}
+
+// Put archives the provided Promotion or Freight. Archiving the same resource
+// more than once replaces the existing record.
+func (a *Archive) Put(ctx context.Context, obj client.Object) error {
+	var kind string
+	switch obj.(type) {
+	case *kargoapi.Promotion:
+		kind = KindPromotion
+	case *kargoapi.Freight:
+		kind = KindFreight
+	default:
+		return fmt.Errorf("cannot archive resource of type %T", obj)
+	}
+	obj = obj.DeepCopyObject().(client.Object) // nolint: forcetypeassert
+	obj.GetObjectKind().SetGroupVersionKind(kargoapi.GroupVersion.WithKind(kind))
+	obj.SetManagedFields(nil)
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf(
+			"error marshaling %s %q in namespace %q: %w",
+			kind, obj.GetName(), obj.GetNamespace(), err,
+		)
+	}
+	key := path.Join(
+		obj.GetNamespace(),
+		kindDirs[kind],
+		obj.GetCreationTimestamp().UTC().Format(dateFormat),
+		obj.GetName()+".yaml",
+	)
+	if err = a.store.put(ctx, key, data); err != nil {
+		return fmt.Errorf(
+			"error archiving %s %q in namespace %q: %w",
+			kind, obj.GetName(), obj.GetNamespace(), err,
+		)
+	}
+	record, err := parseRecord(obj.GetNamespace(), kind, key, data)
+	if err != nil {
+		return err
+	}
+	if err = a.updateIndex(ctx, record); err != nil {
+		return fmt.Errorf(
+			"error indexing archived %s %q in namespace %q: %w",
+			kind, obj.GetName(), obj.GetNamespace(), err,
+		)
+	}
+	return nil
+}
+
+// updateIndex adds the provided Record to the index of its date partition,
+// replacing any existing entry for the same key.
+func (a *Archive) updateIndex(ctx context.Context, record Record) error {
+	key := path.Join(path.Dir(record.Key), indexName)
+	idx := index{}
+	data, err := a.store.get(ctx, key)
+	switch {
+	case errors.Is(err, ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		if err = yaml.Unmarshal(data, &idx); err != nil {
+			return fmt.Errorf("error parsing archive index %q: %w", key, err)
+		}
+	}
+	idx.Records = slices.DeleteFunc(idx.Records, func(r Record) bool {
+		return r.Key == record.Key
+	})
+	idx.Records = append(idx.Records, record)
+	slices.SortFunc(idx.Records, func(lhs, rhs Record) int {
+		return strings.Compare(lhs.Key, rhs.Key)
+	})
+	if data, err = yaml.Marshal(idx); err != nil {
+		return fmt.Errorf("error marshaling archive index %q: %w", key, err)
+	}
+	return a.store.put(ctx, key, data)
+}
+
+// List returns records of the resources archived from the specified Project
+// that match the provided options, most recent first. Only the index of each
+// date partition is read, not the records themselves.
+func (a *Archive) List(
+	ctx context.Context,
+	project string,
+	opts ListOptions,
+) ([]Record, error) {
+	kinds := []string{KindPromotion, KindFreight}
+	if opts.Kind != "" {
+		if _, ok := kindDirs[opts.Kind]; !ok {
+			return nil, fmt.Errorf("unsupported kind %q", opts.Kind)
+		}
+		kinds = []string{opts.Kind}
+	}
+	var records []Record
+	for _, kind := range kinds {
+		keys, err := a.store.list(ctx, project+"/"+kindDirs[kind]+"/")
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error listing archived %s records in Project %q: %w",
+				kind, project, err,
+			)
+		}
+		for _, key := range keys {
+			if path.Base(key) != indexName {
+				continue
+			}
+			data, err := a.store.get(ctx, key)
+			if err != nil {
+				return nil, fmt.Errorf("error reading archive index %q: %w", key, err)
+			}
+			idx := index{}
+			if err = yaml.Unmarshal(data, &idx); err != nil {
+				return nil, fmt.Errorf("error parsing archive index %q: %w", key, err)
+			}
+			for _, record := range idx.Records {
+				if opts.Stage != "" && !slices.Contains(record.Stages, opts.Stage) {
+					continue
+				}
+				if opts.Freight != "" && record.Freight != opts.Freight {
+					continue
+				}
+				records = append(records, record)
+			}
+		}
+	}
+	slices.SortFunc(records, func(lhs, rhs Record) int {
+		if c := strings.Compare(rhs.Date, lhs.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(lhs.Key, rhs.Key)
+	})
+	return records, nil
+}
+
+// Get returns the YAML document archived for the specified resource. If the
+// archive holds more than one record for the resource (because resources of
+// the same name were created on different dates), the most recent is
+// returned. If there is no such record, an error wrapping ErrNotFound is
+// returned.
+func (a *Archive) Get(
+	ctx context.Context,
+	project string,
+	kind string,
+	name string,
+) ([]byte, error) {
+	dir, ok := kindDirs[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported kind %q", kind)
+	}
+	keys, err := a.store.list(ctx, project+"/"+dir+"/")
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error listing archived %s records in Project %q: %w",
+			kind, project, err,
+		)
+	}
+	var latest string
+	for _, key := range keys {
+		if path.Base(key) == name+".yaml" && key > latest {
+			latest = key
+		}
+	}
+	if latest == "" {
+		return nil, fmt.Errorf(
+			"%w: %s %q in Project %q", ErrNotFound, kind, name, project,
+		)
+	}
+	return a.store.get(ctx, latest)
+}
+
+// parseRecord returns a Record describing the provided YAML document, which
+// was archived under the provided key.
+func parseRecord(project, kind, key string, data []byte) (Record, error) {
+	record := Record{
+		Project: project,
+		Kind:    kind,
+		Key:     key,
+		Date:    path.Base(path.Dir(key)),
+	}
+	switch kind {
+	case KindPromotion:
+		promo := kargoapi.Promotion{}
+		if err := yaml.Unmarshal(data, &promo); err != nil {
+			return record, fmt.Errorf("error parsing archived record %q: %w", key, err)
+		}
+		record.Name = promo.Name
+		record.Stages = []string{promo.Spec.Stage}
+		record.Freight = promo.Spec.Freight
+	case KindFreight:
+		freight := kargoapi.Freight{}
+		if err := yaml.Unmarshal(data, &freight); err != nil {
+			return record, fmt.Errorf("error parsing archived record %q: %w", key, err)
+		}
+		record.Name = freight.Name
+		record.Freight = freight.Name
+		for stage := range freight.Status.CurrentlyIn {
+			record.Stages = append(record.Stages, stage)
+		}
+		for stage := range freight.Status.VerifiedIn {
+			if !slices.Contains(record.Stages, stage) {
+				record.Stages = append(record.Stages, stage)
+			}
+		}
+		slices.Sort(record.Stages)
+	}
+	return record, nil
+}
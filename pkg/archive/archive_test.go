@@ -0,0 +1,277 @@
+package archive
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        Config
+		assertions func(*testing.T, *Archive, error)
+	}{
+		{
+			name: "no URL",
+			assertions: func(t *testing.T, _ *Archive, err error) {
+				require.ErrorContains(t, err, "no archive URL specified")
+			},
+		},
+		{
+			name: "unsupported scheme",
+			cfg:  Config{URL: "s3://my-bucket"},
+			assertions: func(t *testing.T, _ *Archive, err error) {
+				require.ErrorContains(t, err, "unsupported scheme")
+			},
+		},
+		{
+			name: "invalid bucket URL",
+			cfg:  Config{URL: "https://s3.example.com"},
+			assertions: func(t *testing.T, _ *Archive, err error) {
+				require.ErrorContains(t, err, "must be of the form")
+			},
+		},
+		{
+			name: "bucket URL",
+			cfg:  Config{URL: "https://s3.example.com/my-bucket"},
+			assertions: func(t *testing.T, a *Archive, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &bucketStore{}, a.store)
+			},
+		},
+		{
+			name: "file URL",
+			cfg:  Config{URL: "file:///var/lib/kargo/archive"},
+			assertions: func(t *testing.T, a *Archive, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &fsStore{root: "/var/lib/kargo/archive"}, a.store)
+			},
+		},
+		{
+			name: "filesystem path",
+			cfg:  Config{URL: "/var/lib/kargo/archive"},
+			assertions: func(t *testing.T, a *Archive, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &fsStore{root: "/var/lib/kargo/archive"}, a.store)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			a, err := New(testCase.cfg)
+			testCase.assertions(t, a, err)
+		})
+	}
+}
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	a, err := New(Config{URL: root})
+	require.NoError(t, err)
+
+	day1 := metav1.NewTime(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+	day2 := metav1.NewTime(time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC))
+
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "fake-project",
+			Name:              "fake-freight",
+			CreationTimestamp: day1,
+			ManagedFields: []metav1.ManagedFieldsEntry{{
+				Manager: "fake-manager",
+			}},
+		},
+		Status: kargoapi.FreightStatus{
+			CurrentlyIn: map[string]kargoapi.CurrentStage{"test": {}},
+			VerifiedIn:  map[string]kargoapi.VerifiedStage{"dev": {}, "test": {}},
+		},
+	}
+	promos := []*kargoapi.Promotion{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-project",
+				Name:              "promo-1",
+				CreationTimestamp: day1,
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "dev", Freight: "fake-freight"},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseSucceeded,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-project",
+				Name:              "promo-2",
+				CreationTimestamp: day2,
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "test", Freight: "fake-freight"},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseFailed,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "other-project",
+				Name:              "promo-3",
+				CreationTimestamp: day2,
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "dev", Freight: "other-freight"},
+		},
+	}
+
+	t.Run("put unsupported resource", func(t *testing.T) {
+		err := a.Put(ctx, &kargoapi.Stage{})
+		require.ErrorContains(t, err, "cannot archive resource of type")
+	})
+
+	t.Run("put", func(t *testing.T) {
+		require.NoError(t, a.Put(ctx, freight))
+		// The original object must not have been modified
+		require.NotEmpty(t, freight.ManagedFields)
+		require.Empty(t, freight.Kind)
+		for _, promo := range promos {
+			require.NoError(t, a.Put(ctx, promo))
+		}
+		// Archiving again must be idempotent
+		require.NoError(t, a.Put(ctx, promos[0]))
+		require.FileExists(
+			t,
+			filepath.Join(root, "fake-project", "freight", "2026-10-01", "fake-freight.yaml"),
+		)
+		require.FileExists(
+			t,
+			filepath.Join(root, "fake-project", "promotions", "2026-10-02", "promo-2.yaml"),
+		)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		data, err := a.Get(ctx, "fake-project", KindFreight, "fake-freight")
+		require.NoError(t, err)
+		archived := &kargoapi.Freight{}
+		require.NoError(t, yaml.Unmarshal(data, archived))
+		require.Equal(t, kargoapi.GroupVersion.String(), archived.APIVersion)
+		require.Equal(t, KindFreight, archived.Kind)
+		require.Empty(t, archived.ManagedFields)
+		require.Equal(t, freight.Status, archived.Status)
+
+		data, err = a.Get(ctx, "fake-project", KindPromotion, "promo-2")
+		require.NoError(t, err)
+		archivedPromo := &kargoapi.Promotion{}
+		require.NoError(t, yaml.Unmarshal(data, archivedPromo))
+		require.Equal(t, kargoapi.PromotionPhaseFailed, archivedPromo.Status.Phase)
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		_, err := a.Get(ctx, "fake-project", KindPromotion, "promo-3")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("get unsupported kind", func(t *testing.T) {
+		_, err := a.Get(ctx, "fake-project", "Stage", "dev")
+		require.ErrorContains(t, err, `unsupported kind "Stage"`)
+	})
+
+	testCases := []struct {
+		name          string
+		project       string
+		opts          ListOptions
+		expectedNames []string
+	}{
+		{
+			name:          "all records",
+			project:       "fake-project",
+			expectedNames: []string{"promo-2", "fake-freight", "promo-1"},
+		},
+		{
+			name:          "by kind",
+			project:       "fake-project",
+			opts:          ListOptions{Kind: KindPromotion},
+			expectedNames: []string{"promo-2", "promo-1"},
+		},
+		{
+			name:          "by Stage",
+			project:       "fake-project",
+			opts:          ListOptions{Stage: "dev"},
+			expectedNames: []string{"fake-freight", "promo-1"},
+		},
+		{
+			name:          "by Freight",
+			project:       "other-project",
+			opts:          ListOptions{Freight: "other-freight"},
+			expectedNames: []string{"promo-3"},
+		},
+		{
+			name:    "no records",
+			project: "empty-project",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run("list "+testCase.name, func(t *testing.T) {
+			records, err := a.List(ctx, testCase.project, testCase.opts)
+			require.NoError(t, err)
+			var names []string
+			for _, record := range records {
+				names = append(names, record.Name)
+			}
+			require.Equal(t, testCase.expectedNames, names)
+		})
+	}
+
+	t.Run("list record details", func(t *testing.T) {
+		records, err := a.List(ctx, "fake-project", ListOptions{Kind: KindFreight})
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]Record{{
+				Project: "fake-project",
+				Kind:    KindFreight,
+				Name:    "fake-freight",
+				Date:    "2026-10-01",
+				Key:     "fake-project/freight/2026-10-01/fake-freight.yaml",
+				Stages:  []string{"dev", "test"},
+				Freight: "fake-freight",
+			}},
+			records,
+		)
+	})
+
+	t.Run("list reads only indexes", func(t *testing.T) {
+		require.FileExists(
+			t,
+			filepath.Join(root, "fake-project", "promotions", "2026-10-01", indexName),
+		)
+		// A record that cannot be parsed is of no consequence, as long as its
+		// date partition is indexed.
+		require.NoError(t, os.WriteFile(
+			filepath.Join(root, "fake-project", "promotions", "2026-10-01", "promo-1.yaml"),
+			[]byte("garbage"),
+			0o600,
+		))
+		records, err := a.List(ctx, "fake-project", ListOptions{Kind: KindPromotion})
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, "promo-1", records[1].Name)
+		require.Equal(t, []string{"dev"}, records[1].Stages)
+	})
+
+	t.Run("list ignores partially written records", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(
+			filepath.Join(root, "fake-project", "freight", "2026-10-01", ".tmp-123"),
+			[]byte("garbage"),
+			0o600,
+		))
+		records, err := a.List(ctx, "fake-project", ListOptions{Kind: KindFreight})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+}
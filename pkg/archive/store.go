@@ -0,0 +1,124 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/akuity/kargo/pkg/bucket"
+)
+
+// store is an interface for components that persist archived records as
+// objects addressed by slash-separated keys.
+type store interface {
+	// put creates or replaces the object having the provided key.
+	put(ctx context.Context, key string, data []byte) error
+	// get returns the contents of the object having the provided key. If no
+	// such object exists, an error wrapping ErrNotFound is returned.
+	get(ctx context.Context, key string) ([]byte, error)
+	// list returns the keys of all objects having keys beginning with the
+	// provided prefix.
+	list(ctx context.Context, prefix string) ([]string, error)
+}
+
+// fsStore is an implementation of the store interface that persists objects
+// as files beneath a root directory.
+type fsStore struct {
+	root string
+}
+
+func (f *fsStore) put(_ context.Context, key string, data []byte) error {
+	filePath := filepath.Join(f.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("error creating directory for %q: %w", key, err)
+	}
+	// Write to a temporary file first and rename it so that readers never
+	// observe a partially written record.
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file for %q: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("error writing %q: %w", key, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("error writing %q: %w", key, err)
+	}
+	if err = os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("error writing %q: %w", key, err)
+	}
+	return nil
+}
+
+func (f *fsStore) get(_ context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(f.root, filepath.FromSlash(key)))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %q", ErrNotFound, key)
+		}
+		return nil, fmt.Errorf("error reading %q: %w", key, err)
+	}
+	return data, nil
+}
+
+func (f *fsStore) list(_ context.Context, prefix string) ([]string, error) {
+	// Prefixes used by this package always end in a slash, so it is
+	// sufficient to walk the corresponding directory.
+	dir := path.Dir(prefix + "x")
+	var keys []string
+	err := filepath.WalkDir(
+		filepath.Join(f.root, filepath.FromSlash(dir)),
+		func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return fs.SkipAll
+				}
+				return err
+			}
+			if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+				return nil
+			}
+			rel, err := filepath.Rel(f.root, filePath)
+			if err != nil {
+				return err
+			}
+			if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %q: %w", prefix, err)
+	}
+	return keys, nil
+}
+
+// bucketStore is an implementation of the store interface that persists
+// objects in an S3-compatible bucket.
+type bucketStore struct {
+	bucket *bucket.Bucket
+}
+
+func (b *bucketStore) put(ctx context.Context, key string, data []byte) error {
+	return b.bucket.PutObject(ctx, key, data)
+}
+
+func (b *bucketStore) get(ctx context.Context, key string) ([]byte, error) {
+	data, err := b.bucket.GetObject(ctx, key)
+	if errors.Is(err, bucket.ErrObjectNotFound) {
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, key)
+	}
+	return data, err
+}
+
+func (b *bucketStore) list(ctx context.Context, prefix string) ([]string, error) {
+	return b.bucket.ListKeys(ctx, prefix)
+}
@@ -0,0 +1,76 @@
+package bucket
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Bucket provides read and write access to the objects in an S3-compatible
+// bucket.
+type Bucket struct {
+	client *client
+}
+
+// NewBucket returns a new Bucket for the bucket at the provided path-style
+// URL, e.g. https://s3.us-west-2.amazonaws.com/my-bucket. If the provided
+// region is empty, a default region is used for signing requests. If the
+// provided credentials are nil, requests are not signed.
+func NewBucket(
+	bucketURL string,
+	region string,
+	insecureSkipTLSVerify bool,
+	creds *Credentials,
+) (*Bucket, error) {
+	c, err := newClient(bucketURL, region, insecureSkipTLSVerify, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &Bucket{client: c}, nil
+}
+
+// ListKeys returns the keys of all objects in the bucket having keys beginning
+// with the provided prefix.
+func (b *Bucket) ListKeys(ctx context.Context, prefix string) ([]string, error) {
+	objects, err := b.client.listObjects(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(objects))
+	for i, obj := range objects {
+		keys[i] = obj.Key
+	}
+	return keys, nil
+}
+
+// GetObject returns the contents of the object having the provided key. If no
+// such object exists, an error wrapping ErrObjectNotFound is returned.
+func (b *Bucket) GetObject(ctx context.Context, key string) ([]byte, error) {
+	return b.client.getObject(ctx, key)
+}
+
+// PutObject creates or replaces the object having the provided key with the
+// provided contents.
+func (b *Bucket) PutObject(ctx context.Context, key string, data []byte) error {
+	return b.client.putObject(ctx, key, data)
+}
+
+// URLForObject returns the path-style URL of the bucket that the object at the
+// provided path-style URL belongs to, e.g. for the object URL
+// https://s3.us-west-2.amazonaws.com/my-bucket/my-app/1.0.0.tar.gz, the bucket
+// URL https://s3.us-west-2.amazonaws.com/my-bucket is returned. If the provided
+// URL cannot be the path-style URL of an object, an empty string is returned.
+func URLForObject(objectURL *url.URL) string {
+	if objectURL.Scheme != "http" && objectURL.Scheme != "https" {
+		return ""
+	}
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(objectURL.Path, "/"), "/")
+	if objectURL.Host == "" || bucketName == "" || key == "" {
+		return ""
+	}
+	return (&url.URL{
+		Scheme: objectURL.Scheme,
+		Host:   objectURL.Host,
+		Path:   "/" + bucketName,
+	}).String()
+}
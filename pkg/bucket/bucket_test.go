@@ -0,0 +1,196 @@
+package bucket
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeObjectServer returns an httptest.Server that emulates the
+// ListObjectsV2, GetObject, and PutObject APIs of an S3-compatible object
+// store for a single bucket, keeping objects in memory. Requests lacking a
+// SigV4 Authorization header are rejected, as are PutObject requests whose
+// declared payload hash does not match the request body.
+func newFakeObjectServer(t *testing.T, bucket string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(
+				`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`,
+			))
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if r.URL.Path == "/"+bucket {
+			res := struct {
+				XMLName  xml.Name     `xml:"ListBucketResult"`
+				Contents []fakeObject `xml:"Contents"`
+			}{}
+			for key := range objects {
+				if strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+					res.Contents = append(res.Contents, fakeObject{Key: key})
+				}
+			}
+			sort.Slice(res.Contents, func(i, j int) bool {
+				return res.Contents[i].Key < res.Contents[j].Key
+			})
+			_ = xml.NewEncoder(w).Encode(res)
+			return
+		}
+		key, ok := strings.CutPrefix(r.URL.Path, "/"+bucket+"/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(
+					`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist</Message></Error>`,
+				))
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodPut:
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			sum := sha256.Sum256(data)
+			if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(
+					`<Error><Code>XAmzContentSHA256Mismatch</Code><Message>Mismatch</Message></Error>`,
+				))
+				return
+			}
+			objects[key] = data
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestBucket(t *testing.T) {
+	srv := newFakeObjectServer(t, "my-bucket")
+	ctx := context.Background()
+
+	t.Run("access denied without credentials", func(t *testing.T) {
+		b, err := NewBucket(srv.URL+"/my-bucket", "", false, nil)
+		require.NoError(t, err)
+		err = b.PutObject(ctx, "foo/bar.yaml", []byte("bar"))
+		require.ErrorContains(t, err, `error putting object "foo/bar.yaml"`)
+		require.ErrorContains(t, err, "AccessDenied")
+	})
+
+	b, err := NewBucket(
+		srv.URL+"/my-bucket",
+		"",
+		false,
+		&Credentials{AccessKeyID: "minioadmin", SecretAccessKey: "minioadmin"},
+	)
+	require.NoError(t, err)
+
+	t.Run("object not found", func(t *testing.T) {
+		_, err := b.GetObject(ctx, "foo/bar.yaml")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("put, list, and get objects", func(t *testing.T) {
+		require.NoError(t, b.PutObject(ctx, "foo/bar.yaml", []byte("bar")))
+		require.NoError(t, b.PutObject(ctx, "foo/baz.yaml", []byte("baz")))
+		require.NoError(t, b.PutObject(ctx, "other/qux.yaml", []byte("qux")))
+
+		keys, err := b.ListKeys(ctx, "foo/")
+		require.NoError(t, err)
+		require.Equal(t, []string{"foo/bar.yaml", "foo/baz.yaml"}, keys)
+
+		data, err := b.GetObject(ctx, "foo/baz.yaml")
+		require.NoError(t, err)
+		require.Equal(t, "baz", string(data))
+	})
+}
+
+func TestURLForObject(t *testing.T) {
+	testCases := []struct {
+		name      string
+		objectURL string
+		expected  string
+	}{
+		{
+			name:      "object in bucket",
+			objectURL: "https://s3.us-west-2.amazonaws.com/my-bucket/my-app/1.0.0.tar.gz",
+			expected:  "https://s3.us-west-2.amazonaws.com/my-bucket",
+		},
+		{
+			name:      "object in bucket with port and query",
+			objectURL: "http://minio.example.com:9000/my-bucket/1.0.0.tar.gz?versionId=1",
+			expected:  "http://minio.example.com:9000/my-bucket",
+		},
+		{
+			name:      "no key",
+			objectURL: "https://s3.us-west-2.amazonaws.com/my-bucket",
+		},
+		{
+			name:      "no bucket",
+			objectURL: "https://s3.us-west-2.amazonaws.com/",
+		},
+		{
+			name:      "unsupported scheme",
+			objectURL: "s3://my-bucket/1.0.0.tar.gz",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			u, err := url.Parse(testCase.objectURL)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, URLForObject(u))
+		})
+	}
+}
+
+func TestSignRequest(t *testing.T) {
+	t.Run("without credentials", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "https://s3.example.com/my-bucket/foo", nil)
+		require.NoError(t, err)
+		require.NoError(t, SignRequest(context.Background(), req, "", nil))
+		require.Empty(t, req.Header.Get("Authorization"))
+	})
+
+	t.Run("with credentials", func(t *testing.T) {
+		req, err := http.NewRequest(
+			http.MethodGet,
+			"https://s3.example.com/my-bucket/foo?version=some+value",
+			nil,
+		)
+		require.NoError(t, err)
+		require.NoError(t, SignRequest(
+			context.Background(),
+			req,
+			"eu-west-1",
+			&Credentials{AccessKeyID: "access-key", SecretAccessKey: "secret-key"},
+		))
+		require.Equal(t, "version=some%20value", req.URL.RawQuery)
+		require.Equal(t, emptyPayloadHash, req.Header.Get("X-Amz-Content-Sha256"))
+		auth := req.Header.Get("Authorization")
+		require.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access-key/"))
+		require.Contains(t, auth, "/eu-west-1/s3/aws4_request")
+	})
+}
@@ -0,0 +1,346 @@
+package bucket
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// defaultRegion is the region used for signing requests when none is
+	// specified. This is the region expected by most S3-compatible object
+	// stores.
+	defaultRegion = "us-east-1"
+
+	// emptyPayloadHash is the hex-encoded SHA-256 hash of an empty request
+	// body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" // nolint: gosec
+
+	// maxErrorBodySize is the maximum number of bytes of an error response that
+	// will be read in an attempt to extract a meaningful error message.
+	maxErrorBodySize = 64 << 10
+)
+
+// ErrObjectNotFound is returned when a requested object does not exist in a
+// bucket.
+var ErrObjectNotFound = errors.New("object not found")
+
+// Credentials represents the credentials for accessing an S3-compatible
+// bucket.
+type Credentials struct {
+	// AccessKeyID is the access key ID used for signing requests.
+	AccessKeyID string
+	// SecretAccessKey is the secret access key used for signing requests.
+	SecretAccessKey string
+}
+
+// object is an internal representation of an object in a bucket.
+type object struct {
+	Key          string
+	ETag         string
+	LastModified time.Time
+}
+
+// listBucketResult is the relevant subset of the response body of the S3
+// ListObjectsV2 API.
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		ETag         string    `xml:"ETag"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+// errorResponse is the body of an error response returned by the S3 API.
+type errorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// client is a minimal client for the S3 ListObjectsV2, GetObject, and
+// PutObject APIs that works with AWS S3 as well as with S3-compatible object
+// stores.
+type client struct {
+	bucketURL  *url.URL
+	region     string
+	creds      *Credentials
+	httpClient *http.Client
+	signer     *v4.Signer
+}
+
+// newClient returns a new client for the bucket at the provided path-style
+// URL.
+func newClient(
+	bucketURL string,
+	region string,
+	insecureSkipTLSVerify bool,
+	creds *Credentials,
+) (*client, error) {
+	u, err := url.Parse(strings.TrimSuffix(bucketURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing bucket URL %q: %w", bucketURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf(
+			"bucket URL %q has unsupported scheme %q", bucketURL, u.Scheme,
+		)
+	}
+	if u.Host == "" || strings.Trim(u.Path, "/") == "" {
+		return nil, fmt.Errorf(
+			"bucket URL %q must be of the form <scheme>://<host>/<bucket>",
+			bucketURL,
+		)
+	}
+	if region == "" {
+		region = defaultRegion
+	}
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	return &client{
+		bucketURL: u,
+		region:    region,
+		creds:     creds,
+		httpClient: &http.Client{
+			Transport: httpTransport,
+			Timeout:   30 * time.Second,
+		},
+		signer: v4.NewSigner(),
+	}, nil
+}
+
+// listObjects returns all objects in the bucket having keys beginning with
+// the provided prefix. Keys ending in "/", which conventionally represent
+// directories, are omitted.
+func (c *client) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	var objects []object
+	var continuationToken string
+	for {
+		res, err := c.listObjectsPage(ctx, prefix, continuationToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, content := range res.Contents {
+			if strings.HasSuffix(content.Key, "/") {
+				continue
+			}
+			objects = append(objects, object{
+				Key:          content.Key,
+				ETag:         strings.Trim(content.ETag, `"`),
+				LastModified: content.LastModified,
+			})
+		}
+		if !res.IsTruncated || res.NextContinuationToken == "" {
+			return objects, nil
+		}
+		continuationToken = res.NextContinuationToken
+	}
+}
+
+// listObjectsPage retrieves a single page of results from the ListObjectsV2
+// API.
+func (c *client) listObjectsPage(
+	ctx context.Context,
+	prefix string,
+	continuationToken string,
+) (*listBucketResult, error) {
+	u := *c.bucketURL
+	query := url.Values{}
+	query.Set("list-type", "2")
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if continuationToken != "" {
+		query.Set("continuation-token", continuationToken)
+	}
+	// The SigV4 canonical query string requires spaces be encoded as %20
+	// rather than as "+".
+	u.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if err = c.sign(ctx, req, emptyPayloadHash); err != nil {
+		return nil, fmt.Errorf("error signing request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error listing objects: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError("listing objects", resp)
+	}
+
+	res := &listBucketResult{}
+	if err = xml.NewDecoder(resp.Body).Decode(res); err != nil {
+		return nil, fmt.Errorf("error decoding list objects response: %w", err)
+	}
+	return res, nil
+}
+
+// getObject returns the contents of the object having the provided key. If no
+// such object exists, ErrObjectNotFound is returned.
+func (c *client) getObject(ctx context.Context, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		c.bucketURL.JoinPath(key).String(),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if err = c.sign(ctx, req, emptyPayloadHash); err != nil {
+		return nil, fmt.Errorf("error signing request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting object %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %q", ErrObjectNotFound, key)
+	default:
+		return nil, newResponseError(fmt.Sprintf("getting object %q", key), resp)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading object %q: %w", key, err)
+	}
+	return data, nil
+}
+
+// putObject creates or replaces the object having the provided key with the
+// provided contents.
+func (c *client) putObject(ctx context.Context, key string, data []byte) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPut,
+		c.bucketURL.JoinPath(key).String(),
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	if err = c.sign(ctx, req, hex.EncodeToString(sum[:])); err != nil {
+		return fmt.Errorf("error signing request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error putting object %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newResponseError(fmt.Sprintf("putting object %q", key), resp)
+	}
+	return nil
+}
+
+// sign signs the provided request, whose body has the provided hex-encoded
+// SHA-256 hash, using AWS Signature Version 4 if the client has credentials.
+// Requests made without credentials are left unsigned, which permits access to
+// publicly readable buckets.
+func (c *client) sign(
+	ctx context.Context,
+	req *http.Request,
+	payloadHash string,
+) error {
+	return sign(ctx, c.signer, req, payloadHash, c.region, c.creds)
+}
+
+// SignRequest signs the provided request, which must not have a body, using
+// AWS Signature Version 4 and the provided credentials, as would be done for a
+// request made directly to an S3-compatible bucket by a Warehouse's bucket
+// subscription. If the provided region is empty, a default region is used. If
+// the provided credentials are nil, the request is left unsigned.
+func SignRequest(
+	ctx context.Context,
+	req *http.Request,
+	region string,
+	creds *Credentials,
+) error {
+	if region == "" {
+		region = defaultRegion
+	}
+	// The SigV4 canonical query string requires spaces be encoded as %20
+	// rather than as "+".
+	req.URL.RawQuery = strings.ReplaceAll(req.URL.RawQuery, "+", "%20")
+	return sign(ctx, v4.NewSigner(), req, emptyPayloadHash, region, creds)
+}
+
+// sign signs the provided request, whose body has the provided hex-encoded
+// SHA-256 hash, using AWS Signature Version 4 and the provided credentials. If
+// the provided credentials are nil, the request is left unsigned.
+func sign(
+	ctx context.Context,
+	signer *v4.Signer,
+	req *http.Request,
+	payloadHash string,
+	region string,
+	creds *Credentials,
+) error {
+	if creds == nil || creds.AccessKeyID == "" {
+		return nil
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	return signer.SignHTTP(
+		ctx,
+		aws.Credentials{
+			AccessKeyID:     creds.AccessKeyID,
+			SecretAccessKey: creds.SecretAccessKey,
+		},
+		req,
+		payloadHash,
+		"s3",
+		region,
+		time.Now(),
+	)
+}
+
+// newResponseError returns an error describing an unsuccessful response to a
+// request performing the described action. When possible, the error code and
+// message are extracted from the response body.
+func newResponseError(action string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	errRes := &errorResponse{}
+	if err := xml.Unmarshal(body, errRes); err == nil && errRes.Code != "" {
+		return fmt.Errorf(
+			"error %s: received status code %d: %s: %s",
+			action, resp.StatusCode, errRes.Code, errRes.Message,
+		)
+	}
+	return fmt.Errorf(
+		"error %s: received status code %d", action, resp.StatusCode,
+	)
+}
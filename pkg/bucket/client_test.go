@@ -0,0 +1,207 @@
+package bucket
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeObject is an object served by a fakeS3Server.
+type fakeObject struct {
+	Key          string    `xml:"Key"`
+	ETag         string    `xml:"ETag"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+// newFakeS3Server returns an httptest.Server that emulates the ListObjectsV2
+// API of an S3-compatible object store, such as MinIO, for a single bucket.
+// Results are paginated using the specified page size. If requireAuth is true,
+// requests lacking a SigV4 Authorization header are rejected.
+func newFakeS3Server(
+	t *testing.T,
+	bucket string,
+	objects []fakeObject,
+	pageSize int,
+	requireAuth bool,
+) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+bucket {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(
+				`<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>`,
+			))
+			return
+		}
+		if requireAuth &&
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(
+				`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`,
+			))
+			return
+		}
+		if r.URL.Query().Get("list-type") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		prefix := r.URL.Query().Get("prefix")
+		var matching []fakeObject
+		for _, obj := range objects {
+			if strings.HasPrefix(obj.Key, prefix) {
+				matching = append(matching, obj)
+			}
+		}
+		start := 0
+		if token := r.URL.Query().Get("continuation-token"); token != "" {
+			var err error
+			if start, err = strconv.Atoi(token); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		end := min(start+pageSize, len(matching))
+		res := struct {
+			XMLName               xml.Name     `xml:"ListBucketResult"`
+			IsTruncated           bool         `xml:"IsTruncated"`
+			NextContinuationToken string       `xml:"NextContinuationToken,omitempty"`
+			Contents              []fakeObject `xml:"Contents"`
+		}{
+			IsTruncated: end < len(matching),
+			Contents:    matching[start:end],
+		}
+		if res.IsTruncated {
+			res.NextContinuationToken = strconv.Itoa(end)
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func Test_newClient(t *testing.T) {
+	testCases := []struct {
+		name       string
+		bucketURL  string
+		region     string
+		assertions func(*testing.T, *client, error)
+	}{
+		{
+			name:      "unsupported scheme",
+			bucketURL: "s3://my-bucket",
+			assertions: func(t *testing.T, _ *client, err error) {
+				require.ErrorContains(t, err, "unsupported scheme")
+			},
+		},
+		{
+			name:      "missing bucket",
+			bucketURL: "https://s3.example.com/",
+			assertions: func(t *testing.T, _ *client, err error) {
+				require.ErrorContains(t, err, "must be of the form")
+			},
+		},
+		{
+			name:      "default region",
+			bucketURL: "https://s3.example.com/my-bucket/",
+			assertions: func(t *testing.T, c *client, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "https://s3.example.com/my-bucket", c.bucketURL.String())
+				require.Equal(t, defaultRegion, c.region)
+			},
+		},
+		{
+			name:      "explicit region",
+			bucketURL: "https://s3.example.com/my-bucket",
+			region:    "eu-west-1",
+			assertions: func(t *testing.T, c *client, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "eu-west-1", c.region)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := newClient(testCase.bucketURL, testCase.region, false, nil)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
+
+func Test_client_listObjects(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	objects := []fakeObject{
+		{Key: "builds/", ETag: `"d41d8cd98f00b204e9800998ecf8427e"`, LastModified: now},
+		{Key: "builds/app-1.0.0.tar.gz", ETag: `"etag-1"`, LastModified: now},
+		{Key: "builds/app-1.1.0.tar.gz", ETag: `"etag-2"`, LastModified: now},
+		{Key: "builds/app-1.2.0.tar.gz", ETag: `"etag-3"`, LastModified: now},
+		{Key: "other/app-9.9.9.tar.gz", ETag: `"etag-4"`, LastModified: now},
+	}
+
+	testCases := []struct {
+		name        string
+		bucket      string
+		creds       *Credentials
+		requireAuth bool
+		assertions  func(*testing.T, []object, error)
+	}{
+		{
+			name:   "bucket not found",
+			bucket: "wrong-bucket",
+			assertions: func(t *testing.T, _ []object, err error) {
+				require.ErrorContains(t, err, "received status code 404")
+				require.ErrorContains(t, err, "NoSuchBucket")
+			},
+		},
+		{
+			name:        "access denied without credentials",
+			bucket:      "my-bucket",
+			requireAuth: true,
+			assertions: func(t *testing.T, _ []object, err error) {
+				require.ErrorContains(t, err, "AccessDenied")
+			},
+		},
+		{
+			name:        "success with credentials and pagination",
+			bucket:      "my-bucket",
+			requireAuth: true,
+			creds: &Credentials{
+				AccessKeyID:     "minioadmin",
+				SecretAccessKey: "minioadmin",
+			},
+			assertions: func(t *testing.T, objs []object, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]object{
+						{Key: "builds/app-1.0.0.tar.gz", ETag: "etag-1", LastModified: now},
+						{Key: "builds/app-1.1.0.tar.gz", ETag: "etag-2", LastModified: now},
+						{Key: "builds/app-1.2.0.tar.gz", ETag: "etag-3", LastModified: now},
+					},
+					objs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := newFakeS3Server(t, "my-bucket", objects, 2, testCase.requireAuth)
+			c, err := newClient(
+				srv.URL+"/"+testCase.bucket,
+				"",
+				false,
+				testCase.creds,
+			)
+			require.NoError(t, err)
+			objs, err := c.listObjects(context.Background(), "builds/")
+			testCase.assertions(t, objs, err)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/image"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// Selector is an interface for selecting objects from an S3-compatible bucket.
+type Selector interface {
+	// MatchesKey returns a boolean value indicating whether or not the Selector
+	// would consider an object with the specified key eligible for selection.
+	MatchesKey(string) bool
+	// Select selects objects from an S3-compatible bucket.
+	Select(context.Context) ([]kargoapi.DiscoveredObject, error)
+}
+
+// selector is the Selector implementation for all ObjectSelectionStrategies.
+// The strategies differ only in how eligible objects are sorted, with the
+// SemVer strategy additionally requiring a version be extracted from each
+// object's key.
+type selector struct {
+	client            *client
+	prefix            string
+	strategy          kargoapi.ObjectSelectionStrategy
+	allowKeysRegexes  []*regexp.Regexp
+	ignoreKeysRegexes []*regexp.Regexp
+	versionRegex      *regexp.Regexp
+	constraint        *semver.Constraints
+	strictSemvers     bool
+	discoveryLimit    int
+}
+
+// selectedObject is an object that has been deemed eligible for selection,
+// along with its parsed version, if applicable.
+type selectedObject struct {
+	object
+	version *semver.Version
+}
+
+// NewSelector returns an implementation of the Selector interface that selects
+// objects from an S3-compatible bucket based on the provided subscription.
+func NewSelector(
+	sub kargoapi.BucketSubscription,
+	creds *Credentials,
+) (Selector, error) {
+	s := &selector{
+		prefix:         sub.Prefix,
+		strategy:       sub.ObjectSelectionStrategy,
+		strictSemvers:  sub.StrictSemvers,
+		discoveryLimit: int(sub.DiscoveryLimit),
+	}
+	if s.strategy == "" {
+		s.strategy = kargoapi.ObjectSelectionStrategySemVer
+	}
+	switch s.strategy {
+	case kargoapi.ObjectSelectionStrategySemVer,
+		kargoapi.ObjectSelectionStrategyLexical,
+		kargoapi.ObjectSelectionStrategyNewestModified:
+	default:
+		return nil, fmt.Errorf("unsupported object selection strategy %q", s.strategy)
+	}
+
+	var err error
+	if s.allowKeysRegexes, err = image.CompileRegexes(sub.AllowKeysRegexes); err != nil {
+		return nil, fmt.Errorf("error compiling allow keys regex: %w", err)
+	}
+	if s.ignoreKeysRegexes, err = image.CompileRegexes(sub.IgnoreKeysRegexes); err != nil {
+		return nil, fmt.Errorf("error compiling ignore keys regex: %w", err)
+	}
+	if s.strategy == kargoapi.ObjectSelectionStrategySemVer {
+		if sub.VersionRegex != "" {
+			if s.versionRegex, err = regexp.Compile(sub.VersionRegex); err != nil {
+				return nil, fmt.Errorf(
+					"error compiling version regex %q: %w", sub.VersionRegex, err,
+				)
+			}
+			if s.versionRegex.NumSubexp() != 1 {
+				return nil, fmt.Errorf(
+					"version regex %q must contain exactly one capture group",
+					sub.VersionRegex,
+				)
+			}
+		}
+		if sub.Constraint != "" {
+			if s.constraint, err = semver.NewConstraint(sub.Constraint); err != nil {
+				return nil, fmt.Errorf(
+					"error parsing semver constraint %q: %w", sub.Constraint, err,
+				)
+			}
+		}
+	}
+
+	if s.client, err = newClient(
+		sub.URL,
+		sub.Region,
+		sub.InsecureSkipTLSVerify,
+		creds,
+	); err != nil {
+		return nil, fmt.Errorf("error creating client for bucket %q: %w", sub.URL, err)
+	}
+	return s, nil
+}
+
+// MatchesKey implements Selector.
+func (s *selector) MatchesKey(key string) bool {
+	_, ok := s.matchKey(key)
+	return ok
+}
+
+// matchKey determines whether an object with the provided key is eligible for
+// selection. When the selection strategy is SemVer, the version extracted from
+// the key is also returned.
+func (s *selector) matchKey(key string) (*semver.Version, bool) {
+	if !strings.HasPrefix(key, s.prefix) {
+		return nil, false
+	}
+	if !image.MatchesRegexes(key, s.allowKeysRegexes, s.ignoreKeysRegexes) {
+		return nil, false
+	}
+	if s.strategy != kargoapi.ObjectSelectionStrategySemVer {
+		return nil, true
+	}
+	versionStr := strings.TrimPrefix(key, s.prefix)
+	if s.versionRegex != nil {
+		submatches := s.versionRegex.FindStringSubmatch(versionStr)
+		if submatches == nil {
+			return nil, false
+		}
+		versionStr = submatches[1]
+	}
+	return image.MatchesSemver(versionStr, s.strictSemvers, s.constraint)
+}
+
+// Select implements Selector.
+func (s *selector) Select(ctx context.Context) ([]kargoapi.DiscoveredObject, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"bucket", s.client.bucketURL.String(),
+		"prefix", s.prefix,
+		"selectionStrategy", s.strategy,
+		"semverConstrained", s.constraint != nil,
+	)
+
+	logger.Trace("discovering objects")
+
+	objects, err := s.client.listObjects(ctx, s.prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		logger.Trace("found no objects")
+		return nil, nil
+	}
+	logger.Trace("got all objects", "count", len(objects))
+
+	selected := make([]selectedObject, 0, len(objects))
+	for _, obj := range objects {
+		if version, ok := s.matchKey(obj.Key); ok {
+			selected = append(selected, selectedObject{object: obj, version: version})
+		}
+	}
+	if len(selected) == 0 {
+		logger.Trace("no objects matched criteria")
+		return nil, nil
+	}
+
+	s.sort(selected)
+
+	logger.Trace("discovered objects", "count", len(selected))
+
+	return s.objectsToAPIObjects(selected), nil
+}
+
+// sort sorts the provided objects from newest to oldest in place, according to
+// the selector's selection strategy. Ties are always broken lexically by key
+// to guarantee a deterministic order.
+func (s *selector) sort(objects []selectedObject) {
+	slices.SortFunc(objects, func(lhs, rhs selectedObject) int {
+		switch s.strategy {
+		case kargoapi.ObjectSelectionStrategySemVer:
+			if comp := image.CompareSemvers(lhs.version, rhs.version); comp != 0 {
+				return comp
+			}
+		case kargoapi.ObjectSelectionStrategyNewestModified:
+			if comp := rhs.LastModified.Compare(lhs.LastModified); comp != 0 {
+				return comp
+			}
+		}
+		return strings.Compare(rhs.Key, lhs.Key)
+	})
+}
+
+// objectsToAPIObjects converts a slice of selected objects to a slice of
+// kargoapi.DiscoveredObject, which can be directly used by a caller performing
+// artifact discovery. If the number of objects provided exceeds the selector's
+// discovery limit, the slice returned will be truncated so as not to exceed
+// that limit.
+func (s *selector) objectsToAPIObjects(
+	objects []selectedObject,
+) []kargoapi.DiscoveredObject {
+	limit := s.discoveryLimit
+	if limit <= 0 || limit > len(objects) {
+		limit = len(objects)
+	}
+	apiObjects := make([]kargoapi.DiscoveredObject, limit)
+	for i, obj := range objects[:limit] {
+		apiObjects[i] = kargoapi.DiscoveredObject{
+			Key:  obj.Key,
+			ETag: obj.ETag,
+		}
+		if obj.version != nil {
+			apiObjects[i].Version = obj.version.Original()
+		}
+		if !obj.LastModified.IsZero() {
+			apiObjects[i].LastModified = &metav1.Time{Time: obj.LastModified}
+		}
+	}
+	return apiObjects
+}
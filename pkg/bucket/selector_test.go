@@ -0,0 +1,282 @@
+package bucket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.BucketSubscription
+		assertions func(*testing.T, Selector, error)
+	}{
+		{
+			name: "unsupported selection strategy",
+			sub: kargoapi.BucketSubscription{
+				URL:                     "https://s3.example.com/my-bucket",
+				ObjectSelectionStrategy: "Bogus",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "unsupported object selection strategy")
+			},
+		},
+		{
+			name: "invalid allow keys regex",
+			sub: kargoapi.BucketSubscription{
+				URL:              "https://s3.example.com/my-bucket",
+				AllowKeysRegexes: []string{"("},
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling allow keys regex")
+			},
+		},
+		{
+			name: "version regex without capture group",
+			sub: kargoapi.BucketSubscription{
+				URL:          "https://s3.example.com/my-bucket",
+				VersionRegex: `^app-.+\.tar\.gz$`,
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "exactly one capture group")
+			},
+		},
+		{
+			name: "invalid semver constraint",
+			sub: kargoapi.BucketSubscription{
+				URL:        "https://s3.example.com/my-bucket",
+				Constraint: "bogus",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name: "invalid bucket URL",
+			sub: kargoapi.BucketSubscription{
+				URL: "https://s3.example.com",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error creating client for bucket")
+			},
+		},
+		{
+			name: "success with default strategy",
+			sub: kargoapi.BucketSubscription{
+				URL: "https://s3.example.com/my-bucket",
+			},
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					kargoapi.ObjectSelectionStrategySemVer,
+					s.(*selector).strategy, // nolint: forcetypeassert
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewSelector(testCase.sub, nil)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func Test_selector_MatchesKey(t *testing.T) {
+	testCases := []struct {
+		name    string
+		sub     kargoapi.BucketSubscription
+		key     string
+		matches bool
+	}{
+		{
+			name: "key outside of prefix",
+			sub: kargoapi.BucketSubscription{
+				Prefix:                  "builds/",
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategyLexical,
+			},
+			key:     "other/app.tar.gz",
+			matches: false,
+		},
+		{
+			name: "key not allowed",
+			sub: kargoapi.BucketSubscription{
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategyLexical,
+				AllowKeysRegexes:        []string{`\.tar\.gz$`},
+			},
+			key:     "app.zip",
+			matches: false,
+		},
+		{
+			name: "key ignored",
+			sub: kargoapi.BucketSubscription{
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategyLexical,
+				IgnoreKeysRegexes:       []string{`-rc\d+`},
+			},
+			key:     "app-1.0.0-rc1.tar.gz",
+			matches: false,
+		},
+		{
+			name: "version regex does not match",
+			sub: kargoapi.BucketSubscription{
+				Prefix:       "builds/",
+				VersionRegex: `^app-(.+)\.tar\.gz$`,
+			},
+			key:     "builds/other-1.0.0.tar.gz",
+			matches: false,
+		},
+		{
+			name: "version is not a strict semver",
+			sub: kargoapi.BucketSubscription{
+				Prefix:        "builds/",
+				VersionRegex:  `^app-(.+)\.tar\.gz$`,
+				StrictSemvers: true,
+			},
+			key:     "builds/app-1.0.tar.gz",
+			matches: false,
+		},
+		{
+			name: "version does not satisfy constraint",
+			sub: kargoapi.BucketSubscription{
+				Prefix:       "builds/",
+				VersionRegex: `^app-(.+)\.tar\.gz$`,
+				Constraint:   "^2.0.0",
+			},
+			key:     "builds/app-1.0.0.tar.gz",
+			matches: false,
+		},
+		{
+			name: "version satisfies constraint",
+			sub: kargoapi.BucketSubscription{
+				Prefix:       "builds/",
+				VersionRegex: `^app-(.+)\.tar\.gz$`,
+				Constraint:   "^1.0.0",
+			},
+			key:     "builds/app-1.2.3.tar.gz",
+			matches: true,
+		},
+		{
+			name: "key with prefix removed is the version",
+			sub: kargoapi.BucketSubscription{
+				Prefix: "builds/",
+			},
+			key:     "builds/1.2.3",
+			matches: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.sub.URL = "https://s3.example.com/my-bucket"
+			s, err := NewSelector(testCase.sub, nil)
+			require.NoError(t, err)
+			require.Equal(t, testCase.matches, s.MatchesKey(testCase.key))
+		})
+	}
+}
+
+func Test_selector_Select(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	objects := []fakeObject{
+		{Key: "builds/app-1.0.0.tar.gz", ETag: `"etag-1"`, LastModified: now.Add(-time.Hour)},
+		{Key: "builds/app-1.10.0.tar.gz", ETag: `"etag-2"`, LastModified: now.Add(-2 * time.Hour)},
+		{Key: "builds/app-1.9.0.tar.gz", ETag: `"etag-3"`, LastModified: now},
+		{Key: "builds/app-latest.tar.gz", ETag: `"etag-4"`, LastModified: now.Add(-3 * time.Hour)},
+		{Key: "builds/notes.txt", ETag: `"etag-5"`, LastModified: now.Add(time.Hour)},
+	}
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.BucketSubscription
+		assertions func(*testing.T, []kargoapi.DiscoveredObject, error)
+	}{
+		{
+			name: "SemVer",
+			sub: kargoapi.BucketSubscription{
+				Prefix:                  "builds/",
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategySemVer,
+				VersionRegex:            `^app-(.+)\.tar\.gz$`,
+				StrictSemvers:           true,
+			},
+			assertions: func(t *testing.T, objs []kargoapi.DiscoveredObject, err error) {
+				require.NoError(t, err)
+				require.Len(t, objs, 3)
+				require.Equal(t, "builds/app-1.10.0.tar.gz", objs[0].Key)
+				require.Equal(t, "etag-2", objs[0].ETag)
+				require.Equal(t, "1.10.0", objs[0].Version)
+				require.NotNil(t, objs[0].LastModified)
+				require.Equal(t, "builds/app-1.9.0.tar.gz", objs[1].Key)
+				require.Equal(t, "builds/app-1.0.0.tar.gz", objs[2].Key)
+			},
+		},
+		{
+			name: "Lexical",
+			sub: kargoapi.BucketSubscription{
+				Prefix:                  "builds/",
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategyLexical,
+				AllowKeysRegexes:        []string{`\.tar\.gz$`},
+			},
+			assertions: func(t *testing.T, objs []kargoapi.DiscoveredObject, err error) {
+				require.NoError(t, err)
+				require.Len(t, objs, 4)
+				require.Equal(t, "builds/app-latest.tar.gz", objs[0].Key)
+				require.Empty(t, objs[0].Version)
+				require.Equal(t, "builds/app-1.9.0.tar.gz", objs[1].Key)
+				require.Equal(t, "builds/app-1.10.0.tar.gz", objs[2].Key)
+				require.Equal(t, "builds/app-1.0.0.tar.gz", objs[3].Key)
+			},
+		},
+		{
+			name: "NewestModified with discovery limit",
+			sub: kargoapi.BucketSubscription{
+				Prefix:                  "builds/",
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategyNewestModified,
+				IgnoreKeysRegexes:       []string{`\.txt$`},
+				DiscoveryLimit:          2,
+			},
+			assertions: func(t *testing.T, objs []kargoapi.DiscoveredObject, err error) {
+				require.NoError(t, err)
+				require.Len(t, objs, 2)
+				require.Equal(t, "builds/app-1.9.0.tar.gz", objs[0].Key)
+				require.Equal(t, "builds/app-1.0.0.tar.gz", objs[1].Key)
+			},
+		},
+		{
+			name: "no objects matched",
+			sub: kargoapi.BucketSubscription{
+				Prefix:       "builds/",
+				VersionRegex: `^app-(.+)\.tar\.gz$`,
+				Constraint:   "^2.0.0",
+			},
+			assertions: func(t *testing.T, objs []kargoapi.DiscoveredObject, err error) {
+				require.NoError(t, err)
+				require.Empty(t, objs)
+			},
+		},
+		{
+			name: "no objects found",
+			sub: kargoapi.BucketSubscription{
+				Prefix: "nothing-here/",
+			},
+			assertions: func(t *testing.T, objs []kargoapi.DiscoveredObject, err error) {
+				require.NoError(t, err)
+				require.Empty(t, objs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := newFakeS3Server(t, "my-bucket", objects, 2, false)
+			testCase.sub.URL = srv.URL + "/my-bucket"
+			s, err := NewSelector(testCase.sub, nil)
+			require.NoError(t, err)
+			objs, err := s.Select(context.Background())
+			testCase.assertions(t, objs, err)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package get
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/yaml"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/archive"
+	"github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+type getArchivedRecordsOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	*getOptions
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Kind    string
+	Stage   string
+	Freight string
+	Names   []string
+}
+
+func newGetArchivedRecordsCommand(
+	cfg config.CLIConfig,
+	streams genericiooptions.IOStreams,
+	getOptions *getOptions,
+) *cobra.Command {
+	cmdOpts := &getArchivedRecordsOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		getOptions: getOptions,
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use: "archived-records [--project=project] [--kind=kind] [--stage=stage] " +
+			"[--freight=freight] [NAME ...] [--no-headers]",
+		Aliases: []string{"archived-record", "archived"},
+		Short:   "Display promotions and freight archived by the garbage collector",
+		Example: templates.Example(`
+# List all archived promotions and freight in my-project
+kargo get archived-records --project=my-project
+
+# List all archived promotions to the QA stage in my-project
+kargo get archived-records --project=my-project --kind=promotion --stage=qa
+
+# List all archived records relating to a specific piece of freight
+kargo get archived-records --project=my-project --freight=abc1234
+
+# Get a specific archived promotion, including its final status, in YAML
+kargo get archived-record --project=my-project --kind=promotion abc1234 -o yaml
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the get archived records options to the provided
+// command.
+func (o *getArchivedRecordsOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.AddFlags(cmd)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project for which to list archived records. If not set, the default project will be used.",
+	)
+	option.Kind(
+		cmd.Flags(), &o.Kind,
+		"The kind of archived records to list (promotion or freight). Required when names are specified.",
+	)
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		"The stage for which to list archived records. If not set, all stages will be listed.",
+	)
+	option.Freight(
+		cmd.Flags(), &o.Freight,
+		"The freight for which to list archived records. If not set, all freight will be listed.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *getArchivedRecordsOptions) complete(args []string) {
+	o.Names = slices.Compact(args)
+	switch strings.ToLower(o.Kind) {
+	case "promotion", "promotions", "promo", "promos":
+		o.Kind = archive.KindPromotion
+	case "freight":
+		o.Kind = archive.KindFreight
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *getArchivedRecordsOptions) validate() error {
+	var errs []error
+	if o.Project == "" {
+		errs = append(errs, errors.New("project is required"))
+	}
+	if o.Kind != "" && o.Kind != archive.KindPromotion && o.Kind != archive.KindFreight {
+		errs = append(errs, fmt.Errorf("kind %q is not one of promotion or freight", o.Kind))
+	}
+	if len(o.Names) > 0 && o.Kind == "" {
+		errs = append(errs, errors.New("kind is required when names are specified"))
+	}
+	return errors.Join(errs...)
+}
+
+// run gets the archived records from the server and prints them to the
+// console.
+func (o *getArchivedRecordsOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	if len(o.Names) == 0 {
+		var resp *connect.Response[v1alpha1.ListArchivedRecordsResponse]
+		if resp, err = kargoSvcCli.ListArchivedRecords(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.ListArchivedRecordsRequest{
+					Project: o.Project,
+					Kind:    &o.Kind,
+					Stage:   &o.Stage,
+					Freight: &o.Freight,
+				},
+			),
+		); err != nil {
+			return fmt.Errorf("list archived records: %w", err)
+		}
+		return o.printRecords(resp.Msg.GetRecords())
+	}
+
+	promos := make([]*kargoapi.Promotion, 0, len(o.Names))
+	freight := make([]*kargoapi.Freight, 0, len(o.Names))
+	errs := make([]error, 0, len(o.Names))
+	for _, name := range o.Names {
+		var resp *connect.Response[v1alpha1.GetArchivedRecordResponse]
+		if resp, err = kargoSvcCli.GetArchivedRecord(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.GetArchivedRecordRequest{
+					Project: o.Project,
+					Kind:    o.Kind,
+					Name:    name,
+				},
+			),
+		); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		switch o.Kind {
+		case archive.KindPromotion:
+			promo := &kargoapi.Promotion{}
+			if err = yaml.Unmarshal(resp.Msg.GetRaw(), promo); err != nil {
+				errs = append(errs, fmt.Errorf("parse archived promotion %q: %w", name, err))
+				continue
+			}
+			promos = append(promos, promo)
+		case archive.KindFreight:
+			f := &kargoapi.Freight{}
+			if err = yaml.Unmarshal(resp.Msg.GetRaw(), f); err != nil {
+				errs = append(errs, fmt.Errorf("parse archived freight %q: %w", name, err))
+				continue
+			}
+			freight = append(freight, f)
+		}
+	}
+
+	if o.Kind == archive.KindPromotion {
+		err = PrintObjects(promos, o.PrintFlags, o.IOStreams, o.NoHeaders)
+	} else {
+		err = PrintObjects(freight, o.PrintFlags, o.IOStreams, o.NoHeaders)
+	}
+	if err != nil {
+		return fmt.Errorf("print archived records: %w", err)
+	}
+	return errors.Join(errs...)
+}
+
+// printRecords prints the provided archived records to the console, either
+// as a table or, if requested, as JSON or YAML.
+func (o *getArchivedRecordsOptions) printRecords(records []*v1alpha1.ArchivedRecord) error {
+	if o.OutputFlagSpecified != nil && o.OutputFlagSpecified() {
+		var out []byte
+		var err error
+		switch *o.OutputFormat {
+		case "json":
+			out, err = json.MarshalIndent(records, "", "    ")
+			out = append(out, '\n')
+		case "yaml":
+			out, err = yaml.Marshal(records)
+		default:
+			return fmt.Errorf(
+				"output format %q is not supported when listing archived records",
+				*o.OutputFormat,
+			)
+		}
+		if err != nil {
+			return fmt.Errorf("marshal archived records: %w", err)
+		}
+		_, err = o.Out.Write(out)
+		return err
+	}
+	return printers.
+		NewTablePrinter(
+			printers.PrintOptions{
+				NoHeaders: o.NoHeaders,
+			},
+		).
+		PrintObj(newArchivedRecordTable(records), o.Out)
+}
+
+func newArchivedRecordTable(records []*v1alpha1.ArchivedRecord) *metav1.Table {
+	rows := make([]metav1.TableRow, len(records))
+	for i, record := range records {
+		rows[i] = metav1.TableRow{
+			Cells: []any{
+				record.GetKind(),
+				record.GetName(),
+				strings.Join(record.GetStages(), ","),
+				record.GetFreight(),
+				record.GetDate(),
+			},
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Kind", Type: "string"},
+			{Name: "Name", Type: "string"},
+			{Name: "Stages", Type: "string"},
+			{Name: "Freight", Type: "string"},
+			{Name: "Created", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
@@ -44,6 +44,7 @@ kargo get promotions --project=my-project --stage=my-stage
 	cmdOpts.addFlags(cmd)
 
 	// Register subcommands.
+	cmd.AddCommand(newGetArchivedRecordsCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetClusterConfigCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetCredentialsCommand(cfg, streams, cmdOpts))
 	cmd.AddCommand(newGetFreightCommand(cfg, streams, cmdOpts))
@@ -184,6 +184,10 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 		if promo.Labels != nil {
 			shard = promo.Labels[kargoapi.LabelKeyShard]
 		}
+		var notBefore string
+		if promo.Spec.NotBefore != nil {
+			notBefore = promo.Spec.NotBefore.UTC().Format(time.RFC3339)
+		}
 		rows[i] = metav1.TableRow{
 			Cells: []any{
 				promo.GetName(),
@@ -191,6 +195,7 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 				promo.Spec.Stage,
 				promo.Spec.Freight,
 				promo.GetStatus().Phase,
+				notBefore,
 				duration.HumanDuration(time.Since(promo.CreationTimestamp.Time)),
 			},
 			Object: list.Items[i],
@@ -203,6 +208,7 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 			{Name: "Stage", Type: "string"},
 			{Name: "Freight", Type: "string"},
 			{Name: "Phase", Type: "string"},
+			{Name: "Not Before", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
@@ -0,0 +1,331 @@
+package promote
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigyaml "sigs.k8s.io/yaml"
+
+	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	cliclient "github.com/akuity/kargo/pkg/cli/client"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/dryrun"
+
+	// Register the built-in promotion step runners, so they can be executed
+	// during a dry run.
+	_ "github.com/akuity/kargo/pkg/promotion/runner/builtin"
+)
+
+// runDryRun executes the promotion steps of the stage or promotion task from
+// the manifests locally, and prints a report of their execution. An error is
+// returned if the promotion did not succeed.
+func (o *promotionOptions) runDryRun(ctx context.Context) error {
+	vars, err := parseVars(o.Vars)
+	if err != nil {
+		return err
+	}
+	stubOutputs, err := parseStubOutputs(o.StubOutputs)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := option.ReadManifests(false, o.Filenames...)
+	if err != nil {
+		return fmt.Errorf("read manifests: %w", err)
+	}
+	objects, err := decodeManifests(manifest)
+	if err != nil {
+		return fmt.Errorf("decode manifests: %w", err)
+	}
+
+	project := o.Project
+	var stage *kargoapi.Stage
+	if o.Stage != "" {
+		if stage = findObject[*kargoapi.Stage](objects, o.Stage); stage == nil {
+			return fmt.Errorf("stage %q not found in manifests", o.Stage)
+		}
+		if stage.Namespace != "" {
+			project = stage.Namespace
+		}
+	}
+	if project == "" {
+		return fmt.Errorf("%s is required", option.ProjectFlag)
+	}
+	setProject(objects, project)
+
+	var task *kargoapi.PromotionTaskReference
+	if o.Task != "" {
+		switch {
+		case findObject[*kargoapi.PromotionTask](objects, o.Task) != nil:
+			task = &kargoapi.PromotionTaskReference{Name: o.Task, Kind: "PromotionTask"}
+		case findObject[*kargoapi.ClusterPromotionTask](objects, o.Task) != nil:
+			task = &kargoapi.PromotionTaskReference{Name: o.Task, Kind: "ClusterPromotionTask"}
+		default:
+			return fmt.Errorf("promotion task %q not found in manifests", o.Task)
+		}
+	}
+
+	freight, err := o.getDryRunFreight(ctx, project, objects)
+	if err != nil {
+		return err
+	}
+
+	c, err := dryrun.NewClient(objects...)
+	if err != nil {
+		return fmt.Errorf("new client: %w", err)
+	}
+	report, err := dryrun.NewRunner(c, &dryrun.Options{
+		ExecutedStepKinds: o.Executes,
+		StubOutputs:       stubOutputs,
+	}).Run(ctx, dryrun.Input{
+		Project: project,
+		Stage:   stage,
+		Task:    task,
+		Freight: freight,
+		Vars:    vars,
+	})
+	if err != nil {
+		return fmt.Errorf("dry run: %w", err)
+	}
+
+	if err = printReport(o.Out, report); err != nil {
+		return fmt.Errorf("print report: %w", err)
+	}
+	if report.Result.Status != kargoapi.PromotionPhaseSucceeded {
+		return fmt.Errorf("promotion %s: %s", strings.ToLower(string(report.Result.Status)), report.Result.Message)
+	}
+	return nil
+}
+
+// getDryRunFreight returns the freight to promote during a dry run, with the
+// target freight first. The target freight is selected from the manifests by
+// name or alias and, if not found there, fetched from the project. If no name
+// or alias was provided, the manifests must contain exactly one piece of
+// freight. Any other freight in the manifests is included after the target
+// freight.
+func (o *promotionOptions) getDryRunFreight(
+	ctx context.Context,
+	project string,
+	objects []client.Object,
+) ([]kargoapi.Freight, error) {
+	var target *kargoapi.Freight
+	var others []kargoapi.Freight
+	var candidates int
+	for _, obj := range objects {
+		f, ok := obj.(*kargoapi.Freight)
+		if !ok {
+			continue
+		}
+		candidates++
+		switch {
+		case target == nil && o.FreightName != "" && f.Name == o.FreightName,
+			target == nil && o.FreightAlias != "" && f.Alias == o.FreightAlias,
+			target == nil && o.FreightName == "" && o.FreightAlias == "":
+			target = f
+		default:
+			others = append(others, *f)
+		}
+	}
+
+	switch {
+	case target != nil && o.FreightName == "" && o.FreightAlias == "" && candidates > 1:
+		return nil, fmt.Errorf(
+			"multiple freight found in manifests: %s or %s is required",
+			option.FreightFlag, option.FreightAliasFlag,
+		)
+	case target == nil && o.FreightName == "" && o.FreightAlias == "":
+		return nil, fmt.Errorf(
+			"no freight found in manifests: %s or %s is required",
+			option.FreightFlag, option.FreightAliasFlag,
+		)
+	case target == nil:
+		kargoSvcCli, err := cliclient.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+		if err != nil {
+			return nil, fmt.Errorf("get client from config: %w", err)
+		}
+		res, err := kargoSvcCli.GetFreight(
+			ctx,
+			connect.NewRequest(&v1alpha1.GetFreightRequest{
+				Project: project,
+				Name:    o.FreightName,
+				Alias:   o.FreightAlias,
+			}),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("get freight: %w", err)
+		}
+		target = res.Msg.GetFreight()
+	}
+
+	return append([]kargoapi.Freight{*target}, others...), nil
+}
+
+// decodeManifests decodes the Kargo resources and core Kubernetes resources
+// in the provided multi-document YAML manifest. Resources of other kinds are
+// ignored.
+func decodeManifests(manifest []byte) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(kubernetes.GetScheme()).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifest)))
+	var objects []client.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+			return nil, err
+		}
+		switch obj.GetObjectKind().GroupVersionKind().GroupVersion() {
+		case kargoapi.GroupVersion, corev1.SchemeGroupVersion:
+			if o, ok := obj.(client.Object); ok {
+				objects = append(objects, o)
+			}
+		}
+	}
+}
+
+// findObject returns the object of type T with the provided name from the
+// provided objects, or nil if there is none.
+func findObject[T client.Object](objects []client.Object, name string) T {
+	var zero T
+	for _, obj := range objects {
+		if o, ok := obj.(T); ok && o.GetName() == name {
+			return o
+		}
+	}
+	return zero
+}
+
+// setProject sets the namespace of the project-scoped Kargo resources in the
+// provided objects which do not have one to the provided project.
+func setProject(objects []client.Object, project string) {
+	for _, obj := range objects {
+		switch obj.(type) {
+		case *kargoapi.Freight, *kargoapi.PromotionTask, *kargoapi.Stage, *kargoapi.Warehouse:
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace(project)
+			}
+		}
+	}
+}
+
+// parseVars parses the provided name=value pairs into promotion variables.
+func parseVars(pairs []string) ([]kargoapi.ExpressionVariable, error) {
+	vars := make([]kargoapi.ExpressionVariable, 0, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%s %q must be in the form name=value", option.VarFlag, pair)
+		}
+		vars = append(vars, kargoapi.ExpressionVariable{Name: name, Value: value})
+	}
+	return vars, nil
+}
+
+// parseStubOutputs parses the provided alias=json pairs into the outputs of
+// stubbed steps, by step alias.
+func parseStubOutputs(pairs []string) (map[string]map[string]any, error) {
+	outputs := make(map[string]map[string]any, len(pairs))
+	for _, pair := range pairs {
+		alias, value, ok := strings.Cut(pair, "=")
+		if !ok || alias == "" {
+			return nil, fmt.Errorf("%s %q must be in the form alias=json", option.StubOutputFlag, pair)
+		}
+		var output map[string]any
+		if err := json.Unmarshal([]byte(value), &output); err != nil {
+			return nil, fmt.Errorf("%s %q must be a JSON object: %w", option.StubOutputFlag, pair, err)
+		}
+		outputs[alias] = output
+	}
+	return outputs, nil
+}
+
+// printReport prints a human-readable representation of the provided report
+// to the provided writer.
+func printReport(out io.Writer, report *dryrun.Report) error {
+	for i, step := range report.Steps {
+		annotations := []string{step.Kind}
+		if step.Stubbed {
+			annotations = append(annotations, "stubbed")
+		}
+		if step.Section != promotion.StepSectionMain {
+			annotations = append(annotations, string(step.Section))
+		}
+		_, _ = fmt.Fprintf(out, "Step %d: %s (%s)\n", i+1, step.Alias, strings.Join(annotations, ", "))
+		_, _ = fmt.Fprintf(out, "  Status: %s\n", step.Status)
+		if step.Message != "" {
+			_, _ = fmt.Fprintf(out, "  Message: %s\n", step.Message)
+		}
+		if step.Err != nil {
+			_, _ = fmt.Fprintf(out, "  Error: %s\n", step.Err)
+		}
+		if err := printYAML(out, "Config", step.Config); err != nil {
+			return err
+		}
+		if err := printYAML(out, "Output", step.Output); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintln(out)
+	}
+
+	_, _ = fmt.Fprintf(out, "Promotion: %s\n", report.Result.Status)
+	if report.Result.Message != "" {
+		_, _ = fmt.Fprintf(out, "  Message: %s\n", report.Result.Message)
+	}
+
+	if len(report.Diff) == 0 {
+		_, _ = fmt.Fprintln(out, "\nNo changes")
+		return nil
+	}
+	_, _ = fmt.Fprintln(out, "\nChanges:")
+	for _, diff := range report.Diff {
+		_, _ = fmt.Fprint(out, diff.Diff)
+	}
+	return nil
+}
+
+// printYAML prints the provided value as YAML, indented under the provided
+// heading. Nothing is printed if the value is empty.
+func printYAML[T ~map[string]any](out io.Writer, heading string, value T) error {
+	if len(value) == 0 {
+		return nil
+	}
+	b, err := sigyaml.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", strings.ToLower(heading), err)
+	}
+	_, _ = fmt.Fprintf(out, "  %s:\n", heading)
+	for _, line := range strings.Split(strings.TrimRight(string(b), "\n"), "\n") {
+		if line == "" {
+			_, _ = fmt.Fprintln(out)
+			continue
+		}
+		_, _ = fmt.Fprintf(out, "    %s\n", line)
+	}
+	return nil
+}
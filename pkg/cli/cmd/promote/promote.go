@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -20,6 +22,7 @@ import (
 	"github.com/akuity/kargo/pkg/cli/kubernetes"
 	"github.com/akuity/kargo/pkg/cli/option"
 	"github.com/akuity/kargo/pkg/cli/templates"
+	"github.com/akuity/kargo/pkg/promotion/dryrun"
 )
 
 type promotionOptions struct {
@@ -35,8 +38,16 @@ type promotionOptions struct {
 	Promotion      string
 	Stage          string
 	DownstreamFrom string
+	NotBefore      string
 	Abort          bool
 	Wait           bool
+
+	DryRun      bool
+	Filenames   []string
+	Task        string
+	Vars        []string
+	Executes    []string
+	StubOutputs []string
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -48,7 +59,7 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 
 	cmd := &cobra.Command{
 		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias | --name=name) " +
-			"[(--stage=stage | --downstream-from=stage) | --abort]",
+			"[(--stage=stage [--not-before=time] | --downstream-from=stage) | --abort]",
 		Short: "Promote a piece of freight",
 		Args:  option.NoArgs,
 		// nolint: lll
@@ -65,6 +76,9 @@ kargo promote --project=my-project --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --downstream-from=qa
 
+# Schedule the promotion of a piece of freight specified by name to the prod stage
+kargo promote --project=my-project --freight=abc123 --stage=prod --not-before=2025-01-01T02:00:00Z
+
 # Abort a Promotion by name
 kargo promote --project=my-project --name=my-promotion --abort
 
@@ -83,6 +97,18 @@ kargo promote --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from of the QA stage in the default project
 kargo config set-project my-project
 kargo promote --freight-alias=wonky-wombat --downstream-from=qas
+
+# Execute the promotion steps of the QA stage locally for a piece of freight, without promoting it
+kargo promote --dry-run -f qa-stage.yaml -f freight.yaml --stage=qa
+
+# Execute the promotion steps of the QA stage locally for a piece of freight fetched from the project
+kargo promote --dry-run -f qa-stage.yaml --stage=qa --project=my-project --freight=abc123
+
+# Execute a promotion task locally with additional variables
+kargo promote --dry-run -f task.yaml -f freight.yaml --task=update-image --var=imageRepo=example.com/app
+
+# Execute the promotion steps of the QA stage locally, faking the output of a stubbed step
+kargo promote --dry-run -f qa-stage.yaml -f freight.yaml --stage=qa --stub-output='open-pr={"pr":{"id":42}}'
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -128,18 +154,70 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 			option.StageFlag,
 		),
 	)
+	option.NotBefore(
+		cmd.Flags(), &o.NotBefore,
+		fmt.Sprintf(
+			"An RFC 3339 timestamp before which the promotion must not start. Only used with --%s.",
+			option.StageFlag,
+		),
+	)
 	option.Abort(cmd.Flags(), &o.Abort, false, fmt.Sprintf(
 		"Abort a non-terminal promotion. If set, --%s must be set.", option.NameFlag,
 	))
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
 
-	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
+	option.DryRun(cmd.Flags(), &o.DryRun, fmt.Sprintf(
+		"Execute the promotion steps locally instead of promoting the freight. The stage, promotion "+
+			"tasks and freight are read from the files set with --%s. If the freight is not found in "+
+			"those files, it is fetched from the project.",
+		option.FilenameFlag,
+	))
+	option.Filenames(cmd.Flags(), &o.Filenames, fmt.Sprintf(
+		"Filename or directory of the manifests to use for a dry run. Only used with --%s.",
+		option.DryRunFlag,
+	))
+	option.Task(cmd.Flags(), &o.Task, fmt.Sprintf(
+		"The name of a (cluster) promotion task to execute instead of the promotion steps of the "+
+			"stage. Only used with --%s.",
+		option.DryRunFlag,
+	))
+	option.Vars(cmd.Flags(), &o.Vars, fmt.Sprintf(
+		"A variable for the promotion, in the form name=value. Takes precedence over the variables "+
+			"of the stage. May be specified multiple times. Only used with --%s.",
+		option.DryRunFlag,
+	))
+	option.Executes(cmd.Flags(), &o.Executes, dryrun.DefaultExecutedStepKinds, fmt.Sprintf(
+		"The kinds of promotion steps to execute. Steps of any other kind, including those of "+
+			"plugins, are recorded without being executed. Only used with --%s.",
+		option.DryRunFlag,
+	))
+	option.StubOutputs(cmd.Flags(), &o.StubOutputs, fmt.Sprintf(
+		"The output of a stubbed promotion step, in the form alias=json. May be specified multiple "+
+			"times. Only used with --%s.",
+		option.DryRunFlag,
+	))
+
+	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag, option.FilenameFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
 
-	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
+	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag, option.TaskFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
 
 	cmd.MarkFlagsRequiredTogether(option.NameFlag, option.AbortFlag)
+
+	cmd.MarkFlagsMutuallyExclusive(option.NotBeforeFlag, option.DownstreamFromFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.NotBeforeFlag, option.AbortFlag)
+
+	cmd.MarkFlagsRequiredTogether(option.DryRunFlag, option.FilenameFlag)
+	for _, flag := range []string{
+		option.DownstreamFromFlag,
+		option.AbortFlag,
+		option.NameFlag,
+		option.NotBeforeFlag,
+		option.WaitFlag,
+	} {
+		cmd.MarkFlagsMutuallyExclusive(option.DryRunFlag, flag)
+	}
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -148,14 +226,43 @@ func (o *promotionOptions) validate() error {
 	var errs []error
 	// While the flags are marked as required, a user could still provide an empty
 	// string. This is a check to ensure that the flags are not empty.
-	if o.Project == "" {
+	//
+	// A dry run does not require a project, as it may be derived from the
+	// manifests.
+	if o.Project == "" && !o.DryRun {
 		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
 	}
-	if o.Abort {
+	switch {
+	case o.Abort:
 		if o.Promotion == "" {
 			errs = append(errs, fmt.Errorf("%s is required when aborting a promotion", option.NameFlag))
 		}
-	} else {
+	case o.DryRun:
+		if len(o.Filenames) == 0 {
+			errs = append(errs, fmt.Errorf("%s is required for a dry run", option.FilenameFlag))
+		}
+		if o.Stage == "" && o.Task == "" {
+			errs = append(
+				errs,
+				fmt.Errorf("either %s or %s is required for a dry run", option.StageFlag, option.TaskFlag),
+			)
+		}
+		if _, err := parseVars(o.Vars); err != nil {
+			errs = append(errs, err)
+		}
+		if _, err := parseStubOutputs(o.StubOutputs); err != nil {
+			errs = append(errs, err)
+		}
+	default:
+		if o.Task != "" || len(o.Vars) > 0 || len(o.StubOutputs) > 0 {
+			errs = append(
+				errs,
+				fmt.Errorf(
+					"%s, %s and %s are only supported with %s",
+					option.TaskFlag, option.VarFlag, option.StubOutputFlag, option.DryRunFlag,
+				),
+			)
+		}
 		if o.FreightName == "" && o.FreightAlias == "" {
 			errs = append(
 				errs,
@@ -168,12 +275,24 @@ func (o *promotionOptions) validate() error {
 				fmt.Errorf("either %s or %s is required", option.StageFlag, option.DownstreamFromFlag),
 			)
 		}
+		if o.NotBefore != "" {
+			if _, err := time.Parse(time.RFC3339, o.NotBefore); err != nil {
+				errs = append(
+					errs,
+					fmt.Errorf("%s must be an RFC 3339 timestamp: %w", option.NotBeforeFlag, err),
+				)
+			}
+		}
 	}
 	return errors.Join(errs...)
 }
 
 // run performs the promotion of the freight using the options.
 func (o *promotionOptions) run(ctx context.Context) error {
+	if o.DryRun {
+		return o.runDryRun(ctx)
+	}
+
 	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
 	if err != nil {
 		return fmt.Errorf("get client from config: %w", err)
@@ -199,17 +318,20 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		}
 		return nil
 	case o.Stage != "":
-		res, err := kargoSvcCli.PromoteToStage(
-			ctx,
-			connect.NewRequest(
-				&v1alpha1.PromoteToStageRequest{
-					Project:      o.Project,
-					Freight:      o.FreightName,
-					FreightAlias: o.FreightAlias,
-					Stage:        o.Stage,
-				},
-			),
-		)
+		req := &v1alpha1.PromoteToStageRequest{
+			Project:      o.Project,
+			Freight:      o.FreightName,
+			FreightAlias: o.FreightAlias,
+			Stage:        o.Stage,
+		}
+		if o.NotBefore != "" {
+			notBefore, err := time.Parse(time.RFC3339, o.NotBefore)
+			if err != nil {
+				return fmt.Errorf("parse %s: %w", option.NotBeforeFlag, err)
+			}
+			req.NotBefore = timestamppb.New(notBefore)
+		}
+		res, err := kargoSvcCli.PromoteToStage(ctx, connect.NewRequest(req))
 		if err != nil {
 			return fmt.Errorf("promote stage: %w", err)
 		}
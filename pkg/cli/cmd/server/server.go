@@ -101,6 +101,7 @@ func (o *serverOptions) run(ctx context.Context) error {
 			rbac.ServiceAccountDatabaseConfigFromEnv(),
 		),
 		k8sevent.NewEventSender(&fakeevent.EventRecorder{}),
+		nil,
 	)
 	if err := srv.Serve(ctx, l); err != nil {
 		return fmt.Errorf("serve error: %w", err)
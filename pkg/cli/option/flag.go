@@ -34,6 +34,12 @@ const (
 	// DownstreamFromFlag is the flag name for the downstream-from flag.
 	DownstreamFromFlag = "downstream-from"
 
+	// DryRunFlag is the flag name for the dry-run flag.
+	DryRunFlag = "dry-run"
+
+	// ExecuteFlag is the flag name for the execute flag.
+	ExecuteFlag = "execute"
+
 	// FilenameFlag is the flag name for the filename flag.
 	FilenameFlag = "filename"
 	// FilenameShortFlag is the short flag name for the filename flag.
@@ -60,6 +66,9 @@ const (
 	// InteractivePasswordFlag is the flag name for the interactive-password flag.
 	InteractivePasswordFlag = "interactive-password"
 
+	// KindFlag is the flag name for the kind flag.
+	KindFlag = "kind"
+
 	// MetricFlag is the flag name for the metric flag.
 	MetricFlag = "metric"
 
@@ -69,6 +78,9 @@ const (
 	// NewAliasFlag is the flag name for the new-alias flag.
 	NewAliasFlag = "new-alias"
 
+	// NotBeforeFlag is the flag name for the not-before flag.
+	NotBeforeFlag = "not-before"
+
 	// NoHeadersFlag is the flag name for the no-headers flag.
 	NoHeadersFlag = "no-headers"
 
@@ -112,15 +124,24 @@ const (
 	// StageFlag is the flag name for the stage flag.
 	StageFlag = "stage"
 
+	// StubOutputFlag is the flag name for the stub-output flag.
+	StubOutputFlag = "stub-output"
+
 	// SystemFlag is the flag name for the system flag.
 	SystemFlag = "system"
 
+	// TaskFlag is the flag name for the task flag.
+	TaskFlag = "task"
+
 	// TypeFlag is the flag name for the type flag.
 	TypeFlag = "type"
 
 	// UsernameFlag is the flag name for the username flag.
 	UsernameFlag = "username"
 
+	// VarFlag is the flag name for the var flag.
+	VarFlag = "var"
+
 	// VerbFlag is the flag name for the verb flag.
 	VerbFlag = "verb"
 
@@ -174,6 +195,16 @@ func DownstreamFrom(fs *pflag.FlagSet, downstreamFrom *string, usage string) {
 	fs.StringVar(downstreamFrom, DownstreamFromFlag, "", usage)
 }
 
+// DryRun adds the DryRunFlag to the provided flag set.
+func DryRun(fs *pflag.FlagSet, dryRun *bool, usage string) {
+	fs.BoolVar(dryRun, DryRunFlag, false, usage)
+}
+
+// Executes adds a multi-value ExecuteFlag to the provided flag set.
+func Executes(fs *pflag.FlagSet, executes *[]string, defaultExecutes []string, usage string) {
+	fs.StringSliceVar(executes, ExecuteFlag, defaultExecutes, usage)
+}
+
 // Filenames adds the FilenameFlag and FilenameShortFlag to the provided flag set.
 func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
@@ -214,6 +245,11 @@ func InteractivePassword(fs *pflag.FlagSet, changePasswordInteractively *bool, u
 	fs.BoolVar(changePasswordInteractively, InteractivePasswordFlag, false, usage)
 }
 
+// Kind adds the KindFlag to the provided flag set.
+func Kind(fs *pflag.FlagSet, kind *string, usage string) {
+	fs.StringVar(kind, KindFlag, "", usage)
+}
+
 // Metric adds the MetricFlag to the provided flag set.
 func Metric(fs *pflag.FlagSet, metric *string, usage string) {
 	fs.StringVar(metric, MetricFlag, "", usage)
@@ -244,6 +280,11 @@ func NoHeaders(fs *pflag.FlagSet, noHeaders *bool) {
 	)
 }
 
+// NotBefore adds the NotBeforeFlag to the provided flag set.
+func NotBefore(fs *pflag.FlagSet, notBefore *string, usage string) {
+	fs.StringVar(notBefore, NotBeforeFlag, "", usage)
+}
+
 // OldAlias adds the OldAliasFlag to the provided flag set.
 func OldAlias(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, OldAliasFlag, "", usage)
@@ -313,6 +354,11 @@ func Stage(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, StageFlag, "", usage)
 }
 
+// StubOutputs adds a multi-value StubOutputFlag to the provided flag set.
+func StubOutputs(fs *pflag.FlagSet, stubOutputs *[]string, usage string) {
+	fs.StringArrayVar(stubOutputs, StubOutputFlag, nil, usage)
+}
+
 // System adds the SystemFlag to the provided flag set.
 
 // Wait adds the WaitFlag to the provided flag set.
@@ -320,6 +366,11 @@ func System(fs *pflag.FlagSet, system *bool, defaultSystem bool, usage string) {
 	fs.BoolVar(system, SystemFlag, defaultSystem, usage)
 }
 
+// Task adds the TaskFlag to the provided flag set.
+func Task(fs *pflag.FlagSet, task *string, usage string) {
+	fs.StringVar(task, TaskFlag, "", usage)
+}
+
 // Type adds the TypeFlag to the provided flag set.
 func Type(fs *pflag.FlagSet, repoType *string, usage string) {
 	fs.StringVar(repoType, TypeFlag, "", usage)
@@ -330,6 +381,11 @@ func Username(fs *pflag.FlagSet, username *string, usage string) {
 	fs.StringVar(username, UsernameFlag, "", usage)
 }
 
+// Vars adds a multi-value VarFlag to the provided flag set.
+func Vars(fs *pflag.FlagSet, vars *[]string, usage string) {
+	fs.StringArrayVar(vars, VarFlag, nil, usage)
+}
+
 // Verbs adds a multi-value VerbFlag to the provided flag set.
 func Verbs(fs *pflag.FlagSet, verbs *[]string, usage string) {
 	fs.StringSliceVar(verbs, VerbFlag, nil, usage)
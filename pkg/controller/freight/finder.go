@@ -3,6 +3,8 @@ package freight
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -284,3 +286,208 @@ func FindChart(
 	// from the desired origin has been promoted yet.
 	return nil, nil
 }
+
+// FindArtifact returns the OCI artifact from the specified repository found in
+// the provided Freight. If no origin is specified, the Warehouses of the
+// requested Freight are consulted to determine which one can provide the
+// artifact, and an error is returned if that is ambiguous. If none can, a
+// NotFoundError is returned. Repository URLs are compared in their normalized
+// form.
+func FindArtifact(
+	ctx context.Context,
+	cl client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	desiredOrigin *kargoapi.FreightOrigin,
+	freight []kargoapi.FreightReference,
+	repoURL string,
+) (*kargoapi.OCIArtifact, error) {
+	repoURL = urls.NormalizeImage(repoURL)
+	// If no origin was explicitly identified, we need to look at all possible
+	// origins. If there's only one that could provide the artifact we're looking
+	// for, great. If there's more than one, there's ambiguity, and we need to
+	// return an error.
+	if desiredOrigin == nil {
+		for i := range freightReqs {
+			requestedFreight := freightReqs[i]
+			warehouse, err := api.GetWarehouse(
+				ctx,
+				cl,
+				types.NamespacedName{
+					Name:      requestedFreight.Origin.Name,
+					Namespace: project,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if warehouse == nil {
+				// nolint:staticcheck
+				return nil, fmt.Errorf(
+					"Warehouse %q not found in namespace %q",
+					requestedFreight.Origin.Name, project,
+				)
+			}
+			for _, sub := range warehouse.Spec.Subscriptions {
+				if sub.OCI != nil && urls.NormalizeImage(sub.OCI.RepoURL) == repoURL {
+					if desiredOrigin != nil {
+						return nil, fmt.Errorf(
+							"multiple requested Freight could potentially provide an OCI artifact from "+
+								"repository %s: please provide a Freight origin to disambiguate",
+							repoURL,
+						)
+					}
+					desiredOrigin = &requestedFreight.Origin
+				}
+			}
+		}
+		if desiredOrigin == nil {
+			// There is no chance of finding the artifact we're looking for.
+			return nil, NotFoundError{
+				msg: fmt.Sprintf("OCI artifact from repo %s not found in referenced Freight", repoURL),
+			}
+		}
+	}
+	// We know exactly what we're after, so this should be easy
+	for _, f := range freight {
+		if f.Origin.Equals(desiredOrigin) {
+			for _, a := range f.Artifacts {
+				if urls.NormalizeImage(a.RepoURL) == repoURL {
+					return &a, nil
+				}
+			}
+		}
+	}
+	// If we get to here, we looked at all the FreightReferences and didn't find
+	// any that came from the desired origin. This could be because no Freight
+	// from the desired origin has been promoted yet. We return nil to indicate
+	// that none was found
+	return nil, nil
+}
+
+// FindObject returns the object from the specified bucket and key prefix found
+// in the provided Freight. The Warehouse of the specified origin, or, if no
+// origin is specified, of each requested Freight, is consulted to determine
+// whether it subscribes to the bucket with exactly that prefix. An error is
+// returned if more than one requested Freight could provide the object and,
+// if none can, a NotFoundError is returned. Only objects whose keys fall under
+// the prefix of the matching subscription, and not under a longer prefix of
+// another of that Warehouse's subscriptions to the same bucket, are
+// considered. Bucket URLs are compared in their normalized form.
+func FindObject(
+	ctx context.Context,
+	cl client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	desiredOrigin *kargoapi.FreightOrigin,
+	freight []kargoapi.FreightReference,
+	bucketURL string,
+	prefix string,
+) (*kargoapi.BucketObject, error) {
+	bucketURL = urls.NormalizeBucket(bucketURL)
+	// If no origin was explicitly identified, we need to look at all possible
+	// origins. If there's only one that could provide the object we're looking
+	// for, great. If there's more than one, there's ambiguity, and we need to
+	// return an error. Even an explicitly identified origin is looked up so that
+	// objects can be matched against the prefixes of its subscriptions.
+	origins := []kargoapi.FreightOrigin{}
+	if desiredOrigin != nil {
+		origins = append(origins, *desiredOrigin)
+	} else {
+		for _, requestedFreight := range freightReqs {
+			origins = append(origins, requestedFreight.Origin)
+		}
+	}
+	desiredOrigin = nil
+	var prefixes []string
+	for i := range origins {
+		warehouse, err := api.GetWarehouse(
+			ctx,
+			cl,
+			types.NamespacedName{
+				Name:      origins[i].Name,
+				Namespace: project,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if warehouse == nil {
+			// nolint:staticcheck
+			return nil, fmt.Errorf(
+				"Warehouse %q not found in namespace %q",
+				origins[i].Name, project,
+			)
+		}
+		whPrefixes := bucketSubscriptionPrefixes(warehouse, bucketURL)
+		if !slices.Contains(whPrefixes, prefix) {
+			continue
+		}
+		if desiredOrigin != nil {
+			return nil, fmt.Errorf(
+				"multiple requested Freight could potentially provide an object from "+
+					"bucket %s: please provide a Freight origin to disambiguate",
+				bucketURL,
+			)
+		}
+		desiredOrigin = &origins[i]
+		prefixes = whPrefixes
+	}
+	if desiredOrigin == nil {
+		// There is no chance of finding the object we're looking for.
+		return nil, NotFoundError{
+			msg: fmt.Sprintf(
+				"object with prefix %q from bucket %s not found in referenced Freight",
+				prefix, bucketURL,
+			),
+		}
+	}
+	// We know exactly what we're after, so this should be easy
+	for _, f := range freight {
+		if f.Origin.Equals(desiredOrigin) {
+			for _, o := range f.Objects {
+				if urls.NormalizeBucket(o.URL) == bucketURL &&
+					objectMatchesPrefix(o.Key, prefix, prefixes) {
+					return &o, nil
+				}
+			}
+		}
+	}
+	// If we get to here, we looked at all the FreightReferences and didn't find
+	// any that came from the desired origin. This could be because no Freight
+	// from the desired origin has been promoted yet. We return nil to indicate
+	// that none was found
+	return nil, nil
+}
+
+// bucketSubscriptionPrefixes returns the key prefixes of all the provided
+// Warehouse's subscriptions to the bucket with the specified normalized URL.
+func bucketSubscriptionPrefixes(
+	warehouse *kargoapi.Warehouse,
+	bucketURL string,
+) []string {
+	var prefixes []string
+	for _, sub := range warehouse.Spec.Subscriptions {
+		if sub.Bucket != nil && urls.NormalizeBucket(sub.Bucket.URL) == bucketURL {
+			prefixes = append(prefixes, sub.Bucket.Prefix)
+		}
+	}
+	return prefixes
+}
+
+// objectMatchesPrefix returns a bool indicating whether the specified object
+// key falls under the specified prefix and not under any longer prefix among
+// the provided prefixes of sibling subscriptions to the same bucket. This
+// keeps, for instance, an empty prefix from matching objects that were
+// discovered by a subscription with a more specific prefix.
+func objectMatchesPrefix(key string, prefix string, prefixes []string) bool {
+	if !strings.HasPrefix(key, prefix) {
+		return false
+	}
+	for _, p := range prefixes {
+		if len(p) > len(prefix) && strings.HasPrefix(key, p) {
+			return false
+		}
+	}
+	return true
+}
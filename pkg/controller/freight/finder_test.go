@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -854,3 +855,583 @@ func TestFindChart(t *testing.T) {
 		})
 	}
 }
+
+func TestFindArtifact(t *testing.T) {
+	const testNamespace = "test-namespace"
+	const testRepoURL = "fake-repo-url"
+
+	scheme := runtime.NewScheme()
+	err := kargoapi.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	testOrigin1 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+	testOrigin2 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "some-other-warehouse",
+	}
+
+	testArtifact1 := kargoapi.OCIArtifact{
+		RepoURL: testRepoURL,
+		Tag:     "fake-tag-1",
+	}
+	testArtifact2 := kargoapi.OCIArtifact{
+		RepoURL: testRepoURL,
+		Tag:     "fake-tag-2",
+	}
+
+	testCases := []struct {
+		name          string
+		client        func() client.Client
+		stage         *kargoapi.Stage
+		desiredOrigin *kargoapi.FreightOrigin
+		freight       []kargoapi.FreightReference
+		repoURL       string
+		assertions    func(*testing.T, *kargoapi.OCIArtifact, error)
+	}{
+		{
+			name:          "desired origin specified, but artifact not found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:    testOrigin2, // Wrong origin
+					Artifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Nil(t, artifact)
+			},
+		},
+		{
+			name:          "desired origin specified and artifact is found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:    testOrigin1, // Correct origin
+					Artifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+				{
+					Origin:    testOrigin2,
+					Artifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testArtifact1, artifact)
+			},
+		},
+		{
+			name:          "desired origin specified and artifact is found by normalized repo URL",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin1,
+					Artifacts: []kargoapi.OCIArtifact{{
+						RepoURL: "docker.io/library/nginx",
+						Tag:     "fake-tag-1",
+					}},
+				},
+			},
+			repoURL: "nginx",
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, artifact)
+				require.Equal(t, "docker.io/library/nginx", artifact.RepoURL)
+			},
+		},
+		{
+			name: "desired origin not specified and warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin not specified and more than one possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCI: &kargoapi.OCISubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin2.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCI: &kargoapi.OCISubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+						{Origin: testOrigin2},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"multiple requested Freight could potentially provide",
+				)
+			},
+		},
+		{
+			name: "desired origin not specified and no possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCI: &kargoapi.OCISubscription{
+									RepoURL: "some-other-repo-url",
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+					},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.ErrorAs(t, err, &NotFoundError{})
+				require.ErrorContains(t, err, "not found in referenced Freight")
+				require.Nil(t, artifact)
+			},
+		},
+		{
+			name: "desired origin not specified and successfully inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCI: &kargoapi.OCISubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+					},
+				},
+			},
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:    testOrigin1, // Correct origin
+					Artifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+				{
+					Origin:    testOrigin2,
+					Artifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testArtifact1, artifact)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cl client.Client
+			if testCase.client != nil {
+				cl = testCase.client()
+			}
+			repoURL := testCase.repoURL
+			if repoURL == "" {
+				repoURL = testRepoURL
+			}
+			artifact, err := FindArtifact(
+				context.Background(),
+				cl,
+				testCase.stage.Namespace,
+				testCase.stage.Spec.RequestedFreight,
+				testCase.desiredOrigin,
+				testCase.freight,
+				repoURL,
+			)
+			testCase.assertions(t, artifact, err)
+		})
+	}
+}
+
+func TestFindObject(t *testing.T) {
+	const testNamespace = "test-namespace"
+	const testBucketURL = "https://s3.example.com/fake-bucket"
+	const testPrefix = "builds/"
+
+	scheme := runtime.NewScheme()
+	err := kargoapi.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	testOrigin1 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+	testOrigin2 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "some-other-warehouse",
+	}
+
+	testObject1 := kargoapi.BucketObject{
+		URL:  testBucketURL,
+		Key:  "builds/fake-key-1",
+		ETag: "fake-etag-1",
+	}
+	testObject2 := kargoapi.BucketObject{
+		URL:  testBucketURL,
+		Key:  "builds/fake-key-2",
+		ETag: "fake-etag-2",
+	}
+
+	testWarehouse := func(
+		origin kargoapi.FreightOrigin,
+		subs ...kargoapi.BucketSubscription,
+	) *kargoapi.Warehouse {
+		warehouse := &kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      origin.Name,
+			},
+		}
+		for i := range subs {
+			warehouse.Spec.Subscriptions = append(
+				warehouse.Spec.Subscriptions,
+				kargoapi.RepoSubscription{Bucket: &subs[i]},
+			)
+		}
+		return warehouse
+	}
+
+	testStage := func(origins ...kargoapi.FreightOrigin) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+			},
+		}
+		for _, origin := range origins {
+			stage.Spec.RequestedFreight = append(
+				stage.Spec.RequestedFreight,
+				kargoapi.FreightRequest{Origin: origin},
+			)
+		}
+		return stage
+	}
+
+	testCases := []struct {
+		name          string
+		client        func() client.Client
+		stage         *kargoapi.Stage
+		desiredOrigin *kargoapi.FreightOrigin
+		freight       []kargoapi.FreightReference
+		bucketURL     string
+		prefix        *string
+		assertions    func(*testing.T, *kargoapi.BucketObject, error)
+	}{
+		{
+			name: "desired origin specified, but object not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: testPrefix},
+					),
+				).Build()
+			},
+			stage:         testStage(),
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:  testOrigin2, // Wrong origin
+					Objects: []kargoapi.BucketObject{testObject2},
+				},
+			},
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.NoError(t, err)
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "desired origin specified, but warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage:         testStage(),
+			desiredOrigin: &testOrigin1,
+			assertions: func(t *testing.T, _ *kargoapi.BucketObject, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin specified, but it does not subscribe to the prefix",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: "other/"},
+					),
+				).Build()
+			},
+			stage:         testStage(),
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:  testOrigin1,
+					Objects: []kargoapi.BucketObject{testObject1},
+				},
+			},
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.ErrorAs(t, err, &NotFoundError{})
+				require.ErrorContains(t, err, "not found in referenced Freight")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "desired origin specified and object is found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: testPrefix},
+					),
+				).Build()
+			},
+			stage:         testStage(),
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:  testOrigin1, // Correct origin
+					Objects: []kargoapi.BucketObject{testObject1},
+				},
+				{
+					Origin:  testOrigin2,
+					Objects: []kargoapi.BucketObject{testObject2},
+				},
+			},
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObject1, obj)
+			},
+		},
+		{
+			name: "desired origin not specified and warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage: testStage(testOrigin1),
+			assertions: func(t *testing.T, _ *kargoapi.BucketObject, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin not specified and more than one possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: testPrefix},
+					),
+					testWarehouse(
+						testOrigin2,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: testPrefix},
+					),
+				).Build()
+			},
+			stage: testStage(testOrigin1, testOrigin2),
+			assertions: func(t *testing.T, _ *kargoapi.BucketObject, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"multiple requested Freight could potentially provide",
+				)
+			},
+		},
+		{
+			name: "desired origin not specified and no possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: "s3://some-other-bucket", Prefix: testPrefix},
+					),
+				).Build()
+			},
+			stage: testStage(testOrigin1),
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.ErrorAs(t, err, &NotFoundError{})
+				require.ErrorContains(t, err, "not found in referenced Freight")
+				require.Nil(t, obj)
+			},
+		},
+		{
+			name: "desired origin not specified and successfully inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: testPrefix},
+					),
+				).Build()
+			},
+			stage: testStage(testOrigin1),
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:  testOrigin1, // Correct origin
+					Objects: []kargoapi.BucketObject{testObject1},
+				},
+				{
+					Origin:  testOrigin2,
+					Objects: []kargoapi.BucketObject{testObject2},
+				},
+			},
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObject1, obj)
+			},
+		},
+		{
+			name: "bucket URLs are compared in normalized form",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL + "/", Prefix: testPrefix},
+					),
+				).Build()
+			},
+			stage: testStage(testOrigin1),
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:  testOrigin1,
+					Objects: []kargoapi.BucketObject{testObject1},
+				},
+			},
+			bucketURL: " HTTPS://S3.example.com/Fake-Bucket/ ",
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObject1, obj)
+			},
+		},
+		{
+			name: "empty prefix does not match objects of a more specific subscription",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testWarehouse(
+						testOrigin1,
+						kargoapi.BucketSubscription{URL: testBucketURL, Prefix: testPrefix},
+						kargoapi.BucketSubscription{URL: testBucketURL},
+					),
+				).Build()
+			},
+			stage: testStage(testOrigin1),
+			freight: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin1,
+					Objects: []kargoapi.BucketObject{
+						testObject1,
+						{
+							URL:  testBucketURL,
+							Key:  "fake-key-3",
+							ETag: "fake-etag-3",
+						},
+					},
+				},
+			},
+			prefix: ptr.To(""),
+			assertions: func(t *testing.T, obj *kargoapi.BucketObject, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, obj)
+				require.Equal(t, "fake-key-3", obj.Key)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cl client.Client
+			if testCase.client != nil {
+				cl = testCase.client()
+			}
+			bucketURL := testCase.bucketURL
+			if bucketURL == "" {
+				bucketURL = testBucketURL
+			}
+			prefix := testPrefix
+			if testCase.prefix != nil {
+				prefix = *testCase.prefix
+			}
+			obj, err := FindObject(
+				context.Background(),
+				cl,
+				testCase.stage.Namespace,
+				testCase.stage.Spec.RequestedFreight,
+				testCase.desiredOrigin,
+				testCase.freight,
+				bucketURL,
+				prefix,
+			)
+			testCase.assertions(t, obj, err)
+		})
+	}
+}
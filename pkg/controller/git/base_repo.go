@@ -48,6 +48,9 @@ type ClientOptions struct {
 	// InsecureSkipTLSVerify indicates whether to ignore certificate verification
 	// errors when interacting with the remote repository.
 	InsecureSkipTLSVerify bool
+	// TrustedSigners are the signers whose signatures on commits and tags are
+	// considered valid by WorkTree.VerifyCommit and WorkTree.VerifyTag.
+	TrustedSigners []TrustedSigner
 }
 
 // setupClient sets up "global" git configuration with author and authentication
@@ -65,6 +68,10 @@ func (b *baseRepo) setupClient(homeDir string, opts *ClientOptions) error {
 		return fmt.Errorf("error configuring the credentials: %w", err)
 	}
 
+	if err := b.setupSignatureVerification(homeDir, opts.TrustedSigners); err != nil {
+		return fmt.Errorf("error configuring signature verification: %w", err)
+	}
+
 	if opts.InsecureSkipTLSVerify {
 		cmd := b.buildGitCommand("config", "--global", "http.sslVerify", "false")
 		// Override the home directory set by b.buildGitCommand().
@@ -173,6 +180,17 @@ func (b *baseRepo) setupAuthor(homeDir string, author *User) error {
 				return fmt.Errorf("error configuring commit gpg signing: %w", err)
 			}
 
+			cmd = b.buildGitCommand("config", "--global", "tag.gpgsign", "true")
+			// Override the home directory set by b.buildGitCommand().
+			b.setCmdHome(cmd, homeDir)
+			// Override the cmd.Dir that's set by b.buildGitCommand(). It's normally the
+			// repository's path, but if this method was called as part of the cloning
+			// process, that path may not exist yet.
+			cmd.Dir = homeDir
+			if _, err := libExec.Exec(cmd); err != nil {
+				return fmt.Errorf("error configuring tag gpg signing: %w", err)
+			}
+
 			cmd = b.buildCommand("gpg", "--import", author.SigningKeyPath)
 			// Override the home directory set by b.buildCommand().
 			b.setCmdHome(cmd, homeDir)
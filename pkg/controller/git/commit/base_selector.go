@@ -22,6 +22,8 @@ type baseSelector struct {
 	includePaths          pattern.Matcher
 	excludePaths          pattern.Matcher
 	discoveryLimit        int
+	verifySignatures      bool
+	trustedSigners        []git.TrustedSigner
 
 	gitCloneFn func(
 		repoURL string,
@@ -33,14 +35,19 @@ type baseSelector struct {
 func newBaseSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (*baseSelector, error) {
 	s := &baseSelector{
 		repoURL:               sub.RepoURL,
 		creds:                 creds,
 		insecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
 		discoveryLimit:        int(sub.DiscoveryLimit),
+		verifySignatures:      sub.SignatureVerification != nil,
 		gitCloneFn:            git.Clone,
 	}
+	if s.verifySignatures {
+		s.trustedSigners = trustedSigners
+	}
 	var err error
 	if sub.ExpressionFilter != "" {
 		s.filterExpression, err = expr.Compile(sub.ExpressionFilter)
@@ -63,5 +70,6 @@ func (b *baseSelector) getLoggerContext() []any {
 	return []any{
 		"repo", b.repoURL,
 		"pathConstrained", b.includePaths != nil || b.excludePaths != nil,
+		"signatureConstrained", b.verifySignatures,
 	}
 }
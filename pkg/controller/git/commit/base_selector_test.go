@@ -14,6 +14,7 @@ func TestNewBaseSelector(t *testing.T) {
 		name       string
 		sub        kargoapi.GitSubscription
 		creds      *git.RepoCredentials
+		signers    []git.TrustedSigner
 		assertions func(*testing.T, *baseSelector, error)
 	}{
 		{
@@ -50,11 +51,15 @@ func TestNewBaseSelector(t *testing.T) {
 				IncludePaths:          []string{"apps/"},
 				ExcludePaths:          []string{"hack/"},
 				DiscoveryLimit:        5,
+				SignatureVerification: &kargoapi.GitSignatureVerification{
+					TrustedKeysSecretName: "trusted-keys",
+				},
 			},
 			creds: &git.RepoCredentials{
 				Username: "foo",
 				Password: "bar",
 			},
+			signers: []git.TrustedSigner{{Name: "bob", PublicKeys: "fake-key"}},
 			assertions: func(t *testing.T, s *baseSelector, err error) {
 				require.NoError(t, err)
 				require.Equal(t, "https://github.com/example/repo.git", s.repoURL)
@@ -71,12 +76,18 @@ func TestNewBaseSelector(t *testing.T) {
 				require.NotNil(t, s.includePaths)
 				require.NotNil(t, s.excludePaths)
 				require.Equal(t, 5, s.discoveryLimit)
+				require.True(t, s.verifySignatures)
+				require.Equal(
+					t,
+					[]git.TrustedSigner{{Name: "bob", PublicKeys: "fake-key"}},
+					s.trustedSigners,
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newBaseSelector(testCase.sub, testCase.creds)
+			s, err := newBaseSelector(testCase.sub, testCase.creds, testCase.signers)
 			testCase.assertions(t, s, err)
 		})
 	}
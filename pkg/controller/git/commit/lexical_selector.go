@@ -31,8 +31,9 @@ type lexicalSelector struct {
 func newLexicalSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, trustedSigners)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -75,6 +76,10 @@ func (l *lexicalSelector) Select(ctx context.Context) (
 		return strings.Compare(j.Tag, i.Tag)
 	})
 
+	if tags, err = l.filterTagsBySignatures(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by signatures: %w", err)
+	}
+
 	if tags, err = l.filterTagsByDiffPathsFn(repo, tags); err != nil {
 		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
 	}
@@ -50,8 +50,9 @@ type newestFromBranchSelector struct {
 func newNewestFromBranchSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (Selector, error) {
-	base, err := newBaseSelector(sub, creds)
+	base, err := newBaseSelector(sub, creds, trustedSigners)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -102,6 +103,7 @@ func (n *newestFromBranchSelector) Select(ctx context.Context) (
 		&git.ClientOptions{
 			Credentials:           n.creds,
 			InsecureSkipTLSVerify: n.insecureSkipTLSVerify,
+			TrustedSigners:        n.trustedSigners,
 		},
 		&git.CloneOptions{
 			Branch:       n.branch,
@@ -141,7 +143,8 @@ func (n *newestFromBranchSelector) selectCommits(
 		}
 
 		// If no filters are specified, return the first commits up to the limit.
-		if n.includePaths == nil && n.excludePaths == nil && n.filterExpression == nil {
+		if n.includePaths == nil && n.excludePaths == nil &&
+			n.filterExpression == nil && !n.verifySignatures {
 			return trimSlice(commits, n.discoveryLimit), nil
 		}
 
@@ -171,6 +174,22 @@ func (n *newestFromBranchSelector) selectCommits(
 				}
 			}
 
+			// If signature verification is required, skip commits that were not
+			// signed by a trusted signer.
+			if n.verifySignatures {
+				if commit.Signer, err = repo.VerifyCommit(commit.ID); err != nil {
+					return nil, fmt.Errorf(
+						"error verifying signature of commit %q in git repo %q: %w",
+						commit.ID,
+						n.repoURL,
+						err,
+					)
+				}
+				if commit.Signer == "" {
+					continue
+				}
+			}
+
 			// If we reach this point, the commit got past all the filters.
 			selectedCommits = append(selectedCommits, commit)
 
@@ -254,6 +273,7 @@ func (n *newestFromBranchSelector) commitsToAPICommits(
 			Author:      meta.Author,
 			Committer:   meta.Committer,
 			CreatorDate: &metav1.Time{Time: meta.CommitDate},
+			Signer:      meta.Signer,
 		})
 		logger.Trace(
 			"discovered commit from branch",
@@ -47,7 +47,7 @@ func TestNewNewestFromBranchSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newNewestFromBranchSelector(testCase.sub, nil)
+			s, err := newNewestFromBranchSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
@@ -319,6 +319,7 @@ func Test_newestFromBranchSelector_selectCommits(t *testing.T) {
 	testCases := []struct {
 		name       string
 		selector   *newestFromBranchSelector
+		repo       git.Repo
 		assertions func(*testing.T, []git.CommitMetadata, error)
 	}{
 		{
@@ -440,10 +441,70 @@ func Test_newestFromBranchSelector_selectCommits(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "error verifying signature",
+			selector: &newestFromBranchSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+					discoveryLimit:   3,
+				},
+				listCommitsFn: func(git.Repo, uint, uint) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{{ID: "A"}}, nil
+				},
+			},
+			repo: &git.MockRepo{
+				VerifyCommitFn: func(string) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []git.CommitMetadata, err error) {
+				require.ErrorContains(t, err, "error verifying signature of commit")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "signature verification filters out commits",
+			selector: &newestFromBranchSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: true,
+					discoveryLimit:   2,
+				},
+				listCommitsFn: func(_ git.Repo, _ uint, skip uint) ([]git.CommitMetadata, error) {
+					if skip > 0 {
+						return nil, nil
+					}
+					return []git.CommitMetadata{
+						{ID: "A"},
+						{ID: "B"},
+						{ID: "C"},
+						{ID: "D"},
+					}, nil
+				},
+			},
+			repo: &git.MockRepo{
+				VerifyCommitFn: func(id string) (string, error) {
+					if id == "A" || id == "C" {
+						return "", nil
+					}
+					return "Alice <alice@example.com>", nil
+				},
+			},
+			assertions: func(t *testing.T, commits []git.CommitMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]git.CommitMetadata{
+						{ID: "B", Signer: "Alice <alice@example.com>"},
+						{ID: "D", Signer: "Alice <alice@example.com>"},
+					},
+					commits,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			commits, err := testCase.selector.selectCommits(nil)
+			commits, err := testCase.selector.selectCommits(testCase.repo)
 			testCase.assertions(t, commits, err)
 		})
 	}
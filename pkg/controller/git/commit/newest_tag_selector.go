@@ -29,8 +29,9 @@ type newestTagSelector struct {
 func newNewestTagSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, trustedSigners)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -71,6 +72,10 @@ func (n *newestTagSelector) Select(ctx context.Context) (
 	// Note: Tags are already sorted in descending order by creation date when
 	// retrieved. No further sorting is required.
 
+	if tags, err = n.filterTagsBySignatures(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by signatures: %w", err)
+	}
+
 	if tags, err = n.filterTagsByDiffPathsFn(repo, tags); err != nil {
 		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
 	}
@@ -41,7 +41,7 @@ func TestNewNewestTagSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newNewestTagSelector(testCase.sub, nil)
+			s, err := newNewestTagSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
@@ -22,6 +22,7 @@ type (
 	selectorFactory = func(
 		kargoapi.GitSubscription,
 		*git.RepoCredentials,
+		[]git.TrustedSigner,
 	) (Selector, error)
 
 	// selectorRegistration associates a selectorPredicate with a selectorFactory.
@@ -19,11 +19,14 @@ type Selector interface {
 }
 
 // NewSelector returns some implementation of the Selector interface that
-// selects commits from a Git repository based on the provided subscription.
+// selects commits from a Git repository based on the provided subscription. If
+// the subscription requires signature verification, only commits or tags
+// signed by one of the provided trusted signers are selected.
 func NewSelector(
 	ctx context.Context,
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (Selector, error) {
 	// Pick an appropriate Selector implementation based on the subscription
 	// provided.
@@ -32,5 +35,5 @@ func NewSelector(
 		return nil, fmt.Errorf("error getting selector factory")
 	}
 	factory := reg.Value
-	return factory(sub, creds)
+	return factory(sub, creds, trustedSigners)
 }
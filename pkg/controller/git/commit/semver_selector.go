@@ -36,8 +36,9 @@ type semverSelector struct {
 func newSemverSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, trustedSigners)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -122,6 +123,10 @@ func (s *semverSelector) Select(ctx context.Context) (
 
 	s.sort(tags)
 
+	if tags, err = s.filterTagsBySignatures(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by signatures: %w", err)
+	}
+
 	if tags, err = s.filterTagsByDiffPathsFn(repo, tags); err != nil {
 		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
 	}
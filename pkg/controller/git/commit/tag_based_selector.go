@@ -51,8 +51,9 @@ func compileRegexes(regexStrs []string) ([]*regexp.Regexp, error) {
 func newTagBasedSelector(
 	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	trustedSigners []git.TrustedSigner,
 ) (*tagBasedSelector, error) {
-	base, err := newBaseSelector(sub, creds)
+	base, err := newBaseSelector(sub, creds, trustedSigners)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -160,6 +161,7 @@ func (t *tagBasedSelector) clone(ctx context.Context) (git.Repo, error) {
 		&git.ClientOptions{
 			Credentials:           t.creds,
 			InsecureSkipTLSVerify: t.insecureSkipTLSVerify,
+			TrustedSigners:        t.trustedSigners,
 		},
 		cloneOpts,
 	)
@@ -229,6 +231,41 @@ func (t *tagBasedSelector) filterTagsByExpression(
 	return slices.Clip(filteredTags), nil
 }
 
+// filterTagsBySignatures verifies the signatures of all provided tags,
+// returning only those signed by a trusted signer. The signature of an
+// annotated tag is verified, while the signature of the commit referenced by
+// a lightweight tag is verified instead. If signature verification is not
+// required, all tags are returned.
+func (t *tagBasedSelector) filterTagsBySignatures(
+	repo git.Repo,
+	tags []git.TagMetadata,
+) ([]git.TagMetadata, error) {
+	if !t.verifySignatures {
+		return tags, nil
+	}
+	filteredTags := make([]git.TagMetadata, 0, len(tags))
+	for _, tag := range tags {
+		var err error
+		if tag.Tagger != "" {
+			tag.Signer, err = repo.VerifyTag(tag.Tag)
+		} else {
+			tag.Signer, err = repo.VerifyCommit(tag.CommitID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error verifying signature of tag %q in git repo %q: %w",
+				tag.Tag,
+				t.repoURL,
+				err,
+			)
+		}
+		if tag.Signer != "" {
+			filteredTags = append(filteredTags, tag)
+		}
+	}
+	return slices.Clip(filteredTags), nil
+}
+
 // filterTagsByDiffPaths iterates over all provided tags, for each, retrieving
 // information about paths affected by the commit it references and evaluating
 // those paths against user-defined path-selection criteria. Only tags pointing
@@ -285,6 +322,7 @@ func (t *tagBasedSelector) tagsToAPICommits(
 			Author:      tag.Author,
 			Committer:   tag.Committer,
 			CreatorDate: &metav1.Time{Time: tag.CreatorDate},
+			Signer:      tag.Signer,
 		}
 		logger.Trace(
 			"discovered commit from tag",
@@ -1,6 +1,7 @@
 package commit
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -83,7 +84,7 @@ func TestNewTagBasedSelector(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newTagBasedSelector(testCase.sub, nil)
+			s, err := newTagBasedSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
@@ -277,3 +278,85 @@ func Test_tagBasedSelector_filterTagsByExpression(t *testing.T) {
 		})
 	}
 }
+
+func Test_tagBasedSelector_filterTagsBySignatures(t *testing.T) {
+	testTags := []git.TagMetadata{
+		{Tag: "v1.0.0", CommitID: "A", Tagger: "Alice"},
+		{Tag: "v1.1.0", CommitID: "B"},
+		{Tag: "v1.2.0", CommitID: "C", Tagger: "Mallory"},
+		{Tag: "v1.3.0", CommitID: "D"},
+	}
+
+	testCases := []struct {
+		name             string
+		verifySignatures bool
+		repo             git.Repo
+		assertions       func(*testing.T, []git.TagMetadata, error)
+	}{
+		{
+			name: "signature verification not required",
+			assertions: func(t *testing.T, tags []git.TagMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testTags, tags)
+			},
+		},
+		{
+			name:             "error verifying signature",
+			verifySignatures: true,
+			repo: &git.MockRepo{
+				VerifyTagFn: func(string) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []git.TagMetadata, err error) {
+				require.ErrorContains(t, err, `error verifying signature of tag "v1.0.0"`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:             "tags not signed by a trusted signer are filtered out",
+			verifySignatures: true,
+			repo: &git.MockRepo{
+				VerifyTagFn: func(tag string) (string, error) {
+					if tag == "v1.0.0" {
+						return "Alice <alice@example.com>", nil
+					}
+					return "", nil
+				},
+				VerifyCommitFn: func(id string) (string, error) {
+					if id == "B" {
+						return "bob", nil
+					}
+					return "", nil
+				},
+			},
+			assertions: func(t *testing.T, tags []git.TagMetadata, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]git.TagMetadata{
+						{
+							Tag:      "v1.0.0",
+							CommitID: "A",
+							Tagger:   "Alice",
+							Signer:   "Alice <alice@example.com>",
+						},
+						{Tag: "v1.1.0", CommitID: "B", Signer: "bob"},
+					},
+					tags,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &tagBasedSelector{
+				baseSelector: &baseSelector{
+					verifySignatures: testCase.verifySignatures,
+				},
+			}
+			tags, err := s.filterTagsBySignatures(testCase.repo, testTags)
+			testCase.assertions(t, tags, err)
+		})
+	}
+}
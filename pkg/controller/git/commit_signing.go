@@ -1,5 +1,155 @@
 package git
 
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	libExec "github.com/akuity/kargo/pkg/exec"
+)
+
 type SigningKeyType string
 
 const SigningKeyTypeGPG SigningKeyType = "gpg"
+
+// pgpPublicKeyBlockHeader is the header of an ASCII-armored GPG public key.
+const pgpPublicKeyBlockHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+
+// gpgGoodSignaturePrefix is the prefix of the GPG status line reporting a good
+// signature. It is followed by the ID of the key and the user ID of the
+// signer.
+const gpgGoodSignaturePrefix = "[GNUPG:] GOODSIG "
+
+// sshGoodSignatureRegex matches the line reported by ssh-keygen for a good
+// signature, capturing the principal of the signer.
+var sshGoodSignatureRegex = regexp.MustCompile(`^Good "git" signature for (.+) with \S+ key \S+$`)
+
+// TrustedSigner represents a signer whose signatures on commits and tags are
+// trusted.
+type TrustedSigner struct {
+	// Name identifies the signer. It is used as the principal of the signer's
+	// SSH keys.
+	Name string
+	// PublicKeys contains one or more ASCII-armored GPG public keys or SSH
+	// public keys in authorized_keys format.
+	PublicKeys string
+}
+
+// setupSignatureVerification configures the git CLI to trust signatures
+// created using the keys of the provided signers. GPG keys are imported into a
+// keyring and SSH keys are written to an allowed signers file, both within the
+// virtual home directory specified by homeDir.
+func (b *baseRepo) setupSignatureVerification(
+	homeDir string,
+	signers []TrustedSigner,
+) error {
+	if len(signers) == 0 {
+		return nil
+	}
+
+	var gpgKeys bytes.Buffer
+	var allowedSigners bytes.Buffer
+	for _, signer := range signers {
+		if strings.Contains(signer.PublicKeys, pgpPublicKeyBlockHeader) {
+			gpgKeys.WriteString(signer.PublicKeys)
+			gpgKeys.WriteString("\n")
+			continue
+		}
+		if signer.Name == "" || strings.ContainsAny(signer.Name, " \t\",") {
+			return fmt.Errorf("invalid trusted signer name %q", signer.Name)
+		}
+		scanner := bufio.NewScanner(strings.NewReader(signer.PublicKeys))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+			if err != nil {
+				return fmt.Errorf(
+					"error parsing SSH public key of trusted signer %q: %w",
+					signer.Name, err,
+				)
+			}
+			fmt.Fprintf(
+				&allowedSigners,
+				"%s namespaces=\"git\" %s",
+				signer.Name, ssh.MarshalAuthorizedKey(key),
+			)
+		}
+	}
+
+	if gpgKeys.Len() > 0 {
+		keysPath := filepath.Join(homeDir, "trusted-keys.asc")
+		if err := os.WriteFile(keysPath, gpgKeys.Bytes(), 0600); err != nil {
+			return fmt.Errorf("error writing trusted gpg keys to %q: %w", keysPath, err)
+		}
+		cmd := b.buildCommand("gpg", "--batch", "--import", keysPath)
+		// Override the home directory set by b.buildCommand().
+		b.setCmdHome(cmd, homeDir)
+		// Override the cmd.Dir that's set by b.buildCommand(). It's normally the
+		// repository's path, but if this method was called as part of the cloning
+		// process, that path may not exist yet.
+		cmd.Dir = homeDir
+		if _, err := libExec.Exec(cmd); err != nil {
+			return fmt.Errorf("error importing trusted gpg keys: %w", err)
+		}
+	}
+
+	if allowedSigners.Len() > 0 {
+		sshPath := filepath.Join(homeDir, ".ssh")
+		if err := os.MkdirAll(sshPath, 0700); err != nil {
+			return fmt.Errorf("error creating SSH directory %q: %w", sshPath, err)
+		}
+		allowedSignersPath := filepath.Join(sshPath, "allowed_signers")
+		if err := os.WriteFile(
+			allowedSignersPath,
+			allowedSigners.Bytes(),
+			0600,
+		); err != nil {
+			return fmt.Errorf(
+				"error writing allowed signers to %q: %w", allowedSignersPath, err,
+			)
+		}
+		cmd := b.buildGitCommand(
+			"config", "--global", "gpg.ssh.allowedSignersFile", allowedSignersPath,
+		)
+		// Override the home directory set by b.buildGitCommand().
+		b.setCmdHome(cmd, homeDir)
+		// Override the cmd.Dir that's set by b.buildGitCommand(). It's normally the
+		// repository's path, but if this method was called as part of the cloning
+		// process, that path may not exist yet.
+		cmd.Dir = homeDir
+		if _, err := libExec.Exec(cmd); err != nil {
+			return fmt.Errorf("error configuring gpg.ssh.allowedSignersFile: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// parseSigner returns the identity of the signer of a good signature from the
+// raw output of git verify-commit or git verify-tag. For GPG signatures, this
+// is the user ID of the signing key. For SSH signatures, it is the principal
+// of the signing key.
+func parseSigner(output []byte) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, gpgGoodSignaturePrefix); ok {
+			if _, userID, ok := strings.Cut(rest, " "); ok {
+				return userID, nil
+			}
+		}
+		if matches := sshGoodSignatureRegex.FindStringSubmatch(line); matches != nil {
+			return matches[1], nil
+		}
+	}
+	return "", fmt.Errorf("error parsing signer from output: %s", output)
+}
@@ -0,0 +1,235 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSigner(t *testing.T) {
+	testCases := []struct {
+		name       string
+		output     string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "GPG signature",
+			output: `[GNUPG:] NEWSIG alice@example.com
+[GNUPG:] KEY_CONSIDERED 65C347781BD194C7E63DAB416CE3D90FAB68DC8D 0
+[GNUPG:] GOODSIG 6CE3D90FAB68DC8D Alice <alice@example.com>
+[GNUPG:] VALIDSIG 65C347781BD194C7E63DAB416CE3D90FAB68DC8D 2026-10-18 1792296312 0 4 0 22 8 00 65C347781BD194C7E63DAB416CE3D90FAB68DC8D
+[GNUPG:] TRUST_UNDEFINED 0 pgp
+`,
+			assertions: func(t *testing.T, signer string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Alice <alice@example.com>", signer)
+			},
+		},
+		{
+			name: "SSH signature",
+			output: `Good "git" signature for bob with ED25519 key SHA256:h1hLqyL54JqMUx99mnIqPGQnHc9Ts7wYcp/zpvyauj0
+`,
+			assertions: func(t *testing.T, signer string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "bob", signer)
+			},
+		},
+		{
+			name:   "no good signature",
+			output: "something unexpected\n",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing signer from output")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			signer, err := parseSigner([]byte(testCase.output))
+			testCase.assertions(t, signer, err)
+		})
+	}
+}
+
+func TestSignatureVerification(t *testing.T) {
+	for _, bin := range []string{"gpg", "ssh-keygen"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("%s is not available", bin)
+		}
+	}
+
+	signerHome := t.TempDir()
+	run := func(dir string, name string, args ...string) string {
+		cmd := exec.Command(name, args...)
+		cmd.Dir = dir
+		cmd.Env = append(
+			os.Environ(),
+			"HOME="+signerHome,
+			"GNUPGHOME="+filepath.Join(signerHome, ".gnupg"),
+			"GIT_CONFIG_NOSYSTEM=1",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return string(out)
+	}
+
+	// Create the keys of two trusted signers and an untrusted one
+	require.NoError(t, os.MkdirAll(filepath.Join(signerHome, ".gnupg"), 0700))
+	run(
+		signerHome,
+		"gpg", "--batch", "--passphrase", "",
+		"--quick-gen-key", "Alice <alice@example.com>", "ed25519", "sign", "never",
+	)
+	aliceKey := run(signerHome, "gpg", "--armor", "--export", "alice@example.com")
+	bobKeyPath := filepath.Join(signerHome, "bob")
+	run(signerHome, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "bob", "-f", bobKeyPath)
+	bobKey, err := os.ReadFile(bobKeyPath + ".pub")
+	require.NoError(t, err)
+	malloryKeyPath := filepath.Join(signerHome, "mallory")
+	run(signerHome, "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", malloryKeyPath)
+
+	// Create a repository with commits and tags signed in various ways
+	repoDir := t.TempDir()
+	run(repoDir, "git", "init", "-q", "-b", "main")
+	run(repoDir, "git", "config", "user.name", "Test")
+	run(repoDir, "git", "config", "user.email", "test@example.com")
+	commit := func(signArgs ...string) string {
+		args := append(signArgs, "commit", "-q", "--allow-empty", "-m", "commit")
+		run(repoDir, "git", args...)
+		return strings.TrimSpace(run(repoDir, "git", "rev-parse", "HEAD"))
+	}
+	gpgCommit := commit("-c", "user.signingkey=alice@example.com", "-c", "commit.gpgsign=true")
+	sshArgs := func(keyPath string) []string {
+		return []string{"-c", "gpg.format=ssh", "-c", "user.signingkey=" + keyPath + ".pub"}
+	}
+	sshCommit := commit(append(sshArgs(bobKeyPath), "-c", "commit.gpgsign=true")...)
+	untrustedCommit := commit(append(sshArgs(malloryKeyPath), "-c", "commit.gpgsign=true")...)
+	unsignedCommit := commit()
+	run(repoDir, "git", append(sshArgs(bobKeyPath), "tag", "-s", "-m", "signed", "signed", sshCommit)...)
+	run(repoDir, "git", "tag", "-a", "-m", "unsigned", "unsigned", gpgCommit)
+
+	repo, err := Clone(
+		"file://"+repoDir,
+		&ClientOptions{
+			TrustedSigners: []TrustedSigner{
+				{Name: "alice", PublicKeys: aliceKey},
+				{Name: "bob", PublicKeys: string(bobKey)},
+			},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	defer repo.Close()
+	// Tags are not cloned, so fetch them the way tag-based selectors do
+	_, err = repo.ListTags()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		verify         func() (string, error)
+		expectedSigner string
+	}{
+		{
+			name:           "commit signed with trusted GPG key",
+			verify:         func() (string, error) { return repo.VerifyCommit(gpgCommit) },
+			expectedSigner: "Alice <alice@example.com>",
+		},
+		{
+			name:           "commit signed with trusted SSH key",
+			verify:         func() (string, error) { return repo.VerifyCommit(sshCommit) },
+			expectedSigner: "bob",
+		},
+		{
+			name:   "commit signed with untrusted SSH key",
+			verify: func() (string, error) { return repo.VerifyCommit(untrustedCommit) },
+		},
+		{
+			name:   "unsigned commit",
+			verify: func() (string, error) { return repo.VerifyCommit(unsignedCommit) },
+		},
+		{
+			name:           "tag signed with trusted SSH key",
+			verify:         func() (string, error) { return repo.VerifyTag("signed") },
+			expectedSigner: "bob",
+		},
+		{
+			name:   "unsigned tag",
+			verify: func() (string, error) { return repo.VerifyTag("unsigned") },
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			signer, err := testCase.verify()
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedSigner, signer)
+		})
+	}
+}
+
+func Test_baseRepo_setupSignatureVerification(t *testing.T) {
+	testCases := []struct {
+		name       string
+		signers    []TrustedSigner
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "no signers",
+			assertions: func(t *testing.T, homeDir string, err error) {
+				require.NoError(t, err)
+				require.NoFileExists(t, filepath.Join(homeDir, ".ssh", "allowed_signers"))
+			},
+		},
+		{
+			name: "invalid SSH key",
+			signers: []TrustedSigner{{
+				Name:       "bob",
+				PublicKeys: "ssh-ed25519 not-a-key",
+			}},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `error parsing SSH public key of trusted signer "bob"`)
+			},
+		},
+		{
+			name: "invalid signer name",
+			signers: []TrustedSigner{{
+				Name:       "bob smith",
+				PublicKeys: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBqfUhbZ5oYmRPzwp9y4FXsN1rrgZvUtNp1YB8HHzl6D",
+			}},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `invalid trusted signer name "bob smith"`)
+			},
+		},
+		{
+			name: "SSH keys",
+			signers: []TrustedSigner{{
+				Name: "bob",
+				PublicKeys: "# Bob's keys\n" +
+					"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBqfUhbZ5oYmRPzwp9y4FXsN1rrgZvUtNp1YB8HHzl6D laptop\n" +
+					"\n" +
+					"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGc2WOkfFV9B1ZvxDRXoCBNKIIjpRuH4dd8Ebl5YgMsw desktop\n",
+			}},
+			assertions: func(t *testing.T, homeDir string, err error) {
+				require.NoError(t, err)
+				allowedSigners, err := os.ReadFile(filepath.Join(homeDir, ".ssh", "allowed_signers"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`bob namespaces="git" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBqfUhbZ5oYmRPzwp9y4FXsN1rrgZvUtNp1YB8HHzl6D`+"\n"+
+						`bob namespaces="git" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGc2WOkfFV9B1ZvxDRXoCBNKIIjpRuH4dd8Ebl5YgMsw`+"\n",
+					string(allowedSigners),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			homeDir := t.TempDir()
+			b := &baseRepo{dir: homeDir, homeDir: homeDir}
+			err := b.setupSignatureVerification(homeDir, testCase.signers)
+			testCase.assertions(t, homeDir, err)
+		})
+	}
+}
@@ -13,6 +13,7 @@ type MockRepo struct {
 	CommitFn                  func(message string, opts *CommitOptions) error
 	CreateChildBranchFn       func(branch string) error
 	CreateOrphanedBranchFn    func(branch string) error
+	CreateTagFn               func(tag string, opts *TagOptions) error
 	CurrentBranchFn           func() (string, error)
 	DeleteBranchFn            func(branch string) error
 	DirFn                     func() string
@@ -26,10 +27,13 @@ type MockRepo struct {
 	ListCommitsFn             func(limit, skip uint) ([]CommitMetadata, error)
 	CommitMessageFn           func(id string) (string, error)
 	PushFn                    func(*PushOptions) error
+	PushTagFn                 func(tag string) error
 	RefsHaveDiffsFn           func(commit1 string, commit2 string) (bool, error)
 	RemoteBranchExistsFn      func(branch string) (bool, error)
 	ResetHardFn               func() error
 	URLFn                     func() string
+	VerifyCommitFn            func(id string) (string, error)
+	VerifyTagFn               func(tag string) (string, error)
 }
 
 func (m *MockRepo) AddAll() error {
@@ -74,6 +78,10 @@ func (m *MockRepo) CreateOrphanedBranch(branch string) error {
 	return m.CreateOrphanedBranchFn(branch)
 }
 
+func (m *MockRepo) CreateTag(tag string, opts *TagOptions) error {
+	return m.CreateTagFn(tag, opts)
+}
+
 func (m *MockRepo) CurrentBranch() (string, error) {
 	return m.CurrentBranchFn()
 }
@@ -128,6 +136,10 @@ func (m *MockRepo) Push(opts *PushOptions) error {
 	return m.PushFn(opts)
 }
 
+func (m *MockRepo) PushTag(tag string) error {
+	return m.PushTagFn(tag)
+}
+
 func (m *MockRepo) RefsHaveDiffs(
 	commit1 string,
 	commit2 string,
@@ -146,3 +158,11 @@ func (m *MockRepo) ResetHard() error {
 func (m *MockRepo) URL() string {
 	return m.URLFn()
 }
+
+func (m *MockRepo) VerifyCommit(id string) (string, error) {
+	return m.VerifyCommitFn(id)
+}
+
+func (m *MockRepo) VerifyTag(tag string) (string, error) {
+	return m.VerifyTagFn(tag)
+}
@@ -160,6 +160,44 @@ with a body
 		require.True(t, exists)
 	})
 
+	t.Run("can create and push a tag", func(t *testing.T) {
+		err = rep.CreateTag("v1.0.0", &TagOptions{Message: "release v1.0.0"})
+		require.NoError(t, err)
+		err = rep.PushTag("v1.0.0")
+		require.NoError(t, err)
+		var tags []TagMetadata
+		tags, err = rep.ListTags()
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, "v1.0.0", tags[0].Tag)
+		require.Equal(t, "release v1.0.0", tags[0].Annotation)
+		require.Equal(t, "Kargo <no-reply@kargo.io>", tags[0].Tagger)
+	})
+
+	t.Run("can create a tag with a custom tagger", func(t *testing.T) {
+		var commitID string
+		commitID, err = rep.LastCommitID()
+		require.NoError(t, err)
+		err = rep.CreateTag("v1.0.1", &TagOptions{
+			CommitID: commitID,
+			Tagger:   &User{Name: "Jane Doe", Email: "jane@example.com"},
+		})
+		require.NoError(t, err)
+		var tags []TagMetadata
+		tags, err = rep.ListTags()
+		require.NoError(t, err)
+		var found bool
+		for _, tag := range tags {
+			if tag.Tag == "v1.0.1" {
+				found = true
+				require.Equal(t, commitID, tag.CommitID)
+				require.Equal(t, "v1.0.1", tag.Annotation)
+				require.Equal(t, "Jane Doe <jane@example.com>", tag.Tagger)
+			}
+		}
+		require.True(t, found)
+	})
+
 	testBranch := fmt.Sprintf("test-branch-%s", uuid.NewString())
 	err = rep.CreateChildBranch(testBranch)
 	require.NoError(t, err)
@@ -39,6 +39,9 @@ type WorkTree interface {
 	// CreateChildBranch creates a new branch that is a child of the current
 	// branch.
 	CreateChildBranch(branch string) error
+	// CreateTag creates an annotated tag. The tag is signed if a signing key is
+	// configured for the tagger.
+	CreateTag(tag string, opts *TagOptions) error
 	// CreateOrphanedBranch creates a new branch that shares no commit history
 	// with any other branch.
 	CreateOrphanedBranch(branch string) error
@@ -78,6 +81,8 @@ type WorkTree interface {
 	CommitMessage(id string) (string, error)
 	// Push pushes from the local repository to the remote repository.
 	Push(*PushOptions) error
+	// PushTag pushes the specified tag to the remote repository.
+	PushTag(tag string) error
 	// RefsHaveDiffs returns whether there is a diff between two commits/branches
 	RefsHaveDiffs(commit1 string, commit2 string) (bool, error)
 	// RemoteBranchExists returns a bool indicating if the specified branch exists
@@ -87,6 +92,14 @@ type WorkTree interface {
 	ResetHard() error
 	// URL returns the remote URL of the repository.
 	URL() string
+	// VerifyCommit verifies the signature of the commit with the specified ID
+	// and returns the identity of its signer. If the commit does not have a
+	// valid signature from a trusted signer, an empty string is returned.
+	VerifyCommit(id string) (string, error)
+	// VerifyTag verifies the signature of the specified annotated tag and
+	// returns the identity of its signer. If the tag does not have a valid
+	// signature from a trusted signer, an empty string is returned.
+	VerifyTag(tag string) (string, error)
 }
 
 // workTree is an implementation of the WorkTree interface for interacting with
@@ -209,31 +222,13 @@ func (w *workTree) Commit(message string, opts *CommitOptions) error {
 		opts = &CommitOptions{}
 	}
 
-	var homeDir string
-	if opts.Author != nil {
-		// This author information is specific to this commit, so we will override
-		// repository-level author information by creating a temporary home
-		// directory, configuring the author information "globally" within it, and
-		// then ensuring the git commit command uses that home directory.
-		var err error
-		if homeDir, err = os.MkdirTemp(w.homeDir, ""); err != nil {
-			return fmt.Errorf(
-				"error creating virtual home directory %q for commit command: %w",
-				homeDir, err,
-			)
-		}
-		defer func() {
-			if cleanErr := os.RemoveAll(homeDir); cleanErr != nil {
-				logging.LoggerFromContext(context.TODO()).
-					Error(cleanErr, "error removing virtual home directory", "path", homeDir)
-			}
-		}()
-		if err = w.setupAuthor(homeDir, opts.Author); err != nil {
-			return fmt.Errorf(
-				"error setting up author information for commit command: %w", err,
-			)
-		}
+	homeDir, cleanup, err := w.setupAuthorHomeDir(opts.Author)
+	if err != nil {
+		return fmt.Errorf(
+			"error setting up author information for commit command: %w", err,
+		)
 	}
+	defer cleanup()
 
 	cmdTokens := []string{"commit", "-m", message}
 	if opts.AllowEmpty {
@@ -251,6 +246,34 @@ func (w *workTree) Commit(message string, opts *CommitOptions) error {
 	return nil
 }
 
+// setupAuthorHomeDir creates a temporary virtual home directory with "global"
+// configuration for the provided author, which may be used to override
+// repository-level author information for a single command. If the provided
+// author is nil, no directory is created and an empty path is returned. The
+// returned function removes the directory and must always be called.
+func (w *workTree) setupAuthorHomeDir(author *User) (string, func(), error) {
+	if author == nil {
+		return "", func() {}, nil
+	}
+	homeDir, err := os.MkdirTemp(w.homeDir, "")
+	if err != nil {
+		return "", func() {}, fmt.Errorf(
+			"error creating virtual home directory %q: %w", homeDir, err,
+		)
+	}
+	cleanup := func() {
+		if cleanErr := os.RemoveAll(homeDir); cleanErr != nil {
+			logging.LoggerFromContext(context.TODO()).
+				Error(cleanErr, "error removing virtual home directory", "path", homeDir)
+		}
+	}
+	if err = w.setupAuthor(homeDir, author); err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+	return homeDir, cleanup, nil
+}
+
 func (w *workTree) CommitMessage(id string) (string, error) {
 	msgBytes, err := libExec.Exec(
 		w.buildGitCommand("log", "-n", "1", "--pretty=format:%B", id),
@@ -279,6 +302,53 @@ func (w *workTree) CreateChildBranch(branch string) error {
 	return nil
 }
 
+// TagOptions represents options for creating a tag in a git repository.
+type TagOptions struct {
+	// Message is the message of the annotated tag. If empty, the name of the
+	// tag is used.
+	Message string
+	// CommitID is the ID of the commit to tag. If empty, HEAD is tagged.
+	CommitID string
+	// Tagger is the creator of the tag. If nil, the default author already
+	// configured in the git repository will be used.
+	Tagger *User
+}
+
+func (w *workTree) CreateTag(tag string, opts *TagOptions) error {
+	if opts == nil {
+		opts = &TagOptions{}
+	}
+
+	// Tags are signed according to the same configuration used to sign
+	// commits. See setupAuthor().
+	homeDir, cleanup, err := w.setupAuthorHomeDir(opts.Tagger)
+	if err != nil {
+		return fmt.Errorf(
+			"error setting up tagger information for tag command: %w", err,
+		)
+	}
+	defer cleanup()
+
+	message := opts.Message
+	if message == "" {
+		message = tag
+	}
+	cmdTokens := []string{"tag", "-a", tag, "-m", message}
+	if opts.CommitID != "" {
+		cmdTokens = append(cmdTokens, opts.CommitID)
+	}
+
+	cmd := w.buildGitCommand(cmdTokens...)
+	if homeDir != "" {
+		// Override the home directory set by b.buildGitCommand().
+		w.setCmdHome(cmd, homeDir)
+	}
+	if _, err := libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error creating tag %q: %w", tag, err)
+	}
+	return nil
+}
+
 func (w *workTree) CreateOrphanedBranch(branch string) error {
 	if _, err := libExec.Exec(w.buildGitCommand(
 		"switch",
@@ -401,6 +471,10 @@ type CommitMetadata struct {
 	Committer string
 	// Subject is the subject (first line) of the commit message.
 	Subject string
+	// Signer is the identity of the trusted signer of the commit. It is not
+	// populated by ListCommits, but by callers that verify the commit's
+	// signature.
+	Signer string
 }
 
 func (w *workTree) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
@@ -480,6 +554,10 @@ type TagMetadata struct {
 	Tagger string
 	// Annotation is the annotation of the tag, if it is an annotated tag.
 	Annotation string
+	// Signer is the identity of the trusted signer of the tag or, for a
+	// lightweight tag, of the commit it references. It is not populated by
+	// ListTags, but by callers that verify the signature.
+	Signer string
 }
 
 func parseTagMetadataLine(line []byte) (TagMetadata, error) {
@@ -633,6 +711,15 @@ func (w *workTree) Push(opts *PushOptions) error {
 	return nil
 }
 
+func (w *workTree) PushTag(tag string) error {
+	if _, err := libExec.Exec(
+		w.buildGitCommand("push", "origin", fmt.Sprintf("refs/tags/%s", tag)),
+	); err != nil {
+		return fmt.Errorf("error pushing tag %q: %w", tag, err)
+	}
+	return nil
+}
+
 func (w *workTree) RefsHaveDiffs(commit1 string, commit2 string) (bool, error) {
 	// `git diff --quiet` returns 0 if no diff, 1 if diff, and non-zero/one for any other error
 	_, err := libExec.Exec(w.buildGitCommand(
@@ -655,3 +742,31 @@ func (w *workTree) ResetHard() error {
 	}
 	return nil
 }
+
+func (w *workTree) VerifyCommit(id string) (string, error) {
+	return w.verify("verify-commit", id)
+}
+
+func (w *workTree) VerifyTag(tag string) (string, error) {
+	return w.verify("verify-tag", tag)
+}
+
+// verify verifies the signature of the specified object using the specified
+// git subcommand and returns the identity of its signer. A command exiting
+// with a status of 1 indicates that the object does not have a valid
+// signature from a trusted signer, in which case an empty string is returned.
+func (w *workTree) verify(subcommand string, object string) (string, error) {
+	res, err := libExec.Exec(w.buildGitCommand(subcommand, "--raw", object))
+	if err != nil {
+		var exitErr *libExec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode == 1 {
+			return "", nil
+		}
+		return "", fmt.Errorf("error verifying signature of %q: %w", object, err)
+	}
+	signer, err := parseSigner(res)
+	if err != nil {
+		return "", fmt.Errorf("error verifying signature of %q: %w", object, err)
+	}
+	return signer, nil
+}
@@ -0,0 +1,411 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/controller"
+	kargoevent "github.com/akuity/kargo/pkg/event"
+	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/notification"
+)
+
+type ReconcilerConfig struct {
+	MaxConcurrentReconciles int `envconfig:"MAX_CONCURRENT_NOTIFICATION_RECONCILES" default:"4"`
+	// MaxEventAge is the maximum age of an Event for which notifications will
+	// be delivered. Older Events, such as those that already existed when the
+	// controller started, are ignored.
+	MaxEventAge time.Duration `envconfig:"NOTIFICATION_MAX_EVENT_AGE" default:"10m"`
+}
+
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// reconciler reconciles Kargo Events by delivering notifications of them to
+// the destinations configured in the ProjectConfig of the Project in which
+// they were recorded.
+type reconciler struct {
+	cfg    ReconcilerConfig
+	client client.Client
+
+	newNotifierFn func(
+		kargoapi.NotificationConfig,
+		*corev1.Secret,
+	) (notification.Notifier, error)
+
+	nowFn func() time.Time
+}
+
+// delivery records the progress of delivering a notification of a single Event
+// to a single destination. The progress of all deliveries for an Event is
+// recorded in the Event's annotations until all of them are done.
+type delivery struct {
+	// Attempts is the number of delivery attempts made so far.
+	Attempts int32 `json:"attempts"`
+	// Done indicates that no further attempts will be made, either because
+	// delivery succeeded or because it failed permanently.
+	Done bool `json:"done,omitempty"`
+	// NextAttemptTime is the earliest time at which another attempt may be
+	// made.
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+}
+
+// SetupReconcilerWithManager initializes a reconciler for Kargo Events and
+// registers it with the provided Manager.
+func SetupReconcilerWithManager(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+	cfg ReconcilerConfig,
+) error {
+	err := ctrl.NewControllerManagedBy(kargoMgr).
+		Named("notifications").
+		For(&corev1.Event{}).
+		WithEventFilter(
+			predicate.Funcs{
+				// Only newly recorded Events are of interest. Events are not
+				// expected to change in any meaningful way once recorded.
+				UpdateFunc: func(event.UpdateEvent) bool {
+					return false
+				},
+				DeleteFunc: func(event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(event.GenericEvent) bool {
+					return false
+				},
+			},
+		).
+		WithEventFilter(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				evt, ok := obj.(*corev1.Event)
+				return ok &&
+					evt.InvolvedObject.APIVersion == kargoapi.GroupVersion.Identifier() &&
+					evt.Annotations[kargoapi.AnnotationKeyNotified] == ""
+			}),
+		).
+		WithOptions(controller.CommonOptions(cfg.MaxConcurrentReconciles)).
+		Complete(newReconciler(kargoMgr.GetClient(), cfg))
+
+	if err == nil {
+		logging.LoggerFromContext(ctx).Info(
+			"Initialized notifications reconciler",
+			"maxConcurrentReconciles", cfg.MaxConcurrentReconciles,
+		)
+	}
+
+	return err
+}
+
+func newReconciler(kubeClient client.Client, cfg ReconcilerConfig) *reconciler {
+	return &reconciler{
+		cfg:           cfg,
+		client:        kubeClient,
+		newNotifierFn: notification.NewNotifier,
+		nowFn:         time.Now,
+	}
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"namespace", req.Namespace,
+		"event", req.Name,
+	)
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	evt := &corev1.Event{}
+	if err := r.client.Get(ctx, req.NamespacedName, evt); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Ignore if not found. Events are short-lived and this can happen if
+			// the Event expired after the reconciliation request was issued.
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf(
+			"error getting Event %q in namespace %q: %w",
+			req.Name, req.Namespace, err,
+		)
+	}
+
+	if evt.Annotations[kargoapi.AnnotationKeyNotified] != "" {
+		logger.Debug("notifications already delivered for Event; nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	if age := r.nowFn().Sub(k8sevent.EventTime(*evt)); age > r.cfg.MaxEventAge {
+		logger.Debug("Event is too old to deliver notifications for", "age", age)
+		return ctrl.Result{}, nil
+	}
+
+	projectCfg, err := api.GetProjectConfig(ctx, r.client, evt.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if projectCfg == nil || len(projectCfg.Spec.Notifications) == 0 {
+		logger.Debug("Project does not define any notifications; nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	kargoEvt, err := k8sevent.FromKubernetesEvent(*evt)
+	if err != nil {
+		// Retrying will not help if the Event cannot be parsed.
+		logger.Error(err, "error parsing Event; skipping notifications")
+		return ctrl.Result{}, nil
+	}
+
+	deliveries := map[string]delivery{}
+	if deliveriesJSON := evt.Annotations[kargoapi.AnnotationKeyNotificationDeliveries]; deliveriesJSON != "" {
+		if err = json.Unmarshal([]byte(deliveriesJSON), &deliveries); err != nil {
+			// This can only happen if the annotation was tampered with. Starting
+			// over is preferable to never delivering notifications at all.
+			logger.Error(err, "error parsing notification deliveries of Event; starting over")
+			deliveries = map[string]delivery{}
+		}
+	}
+
+	logger.Debug("delivering notifications for Event", "type", kargoEvt.Type())
+	statuses, requeueAfter := r.deliverNotifications(ctx, projectCfg, kargoEvt, deliveries)
+
+	if len(statuses) > 0 {
+		if err = r.updateNotificationStatuses(ctx, projectCfg, statuses); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	patch := client.MergeFrom(evt.DeepCopy())
+	if evt.Annotations == nil {
+		evt.Annotations = map[string]string{}
+	}
+	if requeueAfter > 0 {
+		// Some deliveries failed and will be retried once the Event is requeued,
+		// rather than here, so as not to block delivery of notifications of other
+		// Events. Record the progress of all deliveries so that those which are
+		// done are not repeated.
+		var deliveriesJSON []byte
+		if deliveriesJSON, err = json.Marshal(deliveries); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error marshaling notification deliveries: %w", err)
+		}
+		evt.Annotations[kargoapi.AnnotationKeyNotificationDeliveries] = string(deliveriesJSON)
+	} else {
+		// Record that notifications have been delivered for the Event so that
+		// they are not delivered again, e.g. following a restart of the
+		// controller. Destinations that could not be reached after the configured
+		// number of attempts are reported in the ProjectConfig's status and not
+		// retried further.
+		delete(evt.Annotations, kargoapi.AnnotationKeyNotificationDeliveries)
+		evt.Annotations[kargoapi.AnnotationKeyNotified] = kargoapi.AnnotationValueTrue
+	}
+	if err = r.client.Patch(ctx, evt, patch); err != nil {
+		return ctrl.Result{}, fmt.Errorf(
+			"error recording notification deliveries of Event %q in namespace %q: %w",
+			evt.Name, evt.Namespace, err,
+		)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// deliverNotifications makes a single attempt to deliver the provided event to
+// each of the destinations in the provided ProjectConfig whose filters it
+// matches and for which delivery is neither done nor waiting to be retried.
+// The provided deliveries are updated in place to reflect the attempts made.
+// It returns a NotificationStatus describing the outcome of each delivery that
+// became done, along with the duration after which the next pending delivery
+// should be retried. A zero duration indicates that all deliveries are done.
+func (r *reconciler) deliverNotifications(
+	ctx context.Context,
+	projectCfg *kargoapi.ProjectConfig,
+	evt kargoevent.Meta,
+	deliveries map[string]delivery,
+) ([]kargoapi.NotificationStatus, time.Duration) {
+	logger := logging.LoggerFromContext(ctx)
+	var statuses []kargoapi.NotificationStatus
+	var requeueAfter time.Duration
+	retryAfter := func(d time.Duration) {
+		if requeueAfter == 0 || d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+	for _, cfg := range projectCfg.Spec.Notifications {
+		if !notification.Matches(cfg, evt) {
+			continue
+		}
+		d := deliveries[cfg.Name]
+		if d.Done {
+			continue
+		}
+		notificationLogger := logger.WithValues("notification", cfg.Name)
+		now := r.nowFn()
+		if d.NextAttemptTime != nil && now.Before(d.NextAttemptTime.Time) {
+			retryAfter(d.NextAttemptTime.Sub(now))
+			continue
+		}
+		err := r.deliver(ctx, projectCfg.Namespace, cfg, evt)
+		d.Attempts++
+		if err != nil && !notification.IsTerminal(err) && d.Attempts < notification.MaxAttempts(cfg) {
+			delay := notification.RetryDelay(d.Attempts)
+			notificationLogger.Debug(
+				"error delivering notification; will retry",
+				"attempts", d.Attempts,
+				"retryAfter", delay,
+				"error", err.Error(),
+			)
+			d.NextAttemptTime = &metav1.Time{Time: now.Add(delay)}
+			deliveries[cfg.Name] = d
+			retryAfter(delay)
+			continue
+		}
+		deliveries[cfg.Name] = delivery{Attempts: d.Attempts, Done: true}
+		status := kargoapi.NotificationStatus{
+			Name:            cfg.Name,
+			LastEventType:   evt.Type(),
+			LastEventID:     evt.GetID(),
+			LastAttemptTime: &metav1.Time{Time: now},
+			Attempts:        d.Attempts,
+		}
+		if err != nil {
+			notificationLogger.Error(err, "error delivering notification")
+			status.Message = err.Error()
+		} else {
+			notificationLogger.Debug("delivered notification", "attempts", d.Attempts)
+			status.Succeeded = true
+			status.LastSuccessTime = status.LastAttemptTime
+			status.Message = "Notification delivered"
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, requeueAfter
+}
+
+// deliver makes a single attempt to deliver the provided event to the
+// destination described by the provided NotificationConfig.
+func (r *reconciler) deliver(
+	ctx context.Context,
+	namespace string,
+	cfg kargoapi.NotificationConfig,
+	evt kargoevent.Meta,
+) error {
+	secretName := notificationSecretName(cfg)
+	secret := &corev1.Secret{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: secretName},
+		secret,
+	); err != nil {
+		return fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			secretName, namespace, err,
+		)
+	}
+	notifier, err := r.newNotifierFn(cfg, secret)
+	if err != nil {
+		return fmt.Errorf("error creating notifier: %w", err)
+	}
+	return notifier.Notify(ctx, evt)
+}
+
+// updateNotificationStatuses merges the provided NotificationStatuses into the
+// status of the provided ProjectConfig. Statuses for destinations that no
+// longer exist in the ProjectConfig's spec are pruned.
+func (r *reconciler) updateNotificationStatuses(
+	ctx context.Context,
+	projectCfg *kargoapi.ProjectConfig,
+	statuses []kargoapi.NotificationStatus,
+) error {
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &kargoapi.ProjectConfig{}
+		if err := r.client.Get(
+			ctx,
+			client.ObjectKeyFromObject(projectCfg),
+			latest,
+		); err != nil {
+			return err
+		}
+		patch := client.MergeFromWithOptions(
+			latest.DeepCopy(),
+			client.MergeFromWithOptimisticLock{},
+		)
+		latest.Status.Notifications = mergeNotificationStatuses(
+			latest.Spec.Notifications,
+			latest.Status.Notifications,
+			statuses,
+		)
+		return r.client.Status().Patch(ctx, latest, patch)
+	}); err != nil {
+		return fmt.Errorf(
+			"error updating notification status of ProjectConfig %q: %w",
+			projectCfg.Name, err,
+		)
+	}
+	return nil
+}
+
+// mergeNotificationStatuses returns a new slice of NotificationStatuses, one
+// for each of the provided NotificationConfigs that has a status, in the same
+// order as the configs. New statuses take precedence over existing ones, with
+// consecutive failures carried forward from the existing status.
+func mergeNotificationStatuses(
+	cfgs []kargoapi.NotificationConfig,
+	existing []kargoapi.NotificationStatus,
+	updates []kargoapi.NotificationStatus,
+) []kargoapi.NotificationStatus {
+	existingByName := make(map[string]kargoapi.NotificationStatus, len(existing))
+	for _, s := range existing {
+		existingByName[s.Name] = s
+	}
+	updatesByName := make(map[string]kargoapi.NotificationStatus, len(updates))
+	for _, s := range updates {
+		updatesByName[s.Name] = s
+	}
+	merged := make([]kargoapi.NotificationStatus, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		old, hasOld := existingByName[cfg.Name]
+		update, hasUpdate := updatesByName[cfg.Name]
+		switch {
+		case hasUpdate:
+			if !update.Succeeded {
+				update.ConsecutiveFailures = old.ConsecutiveFailures + 1
+				update.LastSuccessTime = old.LastSuccessTime
+			}
+			merged = append(merged, update)
+		case hasOld:
+			merged = append(merged, old)
+		}
+	}
+	return merged
+}
+
+// notificationSecretName returns the name of the Secret referenced by the
+// target of the provided NotificationConfig.
+func notificationSecretName(cfg kargoapi.NotificationConfig) string {
+	switch {
+	case cfg.Slack != nil:
+		return cfg.Slack.SecretRef.Name
+	case cfg.Teams != nil:
+		return cfg.Teams.SecretRef.Name
+	case cfg.Webhook != nil:
+		return cfg.Webhook.SecretRef.Name
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,411 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	kargoevent "github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/notification"
+)
+
+func TestNewReconciler(t *testing.T) {
+	testCfg := ReconcilerConfig{MaxEventAge: time.Minute}
+	r := newReconciler(fake.NewClientBuilder().Build(), testCfg)
+	require.Equal(t, testCfg, r.cfg)
+	require.NotNil(t, r.client)
+	require.NotNil(t, r.newNotifierFn)
+	require.NotNil(t, r.nowFn)
+}
+
+type mockNotifier struct {
+	notifyFn func(context.Context, kargoevent.Meta) error
+}
+
+func (m mockNotifier) Notify(ctx context.Context, evt kargoevent.Meta) error {
+	return m.notifyFn(ctx, evt)
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	const testProject = "fake-project"
+	now := time.Now().Truncate(time.Second)
+
+	promoFailed := &kargoevent.PromotionFailed{
+		Common: kargoevent.Common{
+			Project: testProject,
+			Message: "Promotion failed",
+		},
+		Promotion: kargoevent.Promotion{
+			Name:       "fake-promotion",
+			StageName:  "fake-stage",
+			CreateTime: now,
+		},
+	}
+	newEvent := func(age time.Duration, deliveries ...string) *corev1.Event {
+		annotations := promoFailed.MarshalAnnotations()
+		if len(deliveries) > 0 {
+			annotations[kargoapi.AnnotationKeyNotificationDeliveries] = deliveries[0]
+		}
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testProject,
+				Name:        "fake-event",
+				UID:         "fake-uid",
+				Annotations: annotations,
+			},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: kargoapi.GroupVersion.Identifier(),
+				Kind:       "Promotion",
+				Namespace:  testProject,
+				Name:       "fake-promotion",
+			},
+			Reason:        string(kargoapi.EventTypePromotionFailed),
+			LastTimestamp: metav1.NewTime(now.Add(-age)),
+		}
+	}
+	newProjectConfig := func(notifications ...kargoapi.NotificationConfig) *kargoapi.ProjectConfig {
+		return &kargoapi.ProjectConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      testProject,
+			},
+			Spec: kargoapi.ProjectConfigSpec{
+				Notifications: notifications,
+			},
+			Status: kargoapi.ProjectConfigStatus{
+				Notifications: []kargoapi.NotificationStatus{{
+					Name:                "slack",
+					Succeeded:           false,
+					ConsecutiveFailures: 2,
+				}},
+			},
+		}
+	}
+	slackCfg := kargoapi.NotificationConfig{
+		Name:       "slack",
+		EventTypes: []kargoapi.EventType{kargoapi.EventTypePromotionFailed},
+		Slack: &kargoapi.SlackNotificationConfig{
+			SecretRef: corev1.LocalObjectReference{Name: "slack"},
+		},
+	}
+	webhookCfg := kargoapi.NotificationConfig{
+		Name:        "webhook",
+		MaxAttempts: 5,
+		Webhook: &kargoapi.WebhookNotificationConfig{
+			SecretRef: corev1.LocalObjectReference{Name: "webhook"},
+		},
+	}
+	unmatchedCfg := kargoapi.NotificationConfig{
+		Name:       "unmatched",
+		EventTypes: []kargoapi.EventType{kargoapi.EventTypePromotionSucceeded},
+		Slack: &kargoapi.SlackNotificationConfig{
+			SecretRef: corev1.LocalObjectReference{Name: "slack"},
+		},
+	}
+	newSecret := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testProject, Name: name},
+			Data: map[string][]byte{
+				notification.SecretKeyURL: []byte("https://example.com/" + name),
+			},
+		}
+	}
+
+	getEvent := func(t *testing.T, c client.Client) *corev1.Event {
+		evt := &corev1.Event{}
+		require.NoError(
+			t,
+			c.Get(context.Background(), types.NamespacedName{Namespace: testProject, Name: "fake-event"}, evt),
+		)
+		return evt
+	}
+	getStatuses := func(t *testing.T, c client.Client) []kargoapi.NotificationStatus {
+		projectCfg := &kargoapi.ProjectConfig{}
+		require.NoError(
+			t,
+			c.Get(context.Background(), types.NamespacedName{Namespace: testProject, Name: testProject}, projectCfg),
+		)
+		return projectCfg.Status.Notifications
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		notifyFn   func(context.Context, kargoevent.Meta) error
+		assertions func(*testing.T, client.Client, ctrl.Result, error)
+	}{
+		{
+			name: "Event not found",
+			assertions: func(t *testing.T, _ client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Event too old",
+			objects: []client.Object{
+				newEvent(time.Hour),
+				newProjectConfig(slackCfg),
+				newSecret("slack"),
+			},
+			notifyFn: func(context.Context, kargoevent.Meta) error {
+				require.FailNow(t, "notification should not have been delivered")
+				return nil
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, getEvent(t, c).Annotations[kargoapi.AnnotationKeyNotified])
+			},
+		},
+		{
+			name: "Project has no notifications",
+			objects: []client.Object{
+				newEvent(0),
+				newProjectConfig(),
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, getEvent(t, c).Annotations[kargoapi.AnnotationKeyNotified])
+			},
+		},
+		{
+			name: "notifications delivered",
+			objects: []client.Object{
+				newEvent(0),
+				newProjectConfig(slackCfg, webhookCfg, unmatchedCfg),
+				newSecret("slack"),
+				newSecret("webhook"),
+			},
+			notifyFn: func(_ context.Context, evt kargoevent.Meta) error {
+				require.Equal(t, kargoapi.EventTypePromotionFailed, evt.Type())
+				return nil
+			},
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+
+				statuses := getStatuses(t, c)
+				require.Len(t, statuses, 2)
+
+				require.Equal(t, "slack", statuses[0].Name)
+				require.True(t, statuses[0].Succeeded)
+				require.Equal(t, int32(1), statuses[0].Attempts)
+				require.Zero(t, statuses[0].ConsecutiveFailures)
+				require.Equal(t, kargoapi.EventTypePromotionFailed, statuses[0].LastEventType)
+				require.Equal(t, "fake-uid", statuses[0].LastEventID)
+				require.NotNil(t, statuses[0].LastSuccessTime)
+
+				require.Equal(t, "webhook", statuses[1].Name)
+				require.True(t, statuses[1].Succeeded)
+
+				evt := getEvent(t, c)
+				require.Equal(t, kargoapi.AnnotationValueTrue, evt.Annotations[kargoapi.AnnotationKeyNotified])
+				require.NotContains(t, evt.Annotations, kargoapi.AnnotationKeyNotificationDeliveries)
+			},
+		},
+		{
+			name: "failed delivery is retried later",
+			objects: []client.Object{
+				newEvent(0),
+				newProjectConfig(slackCfg, webhookCfg),
+				newSecret("slack"),
+			},
+			notifyFn: func(context.Context, kargoevent.Meta) error {
+				return nil
+			},
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, time.Second, res.RequeueAfter)
+
+				// Only the successful delivery is done. The webhook's Secret does not
+				// exist, so delivery to it will be retried.
+				statuses := getStatuses(t, c)
+				require.Len(t, statuses, 1)
+				require.Equal(t, "slack", statuses[0].Name)
+				require.True(t, statuses[0].Succeeded)
+
+				evt := getEvent(t, c)
+				require.Empty(t, evt.Annotations[kargoapi.AnnotationKeyNotified])
+				deliveries := map[string]delivery{}
+				require.NoError(t, json.Unmarshal(
+					[]byte(evt.Annotations[kargoapi.AnnotationKeyNotificationDeliveries]),
+					&deliveries,
+				))
+				require.Equal(t, delivery{Attempts: 1, Done: true}, deliveries["slack"])
+				require.Equal(t, int32(1), deliveries["webhook"].Attempts)
+				require.False(t, deliveries["webhook"].Done)
+				require.NotNil(t, deliveries["webhook"].NextAttemptTime)
+			},
+		},
+		{
+			name: "retry not yet due",
+			objects: []client.Object{
+				newEvent(
+					0,
+					fmt.Sprintf(
+						`{"slack":{"attempts":1,"nextAttemptTime":%q}}`,
+						now.Add(5*time.Second).Format(time.RFC3339),
+					),
+				),
+				newProjectConfig(slackCfg),
+				newSecret("slack"),
+			},
+			notifyFn: func(context.Context, kargoevent.Meta) error {
+				require.FailNow(t, "notification should not have been delivered")
+				return nil
+			},
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 5*time.Second, res.RequeueAfter)
+				require.Empty(t, getEvent(t, c).Annotations[kargoapi.AnnotationKeyNotified])
+			},
+		},
+		{
+			name: "completed deliveries are not repeated",
+			objects: []client.Object{
+				newEvent(
+					0,
+					fmt.Sprintf(
+						`{"slack":{"attempts":1,"done":true},"webhook":{"attempts":1,"nextAttemptTime":%q}}`,
+						now.Add(-time.Second).Format(time.RFC3339),
+					),
+				),
+				newProjectConfig(slackCfg, webhookCfg),
+				newSecret("slack"),
+				newSecret("webhook"),
+			},
+			notifyFn: func(context.Context, kargoevent.Meta) error {
+				return nil
+			},
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+
+				statuses := getStatuses(t, c)
+				require.Len(t, statuses, 2)
+				// The pre-existing status of the Slack destination is unchanged.
+				require.Equal(t, "slack", statuses[0].Name)
+				require.False(t, statuses[0].Succeeded)
+				require.Equal(t, "webhook", statuses[1].Name)
+				require.True(t, statuses[1].Succeeded)
+				require.Equal(t, int32(2), statuses[1].Attempts)
+
+				require.Equal(
+					t,
+					kargoapi.AnnotationValueTrue,
+					getEvent(t, c).Annotations[kargoapi.AnnotationKeyNotified],
+				)
+			},
+		},
+		{
+			name: "delivery failure is recorded once attempts are exhausted",
+			objects: []client.Object{
+				newEvent(
+					0,
+					fmt.Sprintf(
+						`{"slack":{"attempts":2,"nextAttemptTime":%q}}`,
+						now.Add(-time.Second).Format(time.RFC3339),
+					),
+				),
+				newProjectConfig(slackCfg),
+				newSecret("slack"),
+			},
+			notifyFn: func(context.Context, kargoevent.Meta) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+
+				statuses := getStatuses(t, c)
+				require.Len(t, statuses, 1)
+				require.False(t, statuses[0].Succeeded)
+				require.Equal(t, int32(3), statuses[0].Attempts)
+				require.Equal(t, int32(3), statuses[0].ConsecutiveFailures)
+				require.Equal(t, "something went wrong", statuses[0].Message)
+
+				require.Equal(
+					t,
+					kargoapi.AnnotationValueTrue,
+					getEvent(t, c).Annotations[kargoapi.AnnotationKeyNotified],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				WithStatusSubresource(&kargoapi.ProjectConfig{}).
+				Build()
+			r := &reconciler{
+				cfg:    ReconcilerConfig{MaxEventAge: 10 * time.Minute},
+				client: c,
+				newNotifierFn: func(
+					kargoapi.NotificationConfig,
+					*corev1.Secret,
+				) (notification.Notifier, error) {
+					return mockNotifier{notifyFn: testCase.notifyFn}, nil
+				},
+				nowFn: func() time.Time { return now },
+			}
+			res, err := r.Reconcile(
+				context.Background(),
+				ctrl.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: testProject,
+						Name:      "fake-event",
+					},
+				},
+			)
+			testCase.assertions(t, c, res, err)
+		})
+	}
+}
+
+func Test_mergeNotificationStatuses(t *testing.T) {
+	lastSuccess := metav1.NewTime(time.Now().Add(-time.Hour))
+	cfgs := []kargoapi.NotificationConfig{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+	existing := []kargoapi.NotificationStatus{
+		{Name: "a", Succeeded: true, LastSuccessTime: &lastSuccess},
+		{Name: "b", Succeeded: true},
+		{Name: "removed", Succeeded: true},
+	}
+	updates := []kargoapi.NotificationStatus{
+		{Name: "a", Succeeded: false, Message: "failed"},
+		{Name: "c", Succeeded: true},
+	}
+	merged := mergeNotificationStatuses(cfgs, existing, updates)
+	require.Equal(
+		t,
+		[]kargoapi.NotificationStatus{
+			{
+				Name:                "a",
+				Succeeded:           false,
+				Message:             "failed",
+				ConsecutiveFailures: 1,
+				LastSuccessTime:     &lastSuccess,
+			},
+			{Name: "b", Succeeded: true},
+			{Name: "c", Succeeded: true},
+		},
+		merged,
+	)
+}
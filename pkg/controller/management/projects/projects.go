@@ -66,6 +66,9 @@ type ReconcilerConfig struct {
 	ArgoCDClusterRoleName    string `envconfig:"ARGOCD_CLUSTER_ROLE_NAME" default:""`
 	ArgoCDNamespace          string `envconfig:"ARGOCD_NAMESPACE" default:"argocd"`
 	ArgoCDWatchNamespaceOnly bool   `envconfig:"ARGOCD_WATCH_ARGOCD_NAMESPACE_ONLY" default:"false"`
+
+	PromotionRunnerServiceAccountName string `envconfig:"PROMOTION_RUNNER_SERVICE_ACCOUNT_NAME" default:""`
+	PromotionRunnerClusterRoleName    string `envconfig:"PROMOTION_RUNNER_CLUSTER_ROLE_NAME" default:""`
 }
 
 func ReconcilerConfigFromEnv() ReconcilerConfig {
@@ -141,6 +144,8 @@ type reconciler struct {
 
 	ensureExtendedPermissionsFn func(context.Context, *kargoapi.Project) error
 
+	ensurePromotionRunnerPermissionsFn func(context.Context, *kargoapi.Project) error
+
 	createServiceAccountFn func(
 		context.Context,
 		client.Object,
@@ -255,6 +260,7 @@ func newReconciler(kubeClient client.Client, cfg ReconcilerConfig) *reconciler {
 	r.ensureControllerPermissionsFn = r.ensureControllerPermissions
 	r.ensureDefaultUserRolesFn = r.ensureDefaultUserRoles
 	r.ensureExtendedPermissionsFn = r.ensureExtendedPermissions
+	r.ensurePromotionRunnerPermissionsFn = r.ensurePromotionRunnerPermissions
 	r.createServiceAccountFn = r.client.Create
 	r.createRoleFn = r.client.Create
 	r.createRoleBindingFn = r.client.Create
@@ -571,6 +577,19 @@ func (r *reconciler) syncProject(
 		}
 	}
 
+	if r.cfg.PromotionRunnerServiceAccountName != "" && r.cfg.PromotionRunnerClusterRoleName != "" {
+		if err := r.ensurePromotionRunnerPermissionsFn(ctx, project); err != nil {
+			conditions.Set(status, &metav1.Condition{
+				Type:               kargoapi.ConditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "EnsuringPromotionRunnerPermissionsFailed",
+				Message:            "Failed to ensure existence of promotion runner permissions: " + err.Error(),
+				ObservedGeneration: project.GetGeneration(),
+			})
+			return *status, fmt.Errorf("error ensuring promotion runner permissions: %w", err)
+		}
+	}
+
 	conditions.Delete(status, kargoapi.ConditionTypeReconciling)
 	conditions.Set(status, &metav1.Condition{
 		Type:               kargoapi.ConditionTypeReady,
@@ -768,6 +787,77 @@ func (r *reconciler) ensureSystemPermissions(
 	return nil
 }
 
+// ensurePromotionRunnerPermissions ensures the existence of the ServiceAccount
+// used by promotion Pods in the Project namespace and of a RoleBinding that
+// grants it the permissions it needs within that namespace only.
+func (r *reconciler) ensurePromotionRunnerPermissions(
+	ctx context.Context,
+	project *kargoapi.Project,
+) error {
+	saName := r.cfg.PromotionRunnerServiceAccountName
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"project", project.Name,
+		"namespace", project.Name,
+		"serviceAccount", saName,
+	)
+
+	if err := r.createServiceAccountFn(
+		ctx,
+		&corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      saName,
+				Namespace: project.Name,
+			},
+		},
+	); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf(
+				"error creating ServiceAccount %q in project namespace %q: %w",
+				saName, project.Name, err,
+			)
+		}
+		logger.Debug("ServiceAccount already exists in project namespace")
+	} else {
+		logger.Debug("created ServiceAccount in project namespace")
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      saName,
+			Namespace: project.Name,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     r.cfg.PromotionRunnerClusterRoleName,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      "ServiceAccount",
+			Name:      saName,
+			Namespace: project.Name,
+		}},
+	}
+	if err := r.createRoleBindingFn(ctx, roleBinding); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf(
+				"error creating RoleBinding %q in Project namespace %q: %w",
+				roleBinding.Name, project.Name, err,
+			)
+		}
+		if err = r.client.Update(ctx, roleBinding); err != nil {
+			return fmt.Errorf(
+				"error updating existing RoleBinding %q in Project namespace %q: %w",
+				roleBinding.Name, project.Name, err,
+			)
+		}
+		logger.Debug("updated RoleBinding")
+		return nil
+	}
+	logger.Debug("created RoleBinding in Project namespace")
+
+	return nil
+}
+
 func (r *reconciler) ensureControllerPermissions(
 	ctx context.Context,
 	project *kargoapi.Project,
@@ -925,14 +1015,14 @@ func (r *reconciler) ensureDefaultUserRoles(
 					Resources: []string{"freights", "stages", "warehouses", "projectconfigs"},
 					Verbs:     []string{"*"},
 				},
-				{ // Promote permission on all stages
+				{ // Promote and PromotionCalendar override permissions on all stages
 					APIGroups: []string{kargoapi.GroupVersion.Group},
 					Resources: []string{"stages"},
-					Verbs:     []string{"promote"},
+					Verbs:     []string{"override-calendar", "promote"},
 				},
-				{ // Nearly full access to all Promotions, but they are immutable
+				{ // Nearly full access to all Promotions and PromotionPlans, but they are immutable
 					APIGroups: []string{kargoapi.GroupVersion.Group},
-					Resources: []string{"promotions"},
+					Resources: []string{"promotionplans", "promotions"},
 					Verbs:     []string{"create", "delete", "get", "list", "watch", "patch"},
 				},
 				{ // Manual approvals involve patching Freight status
@@ -974,8 +1064,10 @@ func (r *reconciler) ensureDefaultUserRoles(
 				},
 				{
 					APIGroups: []string{kargoapi.GroupVersion.Group},
-					Resources: []string{"freights", "promotions", "stages", "warehouses", "projectconfigs"},
-					Verbs:     []string{"get", "list", "watch"},
+					Resources: []string{
+						"freights", "promotionplans", "promotions", "stages", "warehouses", "projectconfigs",
+					},
+					Verbs: []string{"get", "list", "watch"},
 				},
 				{
 					APIGroups: []string{rolloutsapi.GroupVersion.Group},
@@ -1013,9 +1105,9 @@ func (r *reconciler) ensureDefaultUserRoles(
 					Resources: []string{"stages"},
 					Verbs:     []string{"promote"},
 				},
-				{ // Can create and view promotions
+				{ // Can create and view promotions and promotion plans
 					APIGroups: []string{kargoapi.GroupVersion.Group},
-					Resources: []string{"promotions"},
+					Resources: []string{"promotionplans", "promotions"},
 					Verbs:     []string{"create", "get", "list", "watch"},
 				},
 				{ // Manual approvals involve patching Freight status
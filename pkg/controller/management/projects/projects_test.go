@@ -43,6 +43,7 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, r.ensureControllerPermissionsFn)
 	require.NotNil(t, r.ensureDefaultUserRolesFn)
 	require.NotNil(t, r.ensureExtendedPermissionsFn)
+	require.NotNil(t, r.ensurePromotionRunnerPermissionsFn)
 	require.NotNil(t, r.createServiceAccountFn)
 	require.NotNil(t, r.createRoleFn)
 	require.NotNil(t, r.createRoleBindingFn)
@@ -1605,6 +1606,138 @@ func TestReconciler_ensureSystemPermissions(t *testing.T) {
 	}
 }
 
+func TestReconciler_ensurePromotionRunnerPermissions(t *testing.T) {
+	testCfg := ReconcilerConfig{
+		PromotionRunnerServiceAccountName: "kargo-promotion-runner",
+		PromotionRunnerClusterRoleName:    "kargo-promotion-runner",
+	}
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error creating service account",
+			reconciler: &reconciler{
+				cfg: testCfg,
+				createServiceAccountFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error creating ServiceAccount")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error creating role binding",
+			reconciler: &reconciler{
+				cfg: testCfg,
+				createServiceAccountFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return apierrors.NewAlreadyExists(schema.GroupResource{}, "")
+				},
+				createRoleBindingFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error creating RoleBinding")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error updating existing role binding",
+			reconciler: &reconciler{
+				cfg: testCfg,
+				client: fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+					Update: func(
+						context.Context,
+						client.WithWatch,
+						client.Object,
+						...client.UpdateOption,
+					) error {
+						return errors.New("something went wrong")
+					},
+				}).Build(),
+				createServiceAccountFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+				createRoleBindingFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return apierrors.NewAlreadyExists(schema.GroupResource{}, "")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error updating existing RoleBinding")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				cfg: testCfg,
+				createServiceAccountFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					if obj.GetName() != "kargo-promotion-runner" || obj.GetNamespace() != "fake-project" {
+						return errors.New("unexpected ServiceAccount")
+					}
+					return nil
+				},
+				createRoleBindingFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					rb, ok := obj.(*rbacv1.RoleBinding)
+					if !ok || rb.RoleRef.Name != "kargo-promotion-runner" ||
+						len(rb.Subjects) != 1 || rb.Subjects[0].Namespace != "fake-project" {
+						return errors.New("unexpected RoleBinding")
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.reconciler.ensurePromotionRunnerPermissions(
+					context.Background(),
+					&kargoapi.Project{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-project"},
+					},
+				),
+			)
+		})
+	}
+}
+
 func TestReconciler_ensureControllerPermissions(t *testing.T) {
 	cfg := ReconcilerConfigFromEnv()
 
@@ -0,0 +1,610 @@
+package promotionplans
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/controller"
+	"github.com/akuity/kargo/pkg/kargo"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/logging"
+	intpredicate "github.com/akuity/kargo/pkg/predicate"
+)
+
+// gateCheckInterval is the interval at which a wave's health gate is
+// re-evaluated while it has not yet passed.
+const gateCheckInterval = 30 * time.Second
+
+// ReconcilerConfig represents configuration for the PromotionPlan reconciler.
+type ReconcilerConfig struct {
+	IsDefaultController     bool   `envconfig:"IS_DEFAULT_CONTROLLER"`
+	ShardName               string `envconfig:"SHARD_NAME"`
+	MaxConcurrentReconciles int    `envconfig:"MAX_CONCURRENT_PROMOTION_PLAN_RECONCILES" default:"4"`
+}
+
+func (c ReconcilerConfig) Name() string {
+	name := "promotion-plan-controller"
+	if c.ShardName != "" {
+		return name + "-" + c.ShardName
+	}
+	return name
+}
+
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	var cfg ReconcilerConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// reconciler reconciles PromotionPlan resources.
+type reconciler struct {
+	kargoClient    client.Client
+	shardPredicate controller.ResponsibleFor[kargoapi.PromotionPlan]
+
+	cfg ReconcilerConfig
+
+	// The following behaviors are overridable for testing purposes:
+
+	nowFn func() time.Time
+
+	getStageFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error)
+
+	getFreightFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Freight, error)
+
+	getPromotionFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error)
+
+	createPromotionFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+
+	abortPromotionFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+		kargoapi.AbortAction,
+	) error
+}
+
+// SetupReconcilerWithManager initializes a reconciler for PromotionPlan
+// resources and registers it with the provided Manager.
+func SetupReconcilerWithManager(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+	cfg ReconcilerConfig,
+) error {
+	shardPredicate := controller.ResponsibleFor[client.Object]{
+		IsDefaultController: cfg.IsDefaultController,
+		ShardName:           cfg.ShardName,
+	}
+	if err := ctrl.NewControllerManagedBy(kargoMgr).
+		For(
+			&kargoapi.PromotionPlan{},
+			builder.WithPredicates(
+				shardPredicate,
+				intpredicate.IgnoreDelete[client.Object]{},
+				predicate.Or(
+					predicate.GenerationChangedPredicate{},
+					kargo.RefreshRequested{},
+				),
+			),
+		).
+		// Progress of a PromotionPlan is driven by the Promotions it creates,
+		// so any change to one of them enqueues the PromotionPlan.
+		Watches(
+			&kargoapi.Promotion{},
+			handler.EnqueueRequestsFromMapFunc(promotionPlanForPromotion),
+		).
+		WithOptions(controller.CommonOptions(cfg.MaxConcurrentReconciles)).
+		Complete(newReconciler(kargoMgr.GetClient(), cfg)); err != nil {
+		return fmt.Errorf("error building PromotionPlan controller: %w", err)
+	}
+
+	logging.LoggerFromContext(ctx).Info(
+		"Initialized PromotionPlan reconciler",
+		"maxConcurrentReconciles", cfg.MaxConcurrentReconciles,
+	)
+
+	return nil
+}
+
+// promotionPlanForPromotion maps a Promotion to a reconciliation request for
+// the PromotionPlan it was created for, if any.
+func promotionPlanForPromotion(
+	_ context.Context,
+	obj client.Object,
+) []reconcile.Request {
+	plan, ok := obj.GetLabels()[kargoapi.LabelKeyPromotionPlan]
+	if !ok || plan == "" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Namespace: obj.GetNamespace(),
+			Name:      plan,
+		},
+	}}
+}
+
+func newReconciler(kargoClient client.Client, cfg ReconcilerConfig) *reconciler {
+	r := &reconciler{
+		kargoClient: kargoClient,
+		cfg:         cfg,
+		shardPredicate: controller.ResponsibleFor[kargoapi.PromotionPlan]{
+			IsDefaultController: cfg.IsDefaultController,
+			ShardName:           cfg.ShardName,
+		},
+	}
+	r.nowFn = time.Now
+	r.getStageFn = api.GetStage
+	r.getFreightFn = api.GetFreight
+	r.getPromotionFn = api.GetPromotion
+	r.createPromotionFn = kargoClient.Create
+	r.abortPromotionFn = api.AbortPromotion
+	return r
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"namespace", req.Namespace,
+		"promotionPlan", req.Name,
+	)
+	ctx = logging.ContextWithLogger(ctx, logger)
+	logger.Debug("reconciling PromotionPlan")
+
+	plan := &kargoapi.PromotionPlan{}
+	if err := r.kargoClient.Get(ctx, req.NamespacedName, plan); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if plan.Status.Phase.IsTerminal() {
+		return ctrl.Result{}, nil
+	}
+	if !r.shardPredicate.IsResponsible(plan) {
+		logger.Debug("ignoring PromotionPlan because it is not assigned to this shard")
+		return ctrl.Result{}, nil
+	}
+
+	newStatus := plan.Status.DeepCopy()
+	requeueAfter, reconcileErr := r.reconcile(ctx, plan, newStatus)
+
+	if err := kubeclient.PatchStatus(
+		ctx,
+		r.kargoClient,
+		plan,
+		func(status *kargoapi.PromotionPlanStatus) { *status = *newStatus },
+	); err != nil {
+		logger.Error(err, "error updating PromotionPlan status")
+		if reconcileErr == nil {
+			reconcileErr = err
+		}
+	}
+	if reconcileErr != nil {
+		return ctrl.Result{}, reconcileErr
+	}
+
+	logger.Debug("done reconciling PromotionPlan")
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reconcile advances the provided PromotionPlan as far as is currently
+// possible, recording its progress in the provided status. It returns the
+// interval after which the PromotionPlan should be reconciled again, if any.
+func (r *reconciler) reconcile(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	status *kargoapi.PromotionPlanStatus,
+) (time.Duration, error) {
+	if len(status.Waves) == 0 {
+		status.Phase = kargoapi.PromotionPlanPhaseRunning
+		status.CurrentWave = 0
+		status.Waves = make([]kargoapi.PromotionWaveStatus, len(plan.Spec.Waves))
+		for i, wave := range plan.Spec.Waves {
+			status.Waves[i] = kargoapi.PromotionWaveStatus{
+				Name:   wave.Name,
+				Phase:  kargoapi.PromotionWavePhasePending,
+				Stages: make([]kargoapi.PromotionPlanStageStatus, len(wave.Stages)),
+			}
+			for j, stage := range wave.Stages {
+				status.Waves[i].Stages[j].Stage = stage
+			}
+		}
+	}
+
+	if status.Phase == kargoapi.PromotionPlanPhasePaused {
+		token, ok := api.RefreshAnnotationValue(plan.GetAnnotations())
+		if !ok || token == status.LastHandledRefresh {
+			// Nothing to do until a refresh is requested, but Promotions that
+			// were already running when the PromotionPlan paused are still
+			// tracked so their outcomes are visible.
+			_, err := r.syncPromotions(ctx, plan, &status.Waves[status.CurrentWave])
+			return 0, err
+		}
+		status.LastHandledRefresh = token
+		r.resume(status)
+	} else if token, ok := api.RefreshAnnotationValue(plan.GetAnnotations()); ok {
+		status.LastHandledRefresh = token
+	}
+
+	for int(status.CurrentWave) < len(plan.Spec.Waves) {
+		idx := status.CurrentWave
+		requeueAfter, failure, err := r.reconcileWave(
+			ctx, plan, plan.Spec.Waves[idx], &status.Waves[idx],
+		)
+		if err != nil {
+			status.Message = err.Error()
+			return 0, err
+		}
+		if failure != "" {
+			status.Message = failure
+			if plan.Spec.FailurePolicy == kargoapi.PromotionPlanFailurePolicyAbort {
+				r.fail(ctx, plan, status)
+			} else {
+				status.Phase = kargoapi.PromotionPlanPhasePaused
+			}
+			return 0, nil
+		}
+		if status.Waves[idx].Phase != kargoapi.PromotionWavePhaseSucceeded {
+			status.Message = ""
+			return requeueAfter, nil
+		}
+		status.CurrentWave++
+	}
+
+	status.Phase = kargoapi.PromotionPlanPhaseSucceeded
+	status.Message = ""
+	status.FinishedAt = &metav1.Time{Time: r.nowFn()}
+	return 0, nil
+}
+
+// reconcileWave advances the provided wave as far as is currently possible.
+// It returns the interval after which the PromotionPlan should be reconciled
+// again, if any. If one of the wave's Promotions or its health gate failed, a
+// non-empty description of the failure is returned and it is up to the caller
+// to apply the PromotionPlan's failure policy.
+func (r *reconciler) reconcileWave(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	wave kargoapi.PromotionWave,
+	waveStatus *kargoapi.PromotionWaveStatus,
+) (time.Duration, string, error) {
+	now := r.nowFn()
+
+	if waveStatus.Phase == kargoapi.PromotionWavePhasePending {
+		waveStatus.Phase = kargoapi.PromotionWavePhasePromoting
+		waveStatus.StartedAt = &metav1.Time{Time: now}
+	}
+
+	if waveStatus.Phase == kargoapi.PromotionWavePhasePromoting {
+		failed, err := r.syncPromotions(ctx, plan, waveStatus)
+		if err != nil {
+			return 0, "", err
+		}
+		if len(failed) > 0 {
+			return 0, fmt.Sprintf(
+				"Promotion of Freight %q failed for Stage(s) %s",
+				plan.Spec.Freight, strings.Join(failed, ", "),
+			), nil
+		}
+		if err = r.startPromotions(ctx, plan, wave, waveStatus); err != nil {
+			return 0, "", err
+		}
+		for _, stageStatus := range waveStatus.Stages {
+			if stageStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+				// Progress is driven by watching the Promotions.
+				return 0, "", nil
+			}
+		}
+		waveStatus.PromotedAt = &metav1.Time{Time: now}
+		waveStatus.Phase = kargoapi.PromotionWavePhaseGating
+	}
+
+	if waveStatus.Phase == kargoapi.PromotionWavePhaseGating {
+		reason, err := r.checkHealthGate(ctx, plan, wave, waveStatus)
+		if err != nil {
+			return 0, "", err
+		}
+		if reason != "" {
+			gate := wave.HealthGate
+			if gate.Timeout != nil && gate.Timeout.Duration > 0 &&
+				now.Sub(waveStatus.PromotedAt.Time) >= gate.Timeout.Duration {
+				return 0, fmt.Sprintf(
+					"health gate did not pass within %s: %s",
+					gate.Timeout.Duration, reason,
+				), nil
+			}
+			return gateCheckInterval, "", nil
+		}
+		waveStatus.GatePassedAt = &metav1.Time{Time: now}
+		waveStatus.Phase = kargoapi.PromotionWavePhaseSoaking
+	}
+
+	if waveStatus.Phase == kargoapi.PromotionWavePhaseSoaking {
+		if wave.SoakTime != nil {
+			if remaining := waveStatus.GatePassedAt.Add(wave.SoakTime.Duration).Sub(now); remaining > 0 {
+				return remaining, "", nil
+			}
+		}
+		waveStatus.Phase = kargoapi.PromotionWavePhaseSucceeded
+		waveStatus.FinishedAt = &metav1.Time{Time: now}
+	}
+
+	return 0, "", nil
+}
+
+// syncPromotions refreshes the last observed phase of each Promotion that has
+// been created for the provided wave and returns the names of the Stages for
+// which the Promotion did not succeed.
+func (r *reconciler) syncPromotions(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	waveStatus *kargoapi.PromotionWaveStatus,
+) ([]string, error) {
+	var failed []string
+	for i := range waveStatus.Stages {
+		stageStatus := &waveStatus.Stages[i]
+		if stageStatus.Promotion != "" && !stageStatus.Phase.IsTerminal() {
+			promo, err := r.getPromotionFn(ctx, r.kargoClient, types.NamespacedName{
+				Namespace: plan.Namespace,
+				Name:      stageStatus.Promotion,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if promo == nil {
+				// The Promotion was deleted before it finished. Treat this the
+				// same as an aborted Promotion.
+				stageStatus.Phase = kargoapi.PromotionPhaseAborted
+			} else {
+				stageStatus.Phase = promo.Status.Phase
+			}
+		}
+		if stageStatus.Phase.IsTerminal() && stageStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+			failed = append(failed, stageStatus.Stage)
+		}
+	}
+	return failed, nil
+}
+
+// startPromotions creates Promotions for Stages in the provided wave that do
+// not have one yet, without exceeding the wave's maximum concurrency.
+func (r *reconciler) startPromotions(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	wave kargoapi.PromotionWave,
+	waveStatus *kargoapi.PromotionWaveStatus,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	var running int
+	for _, stageStatus := range waveStatus.Stages {
+		if stageStatus.Promotion != "" && !stageStatus.Phase.IsTerminal() {
+			running++
+		}
+	}
+	for i := range waveStatus.Stages {
+		if wave.MaxConcurrency > 0 && running >= int(wave.MaxConcurrency) {
+			return nil
+		}
+		stageStatus := &waveStatus.Stages[i]
+		if stageStatus.Promotion != "" {
+			continue
+		}
+		promo, err := r.findOrCreatePromotion(ctx, plan, stageStatus.Stage)
+		if err != nil {
+			return err
+		}
+		stageStatus.Promotion = promo.Name
+		stageStatus.Phase = promo.Status.Phase
+		if stageStatus.Phase == "" {
+			stageStatus.Phase = kargoapi.PromotionPhasePending
+		}
+		if !stageStatus.Phase.IsTerminal() {
+			running++
+		}
+		logger.Debug(
+			"started Promotion for PromotionPlan",
+			"stage", stageStatus.Stage,
+			"promotion", promo.Name,
+		)
+	}
+	return nil
+}
+
+// findOrCreatePromotion returns a Promotion of the PromotionPlan's Freight to
+// the specified Stage. If the PromotionPlan already created one that has not
+// failed (e.g. because recording it in the PromotionPlan's status did not
+// succeed), it is returned. Otherwise, a new Promotion is created.
+func (r *reconciler) findOrCreatePromotion(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	stageName string,
+) (*kargoapi.Promotion, error) {
+	promos := &kargoapi.PromotionList{}
+	if err := r.kargoClient.List(
+		ctx,
+		promos,
+		client.InNamespace(plan.Namespace),
+		client.MatchingLabels{kargoapi.LabelKeyPromotionPlan: plan.Name},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Promotions for PromotionPlan %q in namespace %q: %w",
+			plan.Name, plan.Namespace, err,
+		)
+	}
+	for _, promo := range promos.Items {
+		if promo.Spec.Stage == stageName &&
+			(!promo.Status.Phase.IsTerminal() || promo.Status.Phase == kargoapi.PromotionPhaseSucceeded) {
+			return &promo, nil
+		}
+	}
+
+	stage, err := r.getStageFn(ctx, r.kargoClient, types.NamespacedName{
+		Namespace: plan.Namespace,
+		Name:      stageName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if stage == nil {
+		// nolint:staticcheck
+		return nil, fmt.Errorf(
+			"Stage %q not found in namespace %q",
+			stageName, plan.Namespace,
+		)
+	}
+	promo, err := kargo.NewPromotionBuilder(r.kargoClient).Build(ctx, *stage, plan.Spec.Freight)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error building Promotion of Freight %q for Stage %q: %w",
+			plan.Spec.Freight, stageName, err,
+		)
+	}
+	if promo.Labels == nil {
+		promo.Labels = make(map[string]string, 1)
+	}
+	promo.Labels[kargoapi.LabelKeyPromotionPlan] = plan.Name
+	if err = r.createPromotionFn(ctx, promo); err != nil {
+		return nil, fmt.Errorf(
+			"error creating Promotion of Freight %q for Stage %q: %w",
+			plan.Spec.Freight, stageName, err,
+		)
+	}
+	return promo, nil
+}
+
+// checkHealthGate evaluates the health gate of the provided wave. It returns
+// a non-empty reason if the gate has not passed.
+func (r *reconciler) checkHealthGate(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	wave kargoapi.PromotionWave,
+	waveStatus *kargoapi.PromotionWaveStatus,
+) (string, error) {
+	gate := wave.HealthGate
+	if gate == nil || (!gate.RequireHealthy && !gate.RequireVerified) {
+		return "", nil
+	}
+	var freight *kargoapi.Freight
+	if gate.RequireVerified {
+		var err error
+		if freight, err = r.getFreightFn(ctx, r.kargoClient, types.NamespacedName{
+			Namespace: plan.Namespace,
+			Name:      plan.Spec.Freight,
+		}); err != nil {
+			return "", err
+		}
+		if freight == nil {
+			return fmt.Sprintf(
+				"Freight %q not found in namespace %q",
+				plan.Spec.Freight, plan.Namespace,
+			), nil
+		}
+	}
+	var reasons []string
+	for _, stageStatus := range waveStatus.Stages {
+		if gate.RequireHealthy {
+			stage, err := r.getStageFn(ctx, r.kargoClient, types.NamespacedName{
+				Namespace: plan.Namespace,
+				Name:      stageStatus.Stage,
+			})
+			if err != nil {
+				return "", err
+			}
+			if stage == nil || stage.Status.Health == nil ||
+				stage.Status.Health.Status != kargoapi.HealthStateHealthy {
+				reasons = append(reasons, fmt.Sprintf("Stage %q is not healthy", stageStatus.Stage))
+				continue
+			}
+		}
+		if gate.RequireVerified && !freight.IsVerifiedIn(stageStatus.Stage) {
+			reasons = append(reasons, fmt.Sprintf(
+				"Freight %q is not verified in Stage %q",
+				plan.Spec.Freight, stageStatus.Stage,
+			))
+		}
+	}
+	return strings.Join(reasons, "; "), nil
+}
+
+// resume prepares a paused PromotionPlan's current wave to be retried. Failed
+// Promotions are forgotten so that new ones are created, and a failed health
+// gate is given a fresh timeout.
+func (r *reconciler) resume(status *kargoapi.PromotionPlanStatus) {
+	waveStatus := &status.Waves[status.CurrentWave]
+	for i := range waveStatus.Stages {
+		stageStatus := &waveStatus.Stages[i]
+		if stageStatus.Phase.IsTerminal() && stageStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+			stageStatus.Promotion = ""
+			stageStatus.Phase = ""
+		}
+	}
+	if waveStatus.Phase == kargoapi.PromotionWavePhaseGating {
+		waveStatus.PromotedAt = &metav1.Time{Time: r.nowFn()}
+	}
+	status.Phase = kargoapi.PromotionPlanPhaseRunning
+	status.Message = ""
+}
+
+// fail marks the PromotionPlan and its current wave as failed and aborts any
+// of the wave's Promotions that are still running.
+func (r *reconciler) fail(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+	status *kargoapi.PromotionPlanStatus,
+) {
+	logger := logging.LoggerFromContext(ctx)
+	now := &metav1.Time{Time: r.nowFn()}
+	waveStatus := &status.Waves[status.CurrentWave]
+	for _, stageStatus := range waveStatus.Stages {
+		if stageStatus.Promotion == "" || stageStatus.Phase.IsTerminal() {
+			continue
+		}
+		if err := r.abortPromotionFn(
+			ctx,
+			r.kargoClient,
+			types.NamespacedName{Namespace: plan.Namespace, Name: stageStatus.Promotion},
+			kargoapi.AbortActionTerminate,
+		); err != nil {
+			logger.Error(
+				err, "error aborting Promotion",
+				"promotion", stageStatus.Promotion,
+			)
+		}
+	}
+	waveStatus.Phase = kargoapi.PromotionWavePhaseFailed
+	waveStatus.FinishedAt = now
+	status.Phase = kargoapi.PromotionPlanPhaseFailed
+	status.FinishedAt = now
+}
@@ -0,0 +1,490 @@
+package promotionplans
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const testProject = "fake-project"
+
+func TestNewReconciler(t *testing.T) {
+	r := newReconciler(fake.NewClientBuilder().Build(), ReconcilerConfig{})
+	require.NotNil(t, r.kargoClient)
+	require.NotNil(t, r.nowFn)
+	require.NotNil(t, r.getStageFn)
+	require.NotNil(t, r.getFreightFn)
+	require.NotNil(t, r.getPromotionFn)
+	require.NotNil(t, r.createPromotionFn)
+	require.NotNil(t, r.abortPromotionFn)
+}
+
+func Test_promotionPlanForPromotion(t *testing.T) {
+	require.Empty(t, promotionPlanForPromotion(context.Background(), &kargoapi.Promotion{}))
+	reqs := promotionPlanForPromotion(
+		context.Background(),
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-promo",
+				Labels:    map[string]string{kargoapi.LabelKeyPromotionPlan: "fake-plan"},
+			},
+		},
+	)
+	require.Len(t, reqs, 1)
+	require.Equal(
+		t,
+		types.NamespacedName{Namespace: testProject, Name: "fake-plan"},
+		reqs[0].NamespacedName,
+	)
+}
+
+func TestReconcile(t *testing.T) {
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		plan       *kargoapi.PromotionPlan
+		objects    []client.Object
+		now        time.Time
+		assertions func(*testing.T, client.Client, ctrl.Result, error)
+	}{
+		{
+			name: "PromotionPlan not found",
+			plan: nil,
+			assertions: func(t *testing.T, _ client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "terminal PromotionPlan is ignored",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Status.Phase = kargoapi.PromotionPlanPhaseSucceeded
+			}),
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Empty(t, listPromotions(t, c))
+			},
+		},
+		{
+			name: "first wave is started within max concurrency",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].Stages = []string{"prod-us", "prod-eu"}
+				p.Spec.Waves[0].MaxConcurrency = 1
+			}),
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				promos := listPromotions(t, c)
+				require.Len(t, promos, 1)
+				require.Equal(t, "prod-us", promos[0].Spec.Stage)
+				require.Equal(t, "fake-freight", promos[0].Spec.Freight)
+				require.Equal(t, "fake-plan", promos[0].Labels[kargoapi.LabelKeyPromotionPlan])
+
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhaseRunning, plan.Status.Phase)
+				require.Equal(t, int32(0), plan.Status.CurrentWave)
+				require.Len(t, plan.Status.Waves, 2)
+				wave := plan.Status.Waves[0]
+				require.Equal(t, kargoapi.PromotionWavePhasePromoting, wave.Phase)
+				require.Equal(t, promos[0].Name, wave.Stages[0].Promotion)
+				require.Equal(t, kargoapi.PromotionPhasePending, wave.Stages[0].Phase)
+				require.Empty(t, wave.Stages[1].Promotion)
+				require.Equal(t, kargoapi.PromotionWavePhasePending, plan.Status.Waves[1].Phase)
+			},
+		},
+		{
+			name: "existing Promotion is adopted",
+			plan: newPlan(),
+			objects: []client.Object{
+				newPromotion("existing", "prod-us", kargoapi.PromotionPhaseRunning),
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Len(t, listPromotions(t, c), 1)
+				plan := getPlan(t, c)
+				require.Equal(t, "existing", plan.Status.Waves[0].Stages[0].Promotion)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, plan.Status.Waves[0].Stages[0].Phase)
+			},
+		},
+		{
+			name: "wave soaks after Promotions succeed",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].SoakTime = &metav1.Duration{Duration: time.Hour}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseRunning)
+			}),
+			objects: []client.Object{
+				newPromotion("promo-1", "prod-us", kargoapi.PromotionPhaseSucceeded),
+			},
+			now: start.Add(time.Minute),
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, time.Hour, res.RequeueAfter)
+				plan := getPlan(t, c)
+				wave := plan.Status.Waves[0]
+				require.Equal(t, kargoapi.PromotionWavePhaseSoaking, wave.Phase)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, wave.Stages[0].Phase)
+				require.NotNil(t, wave.GatePassedAt)
+				require.Equal(t, int32(0), plan.Status.CurrentWave)
+			},
+		},
+		{
+			name: "next wave is started once soak time has elapsed",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].SoakTime = &metav1.Duration{Duration: time.Hour}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseSucceeded)
+				p.Status.Waves[0].Phase = kargoapi.PromotionWavePhaseSoaking
+				p.Status.Waves[0].GatePassedAt = &metav1.Time{Time: start}
+			}),
+			now: start.Add(2 * time.Hour),
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionWavePhaseSucceeded, plan.Status.Waves[0].Phase)
+				require.Equal(t, int32(1), plan.Status.CurrentWave)
+				require.Equal(t, kargoapi.PromotionWavePhasePromoting, plan.Status.Waves[1].Phase)
+				promos := listPromotions(t, c)
+				require.Len(t, promos, 1)
+				require.Equal(t, "prod-ap", promos[0].Spec.Stage)
+			},
+		},
+		{
+			name: "health gate that has not passed is rechecked",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].HealthGate = &kargoapi.PromotionWaveHealthGate{
+					RequireHealthy:  true,
+					RequireVerified: true,
+				}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseSucceeded)
+			}),
+			objects: []client.Object{
+				newFreight(),
+			},
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, gateCheckInterval, res.RequeueAfter)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionWavePhaseGating, plan.Status.Waves[0].Phase)
+				require.Equal(t, kargoapi.PromotionPlanPhaseRunning, plan.Status.Phase)
+			},
+		},
+		{
+			name: "health gate passes",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].HealthGate = &kargoapi.PromotionWaveHealthGate{
+					RequireHealthy:  true,
+					RequireVerified: true,
+				}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseSucceeded)
+			}),
+			objects: []client.Object{
+				newFreight(func(f *kargoapi.Freight) {
+					f.Status.VerifiedIn = map[string]kargoapi.VerifiedStage{"prod-us": {}}
+				}),
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionWavePhaseSucceeded, plan.Status.Waves[0].Phase)
+				require.Equal(t, int32(1), plan.Status.CurrentWave)
+			},
+		},
+		{
+			name: "health gate timeout pauses PromotionPlan",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].HealthGate = &kargoapi.PromotionWaveHealthGate{
+					RequireVerified: true,
+					Timeout:         &metav1.Duration{Duration: 10 * time.Minute},
+				}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseSucceeded)
+				p.Status.Waves[0].Phase = kargoapi.PromotionWavePhaseGating
+				p.Status.Waves[0].PromotedAt = &metav1.Time{Time: start}
+			}),
+			objects: []client.Object{
+				newFreight(),
+			},
+			now: start.Add(time.Hour),
+			assertions: func(t *testing.T, c client.Client, res ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.RequeueAfter)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhasePaused, plan.Status.Phase)
+				require.Contains(t, plan.Status.Message, "health gate did not pass within 10m0s")
+				require.Contains(t, plan.Status.Message, `not verified in Stage "prod-us"`)
+			},
+		},
+		{
+			name: "failed Promotion pauses PromotionPlan",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].Stages = []string{"prod-us", "prod-eu"}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseRunning)
+			}),
+			objects: []client.Object{
+				newPromotion("promo-1", "prod-us", kargoapi.PromotionPhaseFailed),
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhasePaused, plan.Status.Phase)
+				require.Equal(
+					t,
+					`Promotion of Freight "fake-freight" failed for Stage(s) prod-us`,
+					plan.Status.Message,
+				)
+				// No further Promotions are started while paused
+				require.Len(t, listPromotions(t, c), 1)
+			},
+		},
+		{
+			name: "paused PromotionPlan waits for refresh",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseFailed)
+				p.Status.Phase = kargoapi.PromotionPlanPhasePaused
+				p.Status.Message = "something failed"
+			}),
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhasePaused, plan.Status.Phase)
+				require.Equal(t, "something failed", plan.Status.Message)
+				require.Empty(t, listPromotions(t, c))
+			},
+		},
+		{
+			name: "refresh resumes paused PromotionPlan",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Annotations = map[string]string{kargoapi.AnnotationKeyRefresh: "token"}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseFailed)
+				p.Status.Phase = kargoapi.PromotionPlanPhasePaused
+				p.Status.Message = "something failed"
+			}),
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhaseRunning, plan.Status.Phase)
+				require.Empty(t, plan.Status.Message)
+				require.Equal(t, "token", plan.Status.LastHandledRefresh)
+				promos := listPromotions(t, c)
+				require.Len(t, promos, 1)
+				require.Equal(t, promos[0].Name, plan.Status.Waves[0].Stages[0].Promotion)
+				require.NotEqual(t, "promo-1", promos[0].Name)
+			},
+		},
+		{
+			name: "failed Promotion fails PromotionPlan with abort policy",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.FailurePolicy = kargoapi.PromotionPlanFailurePolicyAbort
+				p.Spec.Waves[0].Stages = []string{"prod-us", "prod-eu"}
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseRunning)
+				p.Status.Waves[0].Stages[1].Promotion = "promo-2"
+				p.Status.Waves[0].Stages[1].Phase = kargoapi.PromotionPhaseRunning
+			}),
+			objects: []client.Object{
+				newPromotion("promo-1", "prod-us", kargoapi.PromotionPhaseErrored),
+				newPromotion("promo-2", "prod-eu", kargoapi.PromotionPhaseRunning),
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhaseFailed, plan.Status.Phase)
+				require.NotNil(t, plan.Status.FinishedAt)
+				require.Equal(t, kargoapi.PromotionWavePhaseFailed, plan.Status.Waves[0].Phase)
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testProject, Name: "promo-2"},
+					promo,
+				))
+				require.Contains(t, promo.Annotations, kargoapi.AnnotationKeyAbort)
+			},
+		},
+		{
+			name: "PromotionPlan succeeds after last wave",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Status = runningStatus(p, "promo-1", kargoapi.PromotionPhaseSucceeded)
+				p.Status.Waves[0].Phase = kargoapi.PromotionWavePhaseSucceeded
+				p.Status.Waves[1].Phase = kargoapi.PromotionWavePhasePromoting
+				p.Status.Waves[1].Stages[0].Promotion = "promo-2"
+				p.Status.Waves[1].Stages[0].Phase = kargoapi.PromotionPhaseRunning
+				p.Status.CurrentWave = 1
+			}),
+			objects: []client.Object{
+				newPromotion("promo-2", "prod-ap", kargoapi.PromotionPhaseSucceeded),
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+				plan := getPlan(t, c)
+				require.Equal(t, kargoapi.PromotionPlanPhaseSucceeded, plan.Status.Phase)
+				require.Equal(t, int32(2), plan.Status.CurrentWave)
+				require.NotNil(t, plan.Status.FinishedAt)
+			},
+		},
+		{
+			name: "missing Stage is reported",
+			plan: newPlan(func(p *kargoapi.PromotionPlan) {
+				p.Spec.Waves[0].Stages = []string{"bogus"}
+			}),
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.ErrorContains(t, err, `Stage "bogus" not found`)
+				plan := getPlan(t, c)
+				require.Contains(t, plan.Status.Message, `Stage "bogus" not found`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := k8sruntime.NewScheme()
+			require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+			objects := append(
+				[]client.Object{
+					newStage("prod-us"),
+					newStage("prod-eu"),
+					newStage("prod-ap"),
+				},
+				testCase.objects...,
+			)
+			if testCase.plan != nil {
+				objects = append(objects, testCase.plan)
+			}
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(&kargoapi.PromotionPlan{}, &kargoapi.Promotion{}).
+				Build()
+			r := newReconciler(c, ReconcilerConfig{IsDefaultController: true})
+			now := testCase.now
+			if now.IsZero() {
+				now = start
+			}
+			r.nowFn = func() time.Time { return now }
+			res, err := r.Reconcile(
+				context.Background(),
+				ctrl.Request{NamespacedName: types.NamespacedName{
+					Namespace: testProject,
+					Name:      "fake-plan",
+				}},
+			)
+			testCase.assertions(t, c, res, err)
+		})
+	}
+}
+
+func newPlan(mutators ...func(*kargoapi.PromotionPlan)) *kargoapi.PromotionPlan {
+	plan := &kargoapi.PromotionPlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-plan",
+		},
+		Spec: kargoapi.PromotionPlanSpec{
+			Freight: "fake-freight",
+			Waves: []kargoapi.PromotionWave{
+				{Name: "first", Stages: []string{"prod-us"}},
+				{Name: "second", Stages: []string{"prod-ap"}},
+			},
+			FailurePolicy: kargoapi.PromotionPlanFailurePolicyPause,
+		},
+	}
+	for _, mutate := range mutators {
+		mutate(plan)
+	}
+	return plan
+}
+
+// runningStatus returns the status of a running PromotionPlan whose first
+// wave's first Stage is being promoted by the specified Promotion.
+func runningStatus(
+	plan *kargoapi.PromotionPlan,
+	promo string,
+	phase kargoapi.PromotionPhase,
+) kargoapi.PromotionPlanStatus {
+	status := kargoapi.PromotionPlanStatus{
+		Phase: kargoapi.PromotionPlanPhaseRunning,
+		Waves: make([]kargoapi.PromotionWaveStatus, len(plan.Spec.Waves)),
+	}
+	for i, wave := range plan.Spec.Waves {
+		status.Waves[i].Name = wave.Name
+		status.Waves[i].Phase = kargoapi.PromotionWavePhasePending
+		for _, stage := range wave.Stages {
+			status.Waves[i].Stages = append(
+				status.Waves[i].Stages,
+				kargoapi.PromotionPlanStageStatus{Stage: stage},
+			)
+		}
+	}
+	status.Waves[0].Phase = kargoapi.PromotionWavePhasePromoting
+	status.Waves[0].Stages[0].Promotion = promo
+	status.Waves[0].Stages[0].Phase = phase
+	return status
+}
+
+func newStage(name string) *kargoapi.Stage {
+	return &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      name,
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionTemplate: &kargoapi.PromotionTemplate{
+				Spec: kargoapi.PromotionTemplateSpec{
+					Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+				},
+			},
+		},
+		Status: kargoapi.StageStatus{
+			Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+		},
+	}
+}
+
+func newPromotion(name, stage string, phase kargoapi.PromotionPhase) *kargoapi.Promotion {
+	return &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      name,
+			Labels:    map[string]string{kargoapi.LabelKeyPromotionPlan: "fake-plan"},
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   stage,
+			Freight: "fake-freight",
+		},
+		Status: kargoapi.PromotionStatus{Phase: phase},
+	}
+}
+
+func newFreight(mutators ...func(*kargoapi.Freight)) *kargoapi.Freight {
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-freight",
+		},
+	}
+	for _, mutate := range mutators {
+		mutate(freight)
+	}
+	return freight
+}
+
+func getPlan(t *testing.T, c client.Client) *kargoapi.PromotionPlan {
+	plan := &kargoapi.PromotionPlan{}
+	require.NoError(t, c.Get(
+		context.Background(),
+		types.NamespacedName{Namespace: testProject, Name: "fake-plan"},
+		plan,
+	))
+	return plan
+}
+
+func listPromotions(t *testing.T, c client.Client) []kargoapi.Promotion {
+	promos := &kargoapi.PromotionList{}
+	require.NoError(t, c.List(context.Background(), promos, client.InNamespace(testProject)))
+	return promos.Items
+}
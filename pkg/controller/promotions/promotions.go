@@ -23,7 +23,10 @@ import (
 	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/controller"
 	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
+	"github.com/akuity/kargo/pkg/event/commitstatus"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/kargo"
@@ -32,6 +35,7 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/calendar"
 )
 
 // ReconcilerConfig represents configuration for the promotion reconciler.
@@ -75,6 +79,12 @@ type reconciler struct {
 		types.NamespacedName,
 	) (*kargoapi.Stage, error)
 
+	refreshStageFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error)
+
 	promoteFn func(
 		context.Context,
 		kargoapi.Promotion,
@@ -88,6 +98,24 @@ type reconciler struct {
 		*kargoapi.Promotion,
 		*kargoapi.Freight,
 	) error
+
+	abortPromotionStepsFn func(
+		context.Context,
+		*kargoapi.AbortPromotionRequest,
+		*kargoapi.Promotion,
+	) error
+
+	reportStartedFn func(
+		context.Context,
+		*kargoapi.Promotion,
+		*kargoapi.Freight,
+	) error
+
+	checkPromotionCalendarFn func(
+		context.Context,
+		*kargoapi.Stage,
+		time.Time,
+	) (string, error)
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -97,6 +125,8 @@ func SetupReconcilerWithManager(
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
 	promoEngine promotion.Engine,
+	credentialsDB credentials.Database,
+	cloudEventsSender *cloudevents.Sender,
 	cfg ReconcilerConfig,
 ) error {
 	// Index running Promotions by Argo CD Applications
@@ -114,14 +144,33 @@ func SetupReconcilerWithManager(
 		return fmt.Errorf("index running Promotions by Argo CD Applications: %w", err)
 	}
 
-	reconciler := newReconciler(
+	// The start and outcomes of Promotions are also reported to Git providers
+	// as commit statuses for Projects that are configured for it. This happens
+	// here, rather than anywhere else that events are sent, because each
+	// Promotion is reconciled by exactly one controller.
+	commitStatusSender := commitstatus.NewSender(
+		ctx,
 		kargoMgr.GetClient(),
-		k8sevent.NewEventSender(
-			libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
+		credentialsDB,
+		cfg.APIServerBaseURL,
+	)
+	sender := cloudevents.WithSink(
+		event.NewMultiSender(
+			k8sevent.NewEventSender(
+				libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
+			),
+			commitStatusSender,
 		),
+		cloudEventsSender,
+	)
+
+	reconciler := newReconciler(
+		kargoMgr.GetClient(),
+		sender,
 		promoEngine,
 		cfg,
 	)
+	reconciler.reportStartedFn = commitStatusSender.ReportStarted
 
 	c, err := ctrl.NewControllerManagedBy(kargoMgr).
 		For(&kargoapi.Promotion{}).
@@ -203,8 +252,18 @@ func newReconciler(
 		},
 	}
 	r.getStageFn = api.GetStage
+	r.refreshStageFn = api.RefreshStage
 	r.promoteFn = r.promote
 	r.terminatePromotionFn = r.terminatePromotion
+	r.abortPromotionStepsFn = r.abortPromotionSteps
+	r.checkPromotionCalendarFn = r.checkPromotionCalendar
+	r.reportStartedFn = func(
+		context.Context,
+		*kargoapi.Promotion,
+		*kargoapi.Freight,
+	) error {
+		return nil
+	}
 	return r
 }
 
@@ -262,10 +321,21 @@ func (r *reconciler) Reconcile(
 	if req, ok := api.AbortPromotionAnnotationValue(
 		promo.GetAnnotations(),
 	); ok && req.Action == kargoapi.AbortActionTerminate {
-		if err = r.terminatePromotionFn(ctx, req, promo, freight); err != nil {
-			return ctrl.Result{}, err
+		if promo.Status.Phase != kargoapi.PromotionPhaseRunning ||
+			(len(promo.Spec.OnFailure) == 0 && len(promo.Spec.Finally) == 0) {
+			if err = r.terminatePromotionFn(ctx, req, promo, freight); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+		// A running Promotion with onFailure or finally steps is not
+		// terminated right away. Instead, its main steps are aborted, after
+		// which it continues to execute its onFailure and finally steps.
+		if promo.Status.OnFailure == nil && promo.Status.Finally == nil {
+			if err = r.abortPromotionStepsFn(ctx, req, promo); err != nil {
+				return ctrl.Result{}, err
+			}
 		}
-		return ctrl.Result{}, nil
 	}
 
 	// If the Promotion does not have a Phase, it must be new and (initially)
@@ -278,6 +348,17 @@ func (r *reconciler) Reconcile(
 		}
 	}
 
+	// If the Promotion is scheduled to start at a later time, it remains
+	// pending until then. The Stage will not consider it before that time
+	// either, so there is nothing else to do but to come back when it is due.
+	if now := time.Now(); promo.IsScheduledAfter(now) {
+		logger.Debug(
+			"Promotion is scheduled for a later time",
+			"notBefore", promo.Spec.NotBefore.Time,
+		)
+		return ctrl.Result{RequeueAfter: promo.Spec.NotBefore.Sub(now)}, nil
+	}
+
 	// Retrieve the Stage associated with the Promotion.
 	stage, err := r.getStageFn(
 		ctx,
@@ -300,6 +381,34 @@ func (r *reconciler) Reconcile(
 		)
 	}
 
+	// A scheduled Promotion was checked against any PromotionCalendar
+	// applicable to the Stage when it was created, but the calendar may have
+	// changed since then. Unless the calendar was explicitly overridden, the
+	// Promotion remains pending for as long as the calendar does not allow it
+	// to start.
+	if promo.Spec.NotBefore != nil &&
+		promo.Status.Phase == kargoapi.PromotionPhasePending &&
+		promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverride] == "" {
+		reason, err := r.checkPromotionCalendarFn(ctx, stage, time.Now())
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf(
+				"error checking PromotionCalendar for Stage %q in namespace %q: %w",
+				stage.Name, stage.Namespace, err,
+			)
+		}
+		if reason != "" || promo.Status.Message != "" {
+			if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+				status.Message = reason
+			}); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		if reason != "" {
+			logger.Debug("Promotion is blocked by PromotionCalendar", "reason", reason)
+			return ctrl.Result{RequeueAfter: calendarRecheckInterval}, nil
+		}
+	}
+
 	// Confirm that the Stage is awaiting this Promotion.
 	// This effectively prevents the Promotion from running until the Stage
 	// decides it is the next Promotion to run.
@@ -307,6 +416,21 @@ func (r *reconciler) Reconcile(
 		// The watch on the Stage will requeue the Promotion if the Stage
 		// acknowledges it.
 		logger.Debug("Stage is not awaiting Promotion", "stage", stage.Name, "promotion", promo.Name)
+		// A scheduled Promotion that has just become due may otherwise have to
+		// wait for the Stage's next periodic reconciliation before it is
+		// acknowledged. If the Stage is idle, nudge it to reconsider its
+		// Promotions now.
+		if promo.Spec.NotBefore != nil && stage.Status.CurrentPromotion == nil {
+			if _, err = r.refreshStageFn(ctx, r.kargoClient, types.NamespacedName{
+				Namespace: stage.Namespace,
+				Name:      stage.Name,
+			}); err != nil {
+				return ctrl.Result{}, fmt.Errorf(
+					"error refreshing Stage %q in namespace %q: %w",
+					stage.Name, stage.Namespace, err,
+				)
+			}
+		}
 		return ctrl.Result{}, nil
 	}
 
@@ -320,6 +444,11 @@ func (r *reconciler) Reconcile(
 			return ctrl.Result{}, err
 		}
 		logger.Info("began promotion")
+		if err = r.reportStartedFn(ctx, promo, freight); err != nil {
+			// Reporting the start of a Promotion is best-effort and must not
+			// prevent the Promotion from proceeding.
+			logger.Error(err, "error reporting start of Promotion")
+		}
 	} else {
 		logger.Debug("continuing Promotion")
 	}
@@ -445,6 +574,19 @@ func (r *reconciler) Reconcile(
 				promo,
 				freight,
 			)
+		case kargoapi.PromotionPhaseAborted:
+			// The Promotion was aborted before its onFailure and finally steps
+			// were executed. As with a Promotion that is terminated right
+			// away, the actor is the one that requested the abort.
+			if req, ok := api.AbortPromotionAnnotationValue(promo.GetAnnotations()); ok && req.Actor != "" {
+				actor = req.Actor
+			}
+			evt = event.NewPromotionAborted(
+				msg,
+				actor,
+				promo,
+				freight,
+			)
 		}
 
 		if sendErr := r.sender.Send(ctx, evt); sendErr != nil {
@@ -499,11 +641,13 @@ func (r *reconciler) promote(
 	logger = logger.WithValues("targetFreight", targetFreight.Name)
 
 	targetFreightRef := kargoapi.FreightReference{
-		Name:    targetFreight.Name,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
-		Origin:  targetFreight.Origin,
+		Name:      targetFreight.Name,
+		Commits:   targetFreight.Commits,
+		Images:    targetFreight.Images,
+		Charts:    targetFreight.Charts,
+		Artifacts: targetFreight.Artifacts,
+		Objects:   targetFreight.Objects,
+		Origin:    targetFreight.Origin,
 	}
 
 	// Make a deep copy of the Promotion to pass to the promotion steps execution
@@ -530,9 +674,25 @@ func (r *reconciler) promote(
 		// process again from the beginning, but we DON'T clear shared state. This
 		// allows individual steps to self-discover that they've run before and
 		// examine the results of their own previous execution.
-		promoCtx.StartFromStep = 0
-		promoCtx.StepExecutionMetadata = nil
-		workingPromo.Status.HealthChecks = nil
+		//
+		// If the onFailure or finally steps were already being executed, the
+		// main steps are not executed again, as they may have been aborted.
+		// Only the execution of the onFailure and finally steps starts over.
+		switch {
+		case promoCtx.OnFailure != nil:
+			promoCtx.OnFailure = &kargoapi.PromotionStepSectionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+			}
+			promoCtx.Finally = nil
+		case promoCtx.Finally != nil:
+			promoCtx.Finally = &kargoapi.PromotionStepSectionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+			}
+		default:
+			promoCtx.StartFromStep = 0
+			promoCtx.StepExecutionMetadata = nil
+			workingPromo.Status.HealthChecks = nil
+		}
 	} else if !os.IsExist(err) {
 		return nil, nil, fmt.Errorf("error creating working directory: %w", err)
 	}
@@ -550,6 +710,8 @@ func (r *reconciler) promote(
 	workingPromo.Status.CurrentStep = res.CurrentStep
 	workingPromo.Status.StepExecutionMetadata = res.StepExecutionMetadata
 	workingPromo.Status.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
+	workingPromo.Status.OnFailure = res.OnFailure
+	workingPromo.Status.Finally = res.Finally
 	for _, step := range res.HealthChecks {
 		workingPromo.Status.HealthChecks = append(
 			workingPromo.Status.HealthChecks,
@@ -674,6 +836,14 @@ func (r *reconciler) terminatePromotion(
 	}
 	newStatus.FinishedAt = now
 
+	// If the steps of the Promotion are executing asynchronously (e.g. in a
+	// Pod), stop them before recording the Promotion as aborted.
+	if canceler, ok := r.promoEngine.(promotion.Canceler); ok {
+		if err := canceler.Cancel(ctx, promo.Namespace, promo.Name); err != nil {
+			return fmt.Errorf("error canceling execution of Promotion: %w", err)
+		}
+	}
+
 	if err := kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 		*status = *newStatus
 	}); err != nil {
@@ -689,8 +859,94 @@ func (r *reconciler) terminatePromotion(
 	return nil
 }
 
+// abortPromotionSteps aborts the main steps of the given running Promotion
+// without terminating it, so that its onFailure and finally steps can still be
+// executed. Any main steps that are running are marked as aborted. If none
+// are, the next main step is recorded as aborted instead. The execution of the
+// onFailure steps, or of the finally steps if there are no onFailure steps, is
+// then marked as running, which is what prevents the main steps from being
+// executed any further.
+func (r *reconciler) abortPromotionSteps(
+	ctx context.Context,
+	req *kargoapi.AbortPromotionRequest,
+	promo *kargoapi.Promotion,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	logger.Info("aborting Promotion steps")
+
+	msg := "Promotion terminated per user request"
+	if req.Actor != "" {
+		msg = fmt.Sprintf("Promotion terminated by %s", req.Actor)
+	}
+
+	newStatus := promo.Status.DeepCopy()
+
+	now := &metav1.Time{Time: time.Now()}
+
+	var aborted bool
+	for i := range newStatus.StepExecutionMetadata {
+		meta := &newStatus.StepExecutionMetadata[i]
+		if meta.StartedAt != nil && meta.FinishedAt == nil {
+			meta.Status = kargoapi.PromotionStepStatusAborted
+			meta.Message = msg
+			meta.FinishedAt = now
+			aborted = true
+		}
+	}
+	if next := len(newStatus.StepExecutionMetadata); !aborted && next < len(promo.Spec.Steps) {
+		newStatus.StepExecutionMetadata = append(
+			newStatus.StepExecutionMetadata,
+			kargoapi.StepExecutionMetadata{
+				Alias:      promo.Spec.Steps[next].As,
+				Status:     kargoapi.PromotionStepStatusAborted,
+				Message:    msg,
+				FinishedAt: now,
+			},
+		)
+		newStatus.CurrentStep = int64(next)
+	}
+
+	section := &kargoapi.PromotionStepSectionStatus{Phase: kargoapi.PromotionPhaseRunning}
+	if len(promo.Spec.OnFailure) > 0 {
+		newStatus.OnFailure = section
+	} else {
+		newStatus.Finally = section
+	}
+
+	// If the steps of the Promotion are executing asynchronously (e.g. in a
+	// Pod), stop them before executing the onFailure and finally steps.
+	if canceler, ok := r.promoEngine.(promotion.Canceler); ok {
+		if err := canceler.Cancel(ctx, promo.Namespace, promo.Name); err != nil {
+			return fmt.Errorf("error canceling execution of Promotion: %w", err)
+		}
+	}
+
+	return kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+		*status = *newStatus
+	})
+}
+
+// checkPromotionCalendar returns a non-empty reason if the PromotionCalendars
+// applicable to the provided Stage do not allow promotions at the provided
+// time.
+func (r *reconciler) checkPromotionCalendar(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	at time.Time,
+) (string, error) {
+	cal, err := calendar.ForStage(ctx, r.kargoClient, stage)
+	if err != nil {
+		return "", err
+	}
+	return calendar.Check(cal, at)
+}
+
 var defaultRequeueInterval = 5 * time.Minute
 
+// calendarRecheckInterval is how often a scheduled Promotion that is blocked
+// by a PromotionCalendar is checked against the calendar again.
+var calendarRecheckInterval = time.Minute
+
 func calculateRequeueInterval(
 	ctx context.Context,
 	p *kargoapi.Promotion,
@@ -701,12 +957,24 @@ func calculateRequeueInterval(
 		requeueInterval = *suggestedRequeueInterval
 	}
 
+	// If the onFailure or finally steps are being executed, the current step
+	// is one of those.
+	steps, currentStep, stepExecMetas := p.Spec.Steps, p.Status.CurrentStep, p.Status.StepExecutionMetadata
+	switch {
+	case p.Status.Finally != nil:
+		steps, currentStep, stepExecMetas = p.Spec.Finally, p.Status.Finally.CurrentStep,
+			p.Status.Finally.StepExecutionMetadata
+	case p.Status.OnFailure != nil:
+		steps, currentStep, stepExecMetas = p.Spec.OnFailure, p.Status.OnFailure.CurrentStep,
+			p.Status.OnFailure.StepExecutionMetadata
+	}
+
 	// Ensure we have a step for the current step index.
-	if int(p.Status.CurrentStep) >= len(p.Spec.Steps) {
+	if int(currentStep) >= len(steps) {
 		return requeueInterval
 	}
 
-	step := p.Spec.Steps[p.Status.CurrentStep]
+	step := steps[currentStep]
 	reg, err := promotion.DefaultStepRunnerRegistry.Get(step.Uses)
 	if err != nil {
 		logging.LoggerFromContext(ctx).Error(err, err.Error())
@@ -720,11 +988,11 @@ func calculateRequeueInterval(
 	}
 
 	// Ensure we have an execution metadata entry for the current step.
-	if int(p.Status.CurrentStep) >= len(p.Status.StepExecutionMetadata) {
+	if int(currentStep) >= len(stepExecMetas) {
 		return requeueInterval
 	}
 
-	md := p.Status.StepExecutionMetadata[p.Status.CurrentStep]
+	md := stepExecMetas[currentStep]
 	targetTimeout := md.StartedAt.Add(timeout)
 	if targetTimeout.Before(time.Now().Add(requeueInterval)) {
 		return time.Until(targetTimeout)
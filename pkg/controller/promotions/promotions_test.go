@@ -39,7 +39,10 @@ func TestNewPromotionReconciler(t *testing.T) {
 	require.NotNil(t, r.sender)
 	require.NotNil(t, r.promoEngine)
 	require.NotNil(t, r.getStageFn)
+	require.NotNil(t, r.refreshStageFn)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.reportStartedFn)
+	require.NotNil(t, r.checkPromotionCalendarFn)
 }
 
 func newFakeReconciler(
@@ -75,6 +78,8 @@ func TestReconcile(t *testing.T) {
 		expectedPhase           kargoapi.PromotionPhase
 		expectedEventRecorded   bool
 		expectedEventType       kargoapi.EventType
+		expectStageRefreshed    bool
+		expectedMessage         string
 	}{
 		{
 			name:                  "normal reconcile",
@@ -220,6 +225,146 @@ func TestReconcile(t *testing.T) {
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
 		},
+		{
+			name:                  "scheduled promo not yet due",
+			expectPromoteFnCalled: false,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() *kargoapi.Promotion {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", "", now)
+					p.Spec.NotBefore = &metav1.Time{Time: time.Now().Add(time.Hour)}
+					return p
+				}(),
+			},
+		},
+		{
+			name:                  "scheduled promo due and stage idle",
+			expectPromoteFnCalled: false,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			expectStageRefreshed:  true,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+				},
+				func() *kargoapi.Promotion {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					p.Spec.NotBefore = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+					return p
+				}(),
+			},
+		},
+		{
+			name:                  "scheduled promo due and stage awaiting it",
+			expectPromoteFnCalled: true,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: true,
+			expectedEventType:     kargoapi.EventTypePromotionSucceeded,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() *kargoapi.Promotion {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					p.Spec.NotBefore = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+					return p
+				}(),
+			},
+		},
+		{
+			name:                  "scheduled promo due but frozen",
+			expectPromoteFnCalled: false,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			expectedMessage:       "holiday freeze",
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionCalendar: &kargoapi.PromotionCalendar{
+							Freezes: []kargoapi.PromotionFreeze{{
+								Reason: "holiday freeze",
+								End:    metav1.Time{Time: time.Now().Add(time.Hour)},
+							}},
+						},
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() *kargoapi.Promotion {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					p.Spec.NotBefore = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+					return p
+				}(),
+			},
+		},
+		{
+			name:                  "scheduled promo due and frozen but overridden",
+			expectPromoteFnCalled: true,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: true,
+			expectedEventType:     kargoapi.EventTypePromotionSucceeded,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionCalendar: &kargoapi.PromotionCalendar{
+							Freezes: []kargoapi.PromotionFreeze{{
+								Reason: "holiday freeze",
+								End:    metav1.Time{Time: time.Now().Add(time.Hour)},
+							}},
+						},
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() *kargoapi.Promotion {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					p.Spec.NotBefore = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+					p.Annotations = map[string]string{
+						kargoapi.AnnotationKeyPromotionCalendarOverride: "Hotfix for incident 123",
+					}
+					return p
+				}(),
+			},
+		},
 		{
 			name:                  "promoteFn panics",
 			expectPromoteFnCalled: true,
@@ -298,6 +443,53 @@ func TestReconcile(t *testing.T) {
 			expectPromoteFnCalled:   false,
 			expectTerminateFnCalled: true,
 		},
+		{
+			name: "executes finally steps of running promotion on termination request",
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() *kargoapi.Promotion {
+					p := newPromo(
+						"fake-namespace",
+						"fake-promo",
+						"fake-stage",
+						kargoapi.PromotionPhaseRunning,
+						now,
+					)
+					p.Annotations = map[string]string{
+						kargoapi.AnnotationKeyAbort: string(kargoapi.AbortActionTerminate),
+					}
+					p.Spec.Steps = []kargoapi.PromotionStep{{As: "step-1", Uses: "fake-step"}}
+					p.Spec.Finally = []kargoapi.PromotionStep{{As: "finally-step-1", Uses: "fake-step"}}
+					return p
+				}(),
+			},
+			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			promoteFn: func(
+				_ context.Context,
+				p kargoapi.Promotion,
+				_ *kargoapi.Freight,
+			) (*kargoapi.PromotionStatus, *time.Duration, error) {
+				if p.Status.Finally == nil {
+					return nil, nil, errors.New("expected finally steps to be started")
+				}
+				status := p.Status.DeepCopy()
+				status.Phase = kargoapi.PromotionPhaseAborted
+				return status, nil, nil
+			},
+			expectPromoteFnCalled:   true,
+			expectTerminateFnCalled: false,
+			expectedPhase:           kargoapi.PromotionPhaseAborted,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -360,12 +552,24 @@ func TestReconcile(t *testing.T) {
 				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+				require.Contains(t, updatedPromo.Status.Message, tc.expectedMessage)
 				if tc.expectedEventRecorded {
 					require.Len(t, recorder.Events, 1)
 					event := <-recorder.Events
 					require.Equal(t, tc.expectedEventType, kargoapi.EventType(event.Reason))
 				}
 			}
+
+			if tc.expectStageRefreshed {
+				var stage kargoapi.Stage
+				err = r.kargoClient.Get(
+					ctx,
+					types.NamespacedName{Namespace: req.Namespace, Name: "fake-stage"},
+					&stage,
+				)
+				require.NoError(t, err)
+				require.Contains(t, stage.Annotations, kargoapi.AnnotationKeyRefresh)
+			}
 		})
 	}
 }
@@ -531,6 +735,129 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 	}
 }
 
+func Test_reconciler_abortPromotionSteps(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+
+	newRunningPromo := func(metas ...kargoapi.StepExecutionMetadata) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				CreationTimestamp: now,
+				Name:              "fake-promo",
+				Namespace:         "fake-namespace",
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage: "fake-stage",
+				Steps: []kargoapi.PromotionStep{
+					{As: "step-1", Uses: "fake-step"},
+					{As: "step-2", Uses: "fake-step"},
+				},
+				Finally: []kargoapi.PromotionStep{
+					{As: "finally-step-1", Uses: "fake-step"},
+				},
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase:                 kargoapi.PromotionPhaseRunning,
+				StepExecutionMetadata: metas,
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		req        kargoapi.AbortPromotionRequest
+		promo      *kargoapi.Promotion
+		assertions func(*testing.T, *kargoapi.Promotion, error)
+	}{
+		{
+			name: "aborts running step",
+			req: kargoapi.AbortPromotionRequest{
+				Actor: "fake-actor",
+			},
+			promo: newRunningPromo(kargoapi.StepExecutionMetadata{
+				Alias:     "step-1",
+				StartedAt: &now,
+				Status:    kargoapi.PromotionStepStatusRunning,
+			}),
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				// The Promotion itself keeps running to execute the finally steps.
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Phase)
+
+				require.Len(t, promo.Status.StepExecutionMetadata, 1)
+				meta := promo.Status.StepExecutionMetadata[0]
+				require.Equal(t, kargoapi.PromotionStepStatusAborted, meta.Status)
+				require.Equal(t, "Promotion terminated by fake-actor", meta.Message)
+				require.NotNil(t, meta.FinishedAt)
+
+				require.Nil(t, promo.Status.OnFailure)
+				require.NotNil(t, promo.Status.Finally)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.Finally.Phase)
+			},
+		},
+		{
+			name: "aborts next step",
+			promo: newRunningPromo(kargoapi.StepExecutionMetadata{
+				Alias:      "step-1",
+				StartedAt:  &now,
+				FinishedAt: &now,
+				Status:     kargoapi.PromotionStepStatusSucceeded,
+			}),
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+
+				require.Len(t, promo.Status.StepExecutionMetadata, 2)
+				require.Equal(
+					t,
+					kargoapi.PromotionStepStatusSucceeded,
+					promo.Status.StepExecutionMetadata[0].Status,
+				)
+				meta := promo.Status.StepExecutionMetadata[1]
+				require.Equal(t, "step-2", meta.Alias)
+				require.Equal(t, kargoapi.PromotionStepStatusAborted, meta.Status)
+				require.Equal(t, "Promotion terminated per user request", meta.Message)
+				require.Equal(t, int64(1), promo.Status.CurrentStep)
+
+				require.NotNil(t, promo.Status.Finally)
+			},
+		},
+		{
+			name: "starts onFailure steps before finally steps",
+			promo: func() *kargoapi.Promotion {
+				p := newRunningPromo()
+				p.Spec.OnFailure = []kargoapi.PromotionStep{
+					{As: "on-failure-step-1", Uses: "fake-step"},
+				}
+				return p
+			}(),
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, promo.Status.OnFailure)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, promo.Status.OnFailure.Phase)
+				require.Nil(t, promo.Status.Finally)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.promo).
+				WithStatusSubresource(&kargoapi.Promotion{}).
+				Build()
+
+			r := &reconciler{
+				kargoClient: c,
+				sender:      k8sevent.NewEventSender(fakeevent.NewEventRecorder(1)),
+			}
+
+			req := tt.req
+			err := r.abortPromotionSteps(context.Background(), &req, tt.promo)
+			tt.assertions(t, tt.promo, err)
+		})
+	}
+}
+
 func Test_calculateRequeueInterval(t *testing.T) {
 	testStepKindWithoutTimeout := "fake-step-without-timeout"
 	promotion.DefaultStepRunnerRegistry.MustRegister(
@@ -698,6 +1025,39 @@ func Test_calculateRequeueInterval(t *testing.T) {
 				require.Greater(t, requeueInterval, time.Duration(0))
 			},
 		},
+		{
+			name: "finally step is current step",
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{
+						Uses: testStepKindWithTimeout,
+					}},
+					Finally: []kargoapi.PromotionStep{{
+						Uses: testStepKindWithoutTimeout,
+					}},
+				},
+				Status: kargoapi.PromotionStatus{
+					CurrentStep: 0,
+					StepExecutionMetadata: []kargoapi.StepExecutionMetadata{{
+						// The main step would have timed out long ago, but it is
+						// no longer the current step.
+						StartedAt: &metav1.Time{
+							Time: metav1.Now().Add(-2 * testTimeout),
+						},
+					}},
+					Finally: &kargoapi.PromotionStepSectionStatus{
+						Phase:       kargoapi.PromotionPhaseRunning,
+						CurrentStep: 0,
+						StepExecutionMetadata: []kargoapi.StepExecutionMetadata{{
+							StartedAt: &metav1.Time{Time: time.Now()},
+						}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, requeueInterval time.Duration) {
+				require.Equal(t, defaultRequeueInterval, requeueInterval)
+			},
+		},
 		{
 			name: "timeout would occur after default interval elapses",
 			promo: &kargoapi.Promotion{
@@ -20,6 +20,7 @@ import (
 	"github.com/akuity/kargo/pkg/conditions"
 	"github.com/akuity/kargo/pkg/controller"
 	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/kargo"
@@ -59,11 +60,15 @@ func (r *ControlFlowStageReconciler) SetupWithManager(
 	ctx context.Context,
 	mgr ctrl.Manager,
 	sharedIndexer client.FieldIndexer,
+	cloudEventsSender *cloudevents.Sender,
 ) error {
 	// Configure client and event sender using manager.
 	r.client = mgr.GetClient()
-	r.eventSender = k8sevent.NewEventSender(
-		libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), r.cfg.Name()),
+	r.eventSender = cloudevents.WithSink(
+		k8sevent.NewEventSender(
+			libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), r.cfg.Name()),
+		),
+		cloudEventsSender,
 	)
 
 	// This index is used to find all Freight that are directly available from
@@ -568,6 +573,7 @@ func (r *ControlFlowStageReconciler) clearApprovals(ctx context.Context, stage *
 			continue
 		}
 		delete(newStatus.ApprovedFor, stage.Name)
+		delete(newStatus.Approvals, stage.Name)
 
 		if err := kubeclient.PatchStatus(ctx, r.client, &f, func(status *kargoapi.FreightStatus) {
 			*status = newStatus
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	gocache "github.com/patrickmn/go-cache"
+	batchv1 "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -34,6 +35,7 @@ import (
 	"github.com/akuity/kargo/pkg/controller"
 	argocdapi "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
 	kargoEvent "github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	exprfn "github.com/akuity/kargo/pkg/expressions/function"
 	"github.com/akuity/kargo/pkg/health"
@@ -45,9 +47,17 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/pattern"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
+	"github.com/akuity/kargo/pkg/promotion/calendar"
 	"github.com/akuity/kargo/pkg/rollouts"
+	"github.com/akuity/kargo/pkg/verification"
+	"github.com/akuity/kargo/pkg/verification/native"
 )
 
+// nativeVerificationPollInterval is how often a Stage is reconciled while
+// Kargo-native verification checks are running. Unlike AnalysisRuns, these
+// are not backed by resources that can be watched.
+const nativeVerificationPollInterval = 10 * time.Second
+
 // ReconcilerConfig represents configuration for the stage reconciler.
 type ReconcilerConfig struct {
 	IsDefaultController                bool   `envconfig:"IS_DEFAULT_CONTROLLER"`
@@ -76,11 +86,12 @@ func ReconcilerConfigFromEnv() ReconcilerConfig {
 }
 
 type RegularStageReconciler struct {
-	cfg            ReconcilerConfig
-	client         client.Client
-	eventSender    kargoEvent.Sender
-	healthChecker  health.AggregatingChecker
-	shardPredicate controller.ResponsibleFor[kargoapi.Stage]
+	cfg                  ReconcilerConfig
+	client               client.Client
+	eventSender          kargoEvent.Sender
+	healthChecker        health.AggregatingChecker
+	verificationProvider verification.Provider
+	shardPredicate       controller.ResponsibleFor[kargoapi.Stage]
 
 	backoffCfg wait.Backoff
 }
@@ -114,11 +125,16 @@ func (r *RegularStageReconciler) SetupWithManager(
 	ctx context.Context,
 	kargoMgr, argocdMgr ctrl.Manager,
 	sharedIndexer client.FieldIndexer,
+	cloudEventsSender *cloudevents.Sender,
 ) error {
 	// Configure client and event recorder using manager.
 	r.client = kargoMgr.GetClient()
-	r.eventSender = k8sevent.NewEventSender(
-		libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), r.cfg.Name()),
+	r.verificationProvider = native.NewProvider(r.client)
+	r.eventSender = cloudevents.WithSink(
+		k8sevent.NewEventSender(
+			libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), r.cfg.Name()),
+		),
+		cloudEventsSender,
 	)
 
 	// This index is used to find all Promotions that are associated with a
@@ -393,6 +409,12 @@ func (r *RegularStageReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	if needsRequeue {
 		return ctrl.Result{RequeueAfter: 100 * time.Millisecond}, nil
 	}
+	// Poll the progress of any running Kargo-native verification.
+	if curFreight := newStatus.FreightHistory.Current(); curFreight != nil {
+		if vi := curFreight.VerificationHistory.Current(); vi.HasChecks() && !vi.Phase.IsTerminal() {
+			return ctrl.Result{RequeueAfter: nativeVerificationPollInterval}, nil
+		}
+	}
 	// Otherwise, requeue after a delay.
 	// TODO: Make the requeue delay configurable.
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
@@ -511,6 +533,16 @@ func (r *RegularStageReconciler) reconcile(
 				return status, err
 			},
 		},
+		{
+			name: "rolling back Freight",
+			reconcile: func() (kargoapi.StageStatus, error) {
+				status, err := r.rollbackFreight(ctx, stage)
+				if err != nil {
+					err = fmt.Errorf("failed to roll back Freight: %w", err)
+				}
+				return status, err
+			},
+		},
 		{
 			name: "auto-promoting Freight",
 			reconcile: func() (kargoapi.StageStatus, error) {
@@ -594,6 +626,15 @@ func (r *RegularStageReconciler) syncPromotions(
 		return newStatus, false, err
 	}
 
+	// Promotions that are scheduled to start at a later time are not yet
+	// eligible to run and must not hold up any other Promotions. They are
+	// disregarded until they become due, at which point the Promotion
+	// reconciler will request a refresh of the Stage.
+	now := time.Now()
+	promotions.Items = slices.DeleteFunc(promotions.Items, func(promo kargoapi.Promotion) bool {
+		return promo.IsScheduledAfter(now)
+	})
+
 	// If there are no Promotions, then we are not promoting any Freight.
 	if len(promotions.Items) == 0 {
 		logger.Debug("no Promotions found for Stage")
@@ -1100,7 +1141,7 @@ func (r *RegularStageReconciler) verifyStageFreight(
 				logger.Debug("aborting verification of Stage Freight")
 
 				// Abort the verification.
-				newVI, err = r.abortVerification(ctx, *curFreight, abortReq)
+				newVI, err = r.abortVerification(ctx, stage, *curFreight, abortReq)
 				if newVI != nil {
 					newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(*newVI)
 				}
@@ -1114,7 +1155,7 @@ func (r *RegularStageReconciler) verifyStageFreight(
 			}
 
 			// Get the latest result of the verification.
-			newVI, err = r.getVerificationResult(ctx, *curFreight)
+			newVI, err = r.getVerificationResult(ctx, stage, *curFreight)
 			if newVI != nil {
 				newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(*newVI)
 
@@ -1327,8 +1368,10 @@ func (r *RegularStageReconciler) recordFreightVerificationEvent(
 // If there is no verification configuration for the Stage, then the verification
 // is automatically considered successful and no verification is started.
 //
-// If the Rollouts integration is disabled, then the verification is marked as
-// failed with an appropriate message.
+// If the Stage is verified using Kargo-native checks, then the verification is
+// started by the verification provider. Otherwise, if the Rollouts integration
+// is disabled, then the verification is marked as failed with an appropriate
+// message.
 //
 // To start a verification, the Stage must be healthy.
 func (r *RegularStageReconciler) startVerification(
@@ -1350,6 +1393,18 @@ func (r *RegularStageReconciler) startVerification(
 		newVI.Actor = req.Actor
 	}
 
+	// Kargo-native checks do not depend on the Rollouts integration.
+	if stage.Spec.Verification.HasChecks() {
+		vi, err := r.verificationProvider.Start(ctx, stage, freight, *newVI)
+		if err != nil {
+			newVI.FinishTime = ptr.To(metav1.Now())
+			newVI.Phase = kargoapi.VerificationPhaseError
+			newVI.Message = fmt.Sprintf("error starting verification: %s", err)
+			return newVI, nil
+		}
+		return vi, nil
+	}
+
 	// Return early, as we cannot start the verification if the Rollouts
 	// integration is disabled.
 	if !r.cfg.RolloutsIntegrationEnabled {
@@ -1490,13 +1545,15 @@ func (r *RegularStageReconciler) startVerification(
 // getVerificationResult gets the result of the verification for the current
 // Freight of a Stage.
 //
-// If the Stage does not have an AnalysisRun associated with the verification,
-// an error is returned.
+// If the verification is implemented by Kargo-native checks, the result is
+// obtained from the verification provider. Otherwise, if the Stage does not
+// have an AnalysisRun associated with the verification, an error is returned.
 //
 // If the Rollouts integration is disabled, then the verification is marked as
 // failed with an appropriate message.
 func (r *RegularStageReconciler) getVerificationResult(
 	ctx context.Context,
+	stage *kargoapi.Stage,
 	freight kargoapi.FreightCollection,
 ) (*kargoapi.VerificationInfo, error) {
 	// Ensure all necessary information is available to get the verification.
@@ -1504,6 +1561,9 @@ func (r *RegularStageReconciler) getVerificationResult(
 	if currentVI == nil {
 		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
 	}
+	if currentVI.HasChecks() {
+		return r.verificationProvider.Result(ctx, stage, *currentVI)
+	}
 	if currentVI.AnalysisRun == nil {
 		return nil, fmt.Errorf(
 			"no AnalysisRun reference in current verification info for Freight collection %q",
@@ -1573,6 +1633,7 @@ func (r *RegularStageReconciler) getVerificationResult(
 // abortVerification aborts the verification for the current Freight of a Stage.
 func (r *RegularStageReconciler) abortVerification(
 	ctx context.Context,
+	stage *kargoapi.Stage,
 	freight kargoapi.FreightCollection,
 	req *kargoapi.VerificationRequest,
 ) (*kargoapi.VerificationInfo, error) {
@@ -1581,7 +1642,7 @@ func (r *RegularStageReconciler) abortVerification(
 	if currentVI == nil {
 		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
 	}
-	if currentVI.AnalysisRun == nil {
+	if !currentVI.HasChecks() && currentVI.AnalysisRun == nil {
 		return nil, fmt.Errorf(
 			"no AnalysisRun reference in current verification info for Freight collection %q",
 			freight.ID,
@@ -1600,6 +1661,15 @@ func (r *RegularStageReconciler) abortVerification(
 		actor = req.Actor
 	}
 
+	// Kargo-native checks are aborted by the verification provider.
+	if currentVI.HasChecks() {
+		newVI, err := r.verificationProvider.Abort(ctx, stage, *currentVI)
+		if newVI != nil {
+			newVI.Actor = actor
+		}
+		return newVI, err
+	}
+
 	// If the Rollouts integration is disabled, then we cannot abort the
 	// verification.
 	if !r.cfg.RolloutsIntegrationEnabled {
@@ -1720,6 +1790,22 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	}
 	newStatus.AutoPromotionEnabled = true
 
+	// Auto-promotion is subject to any PromotionCalendar applicable to the
+	// Stage. Unlike a user, the controller never overrides a calendar, so new
+	// Freight simply waits until promotions are allowed again.
+	promoCalendar, err := calendar.ForStage(ctx, r.client, stage)
+	if err != nil {
+		return newStatus, err
+	}
+	reason, err := calendar.Check(promoCalendar, time.Now())
+	if err != nil {
+		return newStatus, err
+	}
+	if reason != "" {
+		logger.Debug("auto-promotion is not currently allowed", "reason", reason)
+		return newStatus, nil
+	}
+
 	// Retrieve promotable Freight for the Stage.
 	promotableFreight, err := r.getPromotableFreight(ctx, stage)
 	if err != nil {
@@ -1768,6 +1854,14 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 			}
 		}
 
+		// Never auto-promote Freight that the Stage has automatically been rolled
+		// back from. It would only fail verification again.
+		if stage.Spec.AutoRollback != nil && stage.Spec.AutoRollback.Enabled &&
+			latestFreight.IsRolledBackFrom(stage.Name) {
+			freightLogger.Debug("Stage was rolled back from Freight; skipping auto-promotion")
+			continue
+		}
+
 		// How we proceed depends on the auto-promotion Freight selection policy...
 		if req.Sources.AutoPromotionOptions != nil &&
 			req.Sources.AutoPromotionOptions.SelectionPolicy == kargoapi.AutoPromotionSelectionPolicyMatchUpstream {
@@ -1933,6 +2027,268 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	return newStatus, nil
 }
 
+// rollbackFreight automatically promotes a Stage's previously verified Freight
+// if auto-rollback is enabled for the Stage and verification of its current
+// Freight has failed without ever having succeeded.
+//
+// To prevent the Stage from flapping between bad Freight, the Stage is rolled
+// back from any given Freight at most once, and never rolled back from Freight
+// that was itself put in place by a rollback.
+func (r *RegularStageReconciler) rollbackFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (kargoapi.StageStatus, error) {
+	logger := logging.LoggerFromContext(ctx)
+	newStatus := *stage.Status.DeepCopy()
+
+	if stage.Spec.AutoRollback == nil || !stage.Spec.AutoRollback.Enabled {
+		return newStatus, nil
+	}
+
+	// If we are currently promoting Freight, then the Stage's current Freight
+	// is about to be replaced anyway.
+	if stage.Status.CurrentPromotion != nil {
+		return newStatus, nil
+	}
+
+	// Only roll back if the most recent verification of the current Freight
+	// failed and no earlier verification of it succeeded.
+	curFreight := stage.Status.FreightHistory.Current()
+	if curFreight == nil || curFreight.HasNonTerminalVerification() {
+		return newStatus, nil
+	}
+	lastVerification := curFreight.VerificationHistory.Current()
+	if lastVerification == nil ||
+		lastVerification.Phase != kargoapi.VerificationPhaseFailed ||
+		hasSuccessfulVerification(curFreight) {
+		return newStatus, nil
+	}
+
+	// Never roll back from Freight that was itself put in place by a rollback.
+	// If the previously verified Freight has also gone bad, a human needs to
+	// decide what to do next.
+	promotedByRollback, err := r.promotedByRollback(ctx, stage)
+	if err != nil {
+		return newStatus, err
+	}
+	if promotedByRollback {
+		logger.Debug("current Freight was promoted by a rollback; not rolling back again")
+		return newStatus, nil
+	}
+
+	// Find the most recent Freight that was verified in the Stage.
+	var prevFreight *kargoapi.FreightCollection
+	for _, col := range stage.Status.FreightHistory[1:] {
+		if col != nil && col.ID != curFreight.ID && hasSuccessfulVerification(col) {
+			prevFreight = col
+			break
+		}
+	}
+	if prevFreight == nil {
+		logger.Debug("Stage has no previously verified Freight to roll back to")
+		return newStatus, nil
+	}
+
+	// Rollback is subject to any PromotionCalendar applicable to the Stage,
+	// just like auto-promotion.
+	promoCalendar, err := calendar.ForStage(ctx, r.client, stage)
+	if err != nil {
+		return newStatus, err
+	}
+	calendarReason, err := calendar.Check(promoCalendar, time.Now())
+	if err != nil {
+		return newStatus, err
+	}
+	if calendarReason != "" {
+		logger.Debug("rollback is not currently allowed", "reason", calendarReason)
+		return newStatus, nil
+	}
+
+	for _, ref := range curFreight.References() {
+		origin := ref.Origin.String()
+		prevRef, ok := prevFreight.Freight[origin]
+		if !ok || prevRef.Name == ref.Name {
+			// Freight from this origin did not change
+			continue
+		}
+
+		freightLogger := logger.WithValues(
+			"origin", origin,
+			"freight", ref.Name,
+			"previousFreight", prevRef.Name,
+		)
+
+		badFreight, err := api.GetFreight(ctx, r.client, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		})
+		if err != nil {
+			return newStatus, err
+		}
+		if badFreight == nil {
+			freightLogger.Debug("current Freight no longer exists; cannot roll back")
+			continue
+		}
+		if badFreight.IsRolledBackFrom(stage.Name) {
+			freightLogger.Debug("Stage has already been rolled back from Freight")
+			continue
+		}
+
+		freight, err := api.GetFreight(ctx, r.client, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      prevRef.Name,
+		})
+		if err != nil {
+			return newStatus, err
+		}
+		if freight == nil {
+			freightLogger.Debug("previously verified Freight no longer exists; cannot roll back")
+			continue
+		}
+
+		reason := fmt.Sprintf("Freight %q failed verification", ref.Name)
+		if lastVerification.Message != "" {
+			reason = fmt.Sprintf("%s: %s", reason, lastVerification.Message)
+		}
+
+		// A rollback Promotion may already exist if we previously failed to
+		// record the rollback on the Freight. If so, reuse it instead of
+		// creating another.
+		promotion, err := r.getRollbackPromotion(ctx, stage, ref.Name)
+		if err != nil {
+			return newStatus, err
+		}
+		if promotion == nil {
+			if promotion, err = kargo.NewPromotionBuilder(r.client).
+				Build(ctx, *stage, freight.Name); err != nil {
+				return newStatus, fmt.Errorf(
+					"error building Promotion for Freight %q in namespace %q: %w",
+					freight.Name, stage.Namespace, err,
+				)
+			}
+			if promotion.Labels == nil {
+				promotion.Labels = make(map[string]string, 1)
+			}
+			promotion.Labels[kargoapi.LabelKeyRollbackFrom] = ref.Name
+			promotion.Annotations[kargoapi.AnnotationKeyRollbackReason] = reason
+			if err = r.client.Create(ctx, promotion); err != nil {
+				return newStatus, fmt.Errorf(
+					"error creating Promotion for Freight %q in namespace %q: %w",
+					freight.Name, stage.Namespace, err,
+				)
+			}
+		} else {
+			freightLogger.Debug(
+				"rollback Promotion already exists",
+				"promotion", promotion.Name,
+			)
+		}
+		// Record the rollback on the Freight that was rolled back from. This,
+		// unlike the Promotion, is not subject to garbage collection for as
+		// long as the Freight itself exists.
+		if err = kubeclient.PatchStatus(ctx, r.client, badFreight, func(status *kargoapi.FreightStatus) {
+			status.AddRolledBackStage(stage.Name, promotion.Name, time.Now())
+		}); err != nil {
+			return newStatus, fmt.Errorf(
+				"error marking Freight %q as rolled back from in Stage: %w",
+				badFreight.Name, err,
+			)
+		}
+		evt := kargoEvent.NewPromotionRollbackCreated(
+			fmt.Sprintf(
+				"Automatically rolled back Stage %q from Freight %q",
+				promotion.Spec.Stage, ref.Name,
+			),
+			api.FormatEventControllerActor(r.cfg.Name()),
+			promotion,
+			freight,
+			ref.Name,
+			reason,
+		)
+		if err = r.eventSender.Send(ctx, evt); err != nil {
+			logger.Error(err, "failed to send promotion rollback event")
+		}
+		freightLogger.Debug(
+			"created rollback Promotion resource",
+			"promotion", promotion.Name,
+		)
+	}
+
+	return newStatus, nil
+}
+
+// getRollbackPromotion returns the Promotion, if any, that was created to roll
+// the given Stage back from the Freight with the given name.
+func (r *RegularStageReconciler) getRollbackPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freightName string,
+) (*kargoapi.Promotion, error) {
+	promotions := &kargoapi.PromotionList{}
+	if err := r.client.List(
+		ctx,
+		promotions,
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabels{kargoapi.LabelKeyRollbackFrom: freightName},
+		client.MatchingFields{indexer.PromotionsByStageField: stage.Name},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing rollback Promotions for Stage %q in namespace %q: %w",
+			stage.Name, stage.Namespace, err,
+		)
+	}
+	if len(promotions.Items) == 0 {
+		return nil, nil
+	}
+	return &promotions.Items[0], nil
+}
+
+// promotedByRollback returns true if the given Stage's current Freight was put
+// in place by an automatic rollback. This is the case if the Stage's last
+// Promotion is the one recorded as having rolled the Stage back from any
+// Freight in the collection preceding the current one.
+func (r *RegularStageReconciler) promotedByRollback(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (bool, error) {
+	lastPromo := stage.Status.LastPromotion
+	if lastPromo == nil || len(stage.Status.FreightHistory) < 2 {
+		return false, nil
+	}
+	prevFreight := stage.Status.FreightHistory[1]
+	if prevFreight == nil {
+		return false, nil
+	}
+	for _, ref := range prevFreight.References() {
+		freight, err := api.GetFreight(ctx, r.client, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		})
+		if err != nil {
+			return false, err
+		}
+		if freight == nil {
+			continue
+		}
+		if record, ok := freight.Status.RolledBackFrom[stage.Name]; ok &&
+			record.Promotion == lastPromo.Name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasSuccessfulVerification returns true if any verification of the given
+// FreightCollection succeeded.
+func hasSuccessfulVerification(col *kargoapi.FreightCollection) bool {
+	for _, vi := range col.VerificationHistory {
+		if vi.Phase == kargoapi.VerificationPhaseSuccessful {
+			return true
+		}
+	}
+	return false
+}
+
 // autoPromotionAllowed checks if auto-promotion is allowed for the given Stage.
 func (r *RegularStageReconciler) autoPromotionAllowed(
 	ctx context.Context,
@@ -2041,11 +2397,13 @@ func (r *RegularStageReconciler) handleDelete(ctx context.Context, stage *kargoa
 	}
 
 	// Clear the verification and approval status of all Freight that have been
-	// verified or approved for the Stage, and delete all AnalysisRuns.
+	// verified or approved for the Stage, and delete all AnalysisRuns and
+	// verification Jobs.
 	toClear := []func(context.Context, *kargoapi.Stage) error{
 		r.clearVerifications,
 		r.clearApprovals,
 		r.clearAnalysisRuns,
+		r.clearVerificationJobs,
 	}
 	var errs []error
 	for _, c := range toClear {
@@ -2141,6 +2499,7 @@ func (r *RegularStageReconciler) clearApprovals(ctx context.Context, stage *karg
 			continue
 		}
 		delete(newStatus.ApprovedFor, stage.Name)
+		delete(newStatus.Approvals, stage.Name)
 
 		if err := kubeclient.PatchStatus(ctx, r.client, &f, func(status *kargoapi.FreightStatus) {
 			*status = newStatus
@@ -2178,6 +2537,27 @@ func (r *RegularStageReconciler) clearAnalysisRuns(ctx context.Context, stage *k
 	return nil
 }
 
+// clearVerificationJobs clears all Jobs that were created by Kargo-native
+// verification checks of the given Stage.
+func (r *RegularStageReconciler) clearVerificationJobs(ctx context.Context, stage *kargoapi.Stage) error {
+	if err := r.client.DeleteAllOf(
+		ctx,
+		&batchv1.Job{},
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabels(map[string]string{
+			kargoapi.LabelKeyStage: kubernetes.ShortenLabelValue(stage.Name),
+		}),
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); err != nil {
+		return fmt.Errorf("error deleting verification Jobs for Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
+	}
+	return nil
+}
+
 // summarizeConditions summarizes the conditions of the given Stage. It sets the
 // Ready condition based on the Promoting, Healthy, and Verified conditions.
 // If there is an error, the Ready condition is set to False until the error is
@@ -28,6 +28,7 @@ import (
 	"github.com/akuity/kargo/pkg/health"
 	"github.com/akuity/kargo/pkg/indexer"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
+	"github.com/akuity/kargo/pkg/verification"
 )
 
 func TestRegularStageReconciler_Reconcile(t *testing.T) {
@@ -760,6 +761,79 @@ func TestRegularStageReconciler_syncPromotions(t *testing.T) {
 				assert.Equal(t, "ActivePromotion", promotingCond.Reason)
 			},
 		},
+		{
+			name: "scheduled promotion does not block other promotions",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "scheduled-promotion",
+						Namespace:         "fake-project",
+						CreationTimestamp: metav1.Time{Time: twoHoursAgo},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:     "test-stage",
+						NotBefore: &metav1.Time{Time: now.Add(time.Hour)},
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhasePending,
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "active-promotion",
+						Namespace:         "fake-project",
+						CreationTimestamp: metav1.Time{Time: hourAgo},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage: "test-stage",
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhasePending,
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, hasPendingPromotions bool, err error) {
+				require.NoError(t, err)
+				assert.True(t, hasPendingPromotions)
+				require.NotNil(t, status.CurrentPromotion)
+				assert.Equal(t, "active-promotion", status.CurrentPromotion.Name)
+			},
+		},
+		{
+			name: "only scheduled promotions",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "scheduled-promotion",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:     "test-stage",
+						NotBefore: &metav1.Time{Time: now.Add(time.Hour)},
+					},
+					Status: kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhasePending,
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, hasPendingPromotions bool, err error) {
+				require.NoError(t, err)
+				assert.False(t, hasPendingPromotions)
+				assert.Nil(t, status.CurrentPromotion)
+			},
+		},
 		{
 			name: "blocks new promotion when current freight has running verification",
 			stage: &kargoapi.Stage{
@@ -3621,8 +3695,97 @@ func TestRegularStageReconciler_startVerification(t *testing.T) {
 		req              *kargoapi.VerificationRequest
 		objects          []client.Object
 		rolloutsDisabled bool
+		provider         verification.Provider
 		assertions       func(*testing.T, client.Client, *kargoapi.VerificationInfo, error)
 	}{
+		{
+			name: "starts native checks without rollouts integration",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Checks: []kargoapi.VerificationCheck{{
+							Name: "smoke",
+							HTTP: &kargoapi.HTTPCheck{URL: "https://example.com"},
+						}},
+					},
+				},
+			},
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+			},
+			rolloutsDisabled: true,
+			provider: &verification.MockProvider{
+				StartFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ kargoapi.FreightCollection,
+					vi kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					vi.Phase = kargoapi.VerificationPhaseRunning
+					vi.Checks = []kargoapi.VerificationCheckStatus{{
+						Name:  "smoke",
+						Phase: kargoapi.VerificationPhaseRunning,
+					}}
+					return &vi, nil
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.NotEmpty(t, vi.ID)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+				assert.Len(t, vi.Checks, 1)
+			},
+		},
+		{
+			name: "error starting native checks",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Checks: []kargoapi.VerificationCheck{{
+							Name: "smoke",
+							HTTP: &kargoapi.HTTPCheck{URL: "https://example.com"},
+						}},
+					},
+				},
+			},
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+			},
+			provider: &verification.MockProvider{
+				StartFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightCollection,
+					kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "error starting verification")
+				assert.Contains(t, vi.Message, "something went wrong")
+			},
+		},
 		{
 			name: "rollouts integration disabled",
 			stage: &kargoapi.Stage{
@@ -4019,6 +4182,7 @@ func TestRegularStageReconciler_startVerification(t *testing.T) {
 					RolloutsIntegrationEnabled:   !tt.rolloutsDisabled,
 					RolloutsControllerInstanceID: "test-instance",
 				},
+				verificationProvider: tt.provider,
 			}
 
 			vi, err := r.startVerification(context.Background(), tt.stage, tt.freightCol, tt.req, now)
@@ -4040,6 +4204,7 @@ func TestRegularStageReconciler_getVerificationResult(t *testing.T) {
 		freight          kargoapi.FreightCollection
 		objects          []client.Object
 		rolloutsDisabled bool
+		provider         verification.Provider
 		assertions       func(*testing.T, *kargoapi.VerificationInfo, error)
 	}{
 		{
@@ -4328,6 +4493,78 @@ func TestRegularStageReconciler_getVerificationResult(t *testing.T) {
 				assert.Equal(t, string(rolloutsapi.AnalysisPhaseError), vi.AnalysisRun.Phase)
 			},
 		},
+		{
+			name: "gets result of native checks",
+			freight: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Checks: []kargoapi.VerificationCheckStatus{{
+							Name:  "smoke",
+							Phase: kargoapi.VerificationPhaseRunning,
+						}},
+					},
+				},
+			},
+			rolloutsDisabled: true,
+			provider: &verification.MockProvider{
+				ResultFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					vi kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					vi.Phase = kargoapi.VerificationPhaseSuccessful
+					vi.Checks[0].Phase = kargoapi.VerificationPhaseSuccessful
+					return &vi, nil
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, "test-verification", vi.ID)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Phase)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Checks[0].Phase)
+			},
+		},
+		{
+			name: "error getting result of native checks",
+			freight: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Checks: []kargoapi.VerificationCheckStatus{{
+							Name:  "smoke",
+							Phase: kargoapi.VerificationPhaseRunning,
+						}},
+					},
+				},
+			},
+			provider: &verification.MockProvider{
+				ResultFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.VerificationInfo, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -4343,6 +4580,7 @@ func TestRegularStageReconciler_getVerificationResult(t *testing.T) {
 				cfg: ReconcilerConfig{
 					RolloutsIntegrationEnabled: !tt.rolloutsDisabled,
 				},
+				verificationProvider: tt.provider,
 				backoffCfg: wait.Backoff{
 					Duration: 1 * time.Second,
 					Factor:   2,
@@ -4352,7 +4590,7 @@ func TestRegularStageReconciler_getVerificationResult(t *testing.T) {
 				},
 			}
 
-			vi, err := r.getVerificationResult(context.Background(), tt.freight)
+			vi, err := r.getVerificationResult(context.Background(), &kargoapi.Stage{}, tt.freight)
 			tt.assertions(t, vi, err)
 		})
 	}
@@ -4372,6 +4610,7 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 		objects          []client.Object
 		rolloutsDisabled bool
 		interceptor      interceptor.Funcs
+		provider         verification.Provider
 		assertions       func(*testing.T, client.Client, *kargoapi.VerificationInfo, error)
 	}{
 		{
@@ -4674,6 +4913,40 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 				assert.Contains(t, vi.Message, "error terminating AnalysisRun")
 			},
 		},
+		{
+			name: "aborts native checks",
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Checks: []kargoapi.VerificationCheckStatus{{
+							Name:  "smoke",
+							Phase: kargoapi.VerificationPhaseRunning,
+						}},
+					},
+				},
+			},
+			req: &kargoapi.VerificationRequest{
+				ID:    "test-verification",
+				Actor: "test-user",
+			},
+			rolloutsDisabled: true,
+			provider:         &verification.MockProvider{},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, "test-verification", vi.ID)
+				assert.Equal(t, kargoapi.VerificationPhaseAborted, vi.Phase)
+				assert.Equal(t, "test-user", vi.Actor)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -4694,9 +4967,10 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 				cfg: ReconcilerConfig{
 					RolloutsIntegrationEnabled: !tt.rolloutsDisabled,
 				},
+				verificationProvider: tt.provider,
 			}
 
-			vi, err := r.abortVerification(context.Background(), tt.freightCol, tt.req)
+			vi, err := r.abortVerification(context.Background(), &kargoapi.Stage{}, tt.freightCol, tt.req)
 			tt.assertions(t, c, vi, err)
 		})
 	}
@@ -5183,7 +5457,7 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 			},
 		},
 		{
-			name: "skips promotion when current freight is latest",
+			name: "skips Freight the Stage was rolled back from",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -5201,15 +5475,16 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 							},
 						},
 					},
-				},
-				Status: kargoapi.StageStatus{
-					FreightHistory: kargoapi.FreightHistory{
-						{
-							Freight: map[string]kargoapi.FreightReference{
-								"Warehouse/test-warehouse": {Name: "test-freight-1"},
+					PromotionTemplate: &kargoapi.PromotionTemplate{
+						Spec: kargoapi.PromotionTemplateSpec{
+							Steps: []kargoapi.PromotionStep{
+								{
+									Uses: "fake-step",
+								},
 							},
 						},
 					},
+					AutoRollback: &kargoapi.AutoRollback{Enabled: true},
 				},
 			},
 			objects: []client.Object{
@@ -5243,6 +5518,11 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 						Kind: kargoapi.FreightOriginKindWarehouse,
 						Name: "test-warehouse",
 					},
+					Status: kargoapi.FreightStatus{
+						RolledBackFrom: map[string]kargoapi.RolledBackStage{
+							"test-stage": {Promotion: "rollback-promotion"},
+						},
+					},
 				},
 			},
 			assertions: func(
@@ -5256,14 +5536,14 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 
 				assert.True(t, status.AutoPromotionEnabled)
 
-				// Verify no promotions were created
+				// Verify no new promotion was created
 				promoList := &kargoapi.PromotionList{}
 				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
-				assert.Empty(t, promoList.Items)
+				require.Empty(t, promoList.Items)
 			},
 		},
 		{
-			name: "skips promotion if a non-terminal one already exists",
+			name: "skips promotion during a promotion freeze",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -5281,6 +5561,15 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 							},
 						},
 					},
+					PromotionTemplate: &kargoapi.PromotionTemplate{
+						Spec: kargoapi.PromotionTemplateSpec{
+							Steps: []kargoapi.PromotionStep{
+								{
+									Uses: "fake-step",
+								},
+							},
+						},
+					},
 				},
 			},
 			objects: []client.Object{
@@ -5296,6 +5585,14 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 								AutoPromotionEnabled: true,
 							},
 						},
+						PromotionCalendars: []kargoapi.PromotionCalendarPolicy{
+							{
+								Freezes: []kargoapi.PromotionFreeze{{
+									Reason: "Holidays",
+									End:    metav1.NewTime(now.Add(time.Hour)),
+								}},
+							},
+						},
 					},
 				},
 				&kargoapi.Warehouse{
@@ -5315,19 +5612,6 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 						Name: "test-warehouse",
 					},
 				},
-				&kargoapi.Promotion{
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: "fake-project",
-						Name:      "existing-promotion",
-						Labels: map[string]string{
-							kargoapi.LabelKeyStage: "test-stage",
-						},
-					},
-					Spec: kargoapi.PromotionSpec{
-						Stage:   "test-stage",
-						Freight: "test-freight-1",
-					},
-				},
 			},
 			assertions: func(
 				t *testing.T,
@@ -5340,15 +5624,14 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 
 				assert.True(t, status.AutoPromotionEnabled)
 
-				// Verify no new promotions were created
+				// Verify no promotions were created
 				promoList := &kargoapi.PromotionList{}
 				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
-				assert.Len(t, promoList.Items, 1)
-				assert.Equal(t, "existing-promotion", promoList.Items[0].Name)
+				assert.Empty(t, promoList.Items)
 			},
 		},
 		{
-			name: "skips promotion if the last terminal one was not successful",
+			name: "skips promotion when current freight is latest",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -5367,6 +5650,15 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 						},
 					},
 				},
+				Status: kargoapi.StageStatus{
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								"Warehouse/test-warehouse": {Name: "test-freight-1"},
+							},
+						},
+					},
+				},
 			},
 			objects: []client.Object{
 				&kargoapi.ProjectConfig{
@@ -5400,14 +5692,170 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 						Name: "test-warehouse",
 					},
 				},
-				&kargoapi.Promotion{
-					ObjectMeta: metav1.ObjectMeta{
-						Namespace: "fake-project",
-						Name:      "existing-promotion",
-						Labels: map[string]string{
-							kargoapi.LabelKeyStage: "test-stage",
-						},
-					},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				assert.True(t, status.AutoPromotionEnabled)
+
+				// Verify no promotions were created
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name: "skips promotion if a non-terminal one already exists",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Direct: true,
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.ProjectConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-project",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.ProjectConfigSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+							},
+						},
+					},
+				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-warehouse",
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-1",
+						CreationTimestamp: metav1.Time{Time: now},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "existing-promotion",
+						Labels: map[string]string{
+							kargoapi.LabelKeyStage: "test-stage",
+						},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "test-stage",
+						Freight: "test-freight-1",
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				assert.True(t, status.AutoPromotionEnabled)
+
+				// Verify no new promotions were created
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Len(t, promoList.Items, 1)
+				assert.Equal(t, "existing-promotion", promoList.Items[0].Name)
+			},
+		},
+		{
+			name: "skips promotion if the last terminal one was not successful",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Direct: true,
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.ProjectConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-project",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.ProjectConfigSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+							},
+						},
+					},
+				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-warehouse",
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-1",
+						CreationTimestamp: metav1.Time{Time: now},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "existing-promotion",
+						Labels: map[string]string{
+							kargoapi.LabelKeyStage: "test-stage",
+						},
+					},
 					Spec: kargoapi.PromotionSpec{
 						Stage:   "test-stage",
 						Freight: "test-freight-1",
@@ -6169,6 +6617,11 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 					indexer.PromotionsByTerminalField,
 					indexer.PromotionsByTerminal,
 				).
+				WithIndex(
+					&kargoapi.Promotion{},
+					indexer.PromotionsByStageField,
+					indexer.PromotionsByStage,
+				).
 				WithIndex(
 					&kargoapi.Freight{},
 					indexer.FreightByWarehouseField,
@@ -6199,6 +6652,353 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 	}
 }
 
+func TestRegularStageReconciler_rollbackFreight(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	origin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+
+	newStage := func(autoRollback bool, lastPhase kargoapi.VerificationPhase) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "test-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin:  origin,
+					Sources: kargoapi.FreightSources{Direct: true},
+				}},
+				PromotionTemplate: &kargoapi.PromotionTemplate{
+					Spec: kargoapi.PromotionTemplateSpec{
+						Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+					},
+				},
+				AutoRollback: &kargoapi.AutoRollback{Enabled: autoRollback},
+			},
+			Status: kargoapi.StageStatus{
+				LastPromotion: &kargoapi.PromotionReference{
+					Name: "last-promotion",
+				},
+				FreightHistory: kargoapi.FreightHistory{
+					{
+						ID: "bad-collection",
+						Freight: map[string]kargoapi.FreightReference{
+							origin.String(): {Name: "bad-freight", Origin: origin},
+						},
+						VerificationHistory: []kargoapi.VerificationInfo{{
+							ID:      "bad-verification",
+							Phase:   lastPhase,
+							Message: "analysis failed",
+						}},
+					},
+					{
+						ID: "unverified-collection",
+						Freight: map[string]kargoapi.FreightReference{
+							origin.String(): {Name: "unverified-freight", Origin: origin},
+						},
+					},
+					{
+						ID: "good-collection",
+						Freight: map[string]kargoapi.FreightReference{
+							origin.String(): {Name: "good-freight", Origin: origin},
+						},
+						VerificationHistory: []kargoapi.VerificationInfo{{
+							ID:    "good-verification",
+							Phase: kargoapi.VerificationPhaseSuccessful,
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	goodFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "good-freight",
+		},
+		Origin: origin,
+	}
+
+	newBadFreight := func(rolledBackFrom map[string]kargoapi.RolledBackStage) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "bad-freight",
+			},
+			Origin: origin,
+			Status: kargoapi.FreightStatus{RolledBackFrom: rolledBackFrom},
+		}
+	}
+
+	requireNoRollback := func(t *testing.T, c client.Client) {
+		promoList := &kargoapi.PromotionList{}
+		require.NoError(
+			t,
+			c.List(
+				context.Background(),
+				promoList,
+				client.InNamespace("fake-project"),
+				client.HasLabels{kargoapi.LabelKeyRollbackFrom},
+			),
+		)
+		require.Empty(t, promoList.Items)
+	}
+
+	tests := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		objects    []client.Object
+		assertions func(*testing.T, *fakeevent.EventRecorder, client.Client, error)
+	}{
+		{
+			name:    "auto-rollback not enabled",
+			stage:   newStage(false, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{goodFreight, newBadFreight(nil)},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:    "current Freight did not fail verification",
+			stage:   newStage(true, kargoapi.VerificationPhaseError),
+			objects: []client.Object{goodFreight, newBadFreight(nil)},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name: "current Freight was previously verified",
+			stage: func() *kargoapi.Stage {
+				stage := newStage(true, kargoapi.VerificationPhaseFailed)
+				stage.Status.FreightHistory[0].VerificationHistory = []kargoapi.VerificationInfo{
+					{ID: "reverification", Phase: kargoapi.VerificationPhaseFailed},
+					{ID: "first-verification", Phase: kargoapi.VerificationPhaseSuccessful},
+				}
+				return stage
+			}(),
+			objects: []client.Object{goodFreight, newBadFreight(nil)},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:  "current Freight was promoted by a rollback",
+			stage: newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{
+				goodFreight,
+				newBadFreight(nil),
+				// The rollback Promotion itself may since have been garbage
+				// collected. Only the record on the Freight counts.
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "unverified-freight",
+					},
+					Origin: origin,
+					Status: kargoapi.FreightStatus{
+						RolledBackFrom: map[string]kargoapi.RolledBackStage{
+							"test-stage": {Promotion: "last-promotion"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:  "previous Freight was rolled back from by an earlier Promotion",
+			stage: newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{
+				goodFreight,
+				newBadFreight(nil),
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "unverified-freight",
+					},
+					Origin: origin,
+					Status: kargoapi.FreightStatus{
+						RolledBackFrom: map[string]kargoapi.RolledBackStage{
+							"test-stage": {Promotion: "earlier-promotion"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				require.Equal(t, "good-freight", promoList.Items[0].Spec.Freight)
+			},
+		},
+		{
+			name: "no previously verified Freight",
+			stage: func() *kargoapi.Stage {
+				stage := newStage(true, kargoapi.VerificationPhaseFailed)
+				stage.Status.FreightHistory = stage.Status.FreightHistory[:2]
+				return stage
+			}(),
+			objects: []client.Object{goodFreight, newBadFreight(nil)},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:  "Stage was already rolled back from Freight",
+			stage: newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{
+				goodFreight,
+				newBadFreight(map[string]kargoapi.RolledBackStage{
+					"test-stage": {Promotion: "earlier-rollback"},
+				}),
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:  "current Freight no longer exists",
+			stage: newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{
+				goodFreight,
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:    "previously verified Freight no longer exists",
+			stage:   newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{newBadFreight(nil)},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+				requireNoRollback(t, c)
+			},
+		},
+		{
+			name:  "rollback Promotion exists but was not recorded on Freight",
+			stage: newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{
+				goodFreight,
+				newBadFreight(nil),
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "existing-rollback",
+						Labels: map[string]string{
+							kargoapi.LabelKeyRollbackFrom: "bad-freight",
+						},
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "test-stage",
+						Freight: "good-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				require.Equal(t, "existing-rollback", promoList.Items[0].Name)
+
+				badFreight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "bad-freight"},
+					badFreight,
+				))
+				require.Equal(
+					t,
+					"existing-rollback",
+					badFreight.Status.RolledBackFrom["test-stage"].Promotion,
+				)
+				require.Len(t, recorder.Events, 1)
+			},
+		},
+		{
+			name:    "rolls back to previously verified Freight",
+			stage:   newStage(true, kargoapi.VerificationPhaseFailed),
+			objects: []client.Object{goodFreight, newBadFreight(nil)},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, c client.Client, err error) {
+				require.NoError(t, err)
+
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				promo := promoList.Items[0]
+				require.Equal(t, "test-stage", promo.Spec.Stage)
+				require.Equal(t, "good-freight", promo.Spec.Freight)
+				require.Equal(t, "bad-freight", promo.Labels[kargoapi.LabelKeyRollbackFrom])
+				require.Equal(
+					t,
+					`Freight "bad-freight" failed verification: analysis failed`,
+					promo.Annotations[kargoapi.AnnotationKeyRollbackReason],
+				)
+
+				badFreight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "bad-freight"},
+					badFreight,
+				))
+				require.True(t, badFreight.IsRolledBackFrom("test-stage"))
+				record := badFreight.Status.RolledBackFrom["test-stage"]
+				require.Equal(t, promo.Name, record.Promotion)
+				require.NotNil(t, record.RolledBackAt)
+
+				require.Len(t, recorder.Events, 1)
+				evt := <-recorder.Events
+				require.Equal(t, string(kargoapi.EventTypePromotionRollbackCreated), evt.Reason)
+				require.Equal(
+					t,
+					"bad-freight",
+					evt.Annotations[kargoapi.AnnotationKeyEventRolledBackFreightName],
+				)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				WithStatusSubresource(&kargoapi.Freight{}).
+				WithIndex(
+					&kargoapi.Promotion{},
+					indexer.PromotionsByStageField,
+					indexer.PromotionsByStage,
+				).
+				Build()
+			recorder := fakeevent.NewEventRecorder(5)
+
+			r := &RegularStageReconciler{
+				client:      c,
+				eventSender: k8sevent.NewEventSender(recorder),
+			}
+
+			_, err := r.rollbackFreight(context.Background(), tt.stage)
+			tt.assertions(t, recorder, c, err)
+		})
+	}
+}
+
 func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
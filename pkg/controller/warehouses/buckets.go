@@ -0,0 +1,93 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/bucket"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// discoverObjects discovers the latest suitable objects for the given bucket
+// subscriptions. It returns a list of bucket discovery results, one for each
+// subscription.
+func (r *reconciler) discoverObjects(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.BucketDiscoveryResult, error) {
+	results := make([]kargoapi.BucketDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.Bucket == nil {
+			continue
+		}
+		sub := *s.Bucket
+
+		logger := logging.LoggerFromContext(ctx).WithValues(
+			"bucket", sub.URL,
+			"prefix", sub.Prefix,
+		)
+
+		// Obtain credentials for the bucket. The username and password of bucket
+		// credentials are an access key ID and secret access key, respectively.
+		creds, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeBucket, sub.URL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for bucket %q: %w",
+				sub.URL,
+				err,
+			)
+		}
+		var bucketCreds *bucket.Credentials
+		if creds != nil {
+			bucketCreds = &bucket.Credentials{
+				AccessKeyID:     creds.Username,
+				SecretAccessKey: creds.Password,
+			}
+			logger.Debug("obtained credentials for bucket")
+		} else {
+			logger.Debug("found no credentials for bucket")
+		}
+
+		selector, err := bucket.NewSelector(sub, bucketCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining selector for bucket %q: %w",
+				sub.URL,
+				err,
+			)
+		}
+		objects, err := selector.Select(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering newest applicable objects in bucket %q: %w",
+				sub.URL,
+				err,
+			)
+		}
+
+		if len(objects) == 0 {
+			results = append(results, kargoapi.BucketDiscoveryResult{
+				URL:    sub.URL,
+				Prefix: sub.Prefix,
+			})
+			logger.Debug("discovered no objects")
+			continue
+		}
+
+		results = append(results, kargoapi.BucketDiscoveryResult{
+			URL:     sub.URL,
+			Prefix:  sub.Prefix,
+			Objects: objects,
+		})
+		logger.Debug(
+			"discovered objects",
+			"count", len(objects),
+		)
+	}
+
+	return results, nil
+}
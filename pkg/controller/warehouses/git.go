@@ -3,6 +3,11 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/controller/git"
@@ -51,7 +56,21 @@ func (r *reconciler) discoverCommits(
 			logger.Debug("found no credentials for git repo")
 		}
 
-		selector, err := commit.NewSelector(ctx, *s.Git, repoCreds)
+		var trustedSigners []git.TrustedSigner
+		if sub.SignatureVerification != nil {
+			if trustedSigners, err = r.getTrustedSigners(
+				ctx,
+				namespace,
+				sub.SignatureVerification.TrustedKeysSecretName,
+			); err != nil {
+				return nil, fmt.Errorf(
+					"error obtaining trusted signers for git repo %q: %w",
+					sub.RepoURL, err,
+				)
+			}
+		}
+
+		selector, err := commit.NewSelector(ctx, sub, repoCreds, trustedSigners)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error obtaining selector for commits from git repo %q: %w",
@@ -86,3 +105,32 @@ func (r *reconciler) discoverCommits(
 
 	return results, nil
 }
+
+// getTrustedSigners returns the trusted signers whose public keys are stored in
+// the specified Secret. Each entry of the Secret represents one signer.
+func (r *reconciler) getTrustedSigners(
+	ctx context.Context,
+	namespace string,
+	secretName string,
+) ([]git.TrustedSigner, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: secretName},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf("error getting Secret %q: %w", secretName, err)
+	}
+	signers := make([]git.TrustedSigner, 0, len(secret.Data))
+	for name, keys := range secret.Data {
+		signers = append(signers, git.TrustedSigner{
+			Name:       name,
+			PublicKeys: string(keys),
+		})
+	}
+	// Sort for a stable order
+	slices.SortFunc(signers, func(a, b git.TrustedSigner) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return signers, nil
+}
@@ -0,0 +1,91 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/image"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// discoverOCIArtifacts discovers the latest suitable OCI artifacts for the
+// given OCI subscriptions. It returns a list of OCI discovery results, one for
+// each subscription.
+func (r *reconciler) discoverOCIArtifacts(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.OCIDiscoveryResult, error) {
+	results := make([]kargoapi.OCIDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.OCI == nil {
+			continue
+		}
+		sub := *s.OCI
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// Obtain credentials for the artifact repository. OCI registries are
+		// accessed in the same way regardless of the type of artifact stored in
+		// them, so image credentials are used.
+		creds, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for OCI repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		var regCreds *image.Credentials
+		if creds != nil {
+			regCreds = &image.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+			logger.Debug("obtained credentials for OCI repo")
+		} else {
+			logger.Debug("found no credentials for OCI repo")
+		}
+
+		selector, err := image.NewArtifactSelector(ctx, sub, regCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining selector for OCI artifact %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		refs, err := selector.Select(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering newest applicable OCI artifacts %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+
+		if len(refs) == 0 {
+			results = append(results, kargoapi.OCIDiscoveryResult{
+				RepoURL:      sub.RepoURL,
+				ArtifactType: sub.ArtifactType,
+			})
+			logger.Debug("discovered no OCI artifacts")
+			continue
+		}
+
+		results = append(results, kargoapi.OCIDiscoveryResult{
+			RepoURL:      sub.RepoURL,
+			ArtifactType: sub.ArtifactType,
+			References:   refs,
+		})
+		logger.Debug(
+			"discovered OCI artifacts",
+			"count", len(refs),
+		)
+	}
+
+	return results, nil
+}
@@ -58,6 +58,10 @@ type reconciler struct {
 
 	discoverChartsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ChartDiscoveryResult, error)
 
+	discoverOCIArtifactsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.OCIDiscoveryResult, error)
+
+	discoverObjectsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.BucketDiscoveryResult, error)
+
 	buildFreightFromLatestArtifactsFn func(string, *kargoapi.DiscoveredArtifacts) (*kargoapi.Freight, error)
 
 	createFreightFn func(context.Context, client.Object, ...client.CreateOption) error
@@ -119,6 +123,8 @@ func newReconciler(
 	r.discoverCommitsFn = r.discoverCommits
 	r.discoverImagesFn = r.discoverImages
 	r.discoverChartsFn = r.discoverCharts
+	r.discoverOCIArtifactsFn = r.discoverOCIArtifacts
+	r.discoverObjectsFn = r.discoverObjects
 	r.buildFreightFromLatestArtifactsFn = r.buildFreightFromLatestArtifacts
 	r.patchStatusFn = r.patchStatus
 	return r
@@ -525,11 +531,23 @@ func (r *reconciler) discoverArtifacts(
 		return nil, fmt.Errorf("error discovering charts: %w", err)
 	}
 
+	ociArtifacts, err := r.discoverOCIArtifactsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering OCI artifacts: %w", err)
+	}
+
+	objects, err := r.discoverObjectsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering objects: %w", err)
+	}
+
 	return &kargoapi.DiscoveredArtifacts{
 		DiscoveredAt: metav1.Now(),
 		Git:          commits,
 		Images:       images,
 		Charts:       charts,
+		OCI:          ociArtifacts,
+		Buckets:      objects,
 	}, nil
 }
 
@@ -560,6 +578,7 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 			Message:   latestCommit.Subject,
 			Author:    latestCommit.Author,
 			Committer: latestCommit.Committer,
+			Signer:    latestCommit.Signer,
 		})
 	}
 
@@ -592,6 +611,31 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		})
 	}
 
+	for _, result := range artifacts.OCI {
+		if len(result.References) == 0 {
+			return nil, fmt.Errorf("no OCI artifacts discovered for repository %q", result.RepoURL)
+		}
+		latestArtifact := result.References[0]
+		freight.Artifacts = append(freight.Artifacts, kargoapi.OCIArtifact{
+			RepoURL:     result.RepoURL,
+			Tag:         latestArtifact.Tag,
+			Digest:      latestArtifact.Digest,
+			Annotations: latestArtifact.Annotations,
+		})
+	}
+
+	for _, result := range artifacts.Buckets {
+		if len(result.Objects) == 0 {
+			return nil, fmt.Errorf("no objects discovered for bucket %q", result.URL)
+		}
+		latestObject := result.Objects[0]
+		freight.Objects = append(freight.Objects, kargoapi.BucketObject{
+			URL:  result.URL,
+			Key:  latestObject.Key,
+			ETag: latestObject.ETag,
+		})
+	}
+
 	// Generate a unique ID for the Freight based on its contents.
 	freight.Name = api.GenerateFreightID(freight)
 
@@ -615,7 +659,8 @@ func validateDiscoveredArtifacts(
 ) bool {
 	artifacts := newStatus.DiscoveredArtifacts
 
-	if artifacts == nil || len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts) == 0 {
+	if artifacts == nil ||
+		len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts)+len(artifacts.OCI)+len(artifacts.Buckets) == 0 {
 		message := "No artifacts discovered"
 		conditions.Set(
 			newStatus,
@@ -733,6 +778,72 @@ func validateDiscoveredArtifacts(
 		charts += count
 	}
 
+	var ociArtifacts int
+	for _, artifact := range artifacts.OCI {
+		count := len(artifact.References)
+
+		if count == 0 {
+			message := fmt.Sprintf("No references discovered for OCI repository %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoOCIReferencesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingOCIReferences",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		ociArtifacts += count
+	}
+
+	var objects int
+	for _, artifact := range artifacts.Buckets {
+		count := len(artifact.Objects)
+
+		if count == 0 {
+			message := fmt.Sprintf("No objects discovered for bucket %q", artifact.URL)
+			if artifact.Prefix != "" {
+				message = fmt.Sprintf(
+					"No objects discovered for bucket %q with prefix %q",
+					artifact.URL, artifact.Prefix,
+				)
+			}
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoObjectsDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingObjects",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		objects += count
+	}
+
 	return true
 }
 
@@ -758,7 +869,8 @@ func freightCreationCriteriaSatisfied(
 		return true, nil
 	}
 
-	if artifacts == nil || (len(artifacts.Git) == 0 && len(artifacts.Images) == 0 && len(artifacts.Charts) == 0) {
+	if artifacts == nil || (len(artifacts.Git) == 0 && len(artifacts.Images) == 0 &&
+		len(artifacts.Charts) == 0 && len(artifacts.OCI) == 0 && len(artifacts.Buckets) == 0) {
 		logger.Trace("no artifacts discovered")
 		return true, nil
 	}
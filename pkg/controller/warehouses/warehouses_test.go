@@ -41,6 +41,8 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.discoverCommitsFn)
 	require.NotNil(t, e.discoverImagesFn)
 	require.NotNil(t, e.discoverChartsFn)
+	require.NotNil(t, e.discoverOCIArtifactsFn)
+	require.NotNil(t, e.discoverObjectsFn)
 	require.NotNil(t, e.buildFreightFromLatestArtifactsFn)
 	require.NotNil(t, e.createFreightFn)
 	require.NotNil(t, e.patchStatusFn)
@@ -683,6 +685,80 @@ func TestDiscoverArtifacts(t *testing.T) {
 				require.Nil(t, discoveredArtifacts)
 			},
 		},
+		{
+			name: "error discovering OCI artifacts",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering OCI artifacts")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
+		{
+			name: "error discovering objects",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIDiscoveryResult, error) {
+					return []kargoapi.OCIDiscoveryResult{}, nil
+				},
+				discoverObjectsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.BucketDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering objects")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -716,12 +792,34 @@ func TestDiscoverArtifacts(t *testing.T) {
 						}},
 					}, nil
 				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIDiscoveryResult, error) {
+					return []kargoapi.OCIDiscoveryResult{
+						{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{
+							{Tag: "fake-tag"},
+						}},
+					}, nil
+				},
+				discoverObjectsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.BucketDiscoveryResult, error) {
+					return []kargoapi.BucketDiscoveryResult{
+						{URL: "https://fake-bucket", Objects: []kargoapi.DiscoveredObject{
+							{Key: "fake-key"},
+						}},
+					}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.NoError(t, err)
 				require.Len(t, discoveredArtifacts.Git, 1)
 				require.Len(t, discoveredArtifacts.Images, 1)
 				require.Len(t, discoveredArtifacts.Charts, 1)
+				require.Len(t, discoveredArtifacts.OCI, 1)
+				require.Len(t, discoveredArtifacts.Buckets, 1)
 			},
 		},
 	}
@@ -796,6 +894,30 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Nil(t, freight)
 			},
 		},
+		{
+			name: "no OCI artifacts discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				OCI: []kargoapi.OCIDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no OCI artifacts discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "no objects discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Buckets: []kargoapi.BucketDiscoveryResult{
+					{URL: "https://fake-bucket", Objects: []kargoapi.DiscoveredObject{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no objects discovered for bucket")
+				require.Nil(t, freight)
+			},
+		},
 		{
 			name: "success",
 			artifacts: &kargoapi.DiscoveredArtifacts{
@@ -811,6 +933,12 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
 				},
+				OCI: []kargoapi.OCIDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{Tag: "fake-tag"}}},
+				},
+				Buckets: []kargoapi.BucketDiscoveryResult{
+					{URL: "https://fake-bucket", Objects: []kargoapi.DiscoveredObject{{Key: "fake-key", ETag: "fake-etag"}}},
+				},
 			},
 			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
 				require.NoError(t, err)
@@ -818,6 +946,8 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Len(t, freight.Commits, 2)
 				require.Len(t, freight.Images, 2)
 				require.Len(t, freight.Charts, 2)
+				require.Len(t, freight.Artifacts, 1)
+				require.Len(t, freight.Objects, 1)
 			},
 		},
 	}
@@ -904,6 +1034,65 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
 		},
+		{
+			name: "OCI repository with no references",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					OCI: []kargoapi.OCIDiscoveryResult{
+						{RepoURL: "ghcr.io/example/module"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingOCIReferences", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No references discovered for OCI repository")
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoOCIReferencesDiscovered", healthyCondition.Reason)
+			},
+		},
+		{
+			name: "bucket with no objects",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Buckets: []kargoapi.BucketDiscoveryResult{
+						{URL: "https://s3.example.com/builds", Prefix: "my-app/"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingObjects", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No objects discovered for bucket")
+				require.Contains(t, readyCondition.Message, `with prefix "my-app/"`)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoObjectsDiscovered", healthyCondition.Reason)
+			},
+		},
 		{
 			name: "image repository with no references",
 			warehouse: &kargoapi.Warehouse{
@@ -1215,6 +1404,13 @@ func TestReconcile(t *testing.T) {
 					) ([]kargoapi.ChartDiscoveryResult, error) {
 						return []kargoapi.ChartDiscoveryResult{}, nil
 					},
+					discoverOCIArtifactsFn: func(
+						context.Context,
+						string,
+						[]kargoapi.RepoSubscription,
+					) ([]kargoapi.OCIDiscoveryResult, error) {
+						return []kargoapi.OCIDiscoveryResult{}, nil
+					},
 					buildFreightFromLatestArtifactsFn: func(
 						string,
 						*kargoapi.DiscoveredArtifacts,
@@ -26,6 +26,8 @@ const (
 	TypeHelm Type = "helm"
 	// TypeImage represents credentials for an image repository.
 	TypeImage Type = "image"
+	// TypeBucket represents credentials for an S3-compatible bucket.
+	TypeBucket Type = "bucket"
 )
 
 type Request struct {
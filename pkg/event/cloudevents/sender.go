@@ -0,0 +1,348 @@
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/kelseyhightower/envconfig"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification implemented
+	// by the Sender.
+	SpecVersion = "1.0"
+
+	// ExtensionProject is the name of the CloudEvents extension attribute
+	// containing the name of the Project the event originated from.
+	ExtensionProject = "kargoproject"
+
+	contentTypeJSON           = "application/json"
+	contentTypeCloudEventJSON = "application/cloudevents+json"
+
+	// maxResponseBodySize is the maximum number of bytes of an unsuccessful
+	// response's body that will be included in an error message.
+	maxResponseBodySize = 1 << 10
+)
+
+// Mode is the CloudEvents HTTP content mode used to send events.
+type Mode string
+
+const (
+	// ModeBinary sends the event's data as the request body, with the event's
+	// attributes sent as ce- prefixed headers.
+	ModeBinary Mode = "binary"
+	// ModeStructured sends the entire event, including its attributes, as a
+	// single JSON document in the request body.
+	ModeStructured Mode = "structured"
+)
+
+// SenderConfig represents configuration for a Sender.
+type SenderConfig struct {
+	// SinkURL is the URL to which events are sent. When empty, the sending of
+	// CloudEvents is disabled.
+	SinkURL string `envconfig:"CLOUDEVENTS_SINK_URL"`
+	// Mode is the CloudEvents HTTP content mode used to send events.
+	Mode Mode `envconfig:"CLOUDEVENTS_MODE" default:"binary"`
+	// Source is the prefix of the source attribute of every event. The name of
+	// the Project the event originated from is appended to it.
+	Source string `envconfig:"CLOUDEVENTS_SOURCE" default:"/kargo"`
+	// Timeout is the maximum time to wait for the sink to respond.
+	Timeout time.Duration `envconfig:"CLOUDEVENTS_TIMEOUT" default:"10s"`
+	// QueueSize is the maximum number of events that may be waiting to be sent
+	// to the sink at any given time. Events sent while the queue is full are
+	// dropped.
+	QueueSize int `envconfig:"CLOUDEVENTS_QUEUE_SIZE" default:"1000"`
+}
+
+// NewSenderFromEnv returns a Sender configured from environment variables, or
+// nil if no sink is configured. It is meant to be called once per process so
+// that every component of the process shares a single Sender and its queue.
+func NewSenderFromEnv(ctx context.Context) (*Sender, error) {
+	cfg := SenderConfig{}
+	if err := envconfig.Process("", &cfg); err != nil {
+		return nil, fmt.Errorf("error reading CloudEvents configuration: %w", err)
+	}
+	if cfg.SinkURL == "" {
+		return nil, nil
+	}
+	return NewSender(ctx, cfg)
+}
+
+// Sender is an implementation of event.Sender that sends events to an HTTP
+// sink as CloudEvents. Events are sent asynchronously so that callers, which
+// include admission webhooks, are never blocked by a slow or unavailable sink.
+type Sender struct {
+	cfg        SenderConfig
+	httpClient *http.Client
+	queue      chan *http.Request
+	nowFn      func() time.Time
+}
+
+// NewSender returns a new Sender that sends events to the sink described by
+// the provided SenderConfig. Queued events are sent until the provided context
+// is canceled.
+func NewSender(ctx context.Context, cfg SenderConfig) (*Sender, error) {
+	u, err := url.Parse(cfg.SinkURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing sink URL %q: %w", cfg.SinkURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf(
+			"sink URL %q has unsupported scheme %q", cfg.SinkURL, u.Scheme,
+		)
+	}
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = ModeBinary
+	case ModeBinary, ModeStructured:
+	default:
+		return nil, fmt.Errorf("unsupported CloudEvents mode %q", cfg.Mode)
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1000
+	}
+	s := &Sender{
+		cfg: cfg,
+		httpClient: &http.Client{
+			Transport: cleanhttp.DefaultTransport(),
+			Timeout:   cfg.Timeout,
+		},
+		queue: make(chan *http.Request, cfg.QueueSize),
+		nowFn: time.Now,
+	}
+	go s.run(ctx)
+	return s, nil
+}
+
+// WithSink returns a Sender that sends every event to the provided Sender
+// and, if a CloudEvents Sender is provided, to its sink as a CloudEvent. If no
+// CloudEvents Sender is provided, the provided Sender is returned as is.
+func WithSink(sender event.Sender, sink *Sender) event.Sender {
+	if sink == nil {
+		return sender
+	}
+	return event.NewMultiSender(sender, sink)
+}
+
+// TypeFromEventType returns the CloudEvents type attribute for the provided
+// Kargo event type. Types are formed by prefixing the Kargo event type with
+// the reverse-DNS form of the Kargo API group and its version, e.g.
+// "io.akuity.kargo.v1alpha1.PromotionSucceeded".
+func TypeFromEventType(eventType kargoapi.EventType) string {
+	groupParts := strings.Split(kargoapi.GroupVersion.Group, ".")
+	for i, j := 0, len(groupParts)-1; i < j; i, j = i+1, j-1 {
+		groupParts[i], groupParts[j] = groupParts[j], groupParts[i]
+	}
+	return fmt.Sprintf(
+		"%s.%s.%s",
+		strings.Join(groupParts, "."),
+		kargoapi.GroupVersion.Version,
+		eventType,
+	)
+}
+
+// attributes is the set of CloudEvents context attributes of an event.
+type attributes struct {
+	ID              string
+	Source          string
+	Type            string
+	Subject         string
+	Time            time.Time
+	DataContentType string
+	Project         string
+}
+
+// Send implements event.Sender. The event is converted to a CloudEvent and
+// queued to be sent to the sink. An error is returned only if the event could
+// not be converted or the queue is full.
+func (s *Sender) Send(ctx context.Context, evt event.Meta) error {
+	req, err := s.newRequest(evt)
+	if err != nil {
+		return err
+	}
+	select {
+	case s.queue <- req:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return fmt.Errorf(
+			"CloudEvents queue is full; dropping %s event", evt.Type(),
+		)
+	}
+}
+
+// run sends queued events to the sink until the provided context is canceled.
+func (s *Sender) run(ctx context.Context) {
+	logger := logging.LoggerFromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case req := <-s.queue:
+			if err := s.send(req.WithContext(ctx)); err != nil {
+				logger.Error(
+					err, "error sending CloudEvent",
+					"type", req.Header.Get("ce-type"),
+				)
+			}
+		}
+	}
+}
+
+// newRequest returns a request for sending the provided event to the sink
+// using the configured content mode.
+func (s *Sender) newRequest(evt event.Meta) (*http.Request, error) {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling event data: %w", err)
+	}
+	attrs := s.attributesFor(evt)
+	switch s.cfg.Mode {
+	case ModeStructured:
+		return newStructuredRequest(s.cfg.SinkURL, attrs, data)
+	default:
+		return newBinaryRequest(s.cfg.SinkURL, attrs, data)
+	}
+}
+
+// send sends the provided request to the sink.
+func (s *Sender) send(req *http.Request) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending CloudEvent to sink: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+		return fmt.Errorf(
+			"sink responded to CloudEvent with unexpected status code %d: %s",
+			resp.StatusCode, bytes.TrimSpace(body),
+		)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// attributesFor returns the CloudEvents context attributes for the provided
+// event.
+func (s *Sender) attributesFor(evt event.Meta) attributes {
+	id := evt.GetID()
+	if id == "" {
+		// Events are generally only assigned an ID once they have been recorded
+		// by Kubernetes, so one must usually be generated.
+		id = uuid.NewString()
+	}
+	var evtTime time.Time
+	if timed, ok := evt.(event.Timed); ok {
+		evtTime = timed.GetTime()
+	}
+	if evtTime.IsZero() {
+		// Only events that do not record the time at which they occurred lack
+		// one. The time at which the event was sent is the best approximation
+		// available.
+		evtTime = s.nowFn()
+	}
+	attrs := attributes{
+		ID:              id,
+		Source:          strings.TrimSuffix(s.cfg.Source, "/") + "/" + evt.GetProject(),
+		Type:            TypeFromEventType(evt.Type()),
+		Time:            evtTime.UTC(),
+		DataContentType: contentTypeJSON,
+		Project:         evt.GetProject(),
+	}
+	if evt.Kind() != "" && evt.GetName() != "" {
+		attrs.Subject = strings.ToLower(evt.Kind()) + "/" + evt.GetName()
+	}
+	return attrs
+}
+
+// newBinaryRequest returns a request for sending an event in binary content
+// mode.
+func newBinaryRequest(
+	sinkURL string,
+	attrs attributes,
+	data []byte,
+) (*http.Request, error) {
+	req, err := http.NewRequest(
+		http.MethodPost,
+		sinkURL,
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", attrs.DataContentType)
+	req.Header.Set("ce-specversion", SpecVersion)
+	req.Header.Set("ce-id", attrs.ID)
+	req.Header.Set("ce-source", attrs.Source)
+	req.Header.Set("ce-type", attrs.Type)
+	req.Header.Set("ce-time", attrs.Time.Format(time.RFC3339Nano))
+	if attrs.Subject != "" {
+		req.Header.Set("ce-subject", attrs.Subject)
+	}
+	if attrs.Project != "" {
+		req.Header.Set("ce-"+ExtensionProject, attrs.Project)
+	}
+	return req, nil
+}
+
+// structuredEvent is the JSON representation of an event sent in structured
+// content mode.
+type structuredEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Project         string          `json:"kargoproject,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// newStructuredRequest returns a request for sending an event in structured
+// content mode.
+func newStructuredRequest(
+	sinkURL string,
+	attrs attributes,
+	data []byte,
+) (*http.Request, error) {
+	body, err := json.Marshal(structuredEvent{
+		SpecVersion:     SpecVersion,
+		ID:              attrs.ID,
+		Source:          attrs.Source,
+		Type:            attrs.Type,
+		Subject:         attrs.Subject,
+		Time:            attrs.Time.Format(time.RFC3339Nano),
+		DataContentType: attrs.DataContentType,
+		Project:         attrs.Project,
+		Data:            data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling CloudEvent: %w", err)
+	}
+	req, err := http.NewRequest(
+		http.MethodPost,
+		sinkURL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentTypeCloudEventJSON)
+	return req, nil
+}
@@ -0,0 +1,264 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
+)
+
+func TestTypeFromEventType(t *testing.T) {
+	require.Equal(
+		t,
+		"io.akuity.kargo.v1alpha1.PromotionSucceeded",
+		TypeFromEventType(kargoapi.EventTypePromotionSucceeded),
+	)
+	require.Equal(
+		t,
+		"io.akuity.kargo.v1alpha1.FreightVerificationFailed",
+		TypeFromEventType(kargoapi.EventTypeFreightVerificationFailed),
+	)
+}
+
+func TestNewSender(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        SenderConfig
+		assertions func(*testing.T, *Sender, error)
+	}{
+		{
+			name: "unsupported scheme",
+			cfg:  SenderConfig{SinkURL: "ftp://example.com"},
+			assertions: func(t *testing.T, _ *Sender, err error) {
+				require.ErrorContains(t, err, "unsupported scheme")
+			},
+		},
+		{
+			name: "unsupported mode",
+			cfg: SenderConfig{
+				SinkURL: "https://example.com",
+				Mode:    "bogus",
+			},
+			assertions: func(t *testing.T, _ *Sender, err error) {
+				require.ErrorContains(t, err, "unsupported CloudEvents mode")
+			},
+		},
+		{
+			name: "defaults",
+			cfg:  SenderConfig{SinkURL: "https://example.com"},
+			assertions: func(t *testing.T, s *Sender, err error) {
+				require.NoError(t, err)
+				require.Equal(t, ModeBinary, s.cfg.Mode)
+				require.Equal(t, 1000, cap(s.queue))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			s, err := NewSender(ctx, testCase.cfg)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestNewSenderFromEnv(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	t.Setenv("CLOUDEVENTS_SINK_URL", "")
+	s, err := NewSenderFromEnv(ctx)
+	require.NoError(t, err)
+	require.Nil(t, s)
+
+	t.Setenv("CLOUDEVENTS_SINK_URL", "https://example.com")
+	s, err = NewSenderFromEnv(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+
+	t.Setenv("CLOUDEVENTS_TIMEOUT", "bogus")
+	_, err = NewSenderFromEnv(ctx)
+	require.ErrorContains(t, err, "error reading CloudEvents configuration")
+}
+
+func TestWithSink(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	sender := event.NewMultiSender()
+
+	require.Same(t, sender, WithSink(sender, nil))
+
+	sink, err := NewSender(ctx, SenderConfig{SinkURL: "https://example.com"})
+	require.NoError(t, err)
+	require.IsType(t, &event.MultiSender{}, WithSink(sender, sink))
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func TestSender_Send(t *testing.T) {
+	evtTime := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	testEvt := &event.PromotionSucceeded{
+		Common: event.Common{
+			Project: "fake-project",
+			Message: "Promotion succeeded",
+			ID:      "fake-id",
+			Time:    evtTime,
+		},
+		Promotion: event.Promotion{
+			Name:      "fake-promotion",
+			StageName: "fake-stage",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		mode       Mode
+		assertions func(*testing.T, receivedRequest)
+	}{
+		{
+			name: "binary mode",
+			mode: ModeBinary,
+			assertions: func(t *testing.T, req receivedRequest) {
+				require.Equal(t, "application/json", req.header.Get("Content-Type"))
+				require.Equal(t, "1.0", req.header.Get("ce-specversion"))
+				require.Equal(t, "fake-id", req.header.Get("ce-id"))
+				require.Equal(t, "/kargo/fake-project", req.header.Get("ce-source"))
+				require.Equal(
+					t,
+					"io.akuity.kargo.v1alpha1.PromotionSucceeded",
+					req.header.Get("ce-type"),
+				)
+				require.Equal(t, "promotion/fake-promotion", req.header.Get("ce-subject"))
+				require.Equal(t, "2025-01-02T03:04:05Z", req.header.Get("ce-time"))
+				require.Equal(t, "fake-project", req.header.Get("ce-kargoproject"))
+
+				data := map[string]any{}
+				require.NoError(t, json.Unmarshal(req.body, &data))
+				require.Equal(t, "fake-stage", data["stageName"])
+				require.Equal(t, "Promotion succeeded", data["message"])
+			},
+		},
+		{
+			name: "structured mode",
+			mode: ModeStructured,
+			assertions: func(t *testing.T, req receivedRequest) {
+				require.Equal(
+					t,
+					"application/cloudevents+json",
+					req.header.Get("Content-Type"),
+				)
+				require.Empty(t, req.header.Get("ce-id"))
+
+				ce := map[string]any{}
+				require.NoError(t, json.Unmarshal(req.body, &ce))
+				require.Equal(t, "1.0", ce["specversion"])
+				require.Equal(t, "fake-id", ce["id"])
+				require.Equal(t, "/kargo/fake-project", ce["source"])
+				require.Equal(t, "io.akuity.kargo.v1alpha1.PromotionSucceeded", ce["type"])
+				require.Equal(t, "promotion/fake-promotion", ce["subject"])
+				require.Equal(t, "2025-01-02T03:04:05Z", ce["time"])
+				require.Equal(t, "application/json", ce["datacontenttype"])
+				require.Equal(t, "fake-project", ce["kargoproject"])
+				data, ok := ce["data"].(map[string]any)
+				require.True(t, ok)
+				require.Equal(t, "fake-stage", data["stageName"])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			received := make(chan receivedRequest, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				received <- receivedRequest{header: r.Header, body: body}
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			t.Cleanup(srv.Close)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			t.Cleanup(cancel)
+			s, err := NewSender(ctx, SenderConfig{
+				SinkURL: srv.URL,
+				Mode:    testCase.mode,
+				Source:  "/kargo/",
+				Timeout: 5 * time.Second,
+			})
+			require.NoError(t, err)
+			// The time of the event, rather than the time at which it is sent,
+			// should be used.
+			s.nowFn = func() time.Time { return evtTime.Add(time.Hour) }
+
+			require.NoError(t, s.Send(context.Background(), testEvt))
+			select {
+			case req := <-received:
+				testCase.assertions(t, req)
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "timed out waiting for CloudEvent")
+			}
+		})
+	}
+}
+
+func TestSender_Send_generatesID(t *testing.T) {
+	s := &Sender{
+		cfg:   SenderConfig{SinkURL: "https://example.com", Mode: ModeBinary},
+		queue: make(chan *http.Request, 1),
+		nowFn: time.Now,
+	}
+	require.NoError(t, s.Send(context.Background(), &event.FreightApproved{}))
+	req := <-s.queue
+	require.NotEmpty(t, req.Header.Get("ce-id"))
+}
+
+func TestSender_Send_defaultsTime(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := &Sender{
+		cfg:   SenderConfig{SinkURL: "https://example.com", Mode: ModeBinary},
+		queue: make(chan *http.Request, 1),
+		nowFn: func() time.Time { return now },
+	}
+	require.NoError(t, s.Send(context.Background(), &event.Custom{EventType: "Custom"}))
+	req := <-s.queue
+	require.Equal(t, "2025-01-02T03:04:05Z", req.Header.Get("ce-time"))
+}
+
+func TestSender_Send_queueFull(t *testing.T) {
+	s := &Sender{
+		cfg:   SenderConfig{SinkURL: "https://example.com", Mode: ModeBinary},
+		queue: make(chan *http.Request),
+		nowFn: time.Now,
+	}
+	err := s.Send(context.Background(), &event.FreightApproved{})
+	require.ErrorContains(t, err, "queue is full")
+}
+
+func TestSender_send(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("bad event"))
+	}))
+	t.Cleanup(srv.Close)
+
+	s := &Sender{
+		cfg:        SenderConfig{SinkURL: srv.URL, Mode: ModeBinary},
+		httpClient: srv.Client(),
+		nowFn:      time.Now,
+	}
+	req, err := s.newRequest(&event.FreightApproved{})
+	require.NoError(t, err)
+	err = s.send(req)
+	require.ErrorContains(t, err, "unexpected status code 400: bad event")
+}
@@ -0,0 +1,313 @@
+package commitstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/gitprovider"
+	"github.com/akuity/kargo/pkg/logging"
+
+	_ "github.com/akuity/kargo/pkg/gitprovider/azure"     // Azure provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/bitbucket" // Bitbucket provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitea"     // Gitea provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/github"    // GitHub provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitlab"    // GitLab provider registration
+)
+
+const (
+	// defaultContext is the prefix of the context under which commit statuses
+	// are reported when a Project's configuration does not specify one.
+	defaultContext = "kargo"
+
+	// reportTimeout is the maximum amount of time spent reporting the state of
+	// a single Promotion to Git providers.
+	reportTimeout = time.Minute
+
+	// reportWorkers is the maximum number of Promotions whose states are
+	// reported to Git providers concurrently.
+	reportWorkers = 4
+
+	// reportQueueSize is the maximum number of Promotions whose states may be
+	// awaiting a report. States of Promotions are dropped while the queue is
+	// full.
+	reportQueueSize = 1000
+
+	// startedDescription is the description of the pending commit statuses
+	// reported when a Promotion starts.
+	startedDescription = "Promotion started"
+)
+
+// Sender is an implementation of event.Sender that reports the states of
+// Promotions to Git providers as statuses (and optionally deployments) of the
+// commits referenced by the Freight being promoted. Whether and how this
+// happens is configured per Project in the Project's ProjectConfig. Outcomes
+// of Promotions are reported when events describing them are sent. Events of
+// any other type are ignored. The start of a Promotion is reported using
+// ReportStarted.
+//
+// Reports are queued and made by a fixed number of workers so that callers
+// are never blocked by a slow or unavailable Git provider.
+type Sender struct {
+	client    client.Client
+	credsDB   credentials.Database
+	uiBaseURL string
+	queue     chan statusReport
+
+	newGitProviderFn func(string, *gitprovider.Options) (gitprovider.Interface, error)
+}
+
+// statusReport describes the state of a single Promotion, to be reported as
+// the status of each of the commits referenced by the Freight being promoted.
+type statusReport struct {
+	project     string
+	promotion   event.Promotion
+	state       gitprovider.CommitState
+	description string
+}
+
+// NewSender returns a new Sender. The provided client is used to look up
+// ProjectConfigs and the provided credentials.Database is used to look up the
+// credentials used for authenticating to Git providers. If a UI base URL is
+// provided, reported statuses link to the relevant Stage in the Kargo UI.
+// Queued reports are made until the provided context is canceled.
+func NewSender(
+	ctx context.Context,
+	c client.Client,
+	credsDB credentials.Database,
+	uiBaseURL string,
+) *Sender {
+	s := &Sender{
+		client:           c,
+		credsDB:          credsDB,
+		uiBaseURL:        uiBaseURL,
+		queue:            make(chan statusReport, reportQueueSize),
+		newGitProviderFn: gitprovider.New,
+	}
+	for range reportWorkers {
+		go s.run(ctx)
+	}
+	return s
+}
+
+// Send implements event.Sender. If the event describes the outcome of a
+// Promotion, the outcome is queued to be reported. An error is returned only
+// if the queue is full.
+func (s *Sender) Send(_ context.Context, evt event.Meta) error {
+	promo, state, ok := promotionOutcome(evt)
+	if !ok {
+		return nil
+	}
+	var description string
+	if msg, ok := evt.(event.Message); ok {
+		description = msg.GetMessage()
+	}
+	return s.enqueue(statusReport{
+		project:     evt.GetProject(),
+		promotion:   promo,
+		state:       state,
+		description: description,
+	})
+}
+
+// ReportStarted queues a report that the provided Promotion of the provided
+// Freight has started, which is reported as a pending status of each of the
+// commits referenced by the Freight. An error is returned only if the queue is
+// full.
+func (s *Sender) ReportStarted(
+	_ context.Context,
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+) error {
+	_, p := event.NewPromotionCommon("", "", promo, freight)
+	return s.enqueue(statusReport{
+		project:     promo.Namespace,
+		promotion:   p,
+		state:       gitprovider.CommitStatePending,
+		description: startedDescription,
+	})
+}
+
+// enqueue queues the provided report without blocking.
+func (s *Sender) enqueue(r statusReport) error {
+	select {
+	case s.queue <- r:
+		return nil
+	default:
+		return fmt.Errorf(
+			"commit status queue is full; dropping %s status of Promotion %q",
+			r.state, r.promotion.Name,
+		)
+	}
+}
+
+// run makes queued reports until the provided context is canceled.
+func (s *Sender) run(ctx context.Context) {
+	logger := logging.LoggerFromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-s.queue:
+			reportCtx, cancel := context.WithTimeout(ctx, reportTimeout)
+			if err := s.report(reportCtx, r); err != nil {
+				logger.Error(
+					err, "error reporting commit statuses",
+					"project", r.project,
+					"promotion", r.promotion.Name,
+				)
+			}
+			cancel()
+		}
+	}
+}
+
+// report reports the state of the Promotion described by the provided report
+// to the Git providers hosting each of the commits referenced by the Freight
+// being promoted. Failure to report to one Git provider does not prevent
+// reporting to others. Any errors encountered are joined and returned.
+func (s *Sender) report(ctx context.Context, r statusReport) error {
+	promo := r.promotion
+	if promo.Freight == nil || len(promo.Freight.Commits) == 0 {
+		return nil
+	}
+
+	projectCfg, err := api.GetProjectConfig(ctx, s.client, r.project)
+	if err != nil {
+		return err
+	}
+	if projectCfg == nil || projectCfg.Spec.CommitStatuses == nil {
+		return nil
+	}
+	cfg := projectCfg.Spec.CommitStatuses
+	if len(cfg.Stages) > 0 && !slices.Contains(cfg.Stages, promo.StageName) {
+		return nil
+	}
+
+	statusCtx := cfg.Context
+	if statusCtx == "" {
+		statusCtx = defaultContext
+	}
+	statusCtx = fmt.Sprintf("%s/%s", statusCtx, promo.StageName)
+
+	var targetURL string
+	if s.uiBaseURL != "" {
+		targetURL = fmt.Sprintf(
+			"%s/project/%s/stage/%s",
+			s.uiBaseURL, r.project, promo.StageName,
+		)
+	}
+
+	var errs []error
+	for _, commit := range promo.Freight.Commits {
+		if commit.ID == "" {
+			// Without an ID, there is nothing to attach a status to.
+			continue
+		}
+		if err = s.reportCommit(
+			ctx,
+			r.project,
+			cfg,
+			commit,
+			&gitprovider.CommitStatusOpts{
+				CommitID:    commit.ID,
+				State:       r.state,
+				Context:     statusCtx,
+				Description: r.description,
+				TargetURL:   targetURL,
+			},
+			promo.StageName,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reportCommit reports a single commit's status and, if configured to do so, a
+// deployment of the commit to the environment named after the Stage.
+func (s *Sender) reportCommit(
+	ctx context.Context,
+	project string,
+	cfg *kargoapi.CommitStatusConfig,
+	commit kargoapi.GitCommit,
+	statusOpts *gitprovider.CommitStatusOpts,
+	stage string,
+) error {
+	gpOpts := &gitprovider.Options{
+		InsecureSkipTLSVerify: cfg.InsecureSkipTLSVerify,
+	}
+	creds, err := s.credsDB.Get(ctx, project, credentials.TypeGit, commit.RepoURL)
+	if err != nil {
+		return fmt.Errorf("error getting credentials for %s: %w", commit.RepoURL, err)
+	}
+	if creds != nil {
+		gpOpts.Token = creds.Password
+	}
+	gitProv, err := s.newGitProviderFn(commit.RepoURL, gpOpts)
+	if err != nil {
+		return fmt.Errorf(
+			"error creating git provider service for %s: %w", commit.RepoURL, err,
+		)
+	}
+
+	if err = gitProv.SetCommitStatus(ctx, statusOpts); err != nil {
+		return fmt.Errorf(
+			"error setting status of commit %s in %s: %w",
+			commit.ID, commit.RepoURL, err,
+		)
+	}
+
+	if !cfg.Deployments {
+		return nil
+	}
+	ref := commit.Branch
+	if ref == "" {
+		ref = commit.Tag
+	}
+	if err = gitProv.SetDeploymentStatus(
+		ctx,
+		&gitprovider.DeploymentStatusOpts{
+			CommitID:    commit.ID,
+			Ref:         ref,
+			Environment: stage,
+			State:       statusOpts.State,
+			Description: statusOpts.Description,
+			TargetURL:   statusOpts.TargetURL,
+		},
+	); err != nil && !errors.Is(err, gitprovider.ErrUnsupported) {
+		return fmt.Errorf(
+			"error setting deployment status of commit %s in %s: %w",
+			commit.ID, commit.RepoURL, err,
+		)
+	}
+	return nil
+}
+
+// promotionOutcome returns the Promotion described by the provided event and
+// the commit state corresponding to the Promotion's outcome. If the event does
+// not describe the outcome of a Promotion, false is returned.
+func promotionOutcome(
+	evt event.Meta,
+) (event.Promotion, gitprovider.CommitState, bool) {
+	switch e := evt.(type) {
+	case *event.PromotionSucceeded:
+		return e.Promotion, gitprovider.CommitStateSuccess, true
+	case *event.PromotionFailed:
+		return e.Promotion, gitprovider.CommitStateFailure, true
+	case *event.PromotionErrored:
+		return e.Promotion, gitprovider.CommitStateError, true
+	case *event.PromotionAborted:
+		return e.Promotion, gitprovider.CommitStateError, true
+	default:
+		return event.Promotion{}, "", false
+	}
+}
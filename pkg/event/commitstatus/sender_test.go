@@ -0,0 +1,327 @@
+package commitstatus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/gitprovider"
+)
+
+func TestSender_report(t *testing.T) {
+	const (
+		testProject = "fake-project"
+		testRepoURL = "https://github.com/example/repo"
+	)
+
+	testPromotion := event.Promotion{
+		Name:      "fake-promotion",
+		StageName: "fake-stage",
+		Freight: &event.Freight{
+			Commits: []kargoapi.GitCommit{
+				{RepoURL: testRepoURL, ID: "abc123", Branch: "main"},
+				// Commits without an ID should be skipped
+				{RepoURL: testRepoURL},
+			},
+		},
+	}
+
+	newProjectConfig := func(cfg *kargoapi.CommitStatusConfig) *kargoapi.ProjectConfig {
+		return &kargoapi.ProjectConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testProject,
+				Namespace: testProject,
+			},
+			Spec: kargoapi.ProjectConfigSpec{CommitStatuses: cfg},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		projectCfg *kargoapi.ProjectConfig
+		evt        event.Meta
+		provider   *fakeProvider
+		assertions func(*testing.T, *fakeProvider, error)
+	}{
+		{
+			name: "event is not a Promotion outcome",
+			evt: &event.PromotionCreated{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(&kargoapi.CommitStatusConfig{}),
+			provider:   &fakeProvider{},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Empty(t, p.statuses)
+			},
+		},
+		{
+			name: "ProjectConfig does not exist",
+			evt: &event.PromotionSucceeded{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			provider: &fakeProvider{},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Empty(t, p.statuses)
+			},
+		},
+		{
+			name: "commit statuses are not configured",
+			evt: &event.PromotionSucceeded{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(nil),
+			provider:   &fakeProvider{},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Empty(t, p.statuses)
+			},
+		},
+		{
+			name: "Stage is not selected",
+			evt: &event.PromotionSucceeded{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(&kargoapi.CommitStatusConfig{
+				Stages: []string{"another-stage"},
+			}),
+			provider: &fakeProvider{},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Empty(t, p.statuses)
+			},
+		},
+		{
+			name: "error setting commit status",
+			evt: &event.PromotionSucceeded{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(&kargoapi.CommitStatusConfig{}),
+			provider: &fakeProvider{
+				err: errors.New("something went wrong"),
+			},
+			assertions: func(t *testing.T, _ *fakeProvider, err error) {
+				require.ErrorContains(t, err, "error setting status of commit abc123")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			evt: &event.PromotionFailed{
+				Common: event.Common{
+					Project: testProject,
+					Message: "Promotion failed",
+				},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(&kargoapi.CommitStatusConfig{
+				Stages: []string{"fake-stage"},
+			}),
+			provider: &fakeProvider{},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]gitprovider.CommitStatusOpts{{
+						CommitID:    "abc123",
+						State:       gitprovider.CommitStateFailure,
+						Context:     "kargo/fake-stage",
+						Description: "Promotion failed",
+						TargetURL:   "https://kargo.example.com/project/fake-project/stage/fake-stage",
+					}},
+					p.statuses,
+				)
+				require.Empty(t, p.deployments)
+			},
+		},
+		{
+			name: "success with deployments",
+			evt: &event.PromotionSucceeded{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(&kargoapi.CommitStatusConfig{
+				Context:     "acme",
+				Deployments: true,
+			}),
+			provider: &fakeProvider{},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Len(t, p.statuses, 1)
+				require.Equal(t, "acme/fake-stage", p.statuses[0].Context)
+				require.Equal(t, gitprovider.CommitStateSuccess, p.statuses[0].State)
+				require.Equal(
+					t,
+					[]gitprovider.DeploymentStatusOpts{{
+						CommitID:    "abc123",
+						Ref:         "main",
+						Environment: "fake-stage",
+						State:       gitprovider.CommitStateSuccess,
+						TargetURL:   "https://kargo.example.com/project/fake-project/stage/fake-stage",
+					}},
+					p.deployments,
+				)
+			},
+		},
+		{
+			name: "deployments are unsupported",
+			evt: &event.PromotionAborted{
+				Common:    event.Common{Project: testProject},
+				Promotion: testPromotion,
+			},
+			projectCfg: newProjectConfig(&kargoapi.CommitStatusConfig{
+				Deployments: true,
+			}),
+			provider: &fakeProvider{
+				deploymentErr: gitprovider.ErrUnsupported,
+			},
+			assertions: func(t *testing.T, p *fakeProvider, err error) {
+				require.NoError(t, err)
+				require.Len(t, p.statuses, 1)
+				require.Equal(t, gitprovider.CommitStateError, p.statuses[0].State)
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme)
+			if testCase.projectCfg != nil {
+				c.WithObjects(testCase.projectCfg)
+			}
+			s := newTestSender(c.Build(), testCase.provider)
+			err := s.Send(context.Background(), testCase.evt)
+			require.NoError(t, err)
+			if len(s.queue) > 0 {
+				err = s.report(context.Background(), <-s.queue)
+			}
+			testCase.assertions(t, testCase.provider, err)
+		})
+	}
+}
+
+func TestSender_ReportStarted(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.ProjectConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testProject,
+				Namespace: testProject,
+			},
+			Spec: kargoapi.ProjectConfigSpec{
+				CommitStatuses: &kargoapi.CommitStatusConfig{},
+			},
+		},
+	).Build()
+	provider := &fakeProvider{}
+	s := newTestSender(c, provider)
+
+	err := s.ReportStarted(
+		context.Background(),
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-promotion",
+				Namespace: testProject,
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "fake-stage"},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-freight",
+				Namespace: testProject,
+			},
+			Commits: []kargoapi.GitCommit{{
+				RepoURL: "https://github.com/example/repo",
+				ID:      "abc123",
+			}},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, s.queue, 1)
+
+	require.NoError(t, s.report(context.Background(), <-s.queue))
+	require.Len(t, provider.statuses, 1)
+	require.Equal(t, gitprovider.CommitStatePending, provider.statuses[0].State)
+	require.Equal(t, "abc123", provider.statuses[0].CommitID)
+	require.Equal(t, "kargo/fake-stage", provider.statuses[0].Context)
+	require.Equal(t, startedDescription, provider.statuses[0].Description)
+}
+
+func TestSender_Send_queueFull(t *testing.T) {
+	s := &Sender{queue: make(chan statusReport, 1)}
+	evt := &event.PromotionSucceeded{
+		Common:    event.Common{Project: "fake-project"},
+		Promotion: event.Promotion{Name: "fake-promotion"},
+	}
+	require.NoError(t, s.Send(context.Background(), evt))
+	err := s.Send(context.Background(), evt)
+	require.ErrorContains(t, err, "queue is full")
+}
+
+// newTestSender returns a Sender without workers, whose queued reports can
+// be made synchronously by the test, and which reports to the provided
+// fakeProvider.
+func newTestSender(c client.Client, provider *fakeProvider) *Sender {
+	return &Sender{
+		client:    c,
+		credsDB:   &credentials.FakeDB{},
+		uiBaseURL: "https://kargo.example.com",
+		queue:     make(chan statusReport, 1),
+		newGitProviderFn: func(
+			string,
+			*gitprovider.Options,
+		) (gitprovider.Interface, error) {
+			return provider.fake(), nil
+		},
+	}
+}
+
+// fakeProvider records the commit statuses and deployment statuses that are
+// set through the gitprovider.Fake it returns.
+type fakeProvider struct {
+	err           error
+	deploymentErr error
+	statuses      []gitprovider.CommitStatusOpts
+	deployments   []gitprovider.DeploymentStatusOpts
+}
+
+func (f *fakeProvider) fake() *gitprovider.Fake {
+	return &gitprovider.Fake{
+		SetCommitStatusFn: func(
+			_ context.Context,
+			opts *gitprovider.CommitStatusOpts,
+		) error {
+			f.statuses = append(f.statuses, *opts)
+			return f.err
+		},
+		SetDeploymentStatusFn: func(
+			_ context.Context,
+			opts *gitprovider.DeploymentStatusOpts,
+		) error {
+			f.deployments = append(f.deployments, *opts)
+			return f.deploymentErr
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package event
 
 import (
+	"time"
+
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
 
@@ -29,6 +31,7 @@ type Custom struct {
 	Message    string             `json:"message,omitempty"`
 	Data       map[string]any     `json:"data"`
 	ID         string             `json:"id,omitempty"`
+	Time       time.Time          `json:"time,omitzero"`
 }
 
 func (c *Custom) Kind() string {
@@ -58,3 +61,11 @@ func (c *Custom) SetMessage(msg string) {
 func (c *Custom) GetID() string {
 	return c.ID
 }
+
+func (c *Custom) GetTime() time.Time {
+	return c.Time
+}
+
+func (c *Custom) SetTime(t time.Time) {
+	c.Time = t
+}
@@ -14,6 +14,7 @@ var KnownEventTypes = []kargoapi.EventType{
 	kargoapi.EventTypePromotionFailed,
 	kargoapi.EventTypePromotionErrored,
 	kargoapi.EventTypePromotionAborted,
+	kargoapi.EventTypePromotionRollbackCreated,
 	kargoapi.EventTypeFreightApproved,
 	kargoapi.EventTypeFreightVerificationSucceeded,
 	kargoapi.EventTypeFreightVerificationFailed,
@@ -38,6 +39,16 @@ type Meta interface {
 	GetID() string
 }
 
+// Timed is an interface for built in events that record the time at which they
+// occurred
+type Timed interface {
+	// GetTime returns the time at which the event occurred, or the zero time if
+	// it is unknown
+	GetTime() time.Time
+	// SetTime sets the time at which the event occurred
+	SetTime(time.Time)
+}
+
 // Message is an interface for setting and getting the message of any built in event
 type Message interface {
 	// GetMessage returns the message of the event
@@ -54,10 +65,11 @@ type AnnotationMarshaler interface {
 
 // Common is a struct that contains fields common to all events.
 type Common struct {
-	Project string  `json:"project"`
-	Actor   *string `json:"actor,omitempty"`
-	Message string  `json:"message"`
-	ID      string  `json:"id"`
+	Project string    `json:"project"`
+	Actor   *string   `json:"actor,omitempty"`
+	Message string    `json:"message"`
+	ID      string    `json:"id"`
+	Time    time.Time `json:"time,omitzero"`
 }
 
 func (c Common) GetProject() string {
@@ -76,6 +88,14 @@ func (c Common) GetID() string {
 	return c.ID
 }
 
+func (c Common) GetTime() time.Time {
+	return c.Time
+}
+
+func (c *Common) SetTime(t time.Time) {
+	c.Time = t
+}
+
 // UnmarshalCommonAnnotations populates the Common fields from the given kubernetes annotations and
 // event ID
 func UnmarshalCommonAnnotations(eventID string, annotations map[string]string) (Common, error) {
@@ -100,6 +120,7 @@ func (c *Common) MarshalAnnotationsTo(annotations map[string]string) {
 	}
 	// Message is skipped as it is passed to the k8s event directly
 	// ID is skipped as it is not needed in annotations and is pulled from the event UUID
+	// Time is skipped as it is recorded by the k8s event directly
 }
 
 func newCommonFromPromotion(message, actor string, promotion *kargoapi.Promotion) Common {
@@ -109,6 +130,7 @@ func newCommonFromPromotion(message, actor string, promotion *kargoapi.Promotion
 	evt := Common{
 		Project: promotion.Namespace,
 		Message: message,
+		Time:    time.Now().UTC(),
 	}
 	if actor != "" {
 		evt.Actor = &actor
@@ -126,6 +148,7 @@ func newCommonFromFreight(message,
 ) Common {
 	evt := Common{
 		Message: message,
+		Time:    time.Now().UTC(),
 	}
 	if freight != nil {
 		evt.Project = freight.GetNamespace()
@@ -150,6 +150,8 @@ func TestFreight_MarshalAnnotationsTo(t *testing.T) {
 				Commits:    []kargoapi.GitCommit{{ID: "abc123", Tag: "v1.0.0"}},
 				Images:     []kargoapi.Image{{RepoURL: "example.com/app", Tag: "v1.0.0"}},
 				Charts:     []kargoapi.Chart{{Name: "my-chart", Version: "1.0.0"}},
+				Artifacts:  []kargoapi.OCIArtifact{{RepoURL: "example.com/module", Tag: "v1.0.0"}},
+				Objects:    []kargoapi.BucketObject{{URL: "https://s3.example.com/builds", Key: "app.tar.gz"}},
 			},
 			expected: map[string]string{
 				kargoapi.AnnotationKeyEventFreightName:       "test-freight",
@@ -159,6 +161,8 @@ func TestFreight_MarshalAnnotationsTo(t *testing.T) {
 				kargoapi.AnnotationKeyEventFreightCommits:    `[{"id":"abc123","tag":"v1.0.0"}]`,
 				kargoapi.AnnotationKeyEventFreightImages:     `[{"repoURL":"example.com/app","tag":"v1.0.0"}]`,
 				kargoapi.AnnotationKeyEventFreightCharts:     `[{"name":"my-chart","version":"1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightArtifacts:  `[{"repoURL":"example.com/module","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightObjects:    `[{"url":"https://s3.example.com/builds","key":"app.tar.gz"}]`,
 			},
 		},
 		"minimal freight": {
@@ -340,6 +344,8 @@ func TestUnmarshalFreightAnnotations(t *testing.T) {
 				kargoapi.AnnotationKeyEventFreightCommits:    `[{"id":"abc123","tag":"v1.0.0"}]`,
 				kargoapi.AnnotationKeyEventFreightImages:     `[{"repoURL":"example.com/app","tag":"v1.0.0"}]`,
 				kargoapi.AnnotationKeyEventFreightCharts:     `[{"name":"my-chart","version":"1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightArtifacts:  `[{"repoURL":"example.com/module","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightObjects:    `[{"url":"https://s3.example.com/builds","key":"app.tar.gz"}]`,
 			},
 			expected: Freight{
 				Name:       "test-freight",
@@ -349,6 +355,8 @@ func TestUnmarshalFreightAnnotations(t *testing.T) {
 				Commits:    []kargoapi.GitCommit{{ID: "abc123", Tag: "v1.0.0"}},
 				Images:     []kargoapi.Image{{RepoURL: "example.com/app", Tag: "v1.0.0"}},
 				Charts:     []kargoapi.Chart{{Name: "my-chart", Version: "1.0.0"}},
+				Artifacts:  []kargoapi.OCIArtifact{{RepoURL: "example.com/module", Tag: "v1.0.0"}},
+				Objects:    []kargoapi.BucketObject{{URL: "https://s3.example.com/builds", Key: "app.tar.gz"}},
 			},
 		},
 		"minimal annotations": {
@@ -482,6 +490,10 @@ func TestNewCommonFromPromotion(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			result := newCommonFromPromotion(tc.message, tc.actor, tc.promotion)
+			if tc.promotion != nil {
+				require.WithinDuration(t, time.Now(), result.Time, time.Minute)
+				result.Time = time.Time{}
+			}
 			require.Equal(t, tc.expected, result)
 		})
 	}
@@ -526,6 +538,8 @@ func TestNewCommonFromFreight(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			result := newCommonFromFreight(tc.message, tc.actor, tc.freight)
+			require.WithinDuration(t, time.Now(), result.Time, time.Minute)
+			result.Time = time.Time{}
 			require.Equal(t, tc.expected, result)
 		})
 	}
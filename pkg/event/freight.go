@@ -12,13 +12,15 @@ import (
 
 // Freight is a struct that contains common fields for freight-related events.
 type Freight struct {
-	Name       string               `json:"name"`
-	StageName  string               `json:"stageName"`
-	CreateTime time.Time            `json:"createTime"`
-	Alias      *string              `json:"alias,omitempty"`
-	Commits    []kargoapi.GitCommit `json:"commits,omitempty"`
-	Images     []kargoapi.Image     `json:"images,omitempty"`
-	Charts     []kargoapi.Chart     `json:"charts,omitempty"`
+	Name       string                  `json:"name"`
+	StageName  string                  `json:"stageName"`
+	CreateTime time.Time               `json:"createTime"`
+	Alias      *string                 `json:"alias,omitempty"`
+	Commits    []kargoapi.GitCommit    `json:"commits,omitempty"`
+	Images     []kargoapi.Image        `json:"images,omitempty"`
+	Charts     []kargoapi.Chart        `json:"charts,omitempty"`
+	Artifacts  []kargoapi.OCIArtifact  `json:"artifacts,omitempty"`
+	Objects    []kargoapi.BucketObject `json:"objects,omitempty"`
 }
 
 func (f Freight) GetName() string {
@@ -321,6 +323,16 @@ func (f *Freight) MarshalAnnotationsTo(annotations map[string]string) {
 			annotations[kargoapi.AnnotationKeyEventFreightCharts] = string(data)
 		}
 	}
+	if len(f.Artifacts) > 0 {
+		if data, err := json.Marshal(f.Artifacts); err == nil {
+			annotations[kargoapi.AnnotationKeyEventFreightArtifacts] = string(data)
+		}
+	}
+	if len(f.Objects) > 0 {
+		if data, err := json.Marshal(f.Objects); err == nil {
+			annotations[kargoapi.AnnotationKeyEventFreightObjects] = string(data)
+		}
+	}
 }
 
 func (f *FreightVerificationSucceeded) MarshalAnnotations() map[string]string {
@@ -420,6 +432,20 @@ func UnmarshalFreightAnnotations(annotations map[string]string) (Freight, error)
 		}
 		evt.Charts = cs
 	}
+	if artifacts, ok := annotations[kargoapi.AnnotationKeyEventFreightArtifacts]; ok {
+		var as []kargoapi.OCIArtifact
+		if err := json.Unmarshal([]byte(artifacts), &as); err != nil {
+			return evt, fmt.Errorf("failed to unmarshal freight artifacts: %w", err)
+		}
+		evt.Artifacts = as
+	}
+	if objects, ok := annotations[kargoapi.AnnotationKeyEventFreightObjects]; ok {
+		var objs []kargoapi.BucketObject
+		if err := json.Unmarshal([]byte(objects), &objs); err != nil {
+			return evt, fmt.Errorf("failed to unmarshal freight objects: %w", err)
+		}
+		evt.Objects = objs
+	}
 	return evt, nil
 }
 
@@ -628,5 +654,11 @@ func newFreight(freight *kargoapi.Freight, stageName string) Freight {
 	if len(freight.Charts) > 0 {
 		evt.Charts = freight.Charts
 	}
+	if len(freight.Artifacts) > 0 {
+		evt.Artifacts = freight.Artifacts
+	}
+	if len(freight.Objects) > 0 {
+		evt.Objects = freight.Objects
+	}
 	return evt
 }
@@ -180,6 +180,8 @@ func TestNewFreightCommon(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			common, freight := NewFreightCommon(tc.message, tc.actor, tc.stageName, tc.freight)
+			require.WithinDuration(t, time.Now(), common.Time, time.Minute)
+			common.Time = time.Time{}
 			require.Equal(t, tc.expectedCommon, common)
 			require.Equal(t, tc.expectedFreight, freight)
 		})
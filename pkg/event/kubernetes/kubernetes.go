@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -27,6 +28,8 @@ func FromKubernetesEvent(evt corev1.Event) (event.Meta, error) {
 		parsedEvent, err = event.UnmarshalPromotionErroredAnnotations(id, evt.Annotations)
 	case kargoapi.EventTypePromotionAborted:
 		parsedEvent, err = event.UnmarshalPromotionAbortedAnnotations(id, evt.Annotations)
+	case kargoapi.EventTypePromotionRollbackCreated:
+		parsedEvent, err = event.UnmarshalPromotionRollbackCreatedAnnotations(id, evt.Annotations)
 	case kargoapi.EventTypeFreightApproved:
 		parsedEvent, err = event.UnmarshalFreightApprovedAnnotations(id, evt.Annotations)
 	case kargoapi.EventTypeFreightVerificationSucceeded:
@@ -88,5 +91,24 @@ func FromKubernetesEvent(evt corev1.Event) (event.Meta, error) {
 		return nil, err
 	}
 
+	// The time at which the event occurred is recorded by the k8s event itself
+	// rather than in its annotations.
+	if timed, ok := parsedEvent.(event.Timed); ok {
+		timed.SetTime(EventTime(evt).UTC())
+	}
+
 	return parsedEvent, nil
 }
+
+// EventTime returns the most accurate available time at which the provided
+// Kubernetes event was recorded.
+func EventTime(evt corev1.Event) time.Time {
+	switch {
+	case !evt.LastTimestamp.IsZero():
+		return evt.LastTimestamp.Time
+	case !evt.EventTime.IsZero():
+		return evt.EventTime.Time
+	default:
+		return evt.CreationTimestamp.Time
+	}
+}
@@ -48,6 +48,11 @@ func TestFromKubernetesEvent(t *testing.T) {
 				LastTimestamp: metav1.Time{Time: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
 			},
 			expectedType: kargoapi.EventTypePromotionSucceeded,
+			extraValidation: func(t *testing.T, evt event.Meta) {
+				timed, ok := evt.(event.Timed)
+				require.True(t, ok)
+				require.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), timed.GetTime())
+			},
 		},
 		"promotion failed event": {
 			k8sEvent: corev1.Event{
@@ -210,6 +215,7 @@ func TestFromKubernetesEvent_AllEventTypes(t *testing.T) {
 		kargoapi.EventTypePromotionFailed,
 		kargoapi.EventTypePromotionErrored,
 		kargoapi.EventTypePromotionAborted,
+		kargoapi.EventTypePromotionRollbackCreated,
 		kargoapi.EventTypeFreightVerificationSucceeded,
 		kargoapi.EventTypeFreightVerificationFailed,
 		kargoapi.EventTypeFreightVerificationErrored,
@@ -454,7 +460,8 @@ func isPromotionEvent(eventType kargoapi.EventType) bool {
 		kargoapi.EventTypePromotionSucceeded,
 		kargoapi.EventTypePromotionFailed,
 		kargoapi.EventTypePromotionErrored,
-		kargoapi.EventTypePromotionAborted:
+		kargoapi.EventTypePromotionAborted,
+		kargoapi.EventTypePromotionRollbackCreated:
 		return true
 	default:
 		return false
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"context"
+	"errors"
+)
+
+// MultiSender is a Sender that sends every event to each of several other
+// Senders.
+type MultiSender struct {
+	senders []Sender
+}
+
+// NewMultiSender returns a MultiSender that sends every event to each of the
+// provided Senders.
+func NewMultiSender(senders ...Sender) *MultiSender {
+	return &MultiSender{senders: senders}
+}
+
+// Send sends the event to each of the MultiSender's Senders. Failure to send
+// to one Sender does not prevent the event from being sent to the others. Any
+// errors encountered are joined and returned.
+func (m *MultiSender) Send(ctx context.Context, evt Meta) error {
+	var errs []error
+	for _, s := range m.senders {
+		if err := s.Send(ctx, evt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
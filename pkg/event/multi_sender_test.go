@@ -0,0 +1,36 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockSender struct {
+	err  error
+	sent []Meta
+}
+
+func (m *mockSender) Send(_ context.Context, evt Meta) error {
+	m.sent = append(m.sent, evt)
+	return m.err
+}
+
+func TestMultiSender_Send(t *testing.T) {
+	ok := &mockSender{}
+	failing := &mockSender{err: errors.New("something went wrong")}
+	alsoOK := &mockSender{}
+
+	evt := &FreightApproved{}
+	err := NewMultiSender(ok, failing, alsoOK).Send(context.Background(), evt)
+	require.ErrorContains(t, err, "something went wrong")
+
+	// All senders should have received the event despite the failure
+	for _, s := range []*mockSender{ok, failing, alsoOK} {
+		require.Equal(t, []Meta{evt}, s.sent)
+	}
+
+	require.NoError(t, NewMultiSender(ok).Send(context.Background(), evt))
+}
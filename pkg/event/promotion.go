@@ -91,6 +91,22 @@ func (p *PromotionCreated) Type() kargoapi.EventType {
 	return kargoapi.EventTypePromotionCreated
 }
 
+// PromotionRollbackCreated is event data related to a promotion created to
+// automatically roll a stage back from freight that failed verification.
+type PromotionRollbackCreated struct {
+	Common
+	Promotion
+	// RolledBackFreight is the name of the freight that failed verification
+	// and that the stage is being rolled back from.
+	RolledBackFreight string `json:"rolledBackFreight"`
+	// Reason explains why the rollback was performed.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (p *PromotionRollbackCreated) Type() kargoapi.EventType {
+	return kargoapi.EventTypePromotionRollbackCreated
+}
+
 // NewPromotionCommon creates a new `Promotion` and `Common` event from the given promotion and
 // freight data. Since these fields are common to all events, this is exposed for convenience. The
 // given actor will be used if it is not empty, but it will be overridden if the promotion has an
@@ -168,6 +184,25 @@ func NewPromotionCreated(
 	}
 }
 
+// NewPromotionRollbackCreated creates a new PromotionRollbackCreated event from the given promotion
+// and freight data, the name of the freight that the stage is being rolled back from, and the reason
+// for the rollback. The given actor will be used if it is not empty, but it will be overridden if
+// the promotion has an actor annotation.
+func NewPromotionRollbackCreated(
+	message, actor string,
+	promotion *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+	rolledBackFreight, reason string,
+) *PromotionRollbackCreated {
+	common, promo := NewPromotionCommon(message, actor, promotion, freight)
+	return &PromotionRollbackCreated{
+		Common:            common,
+		Promotion:         promo,
+		RolledBackFreight: rolledBackFreight,
+		Reason:            reason,
+	}
+}
+
 func (p *Promotion) MarshalAnnotationsTo(annotations map[string]string) {
 	annotations[kargoapi.AnnotationKeyEventPromotionName] = p.Name
 	annotations[kargoapi.AnnotationKeyEventStageName] = p.StageName
@@ -225,6 +260,19 @@ func (p *PromotionCreated) MarshalAnnotations() map[string]string {
 	return annotations
 }
 
+func (p *PromotionRollbackCreated) MarshalAnnotations() map[string]string {
+	// Note that we skip message here, as it is not used in the annotations.
+	annotations := map[string]string{
+		kargoapi.AnnotationKeyEventRolledBackFreightName: p.RolledBackFreight,
+	}
+	if p.Reason != "" {
+		annotations[kargoapi.AnnotationKeyEventRollbackReason] = p.Reason
+	}
+	p.Common.MarshalAnnotationsTo(annotations)
+	p.Promotion.MarshalAnnotationsTo(annotations)
+	return annotations
+}
+
 // UnmarshalPromotionAnnotations populates the Promotion fields from the given kubernetes annotations.
 func UnmarshalPromotionAnnotations(annotations map[string]string) (Promotion, error) {
 	var freight *Freight
@@ -362,6 +410,29 @@ func UnmarshalPromotionCreatedAnnotations(
 	return &evt, nil
 }
 
+// UnmarshalPromotionRollbackCreatedAnnotations converts the given annotations into a
+// PromotionRollbackCreated. This is used by the main event handler to convert the data into a normal
+// structured event, but is exposed for convenience.
+func UnmarshalPromotionRollbackCreatedAnnotations(
+	eventID string, annotations map[string]string,
+) (*PromotionRollbackCreated, error) {
+	common, err := UnmarshalCommonAnnotations(eventID, annotations)
+	if err != nil {
+		return nil, err
+	}
+	promotion, err := UnmarshalPromotionAnnotations(annotations)
+	if err != nil {
+		return nil, err
+	}
+	evt := PromotionRollbackCreated{
+		Common:            common,
+		Promotion:         promotion,
+		RolledBackFreight: annotations[kargoapi.AnnotationKeyEventRolledBackFreightName],
+		Reason:            annotations[kargoapi.AnnotationKeyEventRollbackReason],
+	}
+	return &evt, nil
+}
+
 func newPromotion(
 	promotion *kargoapi.Promotion,
 	freight *kargoapi.Freight,
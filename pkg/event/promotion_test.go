@@ -38,6 +38,11 @@ func TestPromotionCreated(t *testing.T) {
 	require.Equal(t, kargoapi.EventTypePromotionCreated, evt.Type())
 }
 
+func TestPromotionRollbackCreated(t *testing.T) {
+	evt := &PromotionRollbackCreated{}
+	require.Equal(t, kargoapi.EventTypePromotionRollbackCreated, evt.Type())
+}
+
 func TestNewPromotionCommon(t *testing.T) {
 	testCases := map[string]struct {
 		message         string
@@ -183,6 +188,14 @@ func TestPromotionConstructors(t *testing.T) {
 			},
 			expectedType: kargoapi.EventTypePromotionCreated,
 		},
+		"rollback created": {
+			constructor: func() Meta {
+				return NewPromotionRollbackCreated(
+					"Rollback message", "test-actor", promotion, freight, "bad-freight", "failed",
+				)
+			},
+			expectedType: kargoapi.EventTypePromotionRollbackCreated,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -278,6 +291,20 @@ func TestPromotionEventMarshalAnnotations(t *testing.T) {
 				kargoapi.AnnotationKeyEventPromotionCreateTime: "2024-01-01T12:00:00Z",
 			},
 		},
+		"promotion rollback created": {
+			event: NewPromotionRollbackCreated(
+				"Rollback message", "test-actor", promotion, nil, "bad-freight", "verification failed",
+			),
+			expected: map[string]string{
+				kargoapi.AnnotationKeyEventProject:               "test-project",
+				kargoapi.AnnotationKeyEventActor:                 "test-actor",
+				kargoapi.AnnotationKeyEventPromotionName:         "test-promotion",
+				kargoapi.AnnotationKeyEventStageName:             "test-stage",
+				kargoapi.AnnotationKeyEventPromotionCreateTime:   "2024-01-01T12:00:00Z",
+				kargoapi.AnnotationKeyEventRolledBackFreightName: "bad-freight",
+				kargoapi.AnnotationKeyEventRollbackReason:        "verification failed",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -408,6 +435,32 @@ func TestPromotionEventUnmarshalAnnotations(t *testing.T) {
 				},
 			},
 		},
+		"promotion rollback created": {
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyEventProject:               "test-project",
+				kargoapi.AnnotationKeyEventPromotionName:         "test-promotion",
+				kargoapi.AnnotationKeyEventStageName:             "test-stage",
+				kargoapi.AnnotationKeyEventPromotionCreateTime:   "2024-01-01T12:00:00Z",
+				kargoapi.AnnotationKeyEventRolledBackFreightName: "bad-freight",
+				kargoapi.AnnotationKeyEventRollbackReason:        "verification failed",
+			},
+			unmarshalFunc: func(annotations map[string]string) (Meta, error) {
+				return UnmarshalPromotionRollbackCreatedAnnotations("event-id", annotations)
+			},
+			expectedType: &PromotionRollbackCreated{
+				Common: Common{
+					Project: "test-project",
+					ID:      "event-id",
+				},
+				Promotion: Promotion{
+					Name:       "test-promotion",
+					StageName:  "test-stage",
+					CreateTime: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+				},
+				RolledBackFreight: "bad-freight",
+				Reason:            "verification failed",
+			},
+		},
 		"invalid promotion annotations": {
 			annotations: map[string]string{
 				kargoapi.AnnotationKeyEventPromotionCreateTime: "invalid-time",
@@ -18,8 +18,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/controller/freight"
-	"github.com/akuity/kargo/pkg/kargo"
 	"github.com/akuity/kargo/pkg/urls"
 )
 
@@ -28,8 +28,9 @@ type exprFn func(params ...any) (any, error)
 // FreightOperations returns a slice of expr.Option containing functions for
 // Freight operations.
 //
-// It provides `warehouse()`, `commitFrom()`, `imageFrom()`, and `chartFrom()`
-// functions that can be used within expressions. The functions operate within
+// It provides `warehouse()`, `commitFrom()`, `imageFrom()`, `chartFrom()`,
+// `artifactFrom()`, and `objectFrom()` functions that can be used within
+// expressions. The functions operate within
 // the context of a given project with the provided freight requests and
 // references.
 func FreightOperations(
@@ -44,6 +45,8 @@ func FreightOperations(
 		CommitFromFreight(ctx, c, project, freightRequests, freightRefs),
 		ImageFromFreight(ctx, c, project, freightRequests, freightRefs),
 		ChartFromFreight(ctx, c, project, freightRequests, freightRefs),
+		ArtifactFromFreight(ctx, c, project, freightRequests, freightRefs),
+		ObjectFromFreight(ctx, c, project, freightRequests, freightRefs),
 		FreightMetadata(ctx, c, project),
 	}
 }
@@ -51,8 +54,8 @@ func FreightOperations(
 // DiscoveredArtifactsOperations returns a slice of expr.Option containing
 // functions for retrieving artifacts from a Warehouse's discovered artifacts.
 //
-// It provides `commitFrom()`, `imageFrom()`, and `chartFrom()` functions for
-// use in the context of expressions defining criteria that permit or block
+// It provides `commitFrom()`, `imageFrom()`, `chartFrom()`, `artifactFrom()`,
+// and `objectFrom()` functions for use in the context of expressions defining criteria that permit or block
 // automatic Freight creation after artifact discovery. These functions behave
 // identically to functions of the same names used within the context of a
 // Promotion process, however, they are implemented differently since they
@@ -62,6 +65,8 @@ func DiscoveredArtifactsOperations(artifacts *kargoapi.DiscoveredArtifacts) []ex
 		CommitFromDiscoveredArtifacts(artifacts),
 		ImageFromDiscoveredArtifacts(artifacts),
 		ChartFromDiscoveredArtifacts(artifacts),
+		ArtifactFromDiscoveredArtifacts(artifacts),
+		ObjectFromDiscoveredArtifacts(artifacts),
 	}
 }
 
@@ -221,6 +226,81 @@ func ChartFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) expr.
 	)
 }
 
+// ArtifactFromFreight returns an expr.Option that provides an `artifactFrom()`
+// function for use in expressions.
+//
+// The artifactFrom function finds OCI artifacts based on repository URL and
+// optional origin, using the provided freight requests and references within
+// the project context.
+func ArtifactFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) expr.Option {
+	return expr.Function(
+		"artifactFrom",
+		getArtifactFromFreight(ctx, c, project, freightReqs, freightRefs),
+		new(func(repoURL string, origin kargoapi.FreightOrigin) kargoapi.OCIArtifact),
+		new(func(repoURL string) kargoapi.OCIArtifact),
+	)
+}
+
+// ArtifactFromDiscoveredArtifacts returns an expr.Option that provides an
+// `artifactFrom()` function for use, specifically, in expressions that define
+// criteria that permit or block automatic Freight creation after artifact
+// discovery.
+//
+// The artifactFrom function finds the latest OCI artifact based on repository
+// URL.
+func ArtifactFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) expr.Option {
+	return expr.Function(
+		"artifactFrom",
+		getArtifactFromDiscoveredArtifacts(artifacts),
+		new(func(repoURL string) kargoapi.DiscoveredImageReference),
+	)
+}
+
+// ObjectFromFreight returns an expr.Option that provides an `objectFrom()`
+// function for use in expressions.
+//
+// The objectFrom function finds objects in S3-compatible buckets based on
+// bucket URL, optional key prefix, and optional origin, using the provided
+// freight requests and references within the project context.
+func ObjectFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) expr.Option {
+	return expr.Function(
+		"objectFrom",
+		getObjectFromFreight(ctx, c, project, freightReqs, freightRefs),
+		new(func(bucketURL string, prefix string, origin kargoapi.FreightOrigin) kargoapi.BucketObject),
+		new(func(bucketURL string, prefix string) kargoapi.BucketObject),
+		new(func(bucketURL string, origin kargoapi.FreightOrigin) kargoapi.BucketObject),
+		new(func(bucketURL string) kargoapi.BucketObject),
+	)
+}
+
+// ObjectFromDiscoveredArtifacts returns an expr.Option that provides an
+// `objectFrom()` function for use, specifically, in expressions that define
+// criteria that permit or block automatic Freight creation after artifact
+// discovery.
+//
+// The objectFrom function finds the latest object based on bucket URL and
+// optional key prefix.
+func ObjectFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) expr.Option {
+	return expr.Function(
+		"objectFrom",
+		getObjectFromDiscoveredArtifacts(artifacts),
+		new(func(bucketURL string, prefix string) kargoapi.DiscoveredObject),
+		new(func(bucketURL string) kargoapi.DiscoveredObject),
+	)
+}
+
 func FreightMetadata(
 	ctx context.Context,
 	c client.Client,
@@ -769,6 +849,174 @@ func getChartFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) ex
 	}
 }
 
+// getArtifactFromFreight returns a function that finds OCI artifacts based on
+// repository URL and optional origin.
+//
+// The returned function uses freight requests and references to locate the
+// appropriate artifact within the project context.
+func getArtifactFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightRequests []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 2 {
+			return nil, fmt.Errorf("expected 1-2 arguments, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var desiredOrigin *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			origin, ok := a[1].(kargoapi.FreightOrigin)
+			if !ok {
+				return nil, fmt.Errorf("second argument must be FreightOrigin, got %T", a[1])
+			}
+			desiredOrigin = &origin
+		}
+
+		return freight.FindArtifact(
+			ctx,
+			c,
+			project,
+			freightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+}
+
+// getArtifactFromDiscoveredArtifacts returns a function that finds the latest OCI artifact based on repository URL.
+func getArtifactFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		if artifacts == nil {
+			return nil, nil
+		}
+
+		repoURL = urls.NormalizeImage(repoURL)
+		for _, oa := range artifacts.OCI {
+			if urls.NormalizeImage(oa.RepoURL) != repoURL {
+				continue
+			}
+			if len(oa.References) > 0 {
+				return oa.References[0], nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// getObjectFromFreight returns a function that finds objects in S3-compatible
+// buckets based on bucket URL, optional key prefix, and optional origin.
+//
+// The returned function uses freight requests and references to locate the
+// appropriate object within the project context.
+func getObjectFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 3 {
+			return nil, fmt.Errorf("expected 1-3 arguments, got %d", len(a))
+		}
+
+		bucketURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var prefix string
+		var desiredOrigin *kargoapi.FreightOrigin
+
+		if len(a) >= 2 {
+			if p, ok := a[1].(string); ok {
+				prefix = p
+			} else if origin, ok := a[1].(kargoapi.FreightOrigin); ok {
+				desiredOrigin = &origin
+			} else {
+				return nil, fmt.Errorf("second argument must be string or FreightOrigin, got %T", a[1])
+			}
+		}
+
+		if len(a) == 3 {
+			if desiredOrigin != nil {
+				return nil, fmt.Errorf("when using three arguments, second argument must be string, got %T", a[1])
+			}
+			origin, ok := a[2].(kargoapi.FreightOrigin)
+			if !ok {
+				return nil, fmt.Errorf("third argument must be FreightOrigin, got %T", a[2])
+			}
+			desiredOrigin = &origin
+		}
+
+		return freight.FindObject(
+			ctx,
+			c,
+			project,
+			freightReqs,
+			desiredOrigin,
+			freightRefs,
+			bucketURL,
+			prefix,
+		)
+	}
+}
+
+// getObjectFromDiscoveredArtifacts returns a function that finds the latest
+// object based on bucket URL and optional key prefix.
+func getObjectFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 2 {
+			return nil, fmt.Errorf("expected 1-2 arguments, got %d", len(a))
+		}
+
+		bucketURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var prefix string
+		if len(a) == 2 {
+			prefix, ok = a[1].(string)
+			if !ok {
+				return nil, fmt.Errorf("second argument must be string, got %T", a[1])
+			}
+		}
+
+		if artifacts == nil {
+			return nil, nil
+		}
+
+		for _, ba := range artifacts.Buckets {
+			if urls.NormalizeBucket(ba.URL) != urls.NormalizeBucket(bucketURL) || ba.Prefix != prefix {
+				continue
+			}
+			if len(ba.Objects) > 0 {
+				return ba.Objects[0], nil
+			}
+		}
+		return nil, nil
+	}
+}
+
 // getConfigMap returns a function that retrieves a ConfigMap by its name
 // within the specified project namespace. If the ConfigMap is not found,
 // it returns an empty map.
@@ -899,7 +1147,7 @@ func getStatus(
 	stepExecMetas kargoapi.StepExecutionMetadataList,
 ) exprFn {
 	var currentStepNamespace string
-	if parts := strings.Split(currentStepAlias, kargo.PromotionAliasSeparator); len(parts) == 2 {
+	if parts := strings.Split(currentStepAlias, api.PromotionAliasSeparator); len(parts) == 2 {
 		currentStepNamespace = parts[0]
 	}
 	return func(a ...any) (any, error) {
@@ -919,7 +1167,7 @@ func getStatus(
 		for _, stepExecMeta := range stepExecMetas {
 			stepShortAlias := stepExecMeta.Alias
 			var stepNamespace string
-			if parts := strings.Split(stepExecMeta.Alias, kargo.PromotionAliasSeparator); len(parts) == 2 {
+			if parts := strings.Split(stepExecMeta.Alias, api.PromotionAliasSeparator); len(parts) == 2 {
 				stepNamespace = parts[0]
 				stepShortAlias = parts[1]
 			}
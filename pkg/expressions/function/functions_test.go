@@ -901,6 +901,544 @@ func Test_getChartfromDiscoveredArtifacts(t *testing.T) {
 	}
 }
 
+func Test_getArtifactFromFreight(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kargoapi.AddToScheme(scheme))
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		freightReqs []kargoapi.FreightRequest
+		freightRefs []kargoapi.FreightReference
+		args        []any
+		assertions  func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "repo URL only",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								OCI: &kargoapi.OCISubscription{
+									RepoURL: "registry.example.com/module",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					Artifacts: []kargoapi.OCIArtifact{
+						{
+							RepoURL: "registry.example.com/module",
+						},
+					},
+				},
+			},
+			args: []any{"registry.example.com/module"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				artifact, ok := result.(*kargoapi.OCIArtifact)
+				assert.True(t, ok)
+				assert.Equal(t, "registry.example.com/module", artifact.RepoURL)
+			},
+		},
+		{
+			name: "repo URL and origin",
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					Artifacts: []kargoapi.OCIArtifact{
+						{
+							RepoURL: "registry.example.com/module",
+						},
+					},
+				},
+			},
+			args: []any{"registry.example.com/module", kargoapi.FreightOrigin{
+				Kind: "Warehouse",
+				Name: "fake-warehouse",
+			}},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				artifact, ok := result.(*kargoapi.OCIArtifact)
+				assert.True(t, ok)
+				assert.Equal(t, "registry.example.com/module", artifact.RepoURL)
+			},
+		},
+		{
+			name: "no arguments",
+			args: []any{},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid first argument type",
+			args: []any{123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "first argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid second argument type",
+			args: []any{"registry.example.com/module", "invalid"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			fn := getArtifactFromFreight(
+				ctx,
+				c,
+				testProject,
+				tt.freightReqs,
+				tt.freightRefs,
+			)
+
+			result, err := fn(tt.args...)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getArtifactFromDiscoveredArtifacts(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		artifacts  *kargoapi.DiscoveredArtifacts
+		args       []any
+		assertions func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "wrong number of args",
+			args: []any{"one", "two"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "expected 1 argument, got 2")
+			},
+		},
+		{
+			name: "invalid arg type",
+			args: []any{1},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "first argument must be string, got int")
+			},
+		},
+		{
+			name: "success",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				OCI: []kargoapi.OCIDiscoveryResult{
+					{
+						RepoURL: "ghcr.io/example/module",
+						References: []kargoapi.DiscoveredImageReference{
+							{Tag: "v1.0.0"},
+							{Tag: "v0.9.0"},
+						},
+					},
+				},
+			},
+			args: []any{"ghcr.io/example/module"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				ref, ok := result.(kargoapi.DiscoveredImageReference)
+				require.True(t, ok)
+				require.Equal(t, "v1.0.0", ref.Tag)
+			},
+		},
+		{
+			name: "nil artifacts",
+			args: []any{"ghcr.io/example/module"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "no artifacts found",
+			artifacts: &kargoapi.DiscoveredArtifacts{},
+			args:      []any{"ghcr.io/example/module"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := getArtifactFromDiscoveredArtifacts(tc.artifacts)
+			result, err := fn(tc.args...)
+			tc.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getObjectFromFreight(t *testing.T) {
+	const testProject = "fake-project"
+	const testBucketURL = "https://s3.example.com/builds"
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testOrigin := kargoapi.FreightOrigin{
+		Name: "fake-warehouse",
+		Kind: "Warehouse",
+	}
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		freightReqs []kargoapi.FreightRequest
+		freightRefs []kargoapi.FreightReference
+		args        []any
+		assertions  func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "bucket URL and prefix",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								Bucket: &kargoapi.BucketSubscription{
+									URL:    testBucketURL,
+									Prefix: "my-app/",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{{Origin: testOrigin}},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin,
+					Objects: []kargoapi.BucketObject{
+						{
+							URL:  testBucketURL,
+							Key:  "my-app/my-app-1.0.0.tar.gz",
+							ETag: "fake-etag",
+						},
+					},
+				},
+			},
+			args: []any{testBucketURL, "my-app/"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				obj, ok := result.(*kargoapi.BucketObject)
+				assert.True(t, ok)
+				assert.Equal(t, "my-app/my-app-1.0.0.tar.gz", obj.Key)
+				assert.Equal(t, "fake-etag", obj.ETag)
+			},
+		},
+		{
+			name: "bucket URL and origin",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								Bucket: &kargoapi.BucketSubscription{
+									URL: testBucketURL,
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{{Origin: testOrigin}},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin,
+					Objects: []kargoapi.BucketObject{
+						{
+							URL: testBucketURL,
+							Key: "my-app-1.0.0.tar.gz",
+						},
+					},
+				},
+			},
+			args: []any{testBucketURL, testOrigin},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				obj, ok := result.(*kargoapi.BucketObject)
+				assert.True(t, ok)
+				assert.Equal(t, "my-app-1.0.0.tar.gz", obj.Key)
+			},
+		},
+		{
+			name: "bucket URL, prefix, and origin",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								Bucket: &kargoapi.BucketSubscription{
+									URL:    testBucketURL,
+									Prefix: "my-app/",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{{Origin: testOrigin}},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: testOrigin,
+					Objects: []kargoapi.BucketObject{
+						{
+							URL: testBucketURL,
+							Key: "other-app/other-app-2.0.0.tar.gz",
+						},
+						{
+							URL: testBucketURL,
+							Key: "my-app/my-app-1.0.0.tar.gz",
+						},
+					},
+				},
+			},
+			args: []any{testBucketURL, "my-app/", testOrigin},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				obj, ok := result.(*kargoapi.BucketObject)
+				assert.True(t, ok)
+				assert.Equal(t, "my-app/my-app-1.0.0.tar.gz", obj.Key)
+			},
+		},
+		{
+			name: "no subscription to bucket",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								Bucket: &kargoapi.BucketSubscription{
+									URL:    testBucketURL,
+									Prefix: "my-app/",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{{Origin: testOrigin}},
+			args:        []any{"s3://some-other-bucket", "my-app/"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "not found in referenced Freight")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "no arguments",
+			args: []any{},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-3 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid first argument type",
+			args: []any{123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "first argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid second argument type",
+			args: []any{testBucketURL, 123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be string or FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "origin as second of three arguments",
+			args: []any{testBucketURL, testOrigin, testOrigin},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid third argument type",
+			args: []any{testBucketURL, "my-app/", "invalid"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "third argument must be FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			fn := getObjectFromFreight(
+				ctx,
+				c,
+				testProject,
+				tt.freightReqs,
+				tt.freightRefs,
+			)
+
+			result, err := fn(tt.args...)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getObjectFromDiscoveredArtifacts(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		artifacts  *kargoapi.DiscoveredArtifacts
+		args       []any
+		assertions func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "wrong number of args",
+			args: []any{"one", "two", "three"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "expected 1-2 arguments, got 3")
+			},
+		},
+		{
+			name: "invalid first arg type",
+			args: []any{1},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "first argument must be string, got int")
+			},
+		},
+		{
+			name: "invalid second arg type",
+			args: []any{"https://s3.example.com/builds", 1},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "second argument must be string, got int")
+			},
+		},
+		{
+			name: "success",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Buckets: []kargoapi.BucketDiscoveryResult{
+					{
+						URL: "https://s3.example.com/builds",
+						Objects: []kargoapi.DiscoveredObject{
+							{Key: "other-app-2.0.0.tar.gz"},
+						},
+					},
+					{
+						URL:    "https://s3.example.com/builds",
+						Prefix: "my-app/",
+						Objects: []kargoapi.DiscoveredObject{
+							{Key: "my-app/my-app-1.1.0.tar.gz", Version: "1.1.0"},
+							{Key: "my-app/my-app-1.0.0.tar.gz", Version: "1.0.0"},
+						},
+					},
+				},
+			},
+			args: []any{"https://s3.example.com/builds", "my-app/"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.NoError(t, err)
+				obj, ok := result.(kargoapi.DiscoveredObject)
+				require.True(t, ok)
+				require.Equal(t, "1.1.0", obj.Version)
+			},
+		},
+		{
+			name: "nil artifacts",
+			args: []any{"https://s3.example.com/builds"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "no objects found",
+			artifacts: &kargoapi.DiscoveredArtifacts{},
+			args:      []any{"https://s3.example.com/builds"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := getObjectFromDiscoveredArtifacts(tc.artifacts)
+			result, err := fn(tc.args...)
+			tc.assertions(t, result, err)
+		})
+	}
+}
+
 func Test_getConfigMap(t *testing.T) {
 	const testProject = "fake-project"
 	const testConfigMap = "fake-configmap"
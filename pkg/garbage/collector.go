@@ -14,6 +14,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/archive"
+	"github.com/akuity/kargo/pkg/logging"
 )
 
 // CollectorConfig is configuration for the garbage collector.
@@ -41,6 +44,10 @@ type CollectorConfig struct {
 	// MinFreightDeletionAge specifies the minimum age Freight must be before
 	// considered eligible for garbage collection.
 	MinFreightDeletionAge time.Duration `envconfig:"MIN_FREIGHT_DELETION_AGE" default:"336h"` // 2 weeks
+	// PushgatewayURL specifies the URL of a Prometheus Pushgateway to which
+	// metrics are pushed once garbage collection is complete. If empty, metrics
+	// are not pushed.
+	PushgatewayURL string `envconfig:"PUSHGATEWAY_URL"`
 }
 
 // CollectorConfigFromEnv returns a CollectorConfig populated from environment
@@ -74,13 +81,34 @@ type collector struct {
 		project string,
 	) error
 
-	cleanProjectPromotionsFn func(context.Context, string) error
+	getRetentionPolicyFn func(
+		ctx context.Context,
+		project string,
+	) (retentionPolicy, error)
+
+	getProjectConfigFn func(
+		ctx context.Context,
+		project string,
+	) (*kargoapi.ProjectConfig, error)
+
+	createEventFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+
+	cleanProjectPromotionsFn func(
+		ctx context.Context,
+		project string,
+		policy retentionPolicy,
+	) (int, error)
 
 	cleanStagePromotionsFn func(
 		ctx context.Context,
 		project string,
 		stage string,
-	) error
+		policy retentionPolicy,
+	) (int, error)
 
 	listProjectsFn func(
 		context.Context,
@@ -100,7 +128,11 @@ type collector struct {
 		...client.DeleteOption,
 	) error
 
-	cleanProjectFreightFn func(context.Context, string) error
+	cleanProjectFreightFn func(
+		ctx context.Context,
+		project string,
+		policy retentionPolicy,
+	) (int, error)
 
 	listWarehousesFn func(
 		context.Context,
@@ -112,7 +144,8 @@ type collector struct {
 		ctx context.Context,
 		project string,
 		warehouse string,
-	) error
+		policy retentionPolicy,
+	) (int, error)
 
 	listFreightFn func(
 		context.Context,
@@ -131,16 +164,38 @@ type collector struct {
 		client.Object,
 		...client.DeleteOption,
 	) error
+
+	// archiveFn, if non-nil, is used to archive each Promotion and Freight
+	// before it is deleted.
+	archiveFn func(context.Context, client.Object) error
+
+	// pushMetricsFn, if non-nil, is used to push metrics once garbage
+	// collection is complete.
+	pushMetricsFn func(context.Context) error
 }
 
 // NewCollector initializes and returns an implementation of the Collector
-// interface.
-func NewCollector(kubeClient client.Client, cfg CollectorConfig) Collector {
+// interface. If the provided Archive is non-nil, every Promotion and Freight
+// is archived to it before being deleted, and any that cannot be archived are
+// spared.
+func NewCollector(
+	kubeClient client.Client,
+	cfg CollectorConfig,
+	arch *archive.Archive,
+) Collector {
 	c := &collector{
 		cfg: cfg,
 	}
 	c.cleanProjectsFn = c.cleanProjects
 	c.cleanProjectFn = c.cleanProject
+	c.getRetentionPolicyFn = c.getRetentionPolicy
+	c.getProjectConfigFn = func(
+		ctx context.Context,
+		project string,
+	) (*kargoapi.ProjectConfig, error) {
+		return api.GetProjectConfig(ctx, kubeClient, project)
+	}
+	c.createEventFn = kubeClient.Create
 	c.cleanProjectPromotionsFn = c.cleanProjectPromotions
 	c.cleanStagePromotionsFn = c.cleanStagePromotions
 	c.listProjectsFn = kubeClient.List
@@ -152,6 +207,14 @@ func NewCollector(kubeClient client.Client, cfg CollectorConfig) Collector {
 	c.listFreightFn = kubeClient.List
 	c.listStagesFn = kubeClient.List
 	c.deleteFreightFn = kubeClient.Delete
+	if arch != nil {
+		c.archiveFn = arch.Put
+	}
+	if cfg.PushgatewayURL != "" {
+		c.pushMetricsFn = func(ctx context.Context) error {
+			return pushMetrics(ctx, cfg.PushgatewayURL)
+		}
+	}
 	return c
 }
 
@@ -225,6 +288,12 @@ func (c *collector) Run(ctx context.Context) error {
 	// Wait for error counter to finish
 	errsWG.Wait()
 
+	if c.pushMetricsFn != nil {
+		if err := c.pushMetricsFn(ctx); err != nil {
+			logging.LoggerFromContext(ctx).Error(err, "error pushing metrics")
+		}
+	}
+
 	if errCount > 0 {
 		return errors.New(
 			"one or more errors were encountered during garbage collection; " +
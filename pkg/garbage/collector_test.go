@@ -10,16 +10,21 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/pkg/archive"
 )
 
 func TestNewCollector(t *testing.T) {
 	watchClient := fake.NewClientBuilder().Build()
 	testCfg := CollectorConfigFromEnv()
-	c, ok := NewCollector(watchClient, testCfg).(*collector)
+	c, ok := NewCollector(watchClient, testCfg, nil).(*collector)
 	require.True(t, ok)
 	require.Equal(t, testCfg, c.cfg)
 	require.NotNil(t, c.cleanProjectsFn)
 	require.NotNil(t, c.cleanProjectFn)
+	require.NotNil(t, c.getRetentionPolicyFn)
+	require.NotNil(t, c.getProjectConfigFn)
+	require.NotNil(t, c.createEventFn)
 	require.NotNil(t, c.cleanProjectPromotionsFn)
 	require.NotNil(t, c.cleanStagePromotionsFn)
 	require.NotNil(t, c.listProjectsFn)
@@ -31,6 +36,19 @@ func TestNewCollector(t *testing.T) {
 	require.NotNil(t, c.listFreightFn)
 	require.NotNil(t, c.listStagesFn)
 	require.NotNil(t, c.deleteFreightFn)
+	require.Nil(t, c.archiveFn)
+	require.Nil(t, c.pushMetricsFn)
+
+	testCfg.PushgatewayURL = "http://pushgateway:9091"
+	c, ok = NewCollector(watchClient, testCfg, nil).(*collector)
+	require.True(t, ok)
+	require.NotNil(t, c.pushMetricsFn)
+
+	arch, err := archive.New(archive.Config{URL: t.TempDir()})
+	require.NoError(t, err)
+	c, ok = NewCollector(watchClient, testCfg, arch).(*collector)
+	require.True(t, ok)
+	require.NotNil(t, c.archiveFn)
 }
 
 func TestRun(t *testing.T) {
@@ -46,7 +64,8 @@ func TestRun(t *testing.T) {
 			projectCh <-chan string,
 			errCh chan<- struct{},
 		)
-		assertions func(*testing.T, error)
+		pushMetricsFn func(context.Context) error
+		assertions    func(*testing.T, error)
 	}{
 		{
 			name: "error listing Projects",
@@ -139,6 +158,25 @@ func TestRun(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			// The objective of this test case is to ensure that failure to push
+			// metrics does not fail garbage collection that otherwise succeeded.
+			name: "error pushing metrics",
+			listProjectsFn: func(
+				context.Context,
+				client.ObjectList,
+				...client.ListOption,
+			) error {
+				return nil
+			},
+			pushMetricsFn: func(context.Context) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -150,6 +188,7 @@ func TestRun(t *testing.T) {
 				},
 				listProjectsFn:  testCase.listProjectsFn,
 				cleanProjectsFn: testCase.cleanProjectsFn,
+				pushMetricsFn:   testCase.pushMetricsFn,
 			}
 			err := c.Run(ctx)
 			testCase.assertions(t, err)
@@ -18,7 +18,14 @@ import (
 //   - More than some configurable number of generations older than the oldest
 //     Freight (from the same Warehouse) that remains in use.
 //   - Older than some configurable minimum age.
-func (c *collector) cleanProjectFreight(ctx context.Context, project string) error {
+//   - Not protected by the Project's retention policy.
+//
+// It returns the number of Freight deleted.
+func (c *collector) cleanProjectFreight(
+	ctx context.Context,
+	project string,
+	policy retentionPolicy,
+) (int, error) {
 	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
 
 	warehouses := &kargoapi.WarehouseList{}
@@ -27,13 +34,15 @@ func (c *collector) cleanProjectFreight(ctx context.Context, project string) err
 		warehouses,
 		client.InNamespace(project),
 	); err != nil {
-		return fmt.Errorf("error listing Warehouses in Project %q: %w", project, err)
+		return 0, fmt.Errorf("error listing Warehouses in Project %q: %w", project, err)
 	}
 
-	var cleanErrCount int
+	var deletedCount, cleanErrCount int
 	for _, warehouse := range warehouses.Items {
 		warehouseLogger := logger.WithValues("warehouse", warehouse.Name)
-		if err := c.cleanWarehouseFreightFn(ctx, project, warehouse.Name); err != nil {
+		deleted, err := c.cleanWarehouseFreightFn(ctx, project, warehouse.Name, policy)
+		deletedCount += deleted
+		if err != nil {
 			warehouseLogger.Error(err, "error cleaning Freight from Warehouse")
 			cleanErrCount++
 			continue
@@ -42,13 +51,13 @@ func (c *collector) cleanProjectFreight(ctx context.Context, project string) err
 	}
 
 	if cleanErrCount > 0 {
-		return fmt.Errorf(
+		return deletedCount, fmt.Errorf(
 			"error cleaning Freight from one or more Warehouses in Project %q",
 			project,
 		)
 	}
 
-	return nil
+	return deletedCount, nil
 }
 
 // cleanWarehouseFreight deletes all Freight from the specified Project and
@@ -56,11 +65,15 @@ func (c *collector) cleanProjectFreight(ctx context.Context, project string) err
 //   - More than some configurable number of generations older than the oldest
 //     Freight (from the same Warehouse) that remains in use.
 //   - Older than some configurable minimum age.
+//   - Not protected by the provided retentionPolicy.
+//
+// It returns the number of Freight deleted.
 func (c *collector) cleanWarehouseFreight(
 	ctx context.Context,
 	project string,
 	warehouse string,
-) error {
+	policy retentionPolicy,
+) (int, error) {
 	logger := logging.LoggerFromContext(ctx).WithValues(
 		"project", project,
 		"warehouse", warehouse,
@@ -75,7 +88,7 @@ func (c *collector) cleanWarehouseFreight(
 			indexer.FreightByWarehouseField: warehouse,
 		},
 	); err != nil {
-		return fmt.Errorf(
+		return 0, fmt.Errorf(
 			"error listing Freight from Warehouse %q in Project %q: %w",
 			warehouse,
 			project,
@@ -83,8 +96,8 @@ func (c *collector) cleanWarehouseFreight(
 		)
 	}
 
-	if len(freight.Items) <= c.cfg.MaxRetainedFreight {
-		return nil // Done
+	if len(freight.Items) <= policy.maxRetainedFreight {
+		return 0, nil // Done
 	}
 
 	// Sort by creation timestamp descending
@@ -108,7 +121,7 @@ func (c *collector) cleanWarehouseFreight(
 				err, "error listing Stages using Freight",
 				"freight", f,
 			)
-			return fmt.Errorf(
+			return 0, fmt.Errorf(
 				"error listing Stages in Project %q using Freight %q: %w",
 				project,
 				f.Name,
@@ -120,33 +133,44 @@ func (c *collector) cleanWarehouseFreight(
 		}
 	}
 
-	firstToDeleteIndex := oldestInUseIndex + c.cfg.MaxRetainedFreight + 1
+	firstToDeleteIndex := oldestInUseIndex + policy.maxRetainedFreight + 1
 	if firstToDeleteIndex >= len(freight.Items) {
-		return nil // Done
+		return 0, nil // Done
 	}
 
-	var deleteErrCount int
+	var deletedCount, deleteErrCount int
 	for i := firstToDeleteIndex; i < len(freight.Items); i++ {
 		f := freight.Items[i]
-		if time.Since(f.CreationTimestamp.Time) < c.cfg.MinFreightDeletionAge {
+		if time.Since(f.CreationTimestamp.Time) < policy.minFreightDeletionAge {
 			continue // Not old enough
 		}
+		if policy.isProtected(f) {
+			continue // Protected by the retention policy
+		}
 		freightLogger := logger.WithValues("freight", f.Name)
+		if c.archiveFn != nil {
+			if err := c.archiveFn(ctx, &f); err != nil {
+				freightLogger.Error(err, "error archiving Freight; it will not be deleted")
+				deleteErrCount++
+				continue
+			}
+		}
 		if err := c.deleteFreightFn(ctx, &f); err != nil {
 			freightLogger.Error(err, "error deleting Freight")
 			deleteErrCount++
 		} else {
 			freightLogger.Debug("deleted Freight")
+			deletedCount++
 		}
 	}
 
 	if deleteErrCount > 0 {
-		return fmt.Errorf(
+		return deletedCount, fmt.Errorf(
 			"error deleting one or more Freight from Warehouse %q in Project %q",
 			warehouse,
 			project,
 		)
 	}
 
-	return nil
+	return deletedCount, nil
 }
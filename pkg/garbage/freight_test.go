@@ -17,7 +17,7 @@ func TestCleanProjectFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
 		collector  *collector
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, int, error)
 	}{
 		{
 			name: "error listing Warehouses",
@@ -30,7 +30,7 @@ func TestCleanProjectFreight(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error listing Warehouses in Project")
 				require.ErrorContains(t, err, "something went wrong")
 			},
@@ -48,14 +48,20 @@ func TestCleanProjectFreight(t *testing.T) {
 					warehouses.Items = []kargoapi.Warehouse{{}}
 					return nil
 				},
-				cleanWarehouseFreightFn: func(context.Context, string, string) error {
-					return errors.New("something went wrong")
+				cleanWarehouseFreightFn: func(
+					context.Context,
+					string,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 1, errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, deleted int, err error) {
 				require.ErrorContains(
 					t, err, "error cleaning Freight from one or more Warehouses",
 				)
+				require.Equal(t, 1, deleted)
 			},
 		},
 		{
@@ -68,27 +74,32 @@ func TestCleanProjectFreight(t *testing.T) {
 				) error {
 					warehouses, ok := objList.(*kargoapi.WarehouseList)
 					require.True(t, ok)
-					warehouses.Items = []kargoapi.Warehouse{{}}
+					warehouses.Items = []kargoapi.Warehouse{{}, {}}
 					return nil
 				},
-				cleanWarehouseFreightFn: func(context.Context, string, string) error {
-					return nil
+				cleanWarehouseFreightFn: func(
+					context.Context,
+					string,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 2, nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, deleted int, err error) {
 				require.NoError(t, err)
+				require.Equal(t, 4, deleted)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.collector.cleanProjectFreight(
-					context.Background(),
-					"fake-project",
-				),
+			deleted, err := testCase.collector.cleanProjectFreight(
+				context.Background(),
+				"fake-project",
+				retentionPolicy{},
 			)
+			testCase.assertions(t, deleted, err)
 		})
 	}
 }
@@ -96,8 +107,9 @@ func TestCleanProjectFreight(t *testing.T) {
 func TestCleanWarehouseFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
+		policy     retentionPolicy
 		collector  *collector
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, int, error)
 	}{
 		{
 			name: "error listing Freight",
@@ -110,17 +122,17 @@ func TestCleanWarehouseFreight(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error listing Freight from Warehouse")
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
 		{
 			name: "fewer Freight than threshold",
+			policy: retentionPolicy{
+				maxRetainedFreight: 2,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight: 2,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -132,16 +144,16 @@ func TestCleanWarehouseFreight(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.NoError(t, err)
 			},
 		},
 		{
 			name: "error listing Stages",
+			policy: retentionPolicy{
+				maxRetainedFreight: 1,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight: 1,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -160,18 +172,18 @@ func TestCleanWarehouseFreight(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error listing Stages in Project")
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
 		{
 			name: "error deleting Freight",
+			policy: retentionPolicy{
+				maxRetainedFreight:    1,
+				minFreightDeletionAge: time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight:    1,
-					MinFreightDeletionAge: time.Minute,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -210,19 +222,238 @@ func TestCleanWarehouseFreight(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(
 					t, err, "error deleting one or more Freight from Warehouse",
 				)
 			},
 		},
+		{
+			name: "error archiving Freight",
+			policy: retentionPolicy{
+				maxRetainedFreight:    1,
+				minFreightDeletionAge: time.Minute,
+			},
+			collector: &collector{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					now := metav1.Now()
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+						},
+					}
+					return nil
+				},
+				listStagesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					// This will appear that no Freight are in use
+					return nil
+				},
+				archiveFn: func(context.Context, client.Object) error {
+					return errors.New("something went wrong")
+				},
+				deleteFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					require.FailNow(t, "Freight that was not archived should not be deleted")
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, deleted int, err error) {
+				require.ErrorContains(
+					t, err, "error deleting one or more Freight from Warehouse",
+				)
+				require.Zero(t, deleted)
+			},
+		},
+		{
+			name: "success with archival",
+			policy: retentionPolicy{
+				maxRetainedFreight:    1,
+				minFreightDeletionAge: time.Minute,
+			},
+			collector: &collector{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					now := metav1.Now()
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "newer",
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "older",
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+						},
+					}
+					return nil
+				},
+				listStagesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					// This will appear that no Freight are in use
+					return nil
+				},
+				archiveFn: func(_ context.Context, obj client.Object) error {
+					require.Equal(t, "older", obj.GetName())
+					return nil
+				},
+				deleteFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, deleted int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, deleted)
+			},
+		},
 		{
 			name: "success",
+			policy: retentionPolicy{
+				maxRetainedFreight:    1,
+				minFreightDeletionAge: time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight:    1,
-					MinFreightDeletionAge: time.Minute,
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					now := metav1.Now()
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+						},
+					}
+					return nil
+				},
+				listStagesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					// This will appear that no Freight are in use
+					return nil
+				},
+				deleteFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return nil
 				},
+			},
+			assertions: func(t *testing.T, deleted int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, deleted)
+			},
+		},
+		{
+			name: "protected Freight is not deleted",
+			policy: retentionPolicy{
+				maxRetainedFreight:    1,
+				minFreightDeletionAge: time.Minute,
+				protectedStages:       map[string]struct{}{"prod": {}},
+			},
+			collector: &collector{
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					now := metav1.Now()
+					freight.Items = []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+							Status: kargoapi.FreightStatus{
+								VerifiedIn: map[string]kargoapi.VerifiedStage{"prod": {}},
+							},
+						},
+					}
+					return nil
+				},
+				listStagesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					// This will appear that no Freight are in use
+					return nil
+				},
+				deleteFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					require.FailNow(t, "protected Freight should not be deleted")
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, deleted int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, deleted)
+			},
+		},
+		{
+			name: "Freight replaced in protected Stage before verification is not deleted",
+			policy: retentionPolicy{
+				maxRetainedFreight:    1,
+				minFreightDeletionAge: time.Minute,
+				protectedStages:       map[string]struct{}{"prod": {}},
+				protectedFreight:      map[string]struct{}{"replaced-freight": {}},
+			},
+			collector: &collector{
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -239,6 +470,7 @@ func TestCleanWarehouseFreight(t *testing.T) {
 						},
 						{
 							ObjectMeta: metav1.ObjectMeta{
+								Name:              "replaced-freight",
 								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
 							},
 						},
@@ -258,24 +490,25 @@ func TestCleanWarehouseFreight(t *testing.T) {
 					client.Object,
 					...client.DeleteOption,
 				) error {
+					require.FailNow(t, "protected Freight should not be deleted")
 					return nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, deleted int, err error) {
 				require.NoError(t, err)
+				require.Zero(t, deleted)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.collector.cleanWarehouseFreight(
-					context.Background(),
-					"fake-project",
-					"fake-warehouse",
-				),
+			deleted, err := testCase.collector.cleanWarehouseFreight(
+				context.Background(),
+				"fake-project",
+				"fake-warehouse",
+				testCase.policy,
 			)
+			testCase.assertions(t, deleted, err)
 		})
 	}
 }
@@ -0,0 +1,38 @@
+package garbage
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	resourceKindPromotion = "Promotion"
+	resourceKindFreight   = "Freight"
+
+	pushgatewayJob = "kargo_garbage_collector"
+)
+
+// deletedResourcesTotal counts the resources deleted by the garbage collector,
+// by Project and resource kind.
+var deletedResourcesTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "kargo_garbage_collector_deleted_resources_total",
+		Help: "Total number of resources deleted by the garbage collector",
+	},
+	[]string{"project", "kind"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(deletedResourcesTotal)
+}
+
+// pushMetrics pushes the garbage collector's metrics to the Prometheus
+// Pushgateway at the specified URL.
+func pushMetrics(ctx context.Context, url string) error {
+	return push.New(url, pushgatewayJob).
+		Collector(deletedResourcesTotal).
+		PushContext(ctx)
+}
@@ -0,0 +1,26 @@
+package garbage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushMetrics(t *testing.T) {
+	var method, path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	deletedResourcesTotal.WithLabelValues("fake-project", resourceKindPromotion).Inc()
+
+	require.NoError(t, pushMetrics(context.Background(), srv.URL))
+	require.Equal(t, http.MethodPut, method)
+	require.Equal(t, "/metrics/job/"+pushgatewayJob, path)
+}
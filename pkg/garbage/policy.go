@@ -0,0 +1,119 @@
+package garbage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// retentionPolicy describes how Promotions and Freight within a single Project
+// are retained. It is derived from the collector's configuration, overridden
+// by any garbage collection policy found in the Project's ProjectConfig.
+type retentionPolicy struct {
+	maxRetainedPromotions   int
+	minPromotionDeletionAge time.Duration
+	maxRetainedFreight      int
+	minFreightDeletionAge   time.Duration
+	// protectedStages is the set of names of Stages in which Freight must never
+	// have been verified for it to be eligible for deletion.
+	protectedStages map[string]struct{}
+	// protectedFreight is the set of names of Freight found in the Freight
+	// history of any protected Stage. This includes Freight that was promoted
+	// to a protected Stage and replaced before it could be verified there.
+	protectedFreight map[string]struct{}
+}
+
+// isProtected returns true if the provided Freight is currently in, was ever
+// verified in, or is recorded in the Freight history of any of the policy's
+// protected Stages.
+func (r retentionPolicy) isProtected(freight kargoapi.Freight) bool {
+	if _, ok := r.protectedFreight[freight.Name]; ok {
+		return true
+	}
+	for stage := range r.protectedStages {
+		if _, ok := freight.Status.CurrentlyIn[stage]; ok {
+			return true
+		}
+		if _, ok := freight.Status.VerifiedIn[stage]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getRetentionPolicy returns the retentionPolicy for the specified Project.
+func (c *collector) getRetentionPolicy(
+	ctx context.Context,
+	project string,
+) (retentionPolicy, error) {
+	policy := retentionPolicy{
+		maxRetainedPromotions:   c.cfg.MaxRetainedPromotions,
+		minPromotionDeletionAge: c.cfg.MinPromotionDeletionAge,
+		maxRetainedFreight:      c.cfg.MaxRetainedFreight,
+		minFreightDeletionAge:   c.cfg.MinFreightDeletionAge,
+	}
+
+	projectCfg, err := c.getProjectConfigFn(ctx, project)
+	if err != nil {
+		return policy, err
+	}
+	if projectCfg == nil || projectCfg.Spec.GarbageCollection == nil {
+		return policy, nil
+	}
+	gc := projectCfg.Spec.GarbageCollection
+
+	if gc.MaxRetainedPromotions != nil {
+		policy.maxRetainedPromotions = int(*gc.MaxRetainedPromotions)
+	}
+	if gc.MinPromotionDeletionAge != nil {
+		policy.minPromotionDeletionAge = gc.MinPromotionDeletionAge.Duration
+	}
+	if gc.MaxRetainedFreight != nil {
+		policy.maxRetainedFreight = int(*gc.MaxRetainedFreight)
+	}
+	if gc.MinFreightDeletionAge != nil {
+		policy.minFreightDeletionAge = gc.MinFreightDeletionAge.Duration
+	}
+
+	if gc.ProtectedStageSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(gc.ProtectedStageSelector)
+		if err != nil {
+			return policy, fmt.Errorf(
+				"error parsing protected Stage selector of Project %q: %w",
+				project, err,
+			)
+		}
+		stages := kargoapi.StageList{}
+		if err = c.listStagesFn(
+			ctx,
+			&stages,
+			client.InNamespace(project),
+			client.MatchingLabelsSelector{Selector: selector},
+		); err != nil {
+			return policy, fmt.Errorf(
+				"error listing protected Stages in Project %q: %w",
+				project, err,
+			)
+		}
+		policy.protectedStages = make(map[string]struct{}, len(stages.Items))
+		policy.protectedFreight = make(map[string]struct{})
+		for _, stage := range stages.Items {
+			policy.protectedStages[stage.Name] = struct{}{}
+			for _, col := range stage.Status.FreightHistory {
+				if col == nil {
+					continue
+				}
+				for _, ref := range col.References() {
+					policy.protectedFreight[ref.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return policy, nil
+}
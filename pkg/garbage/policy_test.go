@@ -0,0 +1,212 @@
+package garbage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestRetentionPolicyIsProtected(t *testing.T) {
+	policy := retentionPolicy{
+		protectedStages: map[string]struct{}{"prod": {}},
+	}
+	require.False(t, policy.isProtected(kargoapi.Freight{}))
+	require.False(t, policy.isProtected(kargoapi.Freight{
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{"test": {}},
+		},
+	}))
+	require.True(t, policy.isProtected(kargoapi.Freight{
+		Status: kargoapi.FreightStatus{
+			CurrentlyIn: map[string]kargoapi.CurrentStage{"prod": {}},
+		},
+	}))
+	require.True(t, policy.isProtected(kargoapi.Freight{
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{"prod": {}},
+		},
+	}))
+
+	// Freight that was promoted to a protected Stage and replaced there before
+	// it was ever verified
+	policy.protectedFreight = map[string]struct{}{"replaced-freight": {}}
+	require.True(t, policy.isProtected(kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Name: "replaced-freight"},
+	}))
+	require.False(t, policy.isProtected(kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-freight"},
+	}))
+}
+
+func TestGetRetentionPolicy(t *testing.T) {
+	testCfg := CollectorConfig{
+		MaxRetainedPromotions:   20,
+		MinPromotionDeletionAge: 336 * time.Hour,
+		MaxRetainedFreight:      20,
+		MinFreightDeletionAge:   336 * time.Hour,
+	}
+	defaultPolicy := retentionPolicy{
+		maxRetainedPromotions:   20,
+		minPromotionDeletionAge: 336 * time.Hour,
+		maxRetainedFreight:      20,
+		minFreightDeletionAge:   336 * time.Hour,
+	}
+
+	testCases := []struct {
+		name          string
+		projectCfg    *kargoapi.ProjectConfig
+		projectCfgErr error
+		listStagesFn  func(context.Context, client.ObjectList, ...client.ListOption) error
+		assertions    func(*testing.T, retentionPolicy, error)
+	}{
+		{
+			name:          "error getting ProjectConfig",
+			projectCfgErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ retentionPolicy, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "no ProjectConfig",
+			assertions: func(t *testing.T, policy retentionPolicy, err error) {
+				require.NoError(t, err)
+				require.Equal(t, defaultPolicy, policy)
+			},
+		},
+		{
+			name:       "no garbage collection policy",
+			projectCfg: &kargoapi.ProjectConfig{},
+			assertions: func(t *testing.T, policy retentionPolicy, err error) {
+				require.NoError(t, err)
+				require.Equal(t, defaultPolicy, policy)
+			},
+		},
+		{
+			name: "invalid protected Stage selector",
+			projectCfg: &kargoapi.ProjectConfig{
+				Spec: kargoapi.ProjectConfigSpec{
+					GarbageCollection: &kargoapi.GarbageCollectionPolicy{
+						ProtectedStageSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{{
+								Key:      "tier",
+								Operator: "bogus",
+							}},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ retentionPolicy, err error) {
+				require.ErrorContains(t, err, "error parsing protected Stage selector")
+			},
+		},
+		{
+			name: "error listing protected Stages",
+			projectCfg: &kargoapi.ProjectConfig{
+				Spec: kargoapi.ProjectConfigSpec{
+					GarbageCollection: &kargoapi.GarbageCollectionPolicy{
+						ProtectedStageSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"tier": "prod"},
+						},
+					},
+				},
+			},
+			listStagesFn: func(context.Context, client.ObjectList, ...client.ListOption) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ retentionPolicy, err error) {
+				require.ErrorContains(t, err, "error listing protected Stages")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "overrides",
+			projectCfg: &kargoapi.ProjectConfig{
+				Spec: kargoapi.ProjectConfigSpec{
+					GarbageCollection: &kargoapi.GarbageCollectionPolicy{
+						MaxRetainedPromotions:   ptr.To[int32](5),
+						MinPromotionDeletionAge: &metav1.Duration{Duration: time.Hour},
+						MaxRetainedFreight:      ptr.To[int32](100),
+						ProtectedStageSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"tier": "prod"},
+						},
+					},
+				},
+			},
+			listStagesFn: func(
+				_ context.Context,
+				objList client.ObjectList,
+				_ ...client.ListOption,
+			) error {
+				stages, ok := objList.(*kargoapi.StageList)
+				require.True(t, ok)
+				stages.Items = []kargoapi.Stage{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "prod-east"},
+						Status: kargoapi.StageStatus{
+							FreightHistory: kargoapi.FreightHistory{
+								{
+									Freight: map[string]kargoapi.FreightReference{
+										"Warehouse/fake-warehouse": {Name: "current-freight"},
+									},
+								},
+								{
+									// Promoted, then replaced before it was verified
+									Freight: map[string]kargoapi.FreightReference{
+										"Warehouse/fake-warehouse": {Name: "replaced-freight"},
+									},
+								},
+							},
+						},
+					},
+					{ObjectMeta: metav1.ObjectMeta{Name: "prod-west"}},
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, policy retentionPolicy, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					retentionPolicy{
+						maxRetainedPromotions:   5,
+						minPromotionDeletionAge: time.Hour,
+						maxRetainedFreight:      100,
+						minFreightDeletionAge:   336 * time.Hour,
+						protectedStages: map[string]struct{}{
+							"prod-east": {},
+							"prod-west": {},
+						},
+						protectedFreight: map[string]struct{}{
+							"current-freight":  {},
+							"replaced-freight": {},
+						},
+					},
+					policy,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &collector{
+				cfg: testCfg,
+				getProjectConfigFn: func(
+					context.Context,
+					string,
+				) (*kargoapi.ProjectConfig, error) {
+					return testCase.projectCfg, testCase.projectCfgErr
+				},
+				listStagesFn: testCase.listStagesFn,
+			}
+			policy, err := c.getRetentionPolicy(context.Background(), "fake-project")
+			testCase.assertions(t, policy, err)
+		})
+	}
+}
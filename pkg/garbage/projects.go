@@ -5,9 +5,18 @@ import (
 	"errors"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/logging"
 )
 
+const (
+	eventReasonGarbageCollected = "GarbageCollected"
+	eventSourceComponent        = "kargo-garbage-collector"
+)
+
 // cleanProjects is a worker function that receives Project names over a channel
 // until that channel is closed. It will execute garbage collection for each
 // Project name received.
@@ -40,23 +49,84 @@ func (c *collector) cleanProjects(
 	}
 }
 
-// cleanProject executes garbage collection for a single Project.
+// cleanProject executes garbage collection for a single Project according to
+// its retention policy and reports what was deleted.
 func (c *collector) cleanProject(ctx context.Context, project string) error {
+	policy, err := c.getRetentionPolicyFn(ctx, project)
+	if err != nil {
+		return fmt.Errorf(
+			"error getting retention policy for Project %q: %w",
+			project, err,
+		)
+	}
+
 	errs := []error{}
 
-	if err := c.cleanProjectPromotionsFn(ctx, project); err != nil {
+	deletedPromos, err := c.cleanProjectPromotionsFn(ctx, project, policy)
+	if err != nil {
 		errs = append(
 			errs,
 			fmt.Errorf("error cleaning Promotions in Project %q: %w", project, err),
 		)
 	}
 
-	if err := c.cleanProjectFreightFn(ctx, project); err != nil {
+	deletedFreight, err := c.cleanProjectFreightFn(ctx, project, policy)
+	if err != nil {
 		errs = append(
 			errs,
 			fmt.Errorf("error cleaning Freight in Project %q: %w", project, err),
 		)
 	}
 
+	c.reportDeletions(ctx, project, deletedPromos, deletedFreight)
+
 	return errors.Join(errs...)
 }
+
+// reportDeletions records the numbers of Promotions and Freight deleted from
+// the specified Project as metrics and, if anything was deleted, as an Event
+// involving the Project. Failure to record the Event is logged, but is not
+// otherwise treated as an error.
+func (c *collector) reportDeletions(
+	ctx context.Context,
+	project string,
+	deletedPromos int,
+	deletedFreight int,
+) {
+	deletedResourcesTotal.WithLabelValues(project, resourceKindPromotion).
+		Add(float64(deletedPromos))
+	deletedResourcesTotal.WithLabelValues(project, resourceKindFreight).
+		Add(float64(deletedFreight))
+
+	if deletedPromos == 0 && deletedFreight == 0 {
+		return
+	}
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    project,
+			GenerateName: project + ".",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Project",
+			Name:       project,
+		},
+		Reason: eventReasonGarbageCollected,
+		Message: fmt.Sprintf(
+			"Deleted %d Promotion(s) and %d Freight",
+			deletedPromos, deletedFreight,
+		),
+		Type:           corev1.EventTypeNormal,
+		Source:         corev1.EventSource{Component: eventSourceComponent},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if err := c.createEventFn(ctx, event); err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error recording garbage collection Event",
+			"project", project,
+		)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestCleanProjects(t *testing.T) {
@@ -87,46 +90,114 @@ func TestCleanProject(t *testing.T) {
 	testCases := []struct {
 		name       string
 		collector  *collector
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, []*corev1.Event, error)
 	}{
+		{
+			name: "error getting retention policy",
+			collector: &collector{
+				getRetentionPolicyFn: func(context.Context, string) (retentionPolicy, error) {
+					return retentionPolicy{}, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, events []*corev1.Event, err error) {
+				require.ErrorContains(t, err, "error getting retention policy for Project")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, events)
+			},
+		},
 		{
 			name: "errors cleaning Promotions and Freight",
 			collector: &collector{
-				cleanProjectPromotionsFn: func(context.Context, string) error {
-					return errors.New("something went wrong")
+				getRetentionPolicyFn: func(context.Context, string) (retentionPolicy, error) {
+					return retentionPolicy{}, nil
+				},
+				cleanProjectPromotionsFn: func(
+					context.Context,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 0, errors.New("something went wrong")
 				},
-				cleanProjectFreightFn: func(context.Context, string) error {
-					return errors.New("something else went wrong")
+				cleanProjectFreightFn: func(
+					context.Context,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 0, errors.New("something else went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, events []*corev1.Event, err error) {
 				require.ErrorContains(t, err, "error cleaning Promotions in Project")
 				require.ErrorContains(t, err, "something went wrong")
 				require.ErrorContains(t, err, "error cleaning Freight in Project")
 				require.ErrorContains(t, err, "something else went wrong")
+				// Nothing was deleted, so nothing should have been reported
+				require.Empty(t, events)
 			},
 		},
 		{
 			name: "success",
 			collector: &collector{
-				cleanProjectPromotionsFn: func(context.Context, string) error {
-					return nil
+				getRetentionPolicyFn: func(context.Context, string) (retentionPolicy, error) {
+					return retentionPolicy{}, nil
 				},
-				cleanProjectFreightFn: func(context.Context, string) error {
-					return nil
+				cleanProjectPromotionsFn: func(
+					context.Context,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 3, nil
+				},
+				cleanProjectFreightFn: func(
+					context.Context,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 2, nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, events []*corev1.Event, err error) {
 				require.NoError(t, err)
+				require.Len(t, events, 1)
+				event := events[0]
+				require.Equal(t, "fake-project", event.Namespace)
+				require.Equal(t, "Project", event.InvolvedObject.Kind)
+				require.Equal(t, "fake-project", event.InvolvedObject.Name)
+				require.Equal(t, eventReasonGarbageCollected, event.Reason)
+				require.Equal(t, "Deleted 3 Promotion(s) and 2 Freight", event.Message)
+				require.Equal(
+					t,
+					float64(3),
+					testutil.ToFloat64(
+						deletedResourcesTotal.WithLabelValues("fake-project", resourceKindPromotion),
+					),
+				)
+				require.Equal(
+					t,
+					float64(2),
+					testutil.ToFloat64(
+						deletedResourcesTotal.WithLabelValues("fake-project", resourceKindFreight),
+					),
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.collector.cleanProject(context.Background(), "fake-project"),
-			)
+			deletedResourcesTotal.Reset()
+			var events []*corev1.Event
+			testCase.collector.createEventFn = func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				event, ok := obj.(*corev1.Event)
+				require.True(t, ok)
+				events = append(events, event)
+				return nil
+			}
+			err := testCase.collector.cleanProject(context.Background(), "fake-project")
+			testCase.assertions(t, events, err)
 		})
 	}
 }
@@ -18,7 +18,13 @@ import (
 //   - More than some configurable number of generations older than the oldest
 //     Promotion (from the same Stage) in a non-terminal phase.
 //   - Older than some configurable minimum age.
-func (c *collector) cleanProjectPromotions(ctx context.Context, project string) error {
+//
+// It returns the number of Promotions deleted.
+func (c *collector) cleanProjectPromotions(
+	ctx context.Context,
+	project string,
+	policy retentionPolicy,
+) (int, error) {
 	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
 
 	stages := &kargoapi.StageList{}
@@ -27,13 +33,15 @@ func (c *collector) cleanProjectPromotions(ctx context.Context, project string)
 		stages,
 		client.InNamespace(project),
 	); err != nil {
-		return fmt.Errorf("error listing Stages in Project %q: %w", project, err)
+		return 0, fmt.Errorf("error listing Stages in Project %q: %w", project, err)
 	}
 
-	var cleanErrCount int
+	var deletedCount, cleanErrCount int
 	for _, stage := range stages.Items {
 		stageLogger := logger.WithValues("stage", stage.Name)
-		if err := c.cleanStagePromotionsFn(ctx, project, stage.Name); err != nil {
+		deleted, err := c.cleanStagePromotionsFn(ctx, project, stage.Name, policy)
+		deletedCount += deleted
+		if err != nil {
 			stageLogger.Error(err, "error cleaning Promotions to Stage")
 			cleanErrCount++
 			continue
@@ -42,20 +50,23 @@ func (c *collector) cleanProjectPromotions(ctx context.Context, project string)
 	}
 
 	if cleanErrCount > 0 {
-		return fmt.Errorf(
+		return deletedCount, fmt.Errorf(
 			"error cleaning Promotions to one or more Stages in Project %q",
 			project,
 		)
 	}
 
-	return nil
+	return deletedCount, nil
 }
 
+// cleanStagePromotions deletes Promotions to the specified Stage according to
+// the provided retentionPolicy. It returns the number of Promotions deleted.
 func (c *collector) cleanStagePromotions(
 	ctx context.Context,
 	project string,
 	stage string,
-) error {
+	policy retentionPolicy,
+) (int, error) {
 	logger := logging.LoggerFromContext(ctx).WithValues(
 		"project", project,
 		"stage", stage,
@@ -70,7 +81,7 @@ func (c *collector) cleanStagePromotions(
 			indexer.PromotionsByStageField: stage,
 		},
 	); err != nil {
-		return fmt.Errorf(
+		return 0, fmt.Errorf(
 			"error listing Promotions to Stage %q in Project %q: %w",
 			stage,
 			project,
@@ -78,8 +89,8 @@ func (c *collector) cleanStagePromotions(
 		)
 	}
 
-	if len(promos.Items) <= c.cfg.MaxRetainedPromotions {
-		return nil // Done
+	if len(promos.Items) <= policy.maxRetainedPromotions {
+		return 0, nil // Done
 	}
 
 	// Sort by creation time desc descending
@@ -95,33 +106,41 @@ func (c *collector) cleanStagePromotions(
 		}
 	}
 
-	firstToDeleteIndex := oldestNonTerminalIndex + c.cfg.MaxRetainedPromotions + 1
+	firstToDeleteIndex := oldestNonTerminalIndex + policy.maxRetainedPromotions + 1
 	if firstToDeleteIndex >= len(promos.Items) {
-		return nil // Done
+		return 0, nil // Done
 	}
 
-	var deleteErrCount int
+	var deletedCount, deleteErrCount int
 	for i := firstToDeleteIndex; i < len(promos.Items); i++ {
 		promo := promos.Items[i]
-		if time.Since(promo.CreationTimestamp.Time) < c.cfg.MinPromotionDeletionAge {
+		if time.Since(promo.CreationTimestamp.Time) < policy.minPromotionDeletionAge {
 			continue // Not old enough
 		}
 		promoLogger := logger.WithValues("promotion", promo.Name)
+		if c.archiveFn != nil {
+			if err := c.archiveFn(ctx, &promo); err != nil {
+				promoLogger.Error(err, "error archiving Promotion; it will not be deleted")
+				deleteErrCount++
+				continue
+			}
+		}
 		if err := c.deletePromotionFn(ctx, &promo); err != nil {
 			promoLogger.Error(err, "error deleting Promotion")
 			deleteErrCount++
 		} else {
 			promoLogger.Debug("deleted Promotion")
+			deletedCount++
 		}
 	}
 
 	if deleteErrCount > 0 {
-		return fmt.Errorf(
+		return deletedCount, fmt.Errorf(
 			"error deleting one or more Promotions from Stage %q in Project %q",
 			stage,
 			project,
 		)
 	}
 
-	return nil
+	return deletedCount, nil
 }
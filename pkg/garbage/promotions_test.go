@@ -17,7 +17,7 @@ func TestCleanProjectPromotions(t *testing.T) {
 	testCases := []struct {
 		name       string
 		collector  *collector
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, int, error)
 	}{
 		{
 			name: "error listing Stages",
@@ -30,7 +30,7 @@ func TestCleanProjectPromotions(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error listing Stages in Project")
 				require.ErrorContains(t, err, "something went wrong")
 			},
@@ -49,11 +49,16 @@ func TestCleanProjectPromotions(t *testing.T) {
 					stages.Items = []kargoapi.Stage{{}}
 					return nil
 				},
-				cleanStagePromotionsFn: func(context.Context, string, string) error {
-					return errors.New("something went wrong")
+				cleanStagePromotionsFn: func(
+					context.Context,
+					string,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 0, errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error cleaning Promotions to one or more Stages")
 			},
 		},
@@ -68,28 +73,32 @@ func TestCleanProjectPromotions(t *testing.T) {
 				) error {
 					stages, ok := objList.(*kargoapi.StageList)
 					require.True(t, ok)
-					stages.Items = []kargoapi.Stage{}
+					stages.Items = []kargoapi.Stage{{}, {}}
 					return nil
 				},
-				cleanStagePromotionsFn: func(context.Context, string, string) error {
-					return nil
+				cleanStagePromotionsFn: func(
+					context.Context,
+					string,
+					string,
+					retentionPolicy,
+				) (int, error) {
+					return 3, nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, deleted int, err error) {
 				require.NoError(t, err)
+				require.Equal(t, 6, deleted)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.collector.cfg.MaxRetainedPromotions = 20
-			testCase.assertions(
-				t,
-				testCase.collector.cleanProjectPromotions(
-					context.Background(),
-					"fake-project",
-				),
+			deleted, err := testCase.collector.cleanProjectPromotions(
+				context.Background(),
+				"fake-project",
+				retentionPolicy{maxRetainedPromotions: 20},
 			)
+			testCase.assertions(t, deleted, err)
 		})
 	}
 }
@@ -97,8 +106,9 @@ func TestCleanProjectPromotions(t *testing.T) {
 func TestCleanStagePromotions(t *testing.T) {
 	testCases := []struct {
 		name       string
+		policy     retentionPolicy
 		collector  *collector
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, int, error)
 	}{
 		{
 			name: "error listing Promotions",
@@ -111,17 +121,17 @@ func TestCleanStagePromotions(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error listing Promotions to Stage")
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
 		{
 			name: "fewer Promotions threshold",
+			policy: retentionPolicy{
+				maxRetainedPromotions: 2,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedPromotions: 2,
-				},
 				listPromotionsFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -133,17 +143,17 @@ func TestCleanStagePromotions(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.NoError(t, err)
 			},
 		},
 		{
 			name: "error deleting Promotion",
+			policy: retentionPolicy{
+				maxRetainedPromotions:   1,
+				minPromotionDeletionAge: time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedPromotions:   1,
-					MinPromotionDeletionAge: time.Minute,
-				},
 				listPromotionsFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -180,17 +190,123 @@ func TestCleanStagePromotions(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ int, err error) {
 				require.ErrorContains(t, err, "error deleting one or more Promotions from Stage")
 			},
 		},
 		{
-			name: "success",
+			name: "error archiving Promotion",
+			policy: retentionPolicy{
+				maxRetainedPromotions:   1,
+				minPromotionDeletionAge: time.Minute,
+			},
+			collector: &collector{
+				listPromotionsFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					now := metav1.Now()
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+					}
+					return nil
+				},
+				archiveFn: func(context.Context, client.Object) error {
+					return errors.New("something went wrong")
+				},
+				deletePromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					require.FailNow(t, "Promotion that was not archived should not be deleted")
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, deleted int, err error) {
+				require.ErrorContains(t, err, "error deleting one or more Promotions from Stage")
+				require.Zero(t, deleted)
+			},
+		},
+		{
+			name: "success with archival",
+			policy: retentionPolicy{
+				maxRetainedPromotions:   1,
+				minPromotionDeletionAge: time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedPromotions:   1,
-					MinPromotionDeletionAge: time.Minute,
+				listPromotionsFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					now := metav1.Now()
+					promos.Items = []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "newer",
+								CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:              "older",
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+					}
+					return nil
+				},
+				archiveFn: func(_ context.Context, obj client.Object) error {
+					require.Equal(t, "older", obj.GetName())
+					return nil
+				},
+				deletePromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return nil
 				},
+			},
+			assertions: func(t *testing.T, deleted int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, deleted)
+			},
+		},
+		{
+			name: "success",
+			policy: retentionPolicy{
+				maxRetainedPromotions:   1,
+				minPromotionDeletionAge: time.Minute,
+			},
+			collector: &collector{
 				listPromotionsFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -227,21 +343,21 @@ func TestCleanStagePromotions(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, deleted int, err error) {
 				require.NoError(t, err)
+				require.Equal(t, 1, deleted)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.collector.cleanStagePromotions(
-					context.Background(),
-					"fake-project",
-					"fake-stage",
-				),
+			deleted, err := testCase.collector.cleanStagePromotions(
+				context.Background(),
+				"fake-project",
+				"fake-stage",
+				testCase.policy,
 			)
+			testCase.assertions(t, deleted, err)
 		})
 	}
 }
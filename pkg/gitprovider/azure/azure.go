@@ -53,6 +53,8 @@ type provider struct {
 	project    string
 	repo       string
 	connection *azuredevops.Connection
+
+	newGitClientFn func(context.Context, *azuredevops.Connection) (adogit.Client, error)
 }
 
 // NewProvider returns an Azure DevOps-based implementation of gitprovider.Interface.
@@ -71,10 +73,11 @@ func NewProvider(
 	connection := azuredevops.NewPatConnection(organizationUrl, opts.Token)
 
 	return &provider{
-		org:        org,
-		project:    project,
-		repo:       repo,
-		connection: connection,
+		org:            org,
+		project:        project,
+		repo:           repo,
+		connection:     connection,
+		newGitClientFn: adogit.NewClient,
 	}, nil
 }
 
@@ -83,7 +86,7 @@ func (p *provider) CreatePullRequest(
 	ctx context.Context,
 	opts *gitprovider.CreatePullRequestOpts,
 ) (*gitprovider.PullRequest, error) {
-	gitClient, err := adogit.NewClient(ctx, p.connection)
+	gitClient, err := p.newGitClientFn(ctx, p.connection)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Azure DevOps client: %w", err)
 	}
@@ -129,7 +132,7 @@ func (p *provider) GetPullRequest(
 	ctx context.Context,
 	id int64,
 ) (*gitprovider.PullRequest, error) {
-	gitClient, err := adogit.NewClient(ctx, p.connection)
+	gitClient, err := p.newGitClientFn(ctx, p.connection)
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +156,7 @@ func (p *provider) ListPullRequests(
 	ctx context.Context,
 	opts *gitprovider.ListPullRequestOptions,
 ) ([]gitprovider.PullRequest, error) {
-	gitClient, err := adogit.NewClient(ctx, p.connection)
+	gitClient, err := p.newGitClientFn(ctx, p.connection)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +191,7 @@ func (p *provider) MergePullRequest(
 ) (*gitprovider.PullRequest, bool, error) {
 	var pr *gitprovider.PullRequest
 
-	gitClient, err := adogit.NewClient(ctx, p.connection)
+	gitClient, err := p.newGitClientFn(ctx, p.connection)
 	if err != nil {
 		return nil, false, fmt.Errorf("error creating Azure DevOps client: %w", err)
 	}
@@ -275,6 +278,71 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// CreateRelease implements gitprovider.Interface. Azure DevOps has no concept
+// of releases for Git repositories, so this only ensures the existence of the
+// tag, creating it as an annotated tag on the target commit if necessary. The
+// release name (or, if empty, the tag) and description are used as the tag
+// message. The target must be a commit; draft and prerelease options are
+// ignored.
+func (p *provider) CreateRelease(
+	ctx context.Context,
+	opts *gitprovider.CreateReleaseOpts,
+) (*gitprovider.Release, error) {
+	if opts == nil {
+		opts = &gitprovider.CreateReleaseOpts{}
+	}
+	gitClient, err := p.newGitClientFn(ctx, p.connection)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Azure DevOps client: %w", err)
+	}
+	release := &gitprovider.Release{
+		Tag:  opts.Tag,
+		Name: opts.Name,
+		URL: fmt.Sprintf(
+			"https://%s/%s/%s/_git/%s?version=GT%s",
+			modernHostSuffix, p.org, p.project, p.repo, url.QueryEscape(opts.Tag),
+		),
+	}
+	refs, err := gitClient.GetRefs(ctx, adogit.GetRefsArgs{
+		Project:      &p.project,
+		RepositoryId: &p.repo,
+		Filter:       ptr.To("tags/" + opts.Tag),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting refs for tag %q: %w", opts.Tag, err)
+	}
+	for _, ref := range refs.Value {
+		if ptr.Deref(ref.Name, "") == "refs/tags/"+opts.Tag {
+			release.Object = ref
+			return release, nil
+		}
+	}
+	if opts.Target == "" {
+		return nil, fmt.Errorf("tag %q does not exist and no target was specified", opts.Tag)
+	}
+	message := opts.Name
+	if message == "" {
+		message = opts.Tag
+	}
+	if opts.Description != "" {
+		message = fmt.Sprintf("%s\n\n%s", message, opts.Description)
+	}
+	tag, err := gitClient.CreateAnnotatedTag(ctx, adogit.CreateAnnotatedTagArgs{
+		Project:      &p.project,
+		RepositoryId: &p.repo,
+		TagObject: &adogit.GitAnnotatedTag{
+			Name:         &opts.Tag,
+			Message:      &message,
+			TaggedObject: &adogit.GitObject{ObjectId: &opts.Target},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating tag %q: %w", opts.Tag, err)
+	}
+	release.Object = tag
+	return release, nil
+}
+
 // mapADOPrState maps a gitprovider.PullRequestState to an adogit.PullRequestStatus.
 func mapADOPrState(state gitprovider.PullRequestState) adogit.PullRequestStatus {
 	switch state {
@@ -287,6 +355,68 @@ func mapADOPrState(state gitprovider.PullRequestState) adogit.PullRequestStatus
 }
 
 // convertADOPullRequest converts an adogit.GitPullRequest to a gitprovider.PullRequest.
+// SetCommitStatus implements gitprovider.Interface.
+func (p *provider) SetCommitStatus(
+	ctx context.Context,
+	opts *gitprovider.CommitStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.CommitStatusOpts{}
+	}
+	gitClient, err := p.newGitClientFn(ctx, p.connection)
+	if err != nil {
+		return fmt.Errorf("error creating Azure DevOps client: %w", err)
+	}
+	statusCtx := opts.Context
+	if statusCtx == "" {
+		statusCtx = "kargo"
+	}
+	status := &adogit.GitStatus{
+		Context: &adogit.GitStatusContext{
+			Genre: ptr.To("kargo"),
+			Name:  &statusCtx,
+		},
+		Description: &opts.Description,
+		State:       ptr.To(toADOStatusState(opts.State)),
+	}
+	if opts.TargetURL != "" {
+		status.TargetUrl = &opts.TargetURL
+	}
+	if _, err = gitClient.CreateCommitStatus(ctx, adogit.CreateCommitStatusArgs{
+		Project:                 &p.project,
+		RepositoryId:            &p.repo,
+		CommitId:                &opts.CommitID,
+		GitCommitStatusToCreate: status,
+	}); err != nil {
+		return fmt.Errorf("error creating status for commit %q: %w", opts.CommitID, err)
+	}
+	return nil
+}
+
+// SetDeploymentStatus implements gitprovider.Interface. Azure Repos has no
+// concept of deployments, so this always returns gitprovider.ErrUnsupported.
+func (p *provider) SetDeploymentStatus(
+	context.Context,
+	*gitprovider.DeploymentStatusOpts,
+) error {
+	return gitprovider.ErrUnsupported
+}
+
+// toADOStatusState converts a gitprovider.CommitState to the equivalent Azure
+// DevOps commit status state.
+func toADOStatusState(state gitprovider.CommitState) adogit.GitStatusState {
+	switch state {
+	case gitprovider.CommitStateSuccess:
+		return adogit.GitStatusStateValues.Succeeded
+	case gitprovider.CommitStateFailure:
+		return adogit.GitStatusStateValues.Failed
+	case gitprovider.CommitStateError:
+		return adogit.GitStatusStateValues.Error
+	default:
+		return adogit.GitStatusStateValues.Pending
+	}
+}
+
 func convertADOPullRequest(pr *adogit.GitPullRequest) (*gitprovider.PullRequest, error) {
 	if pr.LastMergeSourceCommit == nil {
 		return nil, fmt.Errorf("no last merge source commit found for pull request %d", ptr.Deref(pr.PullRequestId, 0))
@@ -1,9 +1,15 @@
 package azure
 
 import (
+	"context"
 	"testing"
 
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	adogit "github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/akuity/kargo/pkg/gitprovider"
 )
 
 func TestParseRepoURL(t *testing.T) {
@@ -125,3 +131,78 @@ func TestGetCommitURL(t *testing.T) {
 		})
 	}
 }
+
+// mockGitClient is a mock implementation of the subset of adogit.Client used
+// by CreateRelease. Calling any other method panics.
+type mockGitClient struct {
+	adogit.Client
+	refs       []adogit.GitRef
+	createArgs *adogit.CreateAnnotatedTagArgs
+}
+
+func (m *mockGitClient) GetRefs(
+	context.Context,
+	adogit.GetRefsArgs,
+) (*adogit.GetRefsResponseValue, error) {
+	return &adogit.GetRefsResponseValue{Value: m.refs}, nil
+}
+
+func (m *mockGitClient) CreateAnnotatedTag(
+	_ context.Context,
+	args adogit.CreateAnnotatedTagArgs,
+) (*adogit.GitAnnotatedTag, error) {
+	m.createArgs = &args
+	return args.TagObject, nil
+}
+
+func TestCreateRelease(t *testing.T) {
+	opts := &gitprovider.CreateReleaseOpts{
+		Tag:         "v1.0.0",
+		Target:      "abc123",
+		Description: "notes",
+	}
+	newProvider := func(client *mockGitClient) *provider {
+		return &provider{
+			org:     "akuity",
+			project: "kargo",
+			repo:    "kargo",
+			newGitClientFn: func(
+				context.Context,
+				*azuredevops.Connection,
+			) (adogit.Client, error) {
+				return client, nil
+			},
+		}
+	}
+
+	t.Run("tag does not exist", func(t *testing.T) {
+		client := &mockGitClient{}
+		release, err := newProvider(client).CreateRelease(context.Background(), opts)
+		require.NoError(t, err)
+		require.Equal(t, "v1.0.0", release.Tag)
+		require.NotNil(t, client.createArgs)
+		require.Equal(t, "v1.0.0", *client.createArgs.TagObject.Name)
+		require.Equal(t, "abc123", *client.createArgs.TagObject.TaggedObject.ObjectId)
+		require.Equal(t, "v1.0.0\n\nnotes", *client.createArgs.TagObject.Message)
+	})
+
+	t.Run("tag already exists", func(t *testing.T) {
+		client := &mockGitClient{
+			refs: []adogit.GitRef{{Name: ptr.To("refs/tags/v1.0.0")}},
+		}
+		release, err := newProvider(client).CreateRelease(context.Background(), opts)
+		require.NoError(t, err)
+		require.Equal(t, "v1.0.0", release.Tag)
+		require.Nil(t, client.createArgs)
+	})
+
+	t.Run("tag does not exist and no target", func(t *testing.T) {
+		client := &mockGitClient{}
+		_, err := newProvider(client).CreateRelease(
+			context.Background(),
+			&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+		)
+		require.ErrorContains(t, err, "no target was specified")
+		require.Nil(t, client.createArgs)
+	})
+}
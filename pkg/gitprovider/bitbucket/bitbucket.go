@@ -65,11 +65,27 @@ type pullRequestClient interface {
 	MergePullRequest(opt *bitbucket.PullRequestsOptions) (any, error)
 }
 
+// tagClient defines the interface for tag operations.
+type tagClient interface {
+	ListTags(opt *bitbucket.RepositoryTagOptions) (*bitbucket.RepositoryTags, error)
+	CreateTag(opt *bitbucket.RepositoryTagCreationOptions) (*bitbucket.RepositoryTag, error)
+}
+
+// commitStatusClient defines the interface for commit status operations.
+type commitStatusClient interface {
+	CreateCommitStatus(
+		cmo *bitbucket.CommitsOptions,
+		cso *bitbucket.CommitStatusOptions,
+	) (any, error)
+}
+
 // provider is a Bitbucket-based implementation of gitprovider.Interface.
 type provider struct {
-	owner    string
-	repoSlug string
-	client   pullRequestClient
+	owner              string
+	repoSlug           string
+	client             pullRequestClient
+	tagClient          tagClient
+	commitStatusClient commitStatusClient
 }
 
 // NewProvider returns a Bitbucket-based implementation of gitprovider.Interface.
@@ -95,10 +111,13 @@ func NewProvider(
 	client := bitbucket.NewOAuthbearerToken(opts.Token)
 	client.HttpClient = cleanhttp.DefaultClient()
 
+	wrapper := &clientWrapper{client}
 	return &provider{
-		owner:    owner,
-		repoSlug: repoSlug,
-		client:   &clientWrapper{client},
+		owner:              owner,
+		repoSlug:           repoSlug,
+		client:             wrapper,
+		tagClient:          wrapper,
+		commitStatusClient: wrapper,
 	}, nil
 }
 
@@ -137,6 +156,25 @@ func (w *clientWrapper) MergePullRequest(
 	return w.client.Repositories.PullRequests.Merge(opt)
 }
 
+func (w *clientWrapper) ListTags(
+	opt *bitbucket.RepositoryTagOptions,
+) (*bitbucket.RepositoryTags, error) {
+	return w.client.Repositories.Repository.ListTags(opt)
+}
+
+func (w *clientWrapper) CreateTag(
+	opt *bitbucket.RepositoryTagCreationOptions,
+) (*bitbucket.RepositoryTag, error) {
+	return w.client.Repositories.Repository.CreateTag(opt)
+}
+
+func (w *clientWrapper) CreateCommitStatus(
+	cmo *bitbucket.CommitsOptions,
+	cso *bitbucket.CommitStatusOptions,
+) (any, error) {
+	return w.client.Repositories.Commits.CreateCommitStatus(cmo, cso)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -382,6 +420,108 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// CreateRelease implements gitprovider.Interface. Bitbucket has no concept of
+// releases, so this only ensures the existence of the tag, creating it from
+// the target commit if necessary. The target must be a commit; all other
+// options are ignored.
+func (p *provider) CreateRelease(
+	_ context.Context,
+	opts *gitprovider.CreateReleaseOpts,
+) (*gitprovider.Release, error) {
+	if opts == nil {
+		opts = &gitprovider.CreateReleaseOpts{}
+	}
+	release := &gitprovider.Release{
+		Tag: opts.Tag,
+		URL: fmt.Sprintf(
+			"https://%s/%s/%s/src/%s", supportedHost, p.owner, p.repoSlug, opts.Tag,
+		),
+	}
+	tags, err := p.tagClient.ListTags(&bitbucket.RepositoryTagOptions{
+		Owner:    p.owner,
+		RepoSlug: p.repoSlug,
+		Query:    fmt.Sprintf("name=%q", opts.Tag),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	for _, tag := range tags.Tags {
+		if tag.Name == opts.Tag {
+			release.Object = tag
+			return release, nil
+		}
+	}
+	if opts.Target == "" {
+		return nil, fmt.Errorf("tag %q does not exist and no target was specified", opts.Tag)
+	}
+	tag, err := p.tagClient.CreateTag(&bitbucket.RepositoryTagCreationOptions{
+		Owner:    p.owner,
+		RepoSlug: p.repoSlug,
+		Name:     opts.Tag,
+		Target:   bitbucket.RepositoryTagTarget{Hash: opts.Target},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating tag %q: %w", opts.Tag, err)
+	}
+	release.Object = tag
+	return release, nil
+}
+
+// SetCommitStatus implements gitprovider.Interface. The status is recorded as a
+// Bitbucket build status keyed by the context.
+func (p *provider) SetCommitStatus(
+	ctx context.Context,
+	opts *gitprovider.CommitStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.CommitStatusOpts{}
+	}
+	key := opts.Context
+	if key == "" {
+		key = "kargo"
+	}
+	commitOpts := &bitbucket.CommitsOptions{
+		Owner:    p.owner,
+		RepoSlug: p.repoSlug,
+		Revision: opts.CommitID,
+	}
+	commitOpts.WithContext(ctx)
+	_, err := p.commitStatusClient.CreateCommitStatus(
+		commitOpts,
+		&bitbucket.CommitStatusOptions{
+			Key:         key,
+			Name:        key,
+			Url:         opts.TargetURL,
+			State:       toBitbucketBuildState(opts.State),
+			Description: opts.Description,
+		},
+	)
+	return err
+}
+
+// SetDeploymentStatus implements gitprovider.Interface. Bitbucket deployments
+// are tied to Bitbucket Pipelines and cannot be created through the API, so
+// this always returns gitprovider.ErrUnsupported.
+func (p *provider) SetDeploymentStatus(
+	context.Context,
+	*gitprovider.DeploymentStatusOpts,
+) error {
+	return gitprovider.ErrUnsupported
+}
+
+// toBitbucketBuildState converts a gitprovider.CommitState to the equivalent
+// Bitbucket build status state.
+func toBitbucketBuildState(state gitprovider.CommitState) string {
+	switch state {
+	case gitprovider.CommitStateSuccess:
+		return "SUCCESSFUL"
+	case gitprovider.CommitStateFailure, gitprovider.CommitStateError:
+		return "FAILED"
+	default:
+		return "INPROGRESS"
+	}
+}
+
 func (p *provider) getFullCommitSHA(ctx context.Context, shortSHA string) (string, error) {
 	if shortSHA == "" {
 		return "", nil
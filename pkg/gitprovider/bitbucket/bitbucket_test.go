@@ -40,6 +40,37 @@ func (m *mockPullRequestClient) MergePullRequest(opt *bitbucket.PullRequestsOpti
 	return m.mergePullRequestFunc(opt)
 }
 
+type mockTagClient struct {
+	listTagsFunc  func(opt *bitbucket.RepositoryTagOptions) (*bitbucket.RepositoryTags, error)
+	createTagFunc func(opt *bitbucket.RepositoryTagCreationOptions) (*bitbucket.RepositoryTag, error)
+}
+
+func (m *mockTagClient) ListTags(
+	opt *bitbucket.RepositoryTagOptions,
+) (*bitbucket.RepositoryTags, error) {
+	return m.listTagsFunc(opt)
+}
+
+func (m *mockTagClient) CreateTag(
+	opt *bitbucket.RepositoryTagCreationOptions,
+) (*bitbucket.RepositoryTag, error) {
+	return m.createTagFunc(opt)
+}
+
+type mockCommitStatusClient struct {
+	createCommitStatusFunc func(
+		cmo *bitbucket.CommitsOptions,
+		cso *bitbucket.CommitStatusOptions,
+	) (any, error)
+}
+
+func (m *mockCommitStatusClient) CreateCommitStatus(
+	cmo *bitbucket.CommitsOptions,
+	cso *bitbucket.CommitStatusOptions,
+) (any, error) {
+	return m.createCommitStatusFunc(cmo, cso)
+}
+
 func TestNewProvider(t *testing.T) {
 	t.Run("successful creation", func(t *testing.T) {
 		provider, err := NewProvider("https://bitbucket.org/owner/repo", &gitprovider.Options{Token: "token"})
@@ -1059,6 +1090,122 @@ func Test_registration(t *testing.T) {
 	})
 }
 
+func TestCreateRelease(t *testing.T) {
+	t.Run("tag already exists", func(t *testing.T) {
+		mockClient := &mockTagClient{
+			listTagsFunc: func(opt *bitbucket.RepositoryTagOptions) (*bitbucket.RepositoryTags, error) {
+				assert.Equal(t, `name="v1.0.0"`, opt.Query)
+				return &bitbucket.RepositoryTags{
+					Tags: []bitbucket.RepositoryTag{{Name: "v1.0.0"}},
+				}, nil
+			},
+		}
+		p := &provider{owner: "owner", repoSlug: "repo", tagClient: mockClient}
+		release, err := p.CreateRelease(
+			context.Background(),
+			&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "v1.0.0", release.Tag)
+		assert.Equal(t, "https://bitbucket.org/owner/repo/src/v1.0.0", release.URL)
+	})
+
+	t.Run("tag does not exist and no target", func(t *testing.T) {
+		mockClient := &mockTagClient{
+			listTagsFunc: func(*bitbucket.RepositoryTagOptions) (*bitbucket.RepositoryTags, error) {
+				return &bitbucket.RepositoryTags{}, nil
+			},
+		}
+		p := &provider{owner: "owner", repoSlug: "repo", tagClient: mockClient}
+		_, err := p.CreateRelease(
+			context.Background(),
+			&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+		)
+		require.ErrorContains(t, err, "no target was specified")
+	})
+
+	t.Run("creates tag", func(t *testing.T) {
+		var createOpts *bitbucket.RepositoryTagCreationOptions
+		mockClient := &mockTagClient{
+			listTagsFunc: func(*bitbucket.RepositoryTagOptions) (*bitbucket.RepositoryTags, error) {
+				return &bitbucket.RepositoryTags{}, nil
+			},
+			createTagFunc: func(
+				opt *bitbucket.RepositoryTagCreationOptions,
+			) (*bitbucket.RepositoryTag, error) {
+				createOpts = opt
+				return &bitbucket.RepositoryTag{Name: opt.Name}, nil
+			},
+		}
+		p := &provider{owner: "owner", repoSlug: "repo", tagClient: mockClient}
+		release, err := p.CreateRelease(
+			context.Background(),
+			&gitprovider.CreateReleaseOpts{Tag: "v1.0.0", Target: "abc123"},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "v1.0.0", createOpts.Name)
+		assert.Equal(t, "abc123", createOpts.Target.Hash)
+		assert.Equal(t, "v1.0.0", release.Tag)
+	})
+
+	t.Run("error listing tags", func(t *testing.T) {
+		mockClient := &mockTagClient{
+			listTagsFunc: func(*bitbucket.RepositoryTagOptions) (*bitbucket.RepositoryTags, error) {
+				return nil, errors.New("something went wrong")
+			},
+		}
+		p := &provider{owner: "owner", repoSlug: "repo", tagClient: mockClient}
+		_, err := p.CreateRelease(
+			context.Background(),
+			&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+		)
+		require.ErrorContains(t, err, "something went wrong")
+	})
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	var commitOpts *bitbucket.CommitsOptions
+	var statusOpts *bitbucket.CommitStatusOptions
+	mockClient := &mockCommitStatusClient{
+		createCommitStatusFunc: func(
+			cmo *bitbucket.CommitsOptions,
+			cso *bitbucket.CommitStatusOptions,
+		) (any, error) {
+			commitOpts = cmo
+			statusOpts = cso
+			return nil, nil
+		},
+	}
+	p := &provider{owner: "owner", repoSlug: "repo", commitStatusClient: mockClient}
+	err := p.SetCommitStatus(
+		context.Background(),
+		&gitprovider.CommitStatusOpts{
+			CommitID:    "abc123",
+			State:       gitprovider.CommitStateFailure,
+			Context:     "kargo/test",
+			Description: "Promotion failed",
+			TargetURL:   "https://kargo.example.com",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "owner", commitOpts.Owner)
+	assert.Equal(t, "repo", commitOpts.RepoSlug)
+	assert.Equal(t, "abc123", commitOpts.Revision)
+	assert.Equal(t, "kargo/test", statusOpts.Key)
+	assert.Equal(t, "FAILED", statusOpts.State)
+	assert.Equal(t, "Promotion failed", statusOpts.Description)
+	assert.Equal(t, "https://kargo.example.com", statusOpts.Url)
+}
+
+func TestSetDeploymentStatus(t *testing.T) {
+	p := &provider{owner: "owner", repoSlug: "repo"}
+	err := p.SetDeploymentStatus(
+		context.Background(),
+		&gitprovider.DeploymentStatusOpts{},
+	)
+	require.ErrorIs(t, err, gitprovider.ErrUnsupported)
+}
+
 func TestGetCommitURL(t *testing.T) {
 	testCases := []struct {
 		repoURL           string
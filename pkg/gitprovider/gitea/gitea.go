@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -75,6 +76,28 @@ type giteaClient interface {
 		number int,
 		labels []string,
 	) ([]*gitea.Label, *gitea.Response, error)
+
+	GetReleaseByTag(
+		ctx context.Context,
+		owner string,
+		repo string,
+		tag string,
+	) (*gitea.Release, *gitea.Response, error)
+
+	CreateRelease(
+		ctx context.Context,
+		owner string,
+		repo string,
+		opts *gitea.CreateReleaseOption,
+	) (*gitea.Release, *gitea.Response, error)
+
+	CreateStatus(
+		ctx context.Context,
+		owner string,
+		repo string,
+		sha string,
+		opts *gitea.CreateStatusOption,
+	) (*gitea.Status, *gitea.Response, error)
 }
 
 // provider is a Gitea implementation of gitprovider.Interface.
@@ -178,6 +201,34 @@ func (g giteaClientWrapper) AddLabelsToIssue(
 	return g.client.AddIssueLabels(owner, repo, int64(number), gitea.IssueLabelsOption{})
 }
 
+func (g giteaClientWrapper) GetReleaseByTag(
+	_ context.Context,
+	owner string,
+	repo string,
+	tag string,
+) (*gitea.Release, *gitea.Response, error) {
+	return g.client.GetReleaseByTag(owner, repo, tag)
+}
+
+func (g giteaClientWrapper) CreateRelease(
+	_ context.Context,
+	owner string,
+	repo string,
+	opts *gitea.CreateReleaseOption,
+) (*gitea.Release, *gitea.Response, error) {
+	return g.client.CreateRelease(owner, repo, *opts)
+}
+
+func (g giteaClientWrapper) CreateStatus(
+	_ context.Context,
+	owner string,
+	repo string,
+	sha string,
+	opts *gitea.CreateStatusOption,
+) (*gitea.Status, *gitea.Response, error) {
+	return g.client.CreateStatus(owner, repo, sha, *opts)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -340,6 +391,96 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// CreateRelease implements gitprovider.Interface.
+func (p *provider) CreateRelease(
+	ctx context.Context,
+	opts *gitprovider.CreateReleaseOpts,
+) (*gitprovider.Release, error) {
+	if opts == nil {
+		opts = &gitprovider.CreateReleaseOpts{}
+	}
+	// Releases are keyed by tag, so if one already exists for the tag (e.g.
+	// because a previous attempt at the same step succeeded), return it
+	// instead of failing on Gitea's 409 response.
+	giteaRelease, resp, err := p.client.GetReleaseByTag(ctx, p.owner, p.repo, opts.Tag)
+	if err == nil && giteaRelease != nil {
+		return toProviderRelease(giteaRelease), nil
+	}
+	if err != nil && (resp == nil || resp.Response == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("error getting release for tag %q: %w", opts.Tag, err)
+	}
+	// Gitea requires releases to have a title.
+	name := opts.Name
+	if name == "" {
+		name = opts.Tag
+	}
+	giteaRelease, _, err = p.client.CreateRelease(ctx,
+		p.owner,
+		p.repo,
+		&gitea.CreateReleaseOption{
+			TagName:      opts.Tag,
+			Target:       opts.Target,
+			Title:        name,
+			Note:         opts.Description,
+			IsDraft:      opts.Draft,
+			IsPrerelease: opts.Prerelease,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if giteaRelease == nil {
+		return nil, fmt.Errorf("unexpected nil release")
+	}
+	return toProviderRelease(giteaRelease), nil
+}
+
+// toProviderRelease converts a Gitea release to a gitprovider.Release.
+func toProviderRelease(giteaRelease *gitea.Release) *gitprovider.Release {
+	return &gitprovider.Release{
+		Tag:    giteaRelease.TagName,
+		Name:   giteaRelease.Title,
+		URL:    giteaRelease.HTMLURL,
+		Object: giteaRelease,
+	}
+}
+
+// SetCommitStatus implements gitprovider.Interface.
+func (p *provider) SetCommitStatus(
+	ctx context.Context,
+	opts *gitprovider.CommitStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.CommitStatusOpts{}
+	}
+	statusCtx := opts.Context
+	if statusCtx == "" {
+		statusCtx = "kargo"
+	}
+	_, _, err := p.client.CreateStatus(
+		ctx,
+		p.owner,
+		p.repo,
+		opts.CommitID,
+		&gitea.CreateStatusOption{
+			State:       gitea.StatusState(opts.State),
+			TargetURL:   opts.TargetURL,
+			Description: opts.Description,
+			Context:     statusCtx,
+		},
+	)
+	return err
+}
+
+// SetDeploymentStatus implements gitprovider.Interface. Gitea has no concept of
+// deployments, so this always returns gitprovider.ErrUnsupported.
+func (p *provider) SetDeploymentStatus(
+	context.Context,
+	*gitprovider.DeploymentStatusOpts,
+) error {
+	return gitprovider.ErrUnsupported
+}
+
 func convertGiteaPR(giteaPR gitea.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:  giteaPR.Index,
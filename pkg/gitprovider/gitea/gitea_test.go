@@ -3,6 +3,7 @@ package gitea
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -187,6 +188,59 @@ func (m *mockGiteaClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGiteaClient) GetReleaseByTag(
+	ctx context.Context,
+	owner string,
+	repo string,
+	tag string,
+) (*gitea.Release, *gitea.Response, error) {
+	args := m.Called(ctx, owner, repo, tag)
+	release, _ := args.Get(0).(*gitea.Release)
+	resp, _ := args.Get(1).(*gitea.Response)
+	return release, resp, args.Error(2)
+}
+
+func (m *mockGiteaClient) CreateRelease(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *gitea.CreateReleaseOption,
+) (*gitea.Release, *gitea.Response, error) {
+	args := m.Called(ctx, owner, repo, opts)
+	m.owner = owner
+	m.repo = repo
+	release, ok := args.Get(0).(*gitea.Release)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*gitea.Response)
+	if !ok {
+		return release, nil, args.Error(2)
+	}
+	return release, resp, args.Error(2)
+}
+
+func (m *mockGiteaClient) CreateStatus(
+	ctx context.Context,
+	owner string,
+	repo string,
+	sha string,
+	opts *gitea.CreateStatusOption,
+) (*gitea.Status, *gitea.Response, error) {
+	args := m.Called(ctx, owner, repo, sha, opts)
+	m.owner = owner
+	m.repo = repo
+	status, ok := args.Get(0).(*gitea.Status)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*gitea.Response)
+	if !ok {
+		return status, nil, args.Error(2)
+	}
+	return status, resp, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -591,6 +645,148 @@ func TestMergePullRequest(t *testing.T) {
 	}
 }
 
+func TestCreateRelease(t *testing.T) {
+	notFoundResp := &gitea.Response{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+	}
+
+	mockClient := &mockGiteaClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, "v1.0.0").
+		Return(nil, notFoundResp, errors.New("404 Not Found"))
+	mockClient.
+		On(
+			"CreateRelease",
+			context.Background(),
+			testRepoOwner,
+			testRepoName,
+			&gitea.CreateReleaseOption{
+				TagName:      "v1.0.0",
+				Target:       "main",
+				Title:        "v1.0.0",
+				Note:         "notes",
+				IsDraft:      true,
+				IsPrerelease: false,
+			},
+		).
+		Return(
+			&gitea.Release{
+				TagName: "v1.0.0",
+				Title:   "v1.0.0",
+				HTMLURL: "https://gitea.com/akuity/kargo/releases/tag/v1.0.0",
+			},
+			&gitea.Response{},
+			nil,
+		)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+	release, err := g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{
+			Tag:         "v1.0.0",
+			Target:      "main",
+			Description: "notes",
+			Draft:       true,
+		},
+	)
+
+	mockClient.AssertExpectations(t)
+	require.NoError(t, err)
+	require.Equal(t, testRepoOwner, mockClient.owner)
+	require.Equal(t, testRepoName, mockClient.repo)
+	require.Equal(t, "v1.0.0", release.Tag)
+	require.Equal(t, "v1.0.0", release.Name)
+	require.Equal(t, "https://gitea.com/akuity/kargo/releases/tag/v1.0.0", release.URL)
+
+	// An existing release for the tag is returned without creating another.
+	mockClient = &mockGiteaClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, "v1.0.0").
+		Return(
+			&gitea.Release{
+				TagName: "v1.0.0",
+				Title:   "existing",
+				HTMLURL: "https://gitea.com/akuity/kargo/releases/tag/v1.0.0",
+			},
+			&gitea.Response{},
+			nil,
+		)
+	g.client = mockClient
+	release, err = g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+	)
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "CreateRelease")
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", release.Tag)
+	require.Equal(t, "existing", release.Name)
+
+	mockClient = &mockGiteaClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, "v1.0.0").
+		Return(nil, nil, errors.New("something went wrong"))
+	g.client = mockClient
+	_, err = g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+	)
+	require.ErrorContains(t, err, "error getting release for tag")
+	mockClient.AssertNotCalled(t, "CreateRelease")
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	mockClient := &mockGiteaClient{}
+	mockClient.
+		On(
+			"CreateStatus",
+			context.Background(),
+			testRepoOwner,
+			testRepoName,
+			"abc123",
+			&gitea.CreateStatusOption{
+				State:       gitea.StatusSuccess,
+				TargetURL:   "https://kargo.example.com",
+				Description: "Promotion succeeded",
+				Context:     "kargo",
+			},
+		).
+		Return(&gitea.Status{}, &gitea.Response{}, nil)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+	err := g.SetCommitStatus(
+		context.Background(),
+		&gitprovider.CommitStatusOpts{
+			CommitID:    "abc123",
+			State:       gitprovider.CommitStateSuccess,
+			Description: "Promotion succeeded",
+			TargetURL:   "https://kargo.example.com",
+		},
+	)
+
+	mockClient.AssertExpectations(t)
+	require.NoError(t, err)
+	require.Equal(t, testRepoOwner, mockClient.owner)
+	require.Equal(t, testRepoName, mockClient.repo)
+}
+
+func TestSetDeploymentStatus(t *testing.T) {
+	g := provider{}
+	err := g.SetDeploymentStatus(
+		context.Background(),
+		&gitprovider.DeploymentStatusOpts{},
+	)
+	require.ErrorIs(t, err, gitprovider.ErrUnsupported)
+}
+
 func TestGetCommitURL(t *testing.T) {
 	testCases := []struct {
 		repoURL           string
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -17,6 +18,15 @@ import (
 
 const ProviderName = "github"
 
+// maxStatusDescriptionLength is the maximum length of the description of a
+// commit status or deployment status accepted by GitHub.
+const maxStatusDescriptionLength = 140
+
+// deploymentTask is the task of every deployment created by this provider. It
+// distinguishes deployments created by Kargo from those created by other
+// systems.
+const deploymentTask = "deploy:kargo"
+
 // GitHub pull request states
 const (
 	prStateAll    = "all"
@@ -86,6 +96,50 @@ type githubClient interface {
 		number int,
 		labels []string,
 	) ([]*github.Label, *github.Response, error)
+
+	GetReleaseByTag(
+		ctx context.Context,
+		owner string,
+		repo string,
+		tag string,
+	) (*github.RepositoryRelease, *github.Response, error)
+
+	CreateRelease(
+		ctx context.Context,
+		owner string,
+		repo string,
+		release *github.RepositoryRelease,
+	) (*github.RepositoryRelease, *github.Response, error)
+
+	CreateStatus(
+		ctx context.Context,
+		owner string,
+		repo string,
+		ref string,
+		status *github.RepoStatus,
+	) (*github.RepoStatus, *github.Response, error)
+
+	ListDeployments(
+		ctx context.Context,
+		owner string,
+		repo string,
+		opts *github.DeploymentsListOptions,
+	) ([]*github.Deployment, *github.Response, error)
+
+	CreateDeployment(
+		ctx context.Context,
+		owner string,
+		repo string,
+		request *github.DeploymentRequest,
+	) (*github.Deployment, *github.Response, error)
+
+	CreateDeploymentStatus(
+		ctx context.Context,
+		owner string,
+		repo string,
+		deployment int64,
+		request *github.DeploymentStatusRequest,
+	) (*github.DeploymentStatus, *github.Response, error)
 }
 
 // provider is a GitHub implementation of gitprovider.Interface.
@@ -191,6 +245,62 @@ func (g githubClientWrapper) AddLabelsToIssue(
 	return g.client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
 }
 
+func (g githubClientWrapper) GetReleaseByTag(
+	ctx context.Context,
+	owner string,
+	repo string,
+	tag string,
+) (*github.RepositoryRelease, *github.Response, error) {
+	return g.client.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+}
+
+func (g githubClientWrapper) CreateRelease(
+	ctx context.Context,
+	owner string,
+	repo string,
+	release *github.RepositoryRelease,
+) (*github.RepositoryRelease, *github.Response, error) {
+	return g.client.Repositories.CreateRelease(ctx, owner, repo, release)
+}
+
+func (g githubClientWrapper) CreateStatus(
+	ctx context.Context,
+	owner string,
+	repo string,
+	ref string,
+	status *github.RepoStatus,
+) (*github.RepoStatus, *github.Response, error) {
+	return g.client.Repositories.CreateStatus(ctx, owner, repo, ref, status)
+}
+
+func (g githubClientWrapper) ListDeployments(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *github.DeploymentsListOptions,
+) ([]*github.Deployment, *github.Response, error) {
+	return g.client.Repositories.ListDeployments(ctx, owner, repo, opts)
+}
+
+func (g githubClientWrapper) CreateDeployment(
+	ctx context.Context,
+	owner string,
+	repo string,
+	request *github.DeploymentRequest,
+) (*github.Deployment, *github.Response, error) {
+	return g.client.Repositories.CreateDeployment(ctx, owner, repo, request)
+}
+
+func (g githubClientWrapper) CreateDeploymentStatus(
+	ctx context.Context,
+	owner string,
+	repo string,
+	deployment int64,
+	request *github.DeploymentStatusRequest,
+) (*github.DeploymentStatus, *github.Response, error) {
+	return g.client.Repositories.CreateDeploymentStatus(ctx, owner, repo, deployment, request)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -365,6 +475,159 @@ func (p *provider) GetCommitURL(
 	return commitURL, nil
 }
 
+// CreateRelease implements gitprovider.Interface.
+func (p *provider) CreateRelease(
+	ctx context.Context,
+	opts *gitprovider.CreateReleaseOpts,
+) (*gitprovider.Release, error) {
+	if opts == nil {
+		opts = &gitprovider.CreateReleaseOpts{}
+	}
+	// Releases are keyed by tag, so if one already exists for the tag (e.g.
+	// because a previous attempt at the same step succeeded), return it
+	// instead of failing on GitHub's 422 response.
+	ghRelease, resp, err := p.client.GetReleaseByTag(ctx, p.owner, p.repo, opts.Tag)
+	if err == nil && ghRelease != nil {
+		return toProviderRelease(ghRelease, opts.Tag), nil
+	}
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, fmt.Errorf("error getting release for tag %q: %w", opts.Tag, err)
+	}
+	release := &github.RepositoryRelease{
+		TagName:    &opts.Tag,
+		Body:       &opts.Description,
+		Draft:      &opts.Draft,
+		Prerelease: &opts.Prerelease,
+	}
+	if opts.Target != "" {
+		release.TargetCommitish = &opts.Target
+	}
+	if opts.Name != "" {
+		release.Name = &opts.Name
+	}
+	if ghRelease, _, err = p.client.CreateRelease(ctx, p.owner, p.repo, release); err != nil {
+		return nil, err
+	}
+	if ghRelease == nil {
+		return nil, fmt.Errorf("unexpected nil release")
+	}
+	return toProviderRelease(ghRelease, opts.Tag), nil
+}
+
+// toProviderRelease converts a GitHub release to a gitprovider.Release,
+// falling back to the provided tag if the release does not specify one.
+func toProviderRelease(
+	ghRelease *github.RepositoryRelease,
+	tag string,
+) *gitprovider.Release {
+	return &gitprovider.Release{
+		Tag:    ptr.Deref(ghRelease.TagName, tag),
+		Name:   ptr.Deref(ghRelease.Name, ""),
+		URL:    ptr.Deref(ghRelease.HTMLURL, ""),
+		Object: ghRelease,
+	}
+}
+
+// SetCommitStatus implements gitprovider.Interface.
+func (p *provider) SetCommitStatus(
+	ctx context.Context,
+	opts *gitprovider.CommitStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.CommitStatusOpts{}
+	}
+	statusCtx := opts.Context
+	if statusCtx == "" {
+		statusCtx = "kargo"
+	}
+	status := &github.RepoStatus{
+		State:       github.Ptr(string(opts.State)),
+		Context:     &statusCtx,
+		Description: github.Ptr(truncate(opts.Description, maxStatusDescriptionLength)),
+	}
+	if opts.TargetURL != "" {
+		status.TargetURL = &opts.TargetURL
+	}
+	_, _, err := p.client.CreateStatus(ctx, p.owner, p.repo, opts.CommitID, status)
+	return err
+}
+
+// SetDeploymentStatus implements gitprovider.Interface. A deployment is
+// created for the commit and environment if Kargo did not already create one.
+func (p *provider) SetDeploymentStatus(
+	ctx context.Context,
+	opts *gitprovider.DeploymentStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.DeploymentStatusOpts{}
+	}
+	deployments, _, err := p.client.ListDeployments(
+		ctx,
+		p.owner,
+		p.repo,
+		&github.DeploymentsListOptions{
+			SHA:         opts.CommitID,
+			Task:        deploymentTask,
+			Environment: opts.Environment,
+			ListOptions: github.ListOptions{PerPage: 1},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error listing deployments: %w", err)
+	}
+	var deploymentID int64
+	if len(deployments) > 0 && deployments[0] != nil {
+		deploymentID = ptr.Deref(deployments[0].ID, 0)
+	} else {
+		deployment, _, err := p.client.CreateDeployment(
+			ctx,
+			p.owner,
+			p.repo,
+			&github.DeploymentRequest{
+				Ref:         &opts.CommitID,
+				Task:        github.Ptr(deploymentTask),
+				Environment: &opts.Environment,
+				// The commit has already been deployed by the time Kargo reports
+				// on it, so GitHub must neither merge the default branch into it
+				// nor wait on any other status checks.
+				AutoMerge:        github.Ptr(false),
+				RequiredContexts: &[]string{},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error creating deployment: %w", err)
+		}
+		if deployment == nil {
+			return fmt.Errorf("unexpected nil deployment")
+		}
+		deploymentID = ptr.Deref(deployment.ID, 0)
+	}
+	statusReq := &github.DeploymentStatusRequest{
+		State:        github.Ptr(string(opts.State)),
+		Description:  github.Ptr(truncate(opts.Description, maxStatusDescriptionLength)),
+		Environment:  &opts.Environment,
+		AutoInactive: github.Ptr(true),
+	}
+	if opts.TargetURL != "" {
+		statusReq.LogURL = &opts.TargetURL
+	}
+	if _, _, err = p.client.CreateDeploymentStatus(
+		ctx, p.owner, p.repo, deploymentID, statusReq,
+	); err != nil {
+		return fmt.Errorf("error creating deployment status: %w", err)
+	}
+	return nil
+}
+
+// truncate returns the provided string, truncated to the specified maximum
+// length if necessary.
+func truncate(str string, maxLen int) string {
+	if len(str) <= maxLen {
+		return str
+	}
+	return str[:maxLen-3] + "..."
+}
+
 func convertGithubPR(ghPR github.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(ghPR.Number, 0)),
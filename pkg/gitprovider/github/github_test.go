@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"errors"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -201,6 +203,84 @@ func (m *mockGithubClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGithubClient) GetReleaseByTag(
+	ctx context.Context,
+	owner string,
+	repo string,
+	tag string,
+) (*github.RepositoryRelease, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, tag)
+	ghRelease, _ := args.Get(0).(*github.RepositoryRelease)
+	resp, _ := args.Get(1).(*github.Response)
+	return ghRelease, resp, args.Error(2)
+}
+
+func (m *mockGithubClient) CreateRelease(
+	ctx context.Context,
+	owner string,
+	repo string,
+	release *github.RepositoryRelease,
+) (*github.RepositoryRelease, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, release)
+	m.owner = owner
+	m.repo = repo
+	ghRelease, ok := args.Get(0).(*github.RepositoryRelease)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*github.Response)
+	if !ok {
+		return ghRelease, nil, args.Error(2)
+	}
+	return ghRelease, resp, args.Error(2)
+}
+
+func (m *mockGithubClient) CreateStatus(
+	ctx context.Context,
+	owner string,
+	repo string,
+	ref string,
+	status *github.RepoStatus,
+) (*github.RepoStatus, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, ref, status)
+	ghStatus, _ := args.Get(0).(*github.RepoStatus)
+	return ghStatus, nil, args.Error(2)
+}
+
+func (m *mockGithubClient) ListDeployments(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *github.DeploymentsListOptions,
+) ([]*github.Deployment, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, opts)
+	deployments, _ := args.Get(0).([]*github.Deployment)
+	return deployments, nil, args.Error(2)
+}
+
+func (m *mockGithubClient) CreateDeployment(
+	ctx context.Context,
+	owner string,
+	repo string,
+	request *github.DeploymentRequest,
+) (*github.Deployment, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, request)
+	deployment, _ := args.Get(0).(*github.Deployment)
+	return deployment, nil, args.Error(2)
+}
+
+func (m *mockGithubClient) CreateDeploymentStatus(
+	ctx context.Context,
+	owner string,
+	repo string,
+	deployment int64,
+	request *github.DeploymentStatusRequest,
+) (*github.DeploymentStatus, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, deployment, request)
+	status, _ := args.Get(0).(*github.DeploymentStatus)
+	return status, nil, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -663,6 +743,210 @@ func TestMergePullRequest(t *testing.T) {
 	}
 }
 
+func TestCreateRelease(t *testing.T) {
+	opts := gitprovider.CreateReleaseOpts{
+		Tag:         "v1.0.0",
+		Target:      "abc123",
+		Description: "notes",
+		Prerelease:  true,
+	}
+
+	notFoundResp := &github.Response{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+	}
+	notFoundErr := &github.ErrorResponse{Response: notFoundResp.Response}
+
+	mockClient := &mockGithubClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, opts.Tag).
+		Return(nil, notFoundResp, notFoundErr)
+	mockClient.
+		On(
+			"CreateRelease",
+			context.Background(),
+			testRepoOwner,
+			testRepoName,
+			mock.MatchedBy(func(r *github.RepositoryRelease) bool {
+				return *r.TagName == opts.Tag &&
+					*r.TargetCommitish == opts.Target &&
+					r.Name == nil &&
+					*r.Body == opts.Description &&
+					!*r.Draft &&
+					*r.Prerelease
+			}),
+		).
+		Return(
+			&github.RepositoryRelease{
+				TagName: github.Ptr(opts.Tag),
+				Name:    github.Ptr(opts.Tag),
+				HTMLURL: github.Ptr("https://github.com/akuity/kargo/releases/tag/v1.0.0"),
+			},
+			&github.Response{},
+			nil,
+		)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+	release, err := g.CreateRelease(context.Background(), &opts)
+
+	mockClient.AssertExpectations(t)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", release.Tag)
+	require.Equal(t, "v1.0.0", release.Name)
+	require.Equal(t, "https://github.com/akuity/kargo/releases/tag/v1.0.0", release.URL)
+
+	mockClient = &mockGithubClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, opts.Tag).
+		Return(nil, notFoundResp, notFoundErr)
+	mockClient.
+		On("CreateRelease", context.Background(), testRepoOwner, testRepoName, mock.Anything).
+		Return(nil, nil, errors.New("something went wrong"))
+	g.client = mockClient
+	_, err = g.CreateRelease(context.Background(), &opts)
+	require.ErrorContains(t, err, "something went wrong")
+
+	// An existing release for the tag is returned without creating another.
+	mockClient = &mockGithubClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, opts.Tag).
+		Return(
+			&github.RepositoryRelease{
+				TagName: github.Ptr(opts.Tag),
+				Name:    github.Ptr("existing"),
+				HTMLURL: github.Ptr("https://github.com/akuity/kargo/releases/tag/v1.0.0"),
+			},
+			&github.Response{},
+			nil,
+		)
+	g.client = mockClient
+	release, err = g.CreateRelease(context.Background(), &opts)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", release.Tag)
+	require.Equal(t, "existing", release.Name)
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "CreateRelease", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// Errors other than not found are surfaced.
+	mockClient = &mockGithubClient{}
+	mockClient.
+		On("GetReleaseByTag", context.Background(), testRepoOwner, testRepoName, opts.Tag).
+		Return(
+			nil,
+			&github.Response{Response: &http.Response{StatusCode: http.StatusUnauthorized}},
+			errors.New("bad credentials"),
+		)
+	g.client = mockClient
+	_, err = g.CreateRelease(context.Background(), &opts)
+	require.ErrorContains(t, err, "error getting release for tag")
+	require.ErrorContains(t, err, "bad credentials")
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	mockClient := &mockGithubClient{}
+	mockClient.
+		On(
+			"CreateStatus",
+			context.Background(),
+			testRepoOwner,
+			testRepoName,
+			"abc123",
+			mock.MatchedBy(func(s *github.RepoStatus) bool {
+				return *s.State == "failure" &&
+					*s.Context == "kargo/test" &&
+					len(*s.Description) == maxStatusDescriptionLength &&
+					*s.TargetURL == "https://kargo.example.com"
+			}),
+		).
+		Return(&github.RepoStatus{}, nil, nil)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+	err := g.SetCommitStatus(
+		context.Background(),
+		&gitprovider.CommitStatusOpts{
+			CommitID:    "abc123",
+			State:       gitprovider.CommitStateFailure,
+			Context:     "kargo/test",
+			Description: strings.Repeat("a", 200),
+			TargetURL:   "https://kargo.example.com",
+		},
+	)
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSetDeploymentStatus(t *testing.T) {
+	opts := &gitprovider.DeploymentStatusOpts{
+		CommitID:    "abc123",
+		Environment: "prod",
+		State:       gitprovider.CommitStateSuccess,
+		Description: "Promotion succeeded",
+	}
+	matchStatus := mock.MatchedBy(func(r *github.DeploymentStatusRequest) bool {
+		return *r.State == "success" && *r.Environment == "prod" && *r.AutoInactive
+	})
+
+	t.Run("existing deployment", func(t *testing.T) {
+		mockClient := &mockGithubClient{}
+		mockClient.
+			On("ListDeployments", context.Background(), testRepoOwner, testRepoName, mock.Anything).
+			Return([]*github.Deployment{{ID: github.Ptr(int64(42))}}, nil, nil)
+		mockClient.
+			On("CreateDeploymentStatus", context.Background(), testRepoOwner, testRepoName, int64(42), matchStatus).
+			Return(&github.DeploymentStatus{}, nil, nil)
+		g := provider{owner: testRepoOwner, repo: testRepoName, client: mockClient}
+		require.NoError(t, g.SetDeploymentStatus(context.Background(), opts))
+		mockClient.AssertExpectations(t)
+		mockClient.AssertNotCalled(t, "CreateDeployment")
+	})
+
+	t.Run("new deployment", func(t *testing.T) {
+		mockClient := &mockGithubClient{}
+		mockClient.
+			On("ListDeployments", context.Background(), testRepoOwner, testRepoName, mock.Anything).
+			Return(nil, nil, nil)
+		mockClient.
+			On(
+				"CreateDeployment",
+				context.Background(),
+				testRepoOwner,
+				testRepoName,
+				mock.MatchedBy(func(r *github.DeploymentRequest) bool {
+					return *r.Ref == "abc123" && *r.Environment == "prod" &&
+						*r.Task == deploymentTask && !*r.AutoMerge
+				}),
+			).
+			Return(&github.Deployment{ID: github.Ptr(int64(43))}, nil, nil)
+		mockClient.
+			On("CreateDeploymentStatus", context.Background(), testRepoOwner, testRepoName, int64(43), matchStatus).
+			Return(&github.DeploymentStatus{}, nil, nil)
+		g := provider{owner: testRepoOwner, repo: testRepoName, client: mockClient}
+		require.NoError(t, g.SetDeploymentStatus(context.Background(), opts))
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error creating deployment", func(t *testing.T) {
+		mockClient := &mockGithubClient{}
+		mockClient.
+			On("ListDeployments", context.Background(), testRepoOwner, testRepoName, mock.Anything).
+			Return(nil, nil, nil)
+		mockClient.
+			On("CreateDeployment", context.Background(), testRepoOwner, testRepoName, mock.Anything).
+			Return(nil, nil, errors.New("something went wrong"))
+		g := provider{owner: testRepoOwner, repo: testRepoName, client: mockClient}
+		err := g.SetDeploymentStatus(context.Background(), opts)
+		require.ErrorContains(t, err, "error creating deployment")
+		require.ErrorContains(t, err, "something went wrong")
+	})
+}
+
 func TestGetCommitURL(t *testing.T) {
 	testCases := []struct {
 		repoURL           string
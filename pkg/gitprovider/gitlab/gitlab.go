@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -68,10 +69,57 @@ type mergeRequestClient interface {
 	) (*gitlab.MergeRequest, *gitlab.Response, error)
 }
 
+type releaseClient interface {
+	GetRelease(
+		pid any,
+		tagName string,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Release, *gitlab.Response, error)
+
+	CreateRelease(
+		pid any,
+		opts *gitlab.CreateReleaseOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Release, *gitlab.Response, error)
+}
+
+type commitStatusClient interface {
+	SetCommitStatus(
+		pid any,
+		sha string,
+		opt *gitlab.SetCommitStatusOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.CommitStatus, *gitlab.Response, error)
+}
+
+type deploymentClient interface {
+	ListProjectDeployments(
+		pid any,
+		opts *gitlab.ListProjectDeploymentsOptions,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.Deployment, *gitlab.Response, error)
+
+	CreateProjectDeployment(
+		pid any,
+		opt *gitlab.CreateProjectDeploymentOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Deployment, *gitlab.Response, error)
+
+	UpdateProjectDeployment(
+		pid any,
+		deployment int,
+		opt *gitlab.UpdateProjectDeploymentOptions,
+		options ...gitlab.RequestOptionFunc,
+	) (*gitlab.Deployment, *gitlab.Response, error)
+}
+
 // provider is a GitLab-based implementation of gitprovider.Interface.
 type provider struct { // nolint: revive
-	projectName string
-	client      mergeRequestClient
+	projectName        string
+	client             mergeRequestClient
+	releaseClient      releaseClient
+	commitStatusClient commitStatusClient
+	deploymentClient   deploymentClient
 }
 
 // NewProvider returns a GitLab-based implementation of gitprovider.Interface.
@@ -113,8 +161,11 @@ func NewProvider(
 	}
 
 	return &provider{
-		projectName: projectName,
-		client:      client.MergeRequests,
+		projectName:        projectName,
+		client:             client.MergeRequests,
+		releaseClient:      client.Releases,
+		commitStatusClient: client.Commits,
+		deploymentClient:   client.Deployments,
 	}, nil
 }
 
@@ -259,6 +310,164 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// CreateRelease implements gitprovider.Interface. GitLab has no concept of
+// draft releases or prereleases, so those options are ignored.
+func (p *provider) CreateRelease(
+	_ context.Context,
+	opts *gitprovider.CreateReleaseOpts,
+) (*gitprovider.Release, error) {
+	if opts == nil {
+		opts = &gitprovider.CreateReleaseOpts{}
+	}
+	// Releases are keyed by tag, so if one already exists for the tag (e.g.
+	// because a previous attempt at the same step succeeded), return it
+	// instead of failing on GitLab's 409 response.
+	glRelease, _, err := p.releaseClient.GetRelease(p.projectName, opts.Tag)
+	if err == nil && glRelease != nil {
+		return toProviderRelease(glRelease), nil
+	}
+	if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+		return nil, fmt.Errorf("error getting release for tag %q: %w", opts.Tag, err)
+	}
+	releaseOpts := &gitlab.CreateReleaseOptions{
+		TagName:     &opts.Tag,
+		Description: &opts.Description,
+	}
+	if opts.Name != "" {
+		releaseOpts.Name = &opts.Name
+	}
+	if opts.Target != "" {
+		releaseOpts.Ref = &opts.Target
+	}
+	if glRelease, _, err = p.releaseClient.CreateRelease(p.projectName, releaseOpts); err != nil {
+		return nil, err
+	}
+	if glRelease == nil {
+		return nil, fmt.Errorf("unexpected nil release")
+	}
+	return toProviderRelease(glRelease), nil
+}
+
+// toProviderRelease converts a GitLab release to a gitprovider.Release.
+func toProviderRelease(glRelease *gitlab.Release) *gitprovider.Release {
+	return &gitprovider.Release{
+		Tag:    glRelease.TagName,
+		Name:   glRelease.Name,
+		URL:    glRelease.Links.Self,
+		Object: glRelease,
+	}
+}
+
+// SetCommitStatus implements gitprovider.Interface.
+func (p *provider) SetCommitStatus(
+	_ context.Context,
+	opts *gitprovider.CommitStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.CommitStatusOpts{}
+	}
+	statusOpts := &gitlab.SetCommitStatusOptions{
+		State:       toGitlabBuildState(opts.State),
+		Description: &opts.Description,
+	}
+	if opts.Context != "" {
+		statusOpts.Name = &opts.Context
+	}
+	if opts.TargetURL != "" {
+		statusOpts.TargetURL = &opts.TargetURL
+	}
+	_, _, err := p.commitStatusClient.SetCommitStatus(
+		p.projectName,
+		opts.CommitID,
+		statusOpts,
+	)
+	return err
+}
+
+// SetDeploymentStatus implements gitprovider.Interface. The most recent
+// deployment of the commit to the environment is updated if one exists.
+// Otherwise, a new deployment is created, along with the environment if it
+// does not already exist.
+func (p *provider) SetDeploymentStatus(
+	_ context.Context,
+	opts *gitprovider.DeploymentStatusOpts,
+) error {
+	if opts == nil {
+		opts = &gitprovider.DeploymentStatusOpts{}
+	}
+	status := toGitlabDeploymentStatus(opts.State)
+	deployments, _, err := p.deploymentClient.ListProjectDeployments(
+		p.projectName,
+		&gitlab.ListProjectDeploymentsOptions{
+			Environment: &opts.Environment,
+			OrderBy:     gitlab.Ptr("id"),
+			Sort:        gitlab.Ptr("desc"),
+			ListOptions: gitlab.ListOptions{PerPage: 20},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error listing deployments: %w", err)
+	}
+	for _, deployment := range deployments {
+		if deployment == nil || deployment.SHA != opts.CommitID {
+			continue
+		}
+		if _, _, err = p.deploymentClient.UpdateProjectDeployment(
+			p.projectName,
+			deployment.ID,
+			&gitlab.UpdateProjectDeploymentOptions{Status: &status},
+		); err != nil {
+			return fmt.Errorf("error updating deployment %d: %w", deployment.ID, err)
+		}
+		return nil
+	}
+	// GitLab requires a ref for every deployment. The commit ID itself is the
+	// best available fallback when no branch or tag is known.
+	ref := opts.Ref
+	if ref == "" {
+		ref = opts.CommitID
+	}
+	if _, _, err = p.deploymentClient.CreateProjectDeployment(
+		p.projectName,
+		&gitlab.CreateProjectDeploymentOptions{
+			Environment: &opts.Environment,
+			Ref:         &ref,
+			SHA:         &opts.CommitID,
+			Tag:         gitlab.Ptr(false),
+			Status:      &status,
+		},
+	); err != nil {
+		return fmt.Errorf("error creating deployment: %w", err)
+	}
+	return nil
+}
+
+// toGitlabBuildState converts a gitprovider.CommitState to the equivalent
+// GitLab commit status state.
+func toGitlabBuildState(state gitprovider.CommitState) gitlab.BuildStateValue {
+	switch state {
+	case gitprovider.CommitStateSuccess:
+		return gitlab.Success
+	case gitprovider.CommitStateFailure, gitprovider.CommitStateError:
+		return gitlab.Failed
+	default:
+		return gitlab.Running
+	}
+}
+
+// toGitlabDeploymentStatus converts a gitprovider.CommitState to the
+// equivalent GitLab deployment status.
+func toGitlabDeploymentStatus(state gitprovider.CommitState) gitlab.DeploymentStatusValue {
+	switch state {
+	case gitprovider.CommitStateSuccess:
+		return gitlab.DeploymentStatusSuccess
+	case gitprovider.CommitStateFailure, gitprovider.CommitStateError:
+		return gitlab.DeploymentStatusFailed
+	default:
+		return gitlab.DeploymentStatusRunning
+	}
+}
+
 func convertGitlabMR(glMR gitlab.BasicMergeRequest) gitprovider.PullRequest {
 	return gitprovider.PullRequest{
 		Number:         int64(glMR.IID),
@@ -430,6 +430,224 @@ func TestParseGitLabURL(t *testing.T) {
 	}
 }
 
+type mockReleaseClient struct {
+	pid             any
+	createOpts      *gitlab.CreateReleaseOptions
+	release         *gitlab.Release
+	err             error
+	existingRelease *gitlab.Release
+	getErr          error
+}
+
+func (m *mockReleaseClient) GetRelease(
+	_ any,
+	_ string,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.Release, *gitlab.Response, error) {
+	if m.existingRelease == nil && m.getErr == nil {
+		return nil, nil, gitlab.ErrNotFound
+	}
+	return m.existingRelease, nil, m.getErr
+}
+
+func (m *mockReleaseClient) CreateRelease(
+	pid any,
+	opts *gitlab.CreateReleaseOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.Release, *gitlab.Response, error) {
+	m.pid = pid
+	m.createOpts = opts
+	return m.release, nil, m.err
+}
+
+func TestCreateRelease(t *testing.T) {
+	mockClient := &mockReleaseClient{
+		release: &gitlab.Release{
+			TagName: "v1.0.0",
+			Name:    "Release 1.0.0",
+		},
+	}
+	mockClient.release.Links.Self = "https://gitlab.com/group/project/-/releases/v1.0.0"
+
+	g := provider{
+		projectName:   testProjectName,
+		releaseClient: mockClient,
+	}
+	release, err := g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{
+			Tag:         "v1.0.0",
+			Target:      "abc123",
+			Name:        "Release 1.0.0",
+			Description: "notes",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "v1.0.0", *mockClient.createOpts.TagName)
+	require.Equal(t, "abc123", *mockClient.createOpts.Ref)
+	require.Equal(t, "Release 1.0.0", *mockClient.createOpts.Name)
+	require.Equal(t, "notes", *mockClient.createOpts.Description)
+	require.Equal(t, "v1.0.0", release.Tag)
+	require.Equal(t, "Release 1.0.0", release.Name)
+	require.Equal(t, "https://gitlab.com/group/project/-/releases/v1.0.0", release.URL)
+
+	mockClient = &mockReleaseClient{err: errors.New("something went wrong")}
+	g.releaseClient = mockClient
+	_, err = g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+	)
+	require.ErrorContains(t, err, "something went wrong")
+	require.Nil(t, mockClient.createOpts.Ref)
+	require.Nil(t, mockClient.createOpts.Name)
+
+	// An existing release for the tag is returned without creating another.
+	mockClient = &mockReleaseClient{
+		existingRelease: &gitlab.Release{
+			TagName: "v1.0.0",
+			Name:    "existing",
+		},
+	}
+	g.releaseClient = mockClient
+	release, err = g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", release.Tag)
+	require.Equal(t, "existing", release.Name)
+	require.Nil(t, mockClient.createOpts)
+
+	mockClient = &mockReleaseClient{getErr: errors.New("something else went wrong")}
+	g.releaseClient = mockClient
+	_, err = g.CreateRelease(
+		context.Background(),
+		&gitprovider.CreateReleaseOpts{Tag: "v1.0.0"},
+	)
+	require.ErrorContains(t, err, "error getting release for tag")
+	require.Nil(t, mockClient.createOpts)
+}
+
+type mockCommitStatusClient struct {
+	pid  any
+	sha  string
+	opts *gitlab.SetCommitStatusOptions
+	err  error
+}
+
+func (m *mockCommitStatusClient) SetCommitStatus(
+	pid any,
+	sha string,
+	opts *gitlab.SetCommitStatusOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.CommitStatus, *gitlab.Response, error) {
+	m.pid = pid
+	m.sha = sha
+	m.opts = opts
+	return &gitlab.CommitStatus{}, nil, m.err
+}
+
+func TestSetCommitStatus(t *testing.T) {
+	mockClient := &mockCommitStatusClient{}
+	g := provider{
+		projectName:        testProjectName,
+		commitStatusClient: mockClient,
+	}
+	err := g.SetCommitStatus(
+		context.Background(),
+		&gitprovider.CommitStatusOpts{
+			CommitID:    "abc123",
+			State:       gitprovider.CommitStateError,
+			Context:     "kargo/test",
+			Description: "Promotion errored",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "abc123", mockClient.sha)
+	require.Equal(t, gitlab.Failed, mockClient.opts.State)
+	require.Equal(t, "kargo/test", *mockClient.opts.Name)
+	require.Equal(t, "Promotion errored", *mockClient.opts.Description)
+	require.Nil(t, mockClient.opts.TargetURL)
+}
+
+type mockDeploymentClient struct {
+	deployments []*gitlab.Deployment
+	createOpts  *gitlab.CreateProjectDeploymentOptions
+	updatedID   int
+	updateOpts  *gitlab.UpdateProjectDeploymentOptions
+	err         error
+}
+
+func (m *mockDeploymentClient) ListProjectDeployments(
+	any,
+	*gitlab.ListProjectDeploymentsOptions,
+	...gitlab.RequestOptionFunc,
+) ([]*gitlab.Deployment, *gitlab.Response, error) {
+	return m.deployments, nil, m.err
+}
+
+func (m *mockDeploymentClient) CreateProjectDeployment(
+	_ any,
+	opts *gitlab.CreateProjectDeploymentOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.Deployment, *gitlab.Response, error) {
+	m.createOpts = opts
+	return &gitlab.Deployment{}, nil, nil
+}
+
+func (m *mockDeploymentClient) UpdateProjectDeployment(
+	_ any,
+	deployment int,
+	opts *gitlab.UpdateProjectDeploymentOptions,
+	_ ...gitlab.RequestOptionFunc,
+) (*gitlab.Deployment, *gitlab.Response, error) {
+	m.updatedID = deployment
+	m.updateOpts = opts
+	return &gitlab.Deployment{}, nil, nil
+}
+
+func TestSetDeploymentStatus(t *testing.T) {
+	opts := &gitprovider.DeploymentStatusOpts{
+		CommitID:    "abc123",
+		Environment: "prod",
+		State:       gitprovider.CommitStateSuccess,
+	}
+
+	// An existing deployment of the commit should be updated
+	mockClient := &mockDeploymentClient{
+		deployments: []*gitlab.Deployment{
+			{ID: 2, SHA: "def456"},
+			{ID: 1, SHA: "abc123"},
+		},
+	}
+	g := provider{
+		projectName:      testProjectName,
+		deploymentClient: mockClient,
+	}
+	require.NoError(t, g.SetDeploymentStatus(context.Background(), opts))
+	require.Equal(t, 1, mockClient.updatedID)
+	require.Equal(t, gitlab.DeploymentStatusSuccess, *mockClient.updateOpts.Status)
+	require.Nil(t, mockClient.createOpts)
+
+	// Otherwise, a new deployment should be created
+	mockClient = &mockDeploymentClient{}
+	g.deploymentClient = mockClient
+	require.NoError(t, g.SetDeploymentStatus(context.Background(), opts))
+	require.Nil(t, mockClient.updateOpts)
+	require.Equal(t, "prod", *mockClient.createOpts.Environment)
+	require.Equal(t, "abc123", *mockClient.createOpts.Ref)
+	require.Equal(t, "abc123", *mockClient.createOpts.SHA)
+	require.Equal(t, gitlab.DeploymentStatusSuccess, *mockClient.createOpts.Status)
+
+	mockClient = &mockDeploymentClient{err: errors.New("something went wrong")}
+	g.deploymentClient = mockClient
+	err := g.SetDeploymentStatus(context.Background(), opts)
+	require.ErrorContains(t, err, "error listing deployments")
+	require.ErrorContains(t, err, "something went wrong")
+}
+
 func TestGetCommitURL(t *testing.T) {
 	testCases := []struct {
 		repoURL           string
@@ -2,9 +2,14 @@ package gitprovider
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrUnsupported is returned by implementations of Interface when the
+// underlying Git hosting provider does not support a requested operation.
+var ErrUnsupported = errors.New("operation is not supported by this Git provider")
+
 // PullRequestState represents the state of a pull request. e.g. Closed, Open,
 // etc.
 type PullRequestState string
@@ -22,6 +27,24 @@ const (
 	PullRequestStateOpen PullRequestState = "Open"
 )
 
+// CommitState represents the state of a commit status or of a deployment.
+type CommitState string
+
+const (
+	// CommitStatePending represents an operation involving a commit that is
+	// still in progress.
+	CommitStatePending CommitState = "pending"
+	// CommitStateSuccess represents an operation involving a commit that
+	// succeeded.
+	CommitStateSuccess CommitState = "success"
+	// CommitStateFailure represents an operation involving a commit that
+	// failed.
+	CommitStateFailure CommitState = "failure"
+	// CommitStateError represents an operation involving a commit that could
+	// not be completed due to an error.
+	CommitStateError CommitState = "error"
+)
+
 // Options encapsulates options used in instantiating any implementation
 // of Interface.
 type Options struct {
@@ -62,6 +85,25 @@ type Interface interface {
 	// GetCommitURL returns a commit URL inferred from the provided repository URL
 	// and commit ID.
 	GetCommitURL(repoURL string, commitID string) (string, error)
+
+	// CreateRelease creates a release for a tag. If the tag does not already
+	// exist, the provider creates it from the target specified in the options.
+	// Providers without a native concept of releases (e.g. Azure DevOps and
+	// Bitbucket) only ensure the existence of the tag. Implementations must be
+	// idempotent: if a release (or, for providers without releases, the tag)
+	// already exists for the tag, it is returned as is, without being updated
+	// to reflect the options, so that retrying a partially completed operation
+	// does not fail.
+	CreateRelease(context.Context, *CreateReleaseOpts) (*Release, error)
+
+	// SetCommitStatus sets the status of a commit. A status set for the same
+	// commit and context as an existing status replaces it.
+	SetCommitStatus(context.Context, *CommitStatusOpts) error
+
+	// SetDeploymentStatus records the status of a deployment of a commit to an
+	// environment, creating the deployment (and environment) if necessary.
+	// Providers without a native concept of deployments return ErrUnsupported.
+	SetDeploymentStatus(context.Context, *DeploymentStatusOpts) error
 }
 
 // CreatePullRequestOpts encapsulates the options used when creating a pull
@@ -96,6 +138,77 @@ type ListPullRequestOptions struct {
 	BaseBranch string
 }
 
+// CreateReleaseOpts encapsulates the options used when creating a release.
+type CreateReleaseOpts struct {
+	// Tag is the name of the tag the release is for.
+	Tag string
+	// Target is the commit (or, for providers that support it, the branch) the
+	// tag is created from if it does not already exist.
+	Target string
+	// Name is the name of the release. Providers that require a name default
+	// to the tag if this is empty.
+	Name string
+	// Description is the body of the release (i.e. its release notes).
+	Description string
+	// Draft indicates whether the release should be created as an unpublished
+	// draft. This is ignored by providers that do not support drafts.
+	Draft bool
+	// Prerelease indicates whether the release should be marked as a
+	// prerelease. This is ignored by providers that do not support
+	// prereleases.
+	Prerelease bool
+}
+
+// CommitStatusOpts encapsulates the options used when setting the status of a
+// commit.
+type CommitStatusOpts struct {
+	// CommitID is the ID (SHA) of the commit.
+	CommitID string
+	// State is the state of the commit status.
+	State CommitState
+	// Context is a label that differentiates the status from the statuses set
+	// by other systems. Providers that require a context default to "kargo" if
+	// this is empty.
+	Context string
+	// Description is a short, human-readable description of the status.
+	// Providers may truncate it.
+	Description string
+	// TargetURL is an optional URL to associate with the status.
+	TargetURL string
+}
+
+// DeploymentStatusOpts encapsulates the options used when recording the status
+// of a deployment.
+type DeploymentStatusOpts struct {
+	// CommitID is the ID (SHA) of the deployed commit.
+	CommitID string
+	// Ref is the branch or tag the commit is known to be on, if any. Some
+	// providers require a ref other than a commit ID to create a deployment.
+	Ref string
+	// Environment is the name of the environment the commit was deployed to.
+	Environment string
+	// State is the state of the deployment.
+	State CommitState
+	// Description is a short, human-readable description of the status.
+	// Providers may truncate it.
+	Description string
+	// TargetURL is an optional URL to associate with the status.
+	TargetURL string
+}
+
+// Release is an abstracted representation of a Git hosting provider's release
+// object (or equivalent; e.g. a tag for providers without releases).
+type Release struct {
+	// Tag is the name of the tag the release is for.
+	Tag string `json:"tag"`
+	// Name is the name of the release.
+	Name string `json:"name,omitempty"`
+	// URL is the URL to the release.
+	URL string `json:"url,omitempty"`
+	// Object is the underlying object from the Git hosting provider.
+	Object any `json:"-"`
+}
+
 // PullRequest is an abstracted representation of a Git hosting provider's pull
 // request object (or equivalent; e.g. a GitLab merge request).
 type PullRequest struct {
@@ -141,6 +254,14 @@ type Fake struct {
 	MergePullRequestFn func(context.Context, int64) (*PullRequest, bool, error)
 	// GetCommitURLFn defines the functionality of the GetCommitURL method.
 	GetCommitURLFn func(repoURL string, commitID string) (string, error)
+	// CreateReleaseFn defines the functionality of the CreateRelease method.
+	CreateReleaseFn func(context.Context, *CreateReleaseOpts) (*Release, error)
+	// SetCommitStatusFn defines the functionality of the SetCommitStatus
+	// method.
+	SetCommitStatusFn func(context.Context, *CommitStatusOpts) error
+	// SetDeploymentStatusFn defines the functionality of the
+	// SetDeploymentStatus method.
+	SetDeploymentStatusFn func(context.Context, *DeploymentStatusOpts) error
 }
 
 // CreatePullRequest implements gitprovider.Interface.
@@ -179,3 +300,27 @@ func (f *Fake) MergePullRequest(
 func (f *Fake) GetCommitURL(repoURL string, sha string) (string, error) {
 	return f.GetCommitURLFn(repoURL, sha)
 }
+
+// CreateRelease implements gitprovider.Interface.
+func (f *Fake) CreateRelease(
+	ctx context.Context,
+	opts *CreateReleaseOpts,
+) (*Release, error) {
+	return f.CreateReleaseFn(ctx, opts)
+}
+
+// SetCommitStatus implements gitprovider.Interface.
+func (f *Fake) SetCommitStatus(
+	ctx context.Context,
+	opts *CommitStatusOpts,
+) error {
+	return f.SetCommitStatusFn(ctx, opts)
+}
+
+// SetDeploymentStatus implements gitprovider.Interface.
+func (f *Fake) SetDeploymentStatus(
+	ctx context.Context,
+	opts *DeploymentStatusOpts,
+) error {
+	return f.SetDeploymentStatusFn(ctx, opts)
+}
@@ -0,0 +1,341 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/kubeclient"
+)
+
+const (
+	fluxResourceStatusesKey = "fluxResourceStatuses"
+
+	// FluxReconcileRequestAnnotation is the annotation used to request that a
+	// Flux controller reconcile a resource outside its regular interval.
+	FluxReconcileRequestAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+	fluxConditionReady       = "Ready"
+	fluxConditionReconciling = "Reconciling"
+)
+
+// fluxGVKs maps the kinds of Flux resources supported by Kargo to the
+// GroupVersionKinds used to interact with them.
+var fluxGVKs = map[string]schema.GroupVersionKind{
+	"GitRepository": {Group: "source.toolkit.fluxcd.io", Version: "v1", Kind: "GitRepository"},
+	"OCIRepository": {Group: "source.toolkit.fluxcd.io", Version: "v1beta2", Kind: "OCIRepository"},
+	"HelmRelease":   {Group: "helm.toolkit.fluxcd.io", Version: "v2", Kind: "HelmRelease"},
+	"Kustomization": {Group: "kustomize.toolkit.fluxcd.io", Version: "v1", Kind: "Kustomization"},
+}
+
+// FluxGroupVersionKind returns the GroupVersionKind used to interact with Flux
+// resources of the specified kind. An error is returned if the kind is not
+// supported.
+func FluxGroupVersionKind(kind string) (schema.GroupVersionKind, error) {
+	gvk, ok := fluxGVKs[kind]
+	if !ok {
+		return schema.GroupVersionKind{}, fmt.Errorf("unsupported Flux resource kind %q", kind)
+	}
+	return gvk, nil
+}
+
+// FluxHealthInput is the input for a health check associated with the
+// flux-update step.
+type FluxHealthInput struct {
+	// KubeconfigSecret is the name of a Secret in the Project namespace
+	// containing a kubeconfig for the cluster Flux runs in.
+	KubeconfigSecret string `json:"kubeconfigSecret"`
+	// Resources is a list of health checks to perform on specific Flux
+	// resources.
+	Resources []FluxResourceHealthCheck `json:"resources"`
+}
+
+// FluxResourceHealthCheck is the configuration for a health check on a single
+// Flux resource.
+type FluxResourceHealthCheck struct {
+	// Kind is the kind of the Flux resource to check.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Flux resource to check.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource to check.
+	Name string `json:"name"`
+	// DesiredRevision is the revision the Flux resource is expected to have
+	// applied. If empty, any revision is acceptable.
+	DesiredRevision string `json:"desiredRevision,omitempty"`
+}
+
+// FluxResourceStatus describes the current state of a single Flux resource.
+type FluxResourceStatus struct {
+	// Kind is the kind of the Flux resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Ready is the status of the Flux resource's Ready condition.
+	Ready metav1.ConditionStatus `json:"ready,omitempty"`
+	// Reason is the reason of the Flux resource's Ready condition.
+	Reason string `json:"reason,omitempty"`
+	// Message is the message of the Flux resource's Ready condition.
+	Message string `json:"message,omitempty"`
+	// Revision is the revision most recently fetched (for sources) or applied
+	// (for HelmReleases and Kustomizations) by Flux.
+	Revision string `json:"revision,omitempty"`
+	// DesiredRevision is the revision the Flux resource is expected to have
+	// applied, if any.
+	DesiredRevision string `json:"desiredRevision,omitempty"`
+}
+
+type fluxChecker struct {
+	kargoClient client.Client
+
+	newTargetClientFn func(
+		ctx context.Context,
+		kargoClient client.Client,
+		namespace string,
+		secretName string,
+	) (client.Client, string, error)
+}
+
+// newFluxChecker returns an implementation of the Checker interface that
+// monitors the readiness and applied revisions of Flux resources.
+func newFluxChecker(kargoClient client.Client) *fluxChecker {
+	return &fluxChecker{
+		kargoClient:       kargoClient,
+		newTargetClientFn: kubeclient.NewClientFromKubeconfigSecret,
+	}
+}
+
+// Name implements the Checker interface.
+func (f *fluxChecker) Name() string {
+	return "flux"
+}
+
+// Check implements the Checker interface.
+func (f *fluxChecker) Check(
+	ctx context.Context,
+	project string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	input, err := health.InputToStruct[FluxHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					f.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return f.check(ctx, project, input)
+}
+
+func (f *fluxChecker) check(
+	ctx context.Context,
+	project string,
+	input FluxHealthInput,
+) health.Result {
+	if f.kargoClient == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"no Kubernetes client is available to this controller; cannot " +
+					"assess the health of Flux resources",
+			},
+		}
+	}
+	fluxClient, _, err := f.newTargetClientFn(ctx, f.kargoClient, project, input.KubeconfigSecret)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{err.Error()},
+		}
+	}
+
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	resStatuses := make([]FluxResourceStatus, len(input.Resources))
+	for i, check := range input.Resources {
+		var state kargoapi.HealthState
+		var issue string
+		state, resStatuses[i], issue = f.getResourceHealth(ctx, fluxClient, check)
+		res.Status = res.Status.Merge(state)
+		if issue != "" {
+			res.Issues = append(res.Issues, issue)
+		}
+	}
+	res.Output = map[string]any{
+		fluxResourceStatusesKey: resStatuses,
+	}
+	return res
+}
+
+// getResourceHealth retrieves the Flux resource described by the provided
+// health check configuration and assesses its health.
+func (f *fluxChecker) getResourceHealth(
+	ctx context.Context,
+	c client.Client,
+	check FluxResourceHealthCheck,
+) (kargoapi.HealthState, FluxResourceStatus, string) {
+	resStatus := FluxResourceStatus{
+		Kind:            check.Kind,
+		Namespace:       check.Namespace,
+		Name:            check.Name,
+		DesiredRevision: check.DesiredRevision,
+	}
+	gvk, err := FluxGroupVersionKind(check.Kind)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, resStatus, err.Error()
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err = c.Get(
+		ctx,
+		client.ObjectKey{Namespace: check.Namespace, Name: check.Name},
+		obj,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return kargoapi.HealthStateUnhealthy, resStatus, fmt.Sprintf(
+				"unable to find %s %q in namespace %q",
+				check.Kind, check.Name, check.Namespace,
+			)
+		}
+		return kargoapi.HealthStateUnknown, resStatus, fmt.Sprintf(
+			"error finding %s %q in namespace %q: %s",
+			check.Kind, check.Name, check.Namespace, err.Error(),
+		)
+	}
+	return EvaluateFluxResource(obj, check.DesiredRevision, "")
+}
+
+// EvaluateFluxResource assesses the health of the provided Flux resource based
+// on its Ready condition and, if a desired revision is specified, on whether
+// that revision has been fetched or applied. If requestedAt is non-empty, the
+// resource is additionally required to have handled the reconciliation request
+// with that value before it can be considered Healthy. In addition to the
+// health state and status of the resource, a description of any issue is
+// returned.
+func EvaluateFluxResource(
+	obj *unstructured.Unstructured,
+	desiredRevision string,
+	requestedAt string,
+) (kargoapi.HealthState, FluxResourceStatus, string) {
+	resStatus := FluxResourceStatus{
+		Kind:            obj.GetKind(),
+		Namespace:       obj.GetNamespace(),
+		Name:            obj.GetName(),
+		Revision:        fluxRevision(obj),
+		DesiredRevision: desiredRevision,
+	}
+	conditions := fluxConditions(obj)
+	ready := meta.FindStatusCondition(conditions, fluxConditionReady)
+	if ready != nil {
+		resStatus.Ready = ready.Status
+		resStatus.Reason = ready.Reason
+		resStatus.Message = ready.Message
+	}
+	desc := fmt.Sprintf("%s %q in namespace %q", obj.GetKind(), obj.GetName(), obj.GetNamespace())
+
+	if requestedAt != "" {
+		lastHandled, _, _ := unstructured.NestedString(obj.Object, "status", "lastHandledReconcileAt")
+		if lastHandled != requestedAt {
+			return kargoapi.HealthStateProgressing, resStatus,
+				desc + " has not yet handled the reconciliation request"
+		}
+	}
+	observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if !found || observedGeneration < obj.GetGeneration() {
+		return kargoapi.HealthStateProgressing, resStatus,
+			desc + " has not yet observed its latest generation"
+	}
+
+	switch {
+	case ready == nil:
+		return kargoapi.HealthStateProgressing, resStatus, desc + " has no Ready condition"
+	case ready.Status == metav1.ConditionFalse &&
+		!meta.IsStatusConditionTrue(conditions, fluxConditionReconciling):
+		return kargoapi.HealthStateUnhealthy, resStatus,
+			fmt.Sprintf("%s is not ready: %s", desc, ready.Message)
+	case ready.Status != metav1.ConditionTrue:
+		return kargoapi.HealthStateProgressing, resStatus,
+			fmt.Sprintf("%s is reconciling: %s", desc, ready.Message)
+	}
+
+	if desiredRevision != "" && !fluxRevisionMatches(resStatus.Revision, desiredRevision) {
+		return kargoapi.HealthStateProgressing, resStatus, fmt.Sprintf(
+			"%s is at revision %q, not the desired revision %q",
+			desc, resStatus.Revision, desiredRevision,
+		)
+	}
+	return kargoapi.HealthStateHealthy, resStatus, ""
+}
+
+// fluxConditions returns the status conditions of the provided Flux resource.
+func fluxConditions(obj *unstructured.Unstructured) []metav1.Condition {
+	rawConditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	conditions := make([]metav1.Condition, 0, len(rawConditions))
+	for _, rawCondition := range rawConditions {
+		rawMap, ok := rawCondition.(map[string]any)
+		if !ok {
+			continue
+		}
+		var condition metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(
+			rawMap, &condition,
+		); err == nil {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// fluxRevision returns the revision most recently fetched by the provided Flux
+// source or applied by the provided Flux HelmRelease or Kustomization.
+func fluxRevision(obj *unstructured.Unstructured) string {
+	switch obj.GetKind() {
+	case "HelmRelease":
+		history, _, _ := unstructured.NestedSlice(obj.Object, "status", "history")
+		if len(history) > 0 {
+			if latest, ok := history[0].(map[string]any); ok {
+				if version, ok := latest["chartVersion"].(string); ok {
+					return version
+				}
+			}
+		}
+		revision, _, _ := unstructured.NestedString(obj.Object, "status", "lastAttemptedRevision")
+		return revision
+	case "Kustomization":
+		revision, _, _ := unstructured.NestedString(obj.Object, "status", "lastAppliedRevision")
+		return revision
+	default:
+		revision, _, _ := unstructured.NestedString(obj.Object, "status", "artifact", "revision")
+		return revision
+	}
+}
+
+// fluxRevisionMatches returns true if the provided Flux revision corresponds
+// to the desired revision. Flux revisions take forms such as
+// "main@sha1:<commit>", "v1.0.0@sha256:<digest>", or a bare chart version, so
+// a desired revision matches if it is equal to the revision, to its named
+// prefix, or to its checksum suffix.
+func fluxRevisionMatches(revision, desired string) bool {
+	return revision == desired ||
+		strings.HasPrefix(revision, desired+"@") ||
+		strings.HasSuffix(revision, "@"+desired) ||
+		strings.HasSuffix(revision, ":"+desired)
+}
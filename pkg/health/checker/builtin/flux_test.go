@@ -0,0 +1,246 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_fluxChecker_Check(t *testing.T) {
+	const testNamespace = "flux-system"
+
+	newFluxObject := func(
+		kind string,
+		name string,
+		generation int64,
+		status map[string]any,
+	) *unstructured.Unstructured {
+		gvk, err := FluxGroupVersionKind(kind)
+		require.NoError(t, err)
+		obj := &unstructured.Unstructured{Object: map[string]any{"status": status}}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetNamespace(testNamespace)
+		obj.SetName(name)
+		obj.SetGeneration(generation)
+		return obj
+	}
+	readyCondition := func(status, message string) []any {
+		return []any{
+			map[string]any{
+				"type":               "Ready",
+				"status":             status,
+				"reason":             "Fake",
+				"message":            message,
+				"lastTransitionTime": "2024-01-01T00:00:00Z",
+			},
+		}
+	}
+
+	objects := []client.Object{
+		newFluxObject("GitRepository", "ready", 1, map[string]any{
+			"observedGeneration": int64(1),
+			"conditions":         readyCondition("True", "stored artifact"),
+			"artifact":           map[string]any{"revision": "main@sha1:abc123"},
+		}),
+		newFluxObject("HelmRelease", "ready", 1, map[string]any{
+			"observedGeneration": int64(1),
+			"conditions":         readyCondition("True", "upgrade succeeded"),
+			"history":            []any{map[string]any{"chartVersion": "1.2.3"}},
+		}),
+		newFluxObject("Kustomization", "stale", 2, map[string]any{
+			"observedGeneration": int64(1),
+			"conditions":         readyCondition("True", "applied revision"),
+		}),
+		newFluxObject("Kustomization", "failed", 1, map[string]any{
+			"observedGeneration": int64(1),
+			"conditions":         readyCondition("False", "health check failed"),
+		}),
+	}
+
+	testCases := []struct {
+		name        string
+		kargoClient client.Client
+		clientErr   error
+		input       health.Input
+		assertions  func(*testing.T, health.Result)
+	}{
+		{
+			name: "no Kubernetes client",
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "no Kubernetes client is available")
+			},
+		},
+		{
+			name:        "invalid input",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input:       health.Input{"resources": "bogus"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "could not convert opaque input")
+			},
+		},
+		{
+			name:        "error creating target client",
+			kargoClient: fake.NewClientBuilder().Build(),
+			clientErr:   errors.New("something went wrong"),
+			input:       health.Input{"kubeconfigSecret": "flux"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Equal(t, []string{"something went wrong"}, res.Issues)
+			},
+		},
+		{
+			name:        "healthy",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "flux",
+				"resources": []any{
+					map[string]any{
+						"kind":            "GitRepository",
+						"namespace":       testNamespace,
+						"name":            "ready",
+						"desiredRevision": "abc123",
+					},
+					map[string]any{
+						"kind":            "HelmRelease",
+						"namespace":       testNamespace,
+						"name":            "ready",
+						"desiredRevision": "1.2.3",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				require.Equal(
+					t,
+					[]FluxResourceStatus{
+						{
+							Kind:            "GitRepository",
+							Namespace:       testNamespace,
+							Name:            "ready",
+							Ready:           "True",
+							Reason:          "Fake",
+							Message:         "stored artifact",
+							Revision:        "main@sha1:abc123",
+							DesiredRevision: "abc123",
+						},
+						{
+							Kind:            "HelmRelease",
+							Namespace:       testNamespace,
+							Name:            "ready",
+							Ready:           "True",
+							Reason:          "Fake",
+							Message:         "upgrade succeeded",
+							Revision:        "1.2.3",
+							DesiredRevision: "1.2.3",
+						},
+					},
+					res.Output[fluxResourceStatusesKey],
+				)
+			},
+		},
+		{
+			name:        "progressing",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "flux",
+				"resources": []any{
+					map[string]any{
+						"kind":            "GitRepository",
+						"namespace":       testNamespace,
+						"name":            "ready",
+						"desiredRevision": "def456",
+					},
+					map[string]any{
+						"kind":      "Kustomization",
+						"namespace": testNamespace,
+						"name":      "stale",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						`GitRepository "ready" in namespace "flux-system" is at revision ` +
+							`"main@sha1:abc123", not the desired revision "def456"`,
+						`Kustomization "stale" in namespace "flux-system" has not yet ` +
+							"observed its latest generation",
+					},
+					res.Issues,
+				)
+			},
+		},
+		{
+			name:        "unhealthy",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "flux",
+				"resources": []any{
+					map[string]any{
+						"kind":      "Kustomization",
+						"namespace": testNamespace,
+						"name":      "failed",
+					},
+					map[string]any{
+						"kind":      "OCIRepository",
+						"namespace": testNamespace,
+						"name":      "missing",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						`Kustomization "failed" in namespace "flux-system" is not ready: ` +
+							"health check failed",
+						`unable to find OCIRepository "missing" in namespace "flux-system"`,
+					},
+					res.Issues,
+				)
+				statuses, ok := res.Output[fluxResourceStatusesKey].([]FluxResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 2)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fluxClient := fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(objects...).
+				Build()
+			checker := newFluxChecker(testCase.kargoClient)
+			checker.newTargetClientFn = func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (client.Client, string, error) {
+				return fluxClient, "", testCase.clientErr
+			}
+			res := checker.Check(
+				context.Background(),
+				"fake-project",
+				"fake-stage",
+				health.Criteria{Input: testCase.input},
+			)
+			testCase.assertions(t, res)
+		})
+	}
+}
@@ -12,9 +12,11 @@ var initialized atomic.Uint32
 
 // Initialize registers all built-in Checkers with the health package's internal
 // Checker registry.
-func Initialize(argocdClient client.Client) {
+func Initialize(kargoClient, argocdClient client.Client) {
 	if !initialized.CompareAndSwap(0, 1) {
 		panic("built-in health checkers already initialized")
 	}
 	health.RegisterChecker(newArgocdChecker(argocdClient))
+	health.RegisterChecker(newKubernetesChecker(kargoClient))
+	health.RegisterChecker(newFluxChecker(kargoClient))
 }
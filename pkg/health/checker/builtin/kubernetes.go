@@ -0,0 +1,294 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/kubeclient"
+)
+
+const objectStatusesKey = "objectStatuses"
+
+// KubernetesHealthInput is the input for a health check on plain Kubernetes
+// objects, such as those applied by the kubernetes-apply step.
+type KubernetesHealthInput struct {
+	// KubeconfigSecret is the name of a Secret in the Project namespace
+	// containing a kubeconfig for the cluster the objects reside in.
+	KubeconfigSecret string `json:"kubeconfigSecret"`
+	// Objects is a list of health checks to perform on specific Kubernetes
+	// objects or on sets of Kubernetes objects.
+	Objects []KubernetesObjectHealthCheck `json:"objects"`
+}
+
+// KubernetesObjectHealthCheck is the configuration for a health check on a
+// single Kubernetes object or, if a selector is specified, on all Kubernetes
+// objects of a given kind that match the selector. Exactly one of Name or
+// Selector must be specified.
+type KubernetesObjectHealthCheck struct {
+	// APIVersion is the API version of the object(s) to check.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the object(s) to check.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object(s) to check. It should be left
+	// empty for cluster-scoped objects. When a selector is specified, leaving it
+	// empty selects objects across all namespaces.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object to check. Mutually exclusive with
+	// Selector.
+	Name string `json:"name,omitempty"`
+	// Selector is a label selector used to discover the objects to check.
+	// Mutually exclusive with Name.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// KubernetesObjectStatus describes the current state of a single Kubernetes
+// object.
+type KubernetesObjectStatus struct {
+	// APIVersion is the API version of the object.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the object.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the object.
+	Name string `json:"name"`
+	// Status is the kstatus of the object, e.g. "Current" or "InProgress".
+	Status string `json:"status"`
+	// Message is a human-readable description of the object's status.
+	Message string `json:"message,omitempty"`
+}
+
+type kubernetesChecker struct {
+	kargoClient client.Client
+
+	newTargetClientFn func(
+		ctx context.Context,
+		kargoClient client.Client,
+		namespace string,
+		secretName string,
+	) (client.Client, string, error)
+}
+
+// newKubernetesChecker returns an implementation of the Checker interface that
+// assesses the readiness of plain Kubernetes objects, such as Deployments,
+// StatefulSets, DaemonSets, and Jobs, using kstatus conventions.
+func newKubernetesChecker(kargoClient client.Client) *kubernetesChecker {
+	return &kubernetesChecker{
+		kargoClient:       kargoClient,
+		newTargetClientFn: kubeclient.NewClientFromKubeconfigSecret,
+	}
+}
+
+// Name implements the Checker interface.
+func (k *kubernetesChecker) Name() string {
+	return "kubernetes"
+}
+
+// Check implements the Checker interface.
+func (k *kubernetesChecker) Check(
+	ctx context.Context,
+	project string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	input, err := health.InputToStruct[KubernetesHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					k.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return k.check(ctx, project, input)
+}
+
+func (k *kubernetesChecker) check(
+	ctx context.Context,
+	project string,
+	input KubernetesHealthInput,
+) health.Result {
+	if k.kargoClient == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"no Kubernetes client is available to this controller; cannot " +
+					"assess the health of Kubernetes objects",
+			},
+		}
+	}
+	targetClient, _, err := k.newTargetClientFn(ctx, k.kargoClient, project, input.KubeconfigSecret)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{err.Error()},
+		}
+	}
+
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	objStatuses := make([]KubernetesObjectStatus, 0, len(input.Objects))
+	for _, check := range input.Objects {
+		statuses, err := k.getObjectStatuses(ctx, targetClient, check)
+		if err != nil {
+			res.Status = res.Status.Merge(kargoapi.HealthStateUnknown)
+			res.Issues = append(res.Issues, err.Error())
+			continue
+		}
+		if len(statuses) == 0 {
+			res.Status = res.Status.Merge(kargoapi.HealthStateUnhealthy)
+			res.Issues = append(
+				res.Issues,
+				fmt.Sprintf("no %s objects matched selector", check.Kind),
+			)
+			continue
+		}
+		for _, objStatus := range statuses {
+			state := healthStateForStatus(status.Status(objStatus.Status))
+			res.Status = res.Status.Merge(state)
+			if state != kargoapi.HealthStateHealthy {
+				res.Issues = append(res.Issues, describeObjectStatus(objStatus))
+			}
+			objStatuses = append(objStatuses, objStatus)
+		}
+	}
+	res.Output = map[string]any{
+		objectStatusesKey: objStatuses,
+	}
+	return res
+}
+
+// getObjectStatuses returns the status of each object matching the provided
+// health check configuration.
+func (k *kubernetesChecker) getObjectStatuses(
+	ctx context.Context,
+	c client.Client,
+	check KubernetesObjectHealthCheck,
+) ([]KubernetesObjectStatus, error) {
+	gv, err := schema.ParseGroupVersion(check.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion %q: %w", check.APIVersion, err)
+	}
+	gvk := gv.WithKind(check.Kind)
+
+	if (check.Name == "") == (check.Selector == nil) {
+		return nil, fmt.Errorf(
+			"exactly one of name or selector must be specified for %s objects",
+			check.Kind,
+		)
+	}
+
+	if check.Selector == nil {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		if err = c.Get(
+			ctx,
+			client.ObjectKey{Namespace: check.Namespace, Name: check.Name},
+			obj,
+		); err != nil {
+			if apierrors.IsNotFound(err) {
+				return []KubernetesObjectStatus{{
+					APIVersion: check.APIVersion,
+					Kind:       check.Kind,
+					Namespace:  check.Namespace,
+					Name:       check.Name,
+					Status:     status.NotFoundStatus.String(),
+					Message:    "object not found",
+				}}, nil
+			}
+			return nil, fmt.Errorf(
+				"error getting %s %q: %w", check.Kind, objectKeyString(check.Namespace, check.Name), err,
+			)
+		}
+		return []KubernetesObjectStatus{computeObjectStatus(obj)}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(check.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector for %s objects: %w", check.Kind, err)
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gv.WithKind(check.Kind + "List"))
+	listOpts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+	if check.Namespace != "" {
+		listOpts = append(listOpts, client.InNamespace(check.Namespace))
+	}
+	if err = c.List(ctx, list, listOpts...); err != nil {
+		return nil, fmt.Errorf("error listing %s objects: %w", check.Kind, err)
+	}
+	statuses := make([]KubernetesObjectStatus, 0, len(list.Items))
+	for i := range list.Items {
+		obj := &list.Items[i]
+		obj.SetGroupVersionKind(gvk)
+		statuses = append(statuses, computeObjectStatus(obj))
+	}
+	return statuses, nil
+}
+
+// computeObjectStatus returns the status of the provided object as determined
+// by its kstatus.
+func computeObjectStatus(obj *unstructured.Unstructured) KubernetesObjectStatus {
+	objStatus := KubernetesObjectStatus{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+	res, err := status.Compute(obj)
+	if err != nil {
+		objStatus.Status = status.UnknownStatus.String()
+		objStatus.Message = err.Error()
+		return objStatus
+	}
+	objStatus.Status = res.Status.String()
+	objStatus.Message = res.Message
+	return objStatus
+}
+
+// healthStateForStatus maps a kstatus to a kargoapi.HealthState.
+func healthStateForStatus(s status.Status) kargoapi.HealthState {
+	switch s {
+	case status.CurrentStatus:
+		return kargoapi.HealthStateHealthy
+	case status.InProgressStatus, status.TerminatingStatus:
+		return kargoapi.HealthStateProgressing
+	case status.FailedStatus, status.NotFoundStatus:
+		return kargoapi.HealthStateUnhealthy
+	default:
+		return kargoapi.HealthStateUnknown
+	}
+}
+
+// describeObjectStatus returns a human-readable description of the provided
+// object status, suitable for inclusion in a health check's issues.
+func describeObjectStatus(s KubernetesObjectStatus) string {
+	desc := fmt.Sprintf(
+		"%s %q has status %q",
+		s.Kind, objectKeyString(s.Namespace, s.Name), s.Status,
+	)
+	if s.Message != "" {
+		desc += ": " + s.Message
+	}
+	return desc
+}
+
+func objectKeyString(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,321 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_kubernetesChecker_Check(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	const testNamespace = "my-app"
+
+	readyDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "ready",
+			Generation: 1,
+			Labels:     map[string]string{"app": "my-app", "tier": "frontend"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           1,
+			UpdatedReplicas:    1,
+			ReadyReplicas:      1,
+			AvailableReplicas:  1,
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+	progressingDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "progressing",
+			Generation: 2,
+			Labels:     map[string]string{"app": "my-app"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](1)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+		},
+	}
+	failedJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  testNamespace,
+			Name:       "migrate",
+			Generation: 1,
+		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Message: "BackoffLimitExceeded",
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		kargoClient client.Client
+		clientErr   error
+		input       health.Input
+		assertions  func(*testing.T, health.Result)
+	}{
+		{
+			name: "no Kubernetes client",
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "no Kubernetes client is available")
+			},
+		},
+		{
+			name:        "invalid input",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input:       health.Input{"objects": "bogus"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "could not convert opaque input")
+			},
+		},
+		{
+			name:        "error creating target client",
+			kargoClient: fake.NewClientBuilder().Build(),
+			clientErr:   errors.New("something went wrong"),
+			input:       health.Input{"kubeconfigSecret": "target"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Equal(t, []string{"something went wrong"}, res.Issues)
+			},
+		},
+		{
+			name:        "healthy",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "target",
+				"objects": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  testNamespace,
+						"name":       "ready",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				require.Equal(
+					t,
+					[]KubernetesObjectStatus{{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  testNamespace,
+						Name:       "ready",
+						Status:     "Current",
+						Message:    "Deployment is available. Replicas: 1",
+					}},
+					res.Output[objectStatusesKey],
+				)
+			},
+		},
+		{
+			name:        "progressing objects discovered by selector",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "target",
+				"objects": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  testNamespace,
+						"selector": map[string]any{
+							"matchLabels": map[string]any{"app": "my-app"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `Deployment "my-app/progressing" has status "InProgress"`)
+				statuses, ok := res.Output[objectStatusesKey].([]KubernetesObjectStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 2)
+				names := []string{statuses[0].Name, statuses[1].Name}
+				require.ElementsMatch(t, []string{"ready", "progressing"}, names)
+			},
+		},
+		{
+			name:        "healthy objects discovered by selector",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "target",
+				"objects": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  testNamespace,
+						"selector": map[string]any{
+							"matchExpressions": []any{
+								map[string]any{
+									"key":      "tier",
+									"operator": "In",
+									"values":   []any{"frontend"},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				statuses, ok := res.Output[objectStatusesKey].([]KubernetesObjectStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 1)
+				require.Equal(t, "ready", statuses[0].Name)
+			},
+		},
+		{
+			name:        "both name and selector specified",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "target",
+				"objects": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  testNamespace,
+						"name":       "ready",
+						"selector": map[string]any{
+							"matchLabels": map[string]any{"app": "my-app"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Equal(
+					t,
+					[]string{"exactly one of name or selector must be specified for Deployment objects"},
+					res.Issues,
+				)
+			},
+		},
+		{
+			name:        "neither name nor selector specified",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "target",
+				"objects": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  testNamespace,
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Equal(
+					t,
+					[]string{"exactly one of name or selector must be specified for Deployment objects"},
+					res.Issues,
+				)
+			},
+		},
+		{
+			name:        "unhealthy",
+			kargoClient: fake.NewClientBuilder().Build(),
+			input: health.Input{
+				"kubeconfigSecret": "target",
+				"objects": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  testNamespace,
+						"name":       "ready",
+					},
+					map[string]any{
+						"apiVersion": "batch/v1",
+						"kind":       "Job",
+						"namespace":  testNamespace,
+						"name":       "migrate",
+					},
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "StatefulSet",
+						"namespace":  testNamespace,
+						"name":       "missing",
+					},
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "DaemonSet",
+						"selector": map[string]any{
+							"matchLabels": map[string]any{"app": "my-app"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						`Job "my-app/migrate" has status "Failed": Job Failed. failed: 0/1`,
+						`StatefulSet "my-app/missing" has status "NotFound": object not found`,
+						"no DaemonSet objects matched selector",
+					},
+					res.Issues,
+				)
+				statuses, ok := res.Output[objectStatusesKey].([]KubernetesObjectStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 3)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			targetClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(readyDeployment, progressingDeployment, failedJob).
+				Build()
+			checker := newKubernetesChecker(testCase.kargoClient)
+			checker.newTargetClientFn = func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (client.Client, string, error) {
+				return targetClient, "", testCase.clientErr
+			}
+			res := checker.Check(
+				context.Background(),
+				"fake-project",
+				"fake-stage",
+				health.Criteria{Input: testCase.input},
+			)
+			testCase.assertions(t, res)
+		})
+	}
+}
@@ -1,11 +1,18 @@
 package image
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"strconv"
 
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/urls"
 )
 
@@ -13,8 +20,12 @@ import (
 // functionality for all Selector implementations. It is not intended to be used
 // directly.
 type baseSelector struct {
-	platform   *platformConstraint
-	repoClient *repositoryClient
+	platform         *platformConstraint
+	filterExpression *vm.Program
+	repoClient       *repositoryClient
+	// verifier, if non-nil, verifies the signatures and attestations of
+	// candidate images. Images that cannot be verified are never selected.
+	verifier SignatureVerifier
 }
 
 func newBaseSelector(
@@ -31,6 +42,11 @@ func newBaseSelector(
 			)
 		}
 	}
+	if sub.ExpressionFilter != "" {
+		if s.filterExpression, err = expr.Compile(sub.ExpressionFilter); err != nil {
+			return nil, fmt.Errorf("error compiling filter expression: %w", err)
+		}
+	}
 	repoURL := urls.NormalizeImage(sub.RepoURL)
 	if s.repoClient, err = newRepositoryClient(
 		repoURL,
@@ -43,9 +59,27 @@ func newBaseSelector(
 			err,
 		)
 	}
+	if sub.Verification != nil {
+		if s.verifier, err = newSignatureVerifier(sub, s.repoClient); err != nil {
+			return nil, fmt.Errorf(
+				"error creating signature verifier for image %q: %w",
+				repoURL,
+				err,
+			)
+		}
+	}
 	return s, nil
 }
 
+// enableArtifactMode switches the selector's underlying repository client into
+// a mode wherein every manifest or index is treated as an opaque OCI artifact
+// instead of as a container image. If artifactType is non-empty, only artifacts
+// of that type will be selected.
+func (b *baseSelector) enableArtifactMode(artifactType string) {
+	b.repoClient.artifacts = true
+	b.repoClient.artifactType = artifactType
+}
+
 // getLoggerContext returns key/value pairs that can be used by any selector to
 // enrich loggers with valuable context.
 func (b *baseSelector) getLoggerContext() []any {
@@ -53,7 +87,130 @@ func (b *baseSelector) getLoggerContext() []any {
 		"registry", b.repoClient.registry.name,
 		"image", b.repoClient.repoURL,
 		"platformConstrained", b.platform != nil,
+		"expressionConstrained", b.filterExpression != nil,
+		"verificationConstrained", b.verifier != nil,
+	}
+}
+
+// getImageByTag retrieves the image with the provided tag. When the selector
+// has a filter expression, metadata must be retrieved for every candidate tag
+// and not just for those that will be selected, so a cache is used to avoid
+// retrieving it again on every discovery.
+func (b *baseSelector) getImageByTag(
+	ctx context.Context,
+	tag string,
+) (*image, error) {
+	if b.filterExpression != nil {
+		return b.repoClient.getImageByTagCached(ctx, tag, b.platform)
+	}
+	return b.repoClient.getImageByTag(ctx, tag, b.platform)
+}
+
+// matchesExpression evaluates the metadata of the provided image against the
+// selector's filter expression, if any, and returns a boolean value indicating
+// whether the image satisfied it.
+func (b *baseSelector) matchesExpression(img image) (bool, error) {
+	if b.filterExpression == nil {
+		return true, nil
+	}
+	env := map[string]any{
+		"tag":         img.Tag,
+		"digest":      img.Digest,
+		"createdAt":   nil,
+		"annotations": img.Annotations,
+		"labels":      img.Labels,
+	}
+	if img.CreatedAt != nil {
+		env["createdAt"] = *img.CreatedAt
+	}
+	if img.Annotations == nil {
+		env["annotations"] = map[string]string{}
+	}
+	if img.Labels == nil {
+		env["labels"] = map[string]string{}
+	}
+
+	result, err := expr.Run(b.filterExpression, env)
+	if err != nil {
+		return false, fmt.Errorf("error evaluating image filter expression: %w", err)
+	}
+
+	switch result := result.(type) {
+	case bool:
+		return result, nil
+	default:
+		parsedBool, err := strconv.ParseBool(fmt.Sprintf("%v", result))
+		if err != nil {
+			return false, fmt.Errorf("error parsing expression result: %w", err)
+		}
+		return parsedBool, nil
+	}
+}
+
+// verify verifies the signatures and attestations of the provided image using
+// the selector's verifier, if any. If the image was verified, a copy of it is
+// returned with its annotations augmented with details of how it was
+// verified. If it could not be verified, nil is returned.
+func (b *baseSelector) verify(ctx context.Context, img image) (*image, error) {
+	if b.verifier == nil {
+		return &img, nil
+	}
+	annotations, err := b.verifier.Verify(ctx, img.Digest)
+	if err != nil {
+		if errors.Is(err, ErrUnverified) {
+			logging.LoggerFromContext(ctx).Debug(
+				"excluding image that could not be verified",
+				"tag", img.Tag,
+				"digest", img.Digest,
+				"reason", err.Error(),
+			)
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error verifying image with tag %q and digest %q: %w",
+			img.Tag, img.Digest, err,
+		)
+	}
+	if len(annotations) > 0 {
+		// The image may be shared with a cache, so it must not be modified.
+		img.Annotations = maps.Clone(img.Annotations)
+		if img.Annotations == nil {
+			img.Annotations = make(map[string]string, len(annotations))
+		}
+		maps.Copy(img.Annotations, annotations)
+	}
+	return &img, nil
+}
+
+// verifyImages verifies the provided images, in order, using the selector's
+// verifier, if any, and returns those that were verified. This stops once an
+// amount of images equal to the provided limit has been verified. A limit of
+// zero or less means no limit.
+func (b *baseSelector) verifyImages(
+	ctx context.Context,
+	images []image,
+	limit int,
+) ([]image, error) {
+	if b.verifier == nil {
+		return images, nil
+	}
+	if limit <= 0 || limit > len(images) {
+		limit = len(images)
+	}
+	verified := make([]image, 0, limit)
+	for _, img := range images {
+		if len(verified) >= limit {
+			break
+		}
+		v, err := b.verify(ctx, img)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil {
+			verified = append(verified, *v)
+		}
 	}
+	return verified, nil
 }
 
 // imagesToAPIImages converts a slice of internal image to a slice of
@@ -1,9 +1,13 @@
 package image
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/expr-lang/expr"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -23,6 +27,16 @@ func TestNewBaseSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error parsing platform constraint")
 			},
 		},
+		{
+			name: "error compiling filter expression",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:          "example/image",
+				ExpressionFilter: "tag ==",
+			},
+			assertions: func(t *testing.T, _ *baseSelector, err error) {
+				require.ErrorContains(t, err, "error compiling filter expression")
+			},
+		},
 		{
 			name: "error creating repository client",
 			sub:  kargoapi.ImageSubscription{}, // No RepoURL
@@ -37,8 +51,9 @@ func TestNewBaseSelector(t *testing.T) {
 		{
 			name: "success",
 			sub: kargoapi.ImageSubscription{
-				RepoURL:  "example/image",
-				Platform: "linux/amd64",
+				RepoURL:          "example/image",
+				Platform:         "linux/amd64",
+				ExpressionFilter: `labels["qa-passed"] == "true"`,
 			},
 			assertions: func(t *testing.T, s *baseSelector, err error) {
 				require.NoError(t, err)
@@ -50,6 +65,7 @@ func TestNewBaseSelector(t *testing.T) {
 					},
 					s.platform,
 				)
+				require.NotNil(t, s.filterExpression)
 				require.NotNil(t, s.repoClient)
 			},
 		},
@@ -106,3 +122,182 @@ func Test_baseSelector_imagesToAPIImages(t *testing.T) {
 		apiImages,
 	)
 }
+
+func Test_baseSelector_matchesExpression(t *testing.T) {
+	createdAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testImage := image{
+		Tag:    "v1.0.0",
+		Digest: "sha256:abc",
+		Annotations: map[string]string{
+			"org.opencontainers.image.source": "https://github.com/example/repo",
+		},
+		Labels:    map[string]string{"git-commit": "1234567"},
+		CreatedAt: &createdAt,
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		img        image
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "no expression",
+			img:  testImage,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name: "expression matches",
+			expression: `annotations["org.opencontainers.image.source"] == ` +
+				`"https://github.com/example/repo" && labels["git-commit"] == "1234567" && ` +
+				`tag == "v1.0.0" && digest == "sha256:abc" && createdAt.Year() == 2024`,
+			img: testImage,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:       "expression does not match",
+			expression: `labels["qa-passed"] == "true"`,
+			img:        testImage,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matches)
+			},
+		},
+		{
+			name:       "missing metadata",
+			expression: `"qa-passed" in annotations || "qa-passed" in labels || createdAt != nil`,
+			img:        image{Tag: "v1.0.0"},
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matches)
+			},
+		},
+		{
+			name:       "string result parsed as boolean",
+			expression: `labels["qa-passed"] ?? "false"`,
+			img: image{
+				Labels: map[string]string{"qa-passed": "true"},
+			},
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:       "result not parseable as boolean",
+			expression: `tag`,
+			img:        testImage,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error parsing expression result")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &baseSelector{}
+			if testCase.expression != "" {
+				var err error
+				s.filterExpression, err = expr.Compile(testCase.expression)
+				require.NoError(t, err)
+			}
+			matches, err := s.matchesExpression(testCase.img)
+			testCase.assertions(t, matches, err)
+		})
+	}
+}
+
+func Test_baseSelector_verifyImages(t *testing.T) {
+	images := []image{
+		{Tag: "v3", Digest: "unverified-digest"},
+		{Tag: "v2", Digest: "verified-digest", Annotations: map[string]string{"foo": "bar"}},
+		{Tag: "v1", Digest: "other-verified-digest"},
+		{Tag: "v0", Digest: "error-digest"},
+	}
+	verifier := &fakeSignatureVerifier{
+		VerifyFn: func(_ context.Context, digest string) (map[string]string, error) {
+			switch digest {
+			case "unverified-digest":
+				return nil, fmt.Errorf("%w: no signatures found", ErrUnverified)
+			case "error-digest":
+				return nil, errors.New("something went wrong")
+			default:
+				return map[string]string{"signer": "someone"}, nil
+			}
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		verifier   SignatureVerifier
+		limit      int
+		assertions func(*testing.T, []image, error)
+	}{
+		{
+			name:  "no verifier",
+			limit: 1,
+			assertions: func(t *testing.T, verified []image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, images, verified)
+			},
+		},
+		{
+			name:     "unverified images do not count toward the limit",
+			verifier: verifier,
+			limit:    2,
+			assertions: func(t *testing.T, verified []image, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]image{
+						{
+							Tag:         "v2",
+							Digest:      "verified-digest",
+							Annotations: map[string]string{"foo": "bar", "signer": "someone"},
+						},
+						{
+							Tag:         "v1",
+							Digest:      "other-verified-digest",
+							Annotations: map[string]string{"signer": "someone"},
+						},
+					},
+					verified,
+				)
+				// The original image must not have been modified
+				require.Equal(t, map[string]string{"foo": "bar"}, images[1].Annotations)
+			},
+		},
+		{
+			name:     "error verifying",
+			verifier: verifier,
+			assertions: func(t *testing.T, _ []image, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &baseSelector{verifier: testCase.verifier}
+			verified, err := s.verifyImages(context.Background(), images, testCase.limit)
+			testCase.assertions(t, verified, err)
+		})
+	}
+}
+
+// fakeSignatureVerifier is a fake implementation of SignatureVerifier.
+type fakeSignatureVerifier struct {
+	VerifyFn func(context.Context, string) (map[string]string, error)
+}
+
+// Verify implements SignatureVerifier.
+func (f *fakeSignatureVerifier) Verify(
+	ctx context.Context,
+	digest string,
+) (map[string]string, error) {
+	return f.VerifyFn(ctx, digest)
+}
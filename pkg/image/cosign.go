@@ -0,0 +1,271 @@
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/tuf"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// publicGoodRekorPublicKey is the public key of the public-good Sigstore
+// instance of the Rekor transparency log (https://rekor.sigstore.dev). It is
+// used to verify proof of inclusion in the log when keyless verification
+// settings do not specify a Rekor public key of their own.
+const publicGoodRekorPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE2G2Y+2tabdTV5BcGiBIx0a9fAFwr
+kBbmLSGtks4L3qX6yYY0zufBnhC8Ur/iy55GhWP/9A/bY2LhC30M9+RYtw==
+-----END PUBLIC KEY-----
+`
+
+// cosignVerifier verifies cosign signatures and attestations using cosign's
+// own verification logic.
+type cosignVerifier struct {
+	// checkOpts are the options with which signatures are verified, less any
+	// options for interacting with the registry.
+	checkOpts cosign.CheckOpts
+	// publicKeyFingerprint is set when signatures must have been created using
+	// a key pair. It is the hex-encoded SHA-256 digest of the DER-encoded
+	// public key.
+	publicKeyFingerprint string
+	// identityRegex is set when signatures must have been created using
+	// keyless signing by an identity matching a regular expression.
+	identityRegex *regexp.Regexp
+	// predicateType is set when images must have an in-toto attestation.
+	predicateType string
+}
+
+func newCosignVerifier(cfg kargoapi.CosignVerification) (*cosignVerifier, error) {
+	v := &cosignVerifier{
+		checkOpts: cosign.CheckOpts{
+			// Everything required for verification is stored alongside the
+			// image. Kargo never queries a transparency log.
+			Offline: true,
+		},
+	}
+	switch {
+	case cfg.PublicKey != "":
+		pub, err := cryptoutils.UnmarshalPEMToPublicKey([]byte(cfg.PublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %w", err)
+		}
+		if v.checkOpts.SigVerifier, err = signature.LoadVerifier(pub, crypto.SHA256); err != nil {
+			return nil, fmt.Errorf("error loading public key: %w", err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling public key: %w", err)
+		}
+		v.publicKeyFingerprint = fmt.Sprintf("%x", sha256.Sum256(der))
+		// Trust in a key does not depend on when it was used, so there is no
+		// need for a transparency log to prove when a signature was created.
+		v.checkOpts.IgnoreTlog = true
+	case cfg.Keyless != nil:
+		certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(cfg.Keyless.FulcioRoots))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing Fulcio roots: %w", err)
+		}
+		if len(certs) == 0 {
+			return nil, errors.New("no PEM-encoded Fulcio roots found")
+		}
+		v.checkOpts.RootCerts, v.checkOpts.IntermediateCerts = splitCertificates(certs)
+		if cfg.Keyless.IdentityRegex != "" {
+			if v.identityRegex, err = regexp.Compile(cfg.Keyless.IdentityRegex); err != nil {
+				return nil, fmt.Errorf(
+					"error compiling identity regex %q: %w", cfg.Keyless.IdentityRegex, err,
+				)
+			}
+		}
+		v.checkOpts.Identities = []cosign.Identity{{
+			Issuer:        cfg.Keyless.Issuer,
+			Subject:       cfg.Keyless.Identity,
+			SubjectRegExp: cfg.Keyless.IdentityRegex,
+		}}
+		// There is no means of configuring trusted certificate transparency
+		// logs.
+		v.checkOpts.IgnoreSCT = true
+		// Signing certificates issued by Fulcio are only valid for minutes, so
+		// whether one was valid when a signature was created can only be
+		// established using the time at which the signature was included in a
+		// transparency log.
+		rekorPublicKey := cfg.Keyless.RekorPublicKey
+		if rekorPublicKey == "" {
+			rekorPublicKey = publicGoodRekorPublicKey
+		}
+		rekorPubKeys := cosign.NewTrustedTransparencyLogPubKeys()
+		if err = rekorPubKeys.AddTransparencyLogPubKey(
+			[]byte(rekorPublicKey),
+			tuf.Active,
+		); err != nil {
+			return nil, fmt.Errorf("error parsing Rekor public key: %w", err)
+		}
+		v.checkOpts.RekorPubKeys = &rekorPubKeys
+	default:
+		return nil, errors.New("neither a public key nor keyless signing is specified")
+	}
+	if cfg.Attestation != nil {
+		v.predicateType = cfg.Attestation.PredicateType
+	}
+	return v, nil
+}
+
+// verifyCosign verifies that the image referenced by the provided digest has
+// a valid cosign signature and, if required, a valid in-toto attestation. If
+// so, it returns annotations describing the signer and the attestation.
+func (v *signatureVerifier) verifyCosign(
+	ctx context.Context,
+	digestRef name.Digest,
+) (map[string]string, error) {
+	co := v.cosign.checkOpts
+	co.RegistryClientOpts = []ociremote.Option{
+		ociremote.WithRemoteOptions(
+			append(v.repoClient.remoteOptions, remote.WithContext(ctx))...,
+		),
+	}
+	co.ClaimVerifier = cosign.SimpleClaimVerifier
+	sigs, _, err := cosign.VerifyImageSignatures(ctx, digestRef, &co)
+	if err != nil {
+		return nil, wrapCosignError("error verifying cosign signatures", err)
+	}
+	annotations, err := v.cosign.signerAnnotations(sigs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if v.cosign.predicateType == "" {
+		return annotations, nil
+	}
+	co.ClaimVerifier = cosign.IntotoSubjectClaimVerifier
+	atts, _, err := cosign.VerifyImageAttestations(ctx, digestRef, &co)
+	if err != nil {
+		return nil, wrapCosignError("error verifying cosign attestations", err)
+	}
+	for _, att := range atts {
+		predicateType, err := getPredicateType(att)
+		if err != nil {
+			return nil, err
+		}
+		if predicateType == v.cosign.predicateType {
+			annotations[kargoapi.AnnotationKeyImageAttestationPredicateType] =
+				v.cosign.predicateType
+			return annotations, nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"%w: no valid attestation with predicate type %q found",
+		ErrUnverified, v.cosign.predicateType,
+	)
+}
+
+// wrapCosignError wraps the provided error returned by cosign with the
+// provided message. If the error indicates that no valid signature or
+// attestation was found, as opposed to verification not having been possible
+// at all, the returned error also wraps ErrUnverified.
+func wrapCosignError(msg string, err error) error {
+	var (
+		noSigsErr     *cosign.ErrNoSignaturesFound
+		noMatchSigErr *cosign.ErrNoMatchingSignatures
+		noMatchAttErr *cosign.ErrNoMatchingAttestations
+		failureErr    *cosign.VerificationFailure
+	)
+	if errors.As(err, &noSigsErr) || errors.As(err, &noMatchSigErr) ||
+		errors.As(err, &noMatchAttErr) || errors.As(err, &failureErr) {
+		return fmt.Errorf("%w: %s: %w", ErrUnverified, msg, err)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// signerAnnotations returns annotations describing the signer of the provided
+// verified signature.
+func (c *cosignVerifier) signerAnnotations(sig oci.Signature) (map[string]string, error) {
+	if c.publicKeyFingerprint != "" {
+		return map[string]string{
+			kargoapi.AnnotationKeyImageCosignSigner: c.publicKeyFingerprint,
+		}, nil
+	}
+	cert, err := sig.Cert()
+	if err != nil {
+		return nil, fmt.Errorf("error getting signing certificate: %w", err)
+	}
+	if cert == nil {
+		return nil, errors.New("no signing certificate found")
+	}
+	// cosign has already verified that one of the identities matches. This
+	// just finds out which one.
+	identities := cryptoutils.GetSubjectAlternateNames(cert)
+	var signer string
+	for _, identity := range identities {
+		if (c.identityRegex != nil && c.identityRegex.MatchString(identity)) ||
+			identity == c.checkOpts.Identities[0].Subject {
+			signer = identity
+			break
+		}
+	}
+	return map[string]string{
+		kargoapi.AnnotationKeyImageCosignSigner: signer,
+		kargoapi.AnnotationKeyImageCosignIssuer: (&cosign.CertExtensions{Cert: cert}).GetIssuer(),
+	}, nil
+}
+
+// getPredicateType returns the predicate type of the in-toto statement in the
+// DSSE envelope that is the payload of the provided verified attestation.
+func getPredicateType(att oci.Signature) (string, error) {
+	payload, err := att.Payload()
+	if err != nil {
+		return "", fmt.Errorf("error getting attestation payload: %w", err)
+	}
+	envelope := struct {
+		Payload string `json:"payload"`
+	}{}
+	if err = json.Unmarshal(payload, &envelope); err != nil {
+		return "", fmt.Errorf("error unmarshaling attestation envelope: %w", err)
+	}
+	statementJSON, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return "", fmt.Errorf("error decoding attestation statement: %w", err)
+	}
+	statement := struct {
+		PredicateType string `json:"predicateType"`
+	}{}
+	if err = json.Unmarshal(statementJSON, &statement); err != nil {
+		return "", fmt.Errorf("error unmarshaling attestation statement: %w", err)
+	}
+	return statement.PredicateType, nil
+}
+
+// splitCertificates splits the provided certificates into a pool of
+// self-signed root certificates and a pool of intermediate certificates. If
+// there are no intermediate certificates, the latter is nil, which permits
+// intermediate certificates to be supplied alongside each signature.
+func splitCertificates(
+	certs []*x509.Certificate,
+) (*x509.CertPool, *x509.CertPool) {
+	roots := x509.NewCertPool()
+	var intermediates *x509.CertPool
+	for _, cert := range certs {
+		if cert.CheckSignatureFrom(cert) == nil {
+			roots.AddCert(cert)
+			continue
+		}
+		if intermediates == nil {
+			intermediates = x509.NewCertPool()
+		}
+		intermediates.AddCert(cert)
+	}
+	return roots, intermediates
+}
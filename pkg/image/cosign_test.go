@@ -0,0 +1,633 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/cosign/bundle"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/dsse"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// testFulcioIssuerOID is the OID of the (deprecated) extension in which
+// Fulcio records the OIDC issuer that authenticated a signer.
+var testFulcioIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+
+func Test_signatureVerifier_verifyCosign(t *testing.T) {
+	const testIssuer = "https://token.actions.githubusercontent.com"
+	const testIdentity = "https://github.com/example/repo/.github/workflows/release.yaml@refs/heads/main"
+	const testPredicateType = "https://slsa.dev/provenance/v1"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// Fulcio-like PKI
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := newTestCA(t, caKey)
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	intermediate := newTestCertificate(
+		t,
+		&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "test-intermediate"},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		},
+		ca,
+		intermediateKey.Public(),
+		caKey,
+	)
+	// Like certificates issued by Fulcio, signing certificates are only valid
+	// for ten minutes, and have long since expired.
+	signingTime := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	newSigningCert := func(identity, issuer string) *x509.Certificate {
+		identityURI, err := url.Parse(identity)
+		require.NoError(t, err)
+		return newTestCertificate(
+			t,
+			&x509.Certificate{
+				NotBefore:   signingTime.Add(-time.Minute),
+				NotAfter:    signingTime.Add(10 * time.Minute),
+				KeyUsage:    x509.KeyUsageDigitalSignature,
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+				URIs:        []*url.URL{identityURI},
+				ExtraExtensions: []pkix.Extension{{
+					Id:    testFulcioIssuerOID,
+					Value: []byte(issuer),
+				}},
+			},
+			intermediate,
+			key.Public(),
+			intermediateKey,
+		)
+	}
+	signingCert := newSigningCert(testIdentity, testIssuer)
+
+	rekorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	// signKeyless pushes a keyless signature created using the provided
+	// signing certificate, with proof of its inclusion in the transparency
+	// log at the provided time.
+	signKeyless := func(
+		t *testing.T,
+		digestRef name.Digest,
+		cert *x509.Certificate,
+		tlog *testTlogEntry,
+	) {
+		attachTestCosignSignature(
+			t,
+			digestRef,
+			newTestCosignSignature(
+				t,
+				digestRef,
+				key,
+				[]*x509.Certificate{cert, intermediate, ca},
+				tlog,
+			),
+		)
+	}
+	loggedAtSigning := &testTlogEntry{key: rekorKey, integratedTime: signingTime}
+
+	keyCfg := kargoapi.CosignVerification{
+		PublicKey: encodeTestPublicKey(t, key.Public()),
+	}
+	keylessCfg := kargoapi.CosignVerification{
+		Keyless: &kargoapi.CosignKeylessVerification{
+			FulcioRoots:    encodeTestCertificate(ca),
+			Identity:       testIdentity,
+			Issuer:         testIssuer,
+			RekorPublicKey: encodeTestPublicKey(t, rekorKey.Public()),
+		},
+	}
+	attestationCfg := kargoapi.CosignVerification{
+		PublicKey:   encodeTestPublicKey(t, key.Public()),
+		Attestation: &kargoapi.CosignAttestationVerification{PredicateType: testPredicateType},
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	keyFingerprint := fmt.Sprintf("%x", sha256.Sum256(der))
+
+	testCases := []struct {
+		name       string
+		cfg        kargoapi.CosignVerification
+		sign       func(*testing.T, name.Digest)
+		assertions func(*testing.T, map[string]string, error)
+	}{
+		{
+			name: "no signatures",
+			cfg:  keyCfg,
+			sign: func(*testing.T, name.Digest) {},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no signatures found")
+			},
+		},
+		{
+			name: "signature by wrong key",
+			cfg:  keyCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestCosignSignature(t, digestRef, otherKey, nil)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no matching signatures")
+			},
+		},
+		{
+			name: "signature of another image",
+			cfg:  keyCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				otherRef := digestRef.Context().Digest("sha256:" + strings.Repeat("1", 64))
+				sig := newTestCosignSignature(t, otherRef, key, nil, nil)
+				attachTestCosignSignature(t, digestRef, sig)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no matching signatures")
+			},
+		},
+		{
+			name: "key signature verified",
+			cfg:  keyCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestCosignSignature(t, digestRef, otherKey, nil)
+				pushTestCosignSignature(t, digestRef, key, nil)
+			},
+			assertions: func(t *testing.T, annotations map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{kargoapi.AnnotationKeyImageCosignSigner: keyFingerprint},
+					annotations,
+				)
+			},
+		},
+		{
+			name: "keyless signature by wrong identity",
+			cfg:  keylessCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(
+					t,
+					digestRef,
+					newSigningCert("https://github.com/example/other", testIssuer),
+					loggedAtSigning,
+				)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name: "keyless signature from wrong issuer",
+			cfg:  keylessCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(
+					t,
+					digestRef,
+					newSigningCert(testIdentity, "https://issuer.example.com"),
+					loggedAtSigning,
+				)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name: "keyless signature from untrusted CA",
+			cfg: kargoapi.CosignVerification{
+				Keyless: &kargoapi.CosignKeylessVerification{
+					FulcioRoots:    encodeTestCertificate(newTestCA(t, otherKey)),
+					Identity:       testIdentity,
+					Issuer:         testIssuer,
+					RekorPublicKey: encodeTestPublicKey(t, rekorKey.Public()),
+				},
+			},
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(t, digestRef, signingCert, loggedAtSigning)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name: "keyless signature not in transparency log",
+			cfg:  keylessCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(t, digestRef, signingCert, nil)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name: "keyless signature logged by untrusted transparency log",
+			cfg:  keylessCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(
+					t,
+					digestRef,
+					signingCert,
+					&testTlogEntry{key: otherKey, integratedTime: signingTime},
+				)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name: "keyless signature logged after signing certificate expired",
+			cfg:  keylessCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(
+					t,
+					digestRef,
+					signingCert,
+					&testTlogEntry{key: rekorKey, integratedTime: signingTime.Add(time.Hour)},
+				)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name: "keyless signature verified",
+			cfg:  keylessCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(t, digestRef, signingCert, loggedAtSigning)
+			},
+			assertions: func(t *testing.T, annotations map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{
+						kargoapi.AnnotationKeyImageCosignSigner: testIdentity,
+						kargoapi.AnnotationKeyImageCosignIssuer: testIssuer,
+					},
+					annotations,
+				)
+			},
+		},
+		{
+			name: "keyless signature verified by identity regex",
+			cfg: kargoapi.CosignVerification{
+				Keyless: &kargoapi.CosignKeylessVerification{
+					FulcioRoots:    encodeTestCertificate(ca),
+					IdentityRegex:  `^https://github\.com/example/`,
+					Issuer:         testIssuer,
+					RekorPublicKey: encodeTestPublicKey(t, rekorKey.Public()),
+				},
+			},
+			sign: func(t *testing.T, digestRef name.Digest) {
+				signKeyless(t, digestRef, signingCert, loggedAtSigning)
+			},
+			assertions: func(t *testing.T, annotations map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testIdentity, annotations[kargoapi.AnnotationKeyImageCosignSigner])
+			},
+		},
+		{
+			name: "no attestations",
+			cfg:  attestationCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestCosignSignature(t, digestRef, key, nil)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "error verifying cosign attestations")
+			},
+		},
+		{
+			name: "attestation with wrong predicate type",
+			cfg:  attestationCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestCosignSignature(t, digestRef, key, nil)
+				pushTestCosignAttestation(t, digestRef, key, "https://example.com/other")
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no valid attestation with predicate type")
+			},
+		},
+		{
+			name: "attestation by wrong key",
+			cfg:  attestationCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestCosignSignature(t, digestRef, key, nil)
+				pushTestCosignAttestation(t, digestRef, otherKey, testPredicateType)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "error verifying cosign attestations")
+			},
+		},
+		{
+			name: "attestation verified",
+			cfg:  attestationCfg,
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestCosignSignature(t, digestRef, key, nil)
+				pushTestCosignAttestation(t, digestRef, key, testPredicateType)
+			},
+			assertions: func(t *testing.T, annotations map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{
+						kargoapi.AnnotationKeyImageCosignSigner:             keyFingerprint,
+						kargoapi.AnnotationKeyImageAttestationPredicateType: testPredicateType,
+					},
+					annotations,
+				)
+			},
+		},
+	}
+	srv := newTestRegistryServer(t)
+	for i, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			digestRef := pushTestImage(t, srv, fmt.Sprintf("cosign-%d", i))
+			testCase.sign(t, digestRef)
+			cosignVerifier, err := newCosignVerifier(testCase.cfg)
+			require.NoError(t, err)
+			v := &signatureVerifier{
+				repoClient: newTestVerifierRepositoryClient(t, digestRef),
+				cosign:     cosignVerifier,
+			}
+			annotations, err := v.verifyCosign(context.Background(), digestRef)
+			testCase.assertions(t, annotations, err)
+		})
+	}
+}
+
+func Test_wrapCosignError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		unverified bool
+	}{
+		{
+			name:       "no signatures found",
+			err:        &cosign.ErrNoSignaturesFound{},
+			unverified: true,
+		},
+		{
+			name:       "no matching signatures",
+			err:        &cosign.ErrNoMatchingSignatures{},
+			unverified: true,
+		},
+		{
+			name:       "no matching attestations",
+			err:        &cosign.ErrNoMatchingAttestations{},
+			unverified: true,
+		},
+		{
+			name:       "verification failure",
+			err:        &cosign.VerificationFailure{},
+			unverified: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("something went wrong"),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := wrapCosignError("error verifying", testCase.err)
+			require.ErrorContains(t, err, "error verifying")
+			require.ErrorIs(t, err, testCase.err)
+			require.Equal(t, testCase.unverified, errors.Is(err, ErrUnverified))
+		})
+	}
+}
+
+func Test_splitCertificates(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := newTestCA(t, caKey)
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	intermediate := newTestCertificate(
+		t,
+		&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "test-intermediate"},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		},
+		ca,
+		intermediateKey.Public(),
+		caKey,
+	)
+
+	roots, intermediates := splitCertificates([]*x509.Certificate{ca})
+	require.True(t, roots.Equal(newTestCertPool(ca)))
+	require.Nil(t, intermediates)
+
+	roots, intermediates = splitCertificates([]*x509.Certificate{intermediate, ca})
+	require.True(t, roots.Equal(newTestCertPool(ca)))
+	require.True(t, intermediates.Equal(newTestCertPool(intermediate)))
+}
+
+func newTestCertPool(certs ...*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool
+}
+
+// testTlogEntry describes the inclusion of a signature in a Rekor transparency
+// log.
+type testTlogEntry struct {
+	// key is the key of the transparency log.
+	key *ecdsa.PrivateKey
+	// integratedTime is the time at which the signature was included.
+	integratedTime time.Time
+}
+
+// newTestCosignSignature returns a cosign signature of the image referenced by
+// the provided digest, created using the provided key. If certs is non-empty,
+// its first element is the signing certificate and the rest are its chain. If
+// tlog is non-nil, the signature is accompanied by proof of its inclusion in a
+// transparency log.
+func newTestCosignSignature(
+	t *testing.T,
+	digestRef name.Digest,
+	key *ecdsa.PrivateKey,
+	certs []*x509.Certificate,
+	tlog *testTlogEntry,
+) oci.Signature {
+	signer, err := signature.LoadECDSASigner(key, crypto.SHA256)
+	require.NoError(t, err)
+	sigPayload, err := payload.Cosign{Image: digestRef}.MarshalJSON()
+	require.NoError(t, err)
+	sig, err := signer.SignMessage(bytes.NewReader(sigPayload))
+	require.NoError(t, err)
+	var opts []static.Option
+	if len(certs) > 0 {
+		opts = append(opts, static.WithCertChain(
+			[]byte(encodeTestCertificate(certs[0])),
+			[]byte(encodeTestCertificate(certs[1:]...)),
+		))
+	}
+	if tlog != nil {
+		opts = append(opts, static.WithBundle(
+			newTestRekorBundle(t, tlog, sigPayload, sig, certs[0]),
+		))
+	}
+	ociSig, err := static.NewSignature(
+		sigPayload,
+		base64.StdEncoding.EncodeToString(sig),
+		opts...,
+	)
+	require.NoError(t, err)
+	return ociSig
+}
+
+// pushTestCosignSignature pushes a cosign signature of the image referenced by
+// the provided digest, created as by newTestCosignSignature.
+func pushTestCosignSignature(
+	t *testing.T,
+	digestRef name.Digest,
+	key *ecdsa.PrivateKey,
+	certs []*x509.Certificate,
+) {
+	attachTestCosignSignature(
+		t,
+		digestRef,
+		newTestCosignSignature(t, digestRef, key, certs, nil),
+	)
+}
+
+// newTestRekorBundle returns proof of the inclusion, in the provided
+// transparency log, of the provided signature over the provided payload,
+// created using the provided signing certificate.
+func newTestRekorBundle(
+	t *testing.T,
+	tlog *testTlogEntry,
+	sigPayload []byte,
+	sig []byte,
+	cert *x509.Certificate,
+) *bundle.RekorBundle {
+	payloadDigest := sha256.Sum256(sigPayload)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{
+					"algorithm": "sha256",
+					"value":     hex.EncodeToString(payloadDigest[:]),
+				},
+			},
+			"signature": map[string]any{
+				"content": base64.StdEncoding.EncodeToString(sig),
+				"publicKey": map[string]any{
+					"content": base64.StdEncoding.EncodeToString(
+						[]byte(encodeTestCertificate(cert)),
+					),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	logKey, err := x509.MarshalPKIXPublicKey(tlog.key.Public())
+	require.NoError(t, err)
+	rekorPayload := bundle.RekorPayload{
+		Body:           base64.StdEncoding.EncodeToString(body),
+		IntegratedTime: tlog.integratedTime.Unix(),
+		LogIndex:       42,
+		LogID:          fmt.Sprintf("%x", sha256.Sum256(logKey)),
+	}
+	// Marshaling a map sorts its keys, which, for a payload with no special
+	// characters, produces canonical JSON.
+	canonicalPayload, err := json.Marshal(map[string]any{
+		"body":           rekorPayload.Body,
+		"integratedTime": rekorPayload.IntegratedTime,
+		"logIndex":       rekorPayload.LogIndex,
+		"logID":          rekorPayload.LogID,
+	})
+	require.NoError(t, err)
+	payloadDigest = sha256.Sum256(canonicalPayload)
+	set, err := ecdsa.SignASN1(rand.Reader, tlog.key, payloadDigest[:])
+	require.NoError(t, err)
+	return &bundle.RekorBundle{
+		SignedEntryTimestamp: set,
+		Payload:              rekorPayload,
+	}
+}
+
+// attachTestCosignSignature pushes the provided cosign signature alongside
+// the image referenced by the provided digest.
+func attachTestCosignSignature(t *testing.T, digestRef name.Digest, sig oci.Signature) {
+	se, err := ociremote.SignedEntity(digestRef)
+	require.NoError(t, err)
+	se, err = mutate.AttachSignatureToEntity(se, sig)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteSignatures(digestRef.Repository, se))
+}
+
+// pushTestCosignAttestation pushes a cosign attestation of the image
+// referenced by the provided digest, with an in-toto statement of the
+// provided predicate type, created using the provided key.
+func pushTestCosignAttestation(
+	t *testing.T,
+	digestRef name.Digest,
+	key *ecdsa.PrivateKey,
+	predicateType string,
+) {
+	signer, err := signature.LoadECDSASigner(key, crypto.SHA256)
+	require.NoError(t, err)
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v0.1",
+		"predicateType": predicateType,
+		"subject": []map[string]any{{
+			"name": digestRef.Context().Name(),
+			"digest": map[string]string{
+				"sha256": strings.TrimPrefix(digestRef.DigestStr(), "sha256:"),
+			},
+		}},
+		"predicate": map[string]any{},
+	})
+	require.NoError(t, err)
+	envelope, err := dsse.WrapSigner(signer, "application/vnd.in-toto+json").
+		SignMessage(bytes.NewReader(statement))
+	require.NoError(t, err)
+	att, err := static.NewAttestation(envelope)
+	require.NoError(t, err)
+	se, err := ociremote.SignedEntity(digestRef)
+	require.NoError(t, err)
+	se, err = mutate.AttachAttestationToEntity(se, att)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteAttestations(digestRef.Repository, se))
+}
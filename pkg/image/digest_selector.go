@@ -64,7 +64,7 @@ func (d *digestSelector) Select(
 
 	logger.Trace("selecting image")
 
-	img, err := d.repoClient.getImageByTag(ctx, d.mutableTag, d.platform)
+	img, err := d.getImageByTag(ctx, d.mutableTag)
 	if err != nil {
 		var te *transport.Error
 		if errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
@@ -80,6 +80,22 @@ func (d *digestSelector) Select(
 		return nil, nil
 	}
 
+	matches, err := d.matchesExpression(*img)
+	if err != nil {
+		return nil, fmt.Errorf("error filtering image with tag %q: %w", d.mutableTag, err)
+	}
+	if !matches {
+		logger.Trace("image with tag did not match filter expression")
+		return nil, nil
+	}
+
+	if img, err = d.verify(ctx, *img); err != nil {
+		return nil, err
+	}
+	if img == nil {
+		return nil, nil
+	}
+
 	logger.Trace("found image with tag")
 	return d.imagesToAPIImages([]image{*img}, 0), nil
 }
@@ -6,12 +6,15 @@ import (
 	"github.com/Masterminds/semver/v3"
 )
 
-// image is a representation of a container image.
+// image is a representation of a container image or, more generally, of any
+// OCI artifact.
 type image struct {
-	Tag         string
-	Digest      string
-	Annotations map[string]string
-	CreatedAt   *time.Time
+	Tag          string
+	Digest       string
+	ArtifactType string
+	Annotations  map[string]string
+	Labels       map[string]string
+	CreatedAt    *time.Time
 
 	semVer *semver.Version
 }
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/logging"
@@ -85,6 +86,12 @@ func (n *newestBuildSelector) Select(
 	logger.Trace("sorting images by date")
 	n.sort(images)
 
+	// Verification is comparatively expensive, so it only happens once images
+	// have been sorted, and only until enough images have been verified.
+	if images, err = n.verifyImages(ctx, images, n.discoveryLimit); err != nil {
+		return nil, err
+	}
+
 	limit := n.discoveryLimit
 	if limit == 0 || limit > len(images) {
 		limit = len(images)
@@ -126,6 +133,16 @@ func (n *newestBuildSelector) getImagesByTags(
 	imageCh := make(chan image, len(tags))
 	// This buffered channel has room for one error
 	errCh := make(chan error, 1)
+	reportErr := func(err error) {
+		// Report the error right away or not at all. errCh is a buffered
+		// channel with room for one error, so if we can't send the error
+		// right away, we know that another goroutine has already sent one.
+		select {
+		case errCh <- err:
+			cancel() // Stop all other goroutines
+		default:
+		}
+	}
 
 	for _, tag := range tags {
 		if err := metaSem.Acquire(ctx, 1); err != nil {
@@ -139,22 +156,23 @@ func (n *newestBuildSelector) getImagesByTags(
 		go func(tag string) {
 			defer wg.Done()
 			defer metaSem.Release(1)
-			image, err := n.repoClient.getImageByTag(ctx, tag, n.platform)
+			image, err := n.getImageByTag(ctx, tag)
 			if err != nil {
-				// Report the error right away or not at all. errCh is a buffered
-				// channel with room for one error, so if we can't send the error
-				// right away, we know that another goroutine has already sent one.
-				select {
-				case errCh <- err:
-					cancel() // Stop all other goroutines
-				default:
-				}
+				reportErr(err)
 				return
 			}
 			if image == nil {
 				// This shouldn't happen
 				return
 			}
+			matches, err := n.matchesExpression(*image)
+			if err != nil {
+				reportErr(fmt.Errorf("error filtering image with tag %q: %w", tag, err))
+				return
+			}
+			if !matches {
+				return
+			}
 			// imageCh is buffered and sized appropriately, so this will never block.
 			imageCh <- *image
 		}(tag)
@@ -182,10 +200,18 @@ func (n *newestBuildSelector) getImagesByTags(
 }
 
 // sort sorts the provided images in place, in chronologically descending order,
-// breaking ties lexically by tag.
+// breaking ties lexically by tag. Images without a known creation time (which
+// is possible for arbitrary OCI artifacts) are treated as the oldest.
 func (n *newestBuildSelector) sort(images []image) {
 	slices.SortFunc(images, func(lhs, rhs image) int {
-		if comp := rhs.CreatedAt.Compare(*lhs.CreatedAt); comp != 0 {
+		var lhsCreatedAt, rhsCreatedAt time.Time
+		if lhs.CreatedAt != nil {
+			lhsCreatedAt = *lhs.CreatedAt
+		}
+		if rhs.CreatedAt != nil {
+			rhsCreatedAt = *rhs.CreatedAt
+		}
+		if comp := rhsCreatedAt.Compare(lhsCreatedAt); comp != 0 {
 			return comp
 		}
 		return strings.Compare(rhs.Tag, lhs.Tag)
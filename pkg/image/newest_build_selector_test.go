@@ -60,6 +60,7 @@ func Test_newestBuildSelector_sort(t *testing.T) {
 		{CreatedAt: timePtr(now.Add(2 * time.Hour))},
 		{CreatedAt: timePtr(now.Add(7 * time.Hour))},
 		{CreatedAt: timePtr(now.Add(3 * time.Hour))},
+		{},
 	}
 
 	(&newestBuildSelector{}).sort(images)
@@ -75,6 +76,7 @@ func Test_newestBuildSelector_sort(t *testing.T) {
 			{CreatedAt: timePtr(now.Add(2 * time.Hour))},
 			{CreatedAt: timePtr(now.Add(time.Hour))},
 			{CreatedAt: &now},
+			{},
 		},
 		images,
 	)
@@ -0,0 +1,161 @@
+package image
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/notaryproject/notation-go"
+	notationregistry "github.com/notaryproject/notation-go/registry"
+	"github.com/notaryproject/notation-go/verifier"
+	"github.com/notaryproject/notation-go/verifier/trustpolicy"
+	"github.com/notaryproject/notation-go/verifier/truststore"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// notationTrustPolicyName is the name of the single policy in the trust
+	// policy document built from a subscription's verification settings.
+	notationTrustPolicyName = "kargo"
+	// notationTrustStoreName is the name of the single trust store holding the
+	// certificates from a subscription's verification settings.
+	notationTrustStoreName = "kargo"
+	// notationAnyScope is the registry scope of a trust policy that applies to
+	// all images.
+	notationAnyScope = "*"
+
+	// maxNotationSignatureAttempts is the maximum number of Notation
+	// signatures of an image that will be evaluated.
+	maxNotationSignatureAttempts = 50
+)
+
+// notationVerifier verifies Notation signatures using Notation's own
+// verification logic.
+type notationVerifier struct {
+	verifier notation.Verifier
+}
+
+func newNotationVerifier(cfg kargoapi.NotationVerification) (*notationVerifier, error) {
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(cfg.TrustStore))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing trust store: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-encoded certificates found in trust store")
+	}
+	v, err := verifier.New(
+		&trustpolicy.Document{
+			Version: "1.0",
+			TrustPolicies: []trustpolicy.TrustPolicy{{
+				Name:           notationTrustPolicyName,
+				RegistryScopes: []string{notationAnyScope},
+				SignatureVerification: trustpolicy.SignatureVerification{
+					VerificationLevel: trustpolicy.LevelStrict.Name,
+				},
+				TrustStores: []string{
+					fmt.Sprintf("%s:%s", truststore.TypeCA, notationTrustStoreName),
+				},
+				TrustedIdentities: cfg.TrustedIdentities,
+			}},
+		},
+		notationTrustStore(certs),
+		// Verification plugins are not supported.
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Notation verifier: %w", err)
+	}
+	return &notationVerifier{verifier: v}, nil
+}
+
+// notationTrustStore is an implementation of truststore.X509TrustStore that
+// holds the certificates of a single trust store of type "ca".
+type notationTrustStore []*x509.Certificate
+
+// GetCertificates implements truststore.X509TrustStore.
+func (n notationTrustStore) GetCertificates(
+	_ context.Context,
+	storeType truststore.Type,
+	namedStore string,
+) ([]*x509.Certificate, error) {
+	if storeType != truststore.TypeCA || namedStore != notationTrustStoreName {
+		return nil, fmt.Errorf("trust store %s:%s does not exist", storeType, namedStore)
+	}
+	return n, nil
+}
+
+// verifyNotation verifies that the image referenced by the provided digest has
+// a valid Notation signature. If so, it returns annotations describing the
+// signer.
+func (v *signatureVerifier) verifyNotation(
+	ctx context.Context,
+	digestRef name.Digest,
+) (map[string]string, error) {
+	repo, err := v.repoClient.newORASRepository()
+	if err != nil {
+		return nil, err
+	}
+	countingRepo := &signatureCountingRepository{Repository: notationregistry.NewRepository(repo)}
+	_, outcomes, err := notation.Verify(
+		ctx,
+		v.notation.verifier,
+		countingRepo,
+		notation.VerifyOptions{
+			ArtifactReference:    digestRef.String(),
+			MaxSignatureAttempts: maxNotationSignatureAttempts,
+		},
+	)
+	var verificationErr notation.ErrorVerificationFailed
+	switch {
+	case err == nil:
+	case countingRepo.listed && countingRepo.signatures == 0:
+		return nil, fmt.Errorf("%w: no Notation signatures found", ErrUnverified)
+	case errors.As(err, &verificationErr):
+		return nil, fmt.Errorf(
+			"%w: no valid Notation signature found: %w", ErrUnverified, err,
+		)
+	default:
+		return nil, fmt.Errorf("error verifying Notation signatures: %w", err)
+	}
+	if len(outcomes) == 0 || outcomes[0].EnvelopeContent == nil ||
+		len(outcomes[0].EnvelopeContent.SignerInfo.CertificateChain) == 0 {
+		return nil, errors.New("Notation verification did not identify a signer") // nolint: staticcheck
+	}
+	return map[string]string{
+		kargoapi.AnnotationKeyImageNotationSigner: outcomes[0].EnvelopeContent.
+			SignerInfo.CertificateChain[0].Subject.String(),
+	}, nil
+}
+
+// signatureCountingRepository wraps a notationregistry.Repository to keep track of
+// how many signatures were listed, so that an image having no signatures at
+// all can be distinguished from an error listing them.
+type signatureCountingRepository struct {
+	notationregistry.Repository
+	// listed indicates whether signatures were listed without error.
+	listed     bool
+	signatures int
+}
+
+// ListSignatures implements notationregistry.Repository.
+func (s *signatureCountingRepository) ListSignatures(
+	ctx context.Context,
+	desc ocispec.Descriptor,
+	fn func([]ocispec.Descriptor) error,
+) error {
+	err := s.Repository.ListSignatures(
+		ctx,
+		desc,
+		func(descs []ocispec.Descriptor) error {
+			s.signatures += len(descs)
+			return fn(descs)
+		},
+	)
+	s.listed = err == nil
+	return err
+}
@@ -0,0 +1,191 @@
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/notaryproject/notation-go"
+	notationregistry "github.com/notaryproject/notation-go/registry"
+	notationsigner "github.com/notaryproject/notation-go/signer"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_signatureVerifier_verifyNotation(t *testing.T) {
+	const testTrustedIdentity = "x509.subject: C=US, ST=WA, O=example.com"
+	const testSigner = "CN=signer,O=example.com,ST=WA,C=US"
+
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ca := newTestCA(t, caKey)
+	otherCAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherCA := newTestCA(t, otherCAKey)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newSigningCert := func(
+		pub crypto.PublicKey,
+		subject pkix.Name,
+		ca *x509.Certificate,
+		caKey crypto.Signer,
+	) *x509.Certificate {
+		return newTestCertificate(
+			t,
+			&x509.Certificate{
+				Subject:     subject,
+				KeyUsage:    x509.KeyUsageDigitalSignature,
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+			},
+			ca,
+			pub,
+			caKey,
+		)
+	}
+	trustedSubject := pkix.Name{
+		Country:      []string{"US"},
+		Province:     []string{"WA"},
+		Organization: []string{"example.com"},
+		CommonName:   "signer",
+	}
+	rsaCert := newSigningCert(rsaKey.Public(), trustedSubject, ca, caKey)
+	ecdsaCert := newSigningCert(ecdsaKey.Public(), trustedSubject, ca, caKey)
+	untrustedCert := newSigningCert(
+		rsaKey.Public(),
+		pkix.Name{
+			Country:      []string{"US"},
+			Province:     []string{"WA"},
+			Organization: []string{"example.org"},
+		},
+		ca,
+		caKey,
+	)
+	otherCACert := newSigningCert(rsaKey.Public(), trustedSubject, otherCA, otherCAKey)
+
+	testCases := []struct {
+		name       string
+		identities []string
+		sign       func(*testing.T, name.Digest)
+		assertions func(*testing.T, map[string]string, error)
+	}{
+		{
+			name:       "no signatures",
+			identities: []string{testTrustedIdentity},
+			sign:       func(*testing.T, name.Digest) {},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no Notation signatures found")
+			},
+		},
+		{
+			name:       "signature by untrusted identity",
+			identities: []string{testTrustedIdentity},
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestNotationSignature(t, digestRef, rsaKey, untrustedCert, ca)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no valid Notation signature found")
+			},
+		},
+		{
+			name:       "signature by untrusted certificate authority",
+			identities: []string{notationAnyScope},
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestNotationSignature(t, digestRef, rsaKey, otherCACert, otherCA)
+			},
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorIs(t, err, ErrUnverified)
+				require.ErrorContains(t, err, "no valid Notation signature found")
+			},
+		},
+		{
+			name:       "RSA signature verified",
+			identities: []string{testTrustedIdentity},
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestNotationSignature(t, digestRef, rsaKey, untrustedCert, ca)
+				pushTestNotationSignature(t, digestRef, rsaKey, rsaCert, ca)
+			},
+			assertions: func(t *testing.T, annotations map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{kargoapi.AnnotationKeyImageNotationSigner: testSigner},
+					annotations,
+				)
+			},
+		},
+		{
+			name:       "ECDSA signature verified",
+			identities: []string{notationAnyScope},
+			sign: func(t *testing.T, digestRef name.Digest) {
+				pushTestNotationSignature(t, digestRef, ecdsaKey, ecdsaCert, ca)
+			},
+			assertions: func(t *testing.T, annotations map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]string{kargoapi.AnnotationKeyImageNotationSigner: testSigner},
+					annotations,
+				)
+			},
+		},
+	}
+	srv := newTestRegistryServer(t)
+	for i, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			digestRef := pushTestImage(t, srv, fmt.Sprintf("notation-%d", i))
+			testCase.sign(t, digestRef)
+			notationVerifier, err := newNotationVerifier(kargoapi.NotationVerification{
+				TrustStore:        encodeTestCertificate(ca),
+				TrustedIdentities: testCase.identities,
+			})
+			require.NoError(t, err)
+			v := &signatureVerifier{
+				repoClient: newTestVerifierRepositoryClient(t, digestRef),
+				notation:   notationVerifier,
+			}
+			annotations, err := v.verifyNotation(context.Background(), digestRef)
+			testCase.assertions(t, annotations, err)
+		})
+	}
+}
+
+// pushTestNotationSignature pushes a Notation signature, in a JWS envelope,
+// of the image referenced by the provided digest. It is created using the
+// provided key and signing certificate, issued by the provided CA.
+func pushTestNotationSignature(
+	t *testing.T,
+	digestRef name.Digest,
+	key crypto.PrivateKey,
+	cert *x509.Certificate,
+	ca *x509.Certificate,
+) {
+	signer, err := notationsigner.New(key, []*x509.Certificate{cert, ca})
+	require.NoError(t, err)
+	repo, err := newTestVerifierRepositoryClient(t, digestRef).newORASRepository()
+	require.NoError(t, err)
+	_, err = notation.Sign(
+		context.Background(),
+		signer,
+		notationregistry.NewRepository(repo),
+		notation.SignOptions{
+			SignerSignOptions: notation.SignerSignOptions{
+				SignatureMediaType: "application/jose+json",
+			},
+			ArtifactReference: digestRef.String(),
+		},
+	)
+	require.NoError(t, err)
+}
@@ -3,6 +3,7 @@ package image
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"maps"
@@ -18,6 +19,8 @@ import (
 	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
+	orasremote "oras.land/oras-go/v2/registry/remote"
+	orasauth "oras.land/oras-go/v2/registry/remote/auth"
 
 	"github.com/akuity/kargo/pkg/logging"
 )
@@ -49,8 +52,19 @@ type repositoryClient struct {
 	registry      *registry
 	repoURL       string
 	repoRef       name.Reference
+	creds         Credentials
+	transport     http.RoundTripper
 	remoteOptions []remote.Option
 
+	// artifacts indicates whether the client should treat every manifest or
+	// index it retrieves as an opaque OCI artifact instead of as a container
+	// image.
+	artifacts bool
+	// artifactType, if non-empty, limits the artifacts the client will return
+	// to those of the specified type. This only has any effect when artifacts
+	// is true.
+	artifactType string
+
 	// The following behaviors are overridable for testing purposes:
 
 	getImageByTagFn func(
@@ -87,6 +101,8 @@ type repositoryClient struct {
 	remoteListFn func(name.Repository, ...remote.Option) ([]string, error)
 
 	remoteGetFn func(name.Reference, ...remote.Option) (*remote.Descriptor, error)
+
+	remoteHeadFn func(name.Reference, ...remote.Option) (*v1.Descriptor, error)
 }
 
 // newRepositoryClient parses the provided repository URL to infer registry
@@ -118,15 +134,18 @@ func newRepositoryClient(
 		Password: creds.Password,
 	}
 
+	transport := &rateLimitedRoundTripper{
+		limiter:              reg.rateLimiter,
+		internalRoundTripper: httpTransport,
+	}
 	r := &repositoryClient{
-		registry: reg,
-		repoURL:  repoURL,
-		repoRef:  repoRef,
+		registry:  reg,
+		repoURL:   repoURL,
+		repoRef:   repoRef,
+		creds:     *creds,
+		transport: transport,
 		remoteOptions: []remote.Option{
-			remote.WithTransport(&rateLimitedRoundTripper{
-				limiter:              reg.rateLimiter,
-				internalRoundTripper: httpTransport,
-			}),
+			remote.WithTransport(transport),
 			remote.WithAuth(auth),
 		},
 	}
@@ -138,10 +157,41 @@ func newRepositoryClient(
 	r.getImageFromV1ImageFn = r.getImageFromV1Image
 	r.remoteListFn = remote.List
 	r.remoteGetFn = remote.Get
+	r.remoteHeadFn = remote.Head
 
 	return r, nil
 }
 
+// newORASRepository returns an ORAS client for the repository, sharing the
+// repositoryClient's credentials and rate-limited transport, for use with
+// libraries built on ORAS rather than on go-containerregistry.
+func (r *repositoryClient) newORASRepository() (*orasremote.Repository, error) {
+	repo, err := orasremote.NewRepository(r.repoRef.Context().Name())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing image repo URL %s: %w", r.repoURL, err,
+		)
+	}
+	// go-containerregistry has already decided whether the registry should be
+	// accessed insecurely, e.g. because it is on the loopback interface.
+	repo.PlainHTTP = r.repoRef.Context().Scheme() == "http"
+	client := &orasauth.Client{
+		Client: &http.Client{Transport: r.transport},
+		Cache:  orasauth.NewCache(),
+	}
+	if r.creds.Username != "" || r.creds.Password != "" {
+		client.Credential = orasauth.StaticCredential(
+			repo.Reference.Host(),
+			orasauth.Credential{
+				Username: r.creds.Username,
+				Password: r.creds.Password,
+			},
+		)
+	}
+	repo.Client = client
+	return repo, nil
+}
+
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
 	tags, err := r.remoteListFn(r.repoRef.Context(), opts...)
@@ -180,6 +230,86 @@ func (r *repositoryClient) getImageByTag(
 	return img, nil
 }
 
+// getImageByTagCached retrieves an Image by tag. Unlike getImageByTag, it
+// first resolves the tag to a digest, which is inexpensive, and then uses a
+// cache keyed by that digest, since the image it identifies will never change.
+// This makes it suitable for use when metadata must be retrieved for many
+// tags, repeatedly.
+func (r *repositoryClient) getImageByTagCached(
+	ctx context.Context,
+	tag string,
+	platform *platformConstraint,
+) (*image, error) {
+	logger := logging.LoggerFromContext(ctx)
+	repoRef := r.repoRef.Context().Tag(tag)
+	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	desc, err := r.remoteHeadFn(repoRef, opts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error resolving digest for tag %q from repo URL %s: %w",
+			tag, r.repoURL, err,
+		)
+	}
+
+	// What is retrieved for a digest depends on the platform constraint and
+	// on whether the client treats everything as an OCI artifact, so both are
+	// part of the key. A nil image (i.e. one that did not match the platform
+	// or artifact type constraint) is cached as well.
+	cacheKey := r.tagCacheKey(desc.Digest.String(), platform)
+	if entry, exists := r.registry.imageCache.Get(cacheKey); exists {
+		cached := entry.(*image) // nolint: forcetypeassert
+		if cached == nil {
+			return nil, nil
+		}
+		img := *cached
+		img.Tag = tag
+		return &img, nil
+	}
+
+	logger.Trace(
+		"image NOT found in cache",
+		"tag", tag,
+		"digest", desc.Digest.String(),
+	)
+
+	img, err := r.getImageByTagFn(ctx, tag, platform)
+	if err != nil {
+		return nil, err
+	}
+	var toCache *image
+	if img != nil {
+		if img.Digest != desc.Digest.String() {
+			// The tag was moved after we resolved it. Don't cache anything.
+			return img, nil
+		}
+		imgCopy := *img
+		toCache = &imgCopy
+	}
+	r.registry.imageCache.Set(cacheKey, toCache, cache.DefaultExpiration)
+	logger.Trace(
+		"cached image",
+		"tag", tag,
+		"digest", desc.Digest.String(),
+	)
+	return img, nil
+}
+
+// tagCacheKey returns the key under which getImageByTagCached caches the image
+// with the provided digest.
+func (r *repositoryClient) tagCacheKey(
+	digest string,
+	platform *platformConstraint,
+) string {
+	var platformStr string
+	if platform != nil {
+		platformStr = platform.String()
+	}
+	return fmt.Sprintf(
+		"tag:%s:%s:%t:%s",
+		digest, platformStr, r.artifacts, r.artifactType,
+	)
+}
+
 // getImageByDigest retrieves an Image for a given digest. This function uses a
 // cache since information retrieved by digest will never change.
 func (r *repositoryClient) getImageByDigest(
@@ -239,6 +369,9 @@ func (r *repositoryClient) getImageFromRemoteDesc(
 	desc *remote.Descriptor,
 	platform *platformConstraint,
 ) (*image, error) {
+	if r.artifacts {
+		return r.getArtifactFromRemoteDesc(desc, platform)
+	}
 	switch desc.MediaType {
 	case types.OCIImageIndex, types.DockerManifestList:
 		idx, err := desc.ImageIndex()
@@ -298,6 +431,64 @@ func (r *repositoryClient) getImageFromRemoteDesc(
 	}
 }
 
+// getArtifactFromRemoteDesc gets an image representing an arbitrary OCI
+// artifact from a given remote.Descriptor. Unlike container images, artifacts
+// are treated opaquely: neither their config nor, in the case of an index,
+// their references are followed. It is valid for this function to return nil
+// if the artifact is not of the type the client is constrained to, or if a
+// platform constraint was specified, since artifacts have no platform.
+func (r *repositoryClient) getArtifactFromRemoteDesc(
+	desc *remote.Descriptor,
+	platform *platformConstraint,
+) (*image, error) {
+	if platform != nil {
+		return nil, nil
+	}
+
+	// Both manifests and indices may specify an artifactType and annotations,
+	// so we unmarshal only those fields we're interested in.
+	manifest := struct {
+		ArtifactType string `json:"artifactType,omitempty"`
+		Config       struct {
+			MediaType types.MediaType `json:"mediaType,omitempty"`
+		} `json:"config"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}{}
+	if err := json.Unmarshal(desc.Manifest, &manifest); err != nil {
+		return nil, fmt.Errorf(
+			"error unmarshaling manifest for artifact with digest %s: %w",
+			desc.Digest.String(), err,
+		)
+	}
+
+	artifactType := manifest.ArtifactType
+	if artifactType == "" {
+		artifactType = string(manifest.Config.MediaType)
+	}
+	if artifactType == "" {
+		artifactType = string(desc.MediaType)
+	}
+	if r.artifactType != "" && artifactType != r.artifactType {
+		// This artifact doesn't match the artifact type constraint.
+		return nil, nil
+	}
+
+	// The descriptor's annotations have a lower precedence than any
+	// annotations found in the manifest itself.
+	annotations := manifest.Annotations
+	if desc.Annotations != nil {
+		annotations = maps.Clone(desc.Annotations)
+		maps.Copy(annotations, manifest.Annotations)
+	}
+
+	return &image{
+		Digest:       desc.Digest.String(),
+		ArtifactType: artifactType,
+		Annotations:  annotations,
+		CreatedAt:    getCreationTime([]map[string]string{annotations}, nil),
+	}, nil
+}
+
 // getImageFromV1ImageIndex gets an Image from a given v1.ImageIndex. It is
 // valid for this function to return nil if no image in the index matches the
 // specified platform, if any.
@@ -453,6 +644,7 @@ func (r *repositoryClient) getImageFromV1Image(
 			&cfg.Created.Time,
 		),
 		Annotations: manifest.Annotations,
+		Labels:      cfg.Config.Labels,
 	}, nil
 }
 
@@ -30,6 +30,7 @@ func TestNewRepositoryClient(t *testing.T) {
 	require.NotNil(t, client.getImageFromV1ImageFn)
 	require.NotNil(t, client.remoteListFn)
 	require.NotNil(t, client.remoteGetFn)
+	require.NotNil(t, client.remoteHeadFn)
 }
 
 func Test_repositoryClient_getImageByTag(t *testing.T) {
@@ -124,6 +125,136 @@ func Test_repositoryClient_getImageByTag(t *testing.T) {
 	}
 }
 
+func Test_repositoryClient_getImageByTagCached(t *testing.T) {
+	const testRepoURL = "fake-url"
+	const testTag = "fake-tag"
+
+	testRepoRef, err := name.ParseReference(testRepoURL)
+	require.NoError(t, err)
+
+	testDigest := v1.Hash{Algorithm: "sha256", Hex: "abc"}
+
+	headFn := func(name.Reference, ...remote.Option) (*v1.Descriptor, error) {
+		return &v1.Descriptor{Digest: testDigest}, nil
+	}
+
+	t.Run("error resolving digest", func(t *testing.T) {
+		client := &repositoryClient{
+			repoRef: testRepoRef,
+			remoteHeadFn: func(name.Reference, ...remote.Option) (*v1.Descriptor, error) {
+				return nil, errors.New("something went wrong")
+			},
+		}
+		_, err := client.getImageByTagCached(context.Background(), testTag, nil)
+		require.ErrorContains(t, err, "error resolving digest for tag")
+		require.ErrorContains(t, err, "something went wrong")
+	})
+
+	t.Run("error getting image by tag", func(t *testing.T) {
+		client := &repositoryClient{
+			repoRef:      testRepoRef,
+			registry:     &registry{imageCache: cache.New(0, 0)},
+			remoteHeadFn: headFn,
+			getImageByTagFn: func(
+				context.Context,
+				string,
+				*platformConstraint,
+			) (*image, error) {
+				return nil, errors.New("something went wrong")
+			},
+		}
+		_, err := client.getImageByTagCached(context.Background(), testTag, nil)
+		require.ErrorContains(t, err, "something went wrong")
+	})
+
+	t.Run("cache miss followed by cache hit", func(t *testing.T) {
+		var calls int
+		client := &repositoryClient{
+			repoRef:      testRepoRef,
+			registry:     &registry{imageCache: cache.New(0, 0)},
+			remoteHeadFn: headFn,
+			getImageByTagFn: func(
+				_ context.Context,
+				tag string,
+				_ *platformConstraint,
+			) (*image, error) {
+				calls++
+				return &image{
+					Tag:    tag,
+					Digest: testDigest.String(),
+					Labels: map[string]string{"foo": "bar"},
+				}, nil
+			},
+		}
+		img, err := client.getImageByTagCached(context.Background(), testTag, nil)
+		require.NoError(t, err)
+		require.Equal(t, testTag, img.Tag)
+
+		// The same digest under a different tag should be served from the cache.
+		img, err = client.getImageByTagCached(context.Background(), "other-tag", nil)
+		require.NoError(t, err)
+		require.Equal(t, "other-tag", img.Tag)
+		require.Equal(t, testDigest.String(), img.Digest)
+		require.Equal(t, map[string]string{"foo": "bar"}, img.Labels)
+		require.Equal(t, 1, calls)
+
+		// A different platform constraint should not be served from the cache.
+		_, err = client.getImageByTagCached(
+			context.Background(),
+			testTag,
+			&platformConstraint{os: "linux", arch: "arm64"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("image not matching constraints is cached", func(t *testing.T) {
+		var calls int
+		client := &repositoryClient{
+			repoRef:      testRepoRef,
+			registry:     &registry{imageCache: cache.New(0, 0)},
+			remoteHeadFn: headFn,
+			getImageByTagFn: func(
+				context.Context,
+				string,
+				*platformConstraint,
+			) (*image, error) {
+				calls++
+				return nil, nil
+			},
+		}
+		for range 2 {
+			img, err := client.getImageByTagCached(context.Background(), testTag, nil)
+			require.NoError(t, err)
+			require.Nil(t, img)
+		}
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("tag moved after digest was resolved", func(t *testing.T) {
+		var calls int
+		client := &repositoryClient{
+			repoRef:      testRepoRef,
+			registry:     &registry{imageCache: cache.New(0, 0)},
+			remoteHeadFn: headFn,
+			getImageByTagFn: func(
+				_ context.Context,
+				tag string,
+				_ *platformConstraint,
+			) (*image, error) {
+				calls++
+				return &image{Tag: tag, Digest: "sha256:def"}, nil
+			},
+		}
+		for range 2 {
+			img, err := client.getImageByTagCached(context.Background(), testTag, nil)
+			require.NoError(t, err)
+			require.Equal(t, "sha256:def", img.Digest)
+		}
+		require.Equal(t, 2, calls)
+	})
+}
+
 func Test_repositoryClient_getImageByDigest(t *testing.T) {
 	const testRepoURL = "fake-url"
 	const testDigest = "fake-digest"
@@ -327,6 +458,110 @@ func Test_repositoryClient_getImageFromRemoteDesc(t *testing.T) {
 	})
 }
 
+func Test_repositoryClient_getArtifactFromRemoteDesc(t *testing.T) {
+	const testDigest = "sha256:8d2d6e5a2b5b8a0c8b1f1e8c7e0b7b0f7f3a1c0d8e7b5b9a1c2d3e4f5a6b7c8d"
+	testCases := []struct {
+		name         string
+		artifactType string
+		desc         *remote.Descriptor
+		platform     *platformConstraint
+		assertions   func(*testing.T, *image, error)
+	}{
+		{
+			name: "platform constraint specified",
+			desc: &remote.Descriptor{
+				Manifest: []byte(`{}`),
+			},
+			platform: &platformConstraint{os: "linux", arch: "amd64"},
+			assertions: func(t *testing.T, img *image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, img)
+			},
+		},
+		{
+			name: "invalid manifest",
+			desc: &remote.Descriptor{
+				Manifest: []byte(`{`),
+			},
+			assertions: func(t *testing.T, _ *image, err error) {
+				require.ErrorContains(t, err, "error unmarshaling manifest for artifact")
+			},
+		},
+		{
+			name:         "artifact type does not match",
+			artifactType: "application/vnd.example.module.v1",
+			desc: &remote.Descriptor{
+				Manifest: []byte(`{"artifactType":"application/vnd.example.other.v1"}`),
+			},
+			assertions: func(t *testing.T, img *image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, img)
+			},
+		},
+		{
+			name:         "artifact type from config media type",
+			artifactType: "application/vnd.cncf.helm.config.v1+json",
+			desc: &remote.Descriptor{
+				Descriptor: v1.Descriptor{
+					Digest: v1.Hash{
+						Algorithm: "sha256",
+						Hex:       testDigest[len("sha256:"):],
+					},
+				},
+				Manifest: []byte(`{"config":{"mediaType":"application/vnd.cncf.helm.config.v1+json"}}`),
+			},
+			assertions: func(t *testing.T, img *image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, testDigest, img.Digest)
+				require.Equal(t, "application/vnd.cncf.helm.config.v1+json", img.ArtifactType)
+				require.Nil(t, img.CreatedAt)
+			},
+		},
+		{
+			name: "success with annotations",
+			desc: &remote.Descriptor{
+				Descriptor: v1.Descriptor{
+					MediaType: types.OCIManifestSchema1,
+					Annotations: map[string]string{
+						"key.one": "descriptor-value", // Should be overridden by manifest
+						"key.two": "descriptor-value", // Unique to descriptor
+					},
+				},
+				Manifest: []byte(`{
+					"artifactType": "application/vnd.example.module.v1",
+					"annotations": {
+						"key.one": "manifest-value",
+						"org.opencontainers.image.created": "2024-01-01T00:00:00Z"
+					}
+				}`),
+			},
+			assertions: func(t *testing.T, img *image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(t, "application/vnd.example.module.v1", img.ArtifactType)
+				require.Equal(t, map[string]string{
+					"key.one":                          "manifest-value",
+					"key.two":                          "descriptor-value",
+					"org.opencontainers.image.created": "2024-01-01T00:00:00Z",
+				}, img.Annotations)
+				require.NotNil(t, img.CreatedAt)
+				require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *img.CreatedAt)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := &repositoryClient{
+				artifacts:    true,
+				artifactType: testCase.artifactType,
+			}
+			img, err := client.getArtifactFromRemoteDesc(testCase.desc, testCase.platform)
+			testCase.assertions(t, img, err)
+		})
+	}
+}
+
 func Test_repositoryClient_getImageFromV1ImageIndex(t *testing.T) {
 	const testDigest = "fake-digest"
 
@@ -772,6 +1007,11 @@ func Test_repositoryClient_getImageFromV1Image(t *testing.T) {
 				expectedTime, err := time.Parse(time.RFC3339, "2023-02-01T00:00:00Z")
 				require.NoError(t, err)
 				require.Equal(t, expectedTime, *img.CreatedAt)
+				require.Equal(
+					t,
+					map[string]string{ociCreatedAnnotation: "2023-02-01T00:00:00Z"},
+					img.Labels,
+				)
 			},
 		},
 		{
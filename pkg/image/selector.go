@@ -34,3 +34,45 @@ func NewSelector(
 	factory := reg.Value
 	return factory(sub, creds)
 }
+
+// artifactModeEnabler is implemented by all Selector implementations that
+// embed a baseSelector.
+type artifactModeEnabler interface {
+	enableArtifactMode(artifactType string)
+}
+
+// NewArtifactSelector returns some implementation of the Selector interface
+// that selects arbitrary OCI artifacts from a repository based on the provided
+// subscription. Artifacts are selected using the same strategies as container
+// images, but are treated opaquely, so they are never subject to a platform
+// constraint.
+func NewArtifactSelector(
+	ctx context.Context,
+	sub kargoapi.OCISubscription,
+	creds *Credentials,
+) (Selector, error) {
+	imgSub := kargoapi.ImageSubscription{
+		RepoURL:                sub.RepoURL,
+		ImageSelectionStrategy: sub.ArtifactSelectionStrategy,
+		StrictSemvers:          sub.StrictSemvers,
+		Constraint:             sub.Constraint,
+		AllowTagsRegexes:       sub.AllowTagsRegexes,
+		IgnoreTagsRegexes:      sub.IgnoreTagsRegexes,
+		InsecureSkipTLSVerify:  sub.InsecureSkipTLSVerify,
+		DiscoveryLimit:         sub.DiscoveryLimit,
+	}
+	s, err := NewSelector(ctx, imgSub, creds)
+	if err != nil {
+		return nil, err
+	}
+	enabler, ok := s.(artifactModeEnabler)
+	if !ok {
+		// This really shouldn't happen.
+		return nil, fmt.Errorf(
+			"selector for strategy %q does not support OCI artifacts",
+			sub.ArtifactSelectionStrategy,
+		)
+	}
+	enabler.enableArtifactMode(sub.ArtifactType)
+	return s, nil
+}
@@ -0,0 +1,54 @@
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewArtifactSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCISubscription
+		assertions func(*testing.T, Selector, error)
+	}{
+		{
+			name: "unknown selection strategy",
+			sub: kargoapi.OCISubscription{
+				RepoURL:                   "example.com/modules/vpc",
+				ArtifactSelectionStrategy: "Unknown",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.OCISubscription{
+				RepoURL:                   "example.com/modules/vpc",
+				ArtifactSelectionStrategy: kargoapi.ImageSelectionStrategySemVer,
+				ArtifactType:              "application/vnd.example.module.v1",
+			},
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				selector, ok := s.(*semverSelector)
+				require.True(t, ok)
+				require.True(t, selector.repoClient.artifacts)
+				require.Equal(
+					t,
+					"application/vnd.example.module.v1",
+					selector.repoClient.artifactType,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewArtifactSelector(context.Background(), testCase.sub, nil)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
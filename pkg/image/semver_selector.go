@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"strings"
 
 	"github.com/Masterminds/semver/v3"
 
@@ -67,14 +66,8 @@ func (s *semverSelector) MatchesTag(tag string) bool {
 	if !s.tagBasedSelector.MatchesTag(tag) {
 		return false
 	}
-	sv := libSemver.Parse(tag, s.strictSemvers)
-	if sv == nil {
-		// The tag wasn't parseable as a semantic version.
-		return false
-	}
-	// Now it all comes down to whether semantic version constraints were
-	// specified and if so, whether the tag satisfies them.
-	return s.constraint == nil || s.constraint.Check(sv)
+	_, ok := MatchesSemver(tag, s.strictSemvers, s.constraint)
+	return ok
 }
 
 // Select implements the Selector interface.
@@ -153,22 +146,13 @@ func (s *semverSelector) filterTags(tags []string) []string {
 // all are parseable as semantic versions. If any tags are not parseable as
 // semantic versions, they will be omitted entirely from the results.
 func (s *semverSelector) sort(tags []string) []string {
-	semvers := make([]semver.Version, 0, len(tags))
+	semvers := make([]*semver.Version, 0, len(tags))
 	for _, tag := range tags {
 		if sv := libSemver.Parse(tag, s.strictSemvers); sv != nil {
-			semvers = append(semvers, *sv)
+			semvers = append(semvers, sv)
 		}
 	}
-	slices.SortFunc(semvers, func(lhs, rhs semver.Version) int {
-		if comp := rhs.Compare(&lhs); comp != 0 {
-			return comp
-		}
-		// If the semvers tie, break the tie lexically using the original strings
-		// used to construct the semvers. This ensures a deterministic comparison of
-		// equivalent semvers, e.g., "1.0.0" > "1.0". The semver package's built-in
-		// sort does not do this!
-		return strings.Compare(rhs.Original(), lhs.Original())
-	})
+	slices.SortFunc(semvers, CompareSemvers)
 	tags = make([]string, len(semvers))
 	for i, sv := range semvers {
 		tags[i] = sv.Original()
@@ -0,0 +1,113 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/patrickmn/go-cache"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ErrUnverified is wrapped by errors returned from SignatureVerifier.Verify
+// when an image could not be verified, as opposed to when verification could
+// not be attempted at all.
+var ErrUnverified = errors.New("image could not be verified")
+
+// SignatureVerifier is an interface for verifying the signatures and
+// attestations of images in a container image repository.
+type SignatureVerifier interface {
+	// Verify verifies the image with the specified digest. If verification
+	// succeeds, it returns annotations describing how the image was verified.
+	// If the image could not be verified, the error returned wraps
+	// ErrUnverified.
+	Verify(ctx context.Context, digest string) (map[string]string, error)
+}
+
+// signatureVerifier is an implementation of SignatureVerifier that verifies
+// cosign signatures and attestations and Notation signatures.
+type signatureVerifier struct {
+	repoClient *repositoryClient
+	cosign     *cosignVerifier
+	notation   *notationVerifier
+	// cacheKeyPrefix is a prefix unique to the repository and verification
+	// settings, under which the results of successful verifications are
+	// cached.
+	cacheKeyPrefix string
+}
+
+// newSignatureVerifier returns a signatureVerifier that verifies images as
+// specified by the provided subscription using the provided repository
+// client.
+func newSignatureVerifier(
+	sub kargoapi.ImageSubscription,
+	repoClient *repositoryClient,
+) (*signatureVerifier, error) {
+	if sub.Verification == nil {
+		return nil, errors.New("subscription does not specify verification")
+	}
+	cfg, err := json.Marshal(sub.Verification)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling verification settings: %w", err)
+	}
+	v := &signatureVerifier{
+		repoClient: repoClient,
+		cacheKeyPrefix: fmt.Sprintf(
+			"verification:%s:%x:", repoClient.repoURL, sha256.Sum256(cfg),
+		),
+	}
+	if sub.Verification.Cosign != nil {
+		if v.cosign, err = newCosignVerifier(*sub.Verification.Cosign); err != nil {
+			return nil, fmt.Errorf("error parsing cosign verification settings: %w", err)
+		}
+	}
+	if sub.Verification.Notation != nil {
+		if v.notation, err = newNotationVerifier(*sub.Verification.Notation); err != nil {
+			return nil, fmt.Errorf("error parsing Notation verification settings: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// Verify implements SignatureVerifier. The results of successful
+// verifications are cached, since an image that has been verified once is
+// unlikely to stop being verifiable. The results of unsuccessful
+// verifications are not, since signatures and attestations are commonly
+// added to images after they have been pushed.
+func (v *signatureVerifier) Verify(
+	ctx context.Context,
+	digest string,
+) (map[string]string, error) {
+	cacheKey := v.cacheKeyPrefix + digest
+	if entry, exists := v.repoClient.registry.imageCache.Get(cacheKey); exists {
+		return maps.Clone(entry.(map[string]string)), nil // nolint: forcetypeassert
+	}
+
+	digestRef := v.repoClient.repoRef.Context().Digest(digest)
+	annotations := map[string]string{}
+	if v.cosign != nil {
+		cosignAnnotations, err := v.verifyCosign(ctx, digestRef)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(annotations, cosignAnnotations)
+	}
+	if v.notation != nil {
+		notationAnnotations, err := v.verifyNotation(ctx, digestRef)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(annotations, notationAnnotations)
+	}
+
+	v.repoClient.registry.imageCache.Set(
+		cacheKey,
+		maps.Clone(annotations),
+		cache.DefaultExpiration,
+	)
+	return annotations, nil
+}
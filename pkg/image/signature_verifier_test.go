@@ -0,0 +1,385 @@
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_newSignatureVerifier(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ImageSubscription
+		assertions func(*testing.T, *signatureVerifier, error)
+	}{
+		{
+			name: "verification not specified",
+			sub:  kargoapi.ImageSubscription{RepoURL: "example.com/repo"},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "subscription does not specify verification")
+			},
+		},
+		{
+			name: "invalid public key",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Cosign: &kargoapi.CosignVerification{PublicKey: "not a key"},
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing cosign verification settings")
+				require.ErrorContains(t, err, "error parsing public key")
+			},
+		},
+		{
+			name: "invalid Fulcio roots",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Cosign: &kargoapi.CosignVerification{
+						Keyless: &kargoapi.CosignKeylessVerification{
+							FulcioRoots: "not a certificate",
+							Identity:    "someone@example.com",
+							Issuer:      "https://issuer.example.com",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing cosign verification settings")
+				require.ErrorContains(t, err, "error parsing Fulcio roots")
+			},
+		},
+		{
+			name: "invalid identity regex",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Cosign: &kargoapi.CosignVerification{
+						Keyless: &kargoapi.CosignKeylessVerification{
+							FulcioRoots:   encodeTestCertificate(newTestCA(t, key)),
+							IdentityRegex: "(",
+							Issuer:        "https://issuer.example.com",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error compiling identity regex")
+			},
+		},
+		{
+			name: "invalid Rekor public key",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Cosign: &kargoapi.CosignVerification{
+						Keyless: &kargoapi.CosignKeylessVerification{
+							FulcioRoots:    encodeTestCertificate(newTestCA(t, key)),
+							Identity:       "someone@example.com",
+							Issuer:         "https://issuer.example.com",
+							RekorPublicKey: "not a key",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing Rekor public key")
+			},
+		},
+		{
+			name: "public-good Rekor instance used by default",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Cosign: &kargoapi.CosignVerification{
+						Keyless: &kargoapi.CosignKeylessVerification{
+							FulcioRoots: encodeTestCertificate(newTestCA(t, key)),
+							Identity:    "someone@example.com",
+							Issuer:      "https://issuer.example.com",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, v *signatureVerifier, err error) {
+				require.NoError(t, err)
+				require.False(t, v.cosign.checkOpts.IgnoreTlog)
+				require.NotNil(t, v.cosign.checkOpts.RekorPubKeys)
+				require.Contains(
+					t,
+					v.cosign.checkOpts.RekorPubKeys.Keys,
+					// The log ID of rekor.sigstore.dev
+					"c0d23d6ad406973f9559f3ba2d1ca01f84147d8ffc5b8445c224f98b9591801d",
+				)
+			},
+		},
+		{
+			name: "invalid Notation trust store",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Notation: &kargoapi.NotationVerification{
+						TrustStore:        "not a certificate",
+						TrustedIdentities: []string{"*"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing Notation verification settings")
+				require.ErrorContains(t, err, "error parsing trust store")
+			},
+		},
+		{
+			name: "invalid Notation trusted identity",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Notation: &kargoapi.NotationVerification{
+						TrustStore:        encodeTestCertificate(newTestCA(t, key)),
+						TrustedIdentities: []string{"O=example.com"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *signatureVerifier, err error) {
+				require.ErrorContains(t, err, "error parsing Notation verification settings")
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example.com/repo",
+				Verification: &kargoapi.ImageVerification{
+					Cosign: &kargoapi.CosignVerification{
+						PublicKey: encodeTestPublicKey(t, key.Public()),
+					},
+					Notation: &kargoapi.NotationVerification{
+						TrustStore:        encodeTestCertificate(newTestCA(t, key)),
+						TrustedIdentities: []string{"*"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, v *signatureVerifier, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, v.repoClient)
+				require.NotNil(t, v.cosign)
+				require.NotNil(t, v.notation)
+				require.NotEmpty(t, v.cacheKeyPrefix)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repoClient, err := newRepositoryClient(testCase.sub.RepoURL, false, nil)
+			require.NoError(t, err)
+			verifier, err := newSignatureVerifier(testCase.sub, repoClient)
+			testCase.assertions(t, verifier, err)
+		})
+	}
+}
+
+func Test_signatureVerifier_Verify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		signed     bool
+		assertions func(*testing.T, *httptest.Server, SignatureVerifier, string, map[string]string, error)
+	}{
+		{
+			name: "not verified",
+			assertions: func(
+				t *testing.T,
+				_ *httptest.Server,
+				_ SignatureVerifier,
+				_ string,
+				_ map[string]string,
+				err error,
+			) {
+				require.ErrorIs(t, err, ErrUnverified)
+			},
+		},
+		{
+			name:   "verified",
+			signed: true,
+			assertions: func(
+				t *testing.T,
+				srv *httptest.Server,
+				verifier SignatureVerifier,
+				digest string,
+				annotations map[string]string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Contains(t, annotations, kargoapi.AnnotationKeyImageCosignSigner)
+
+				// The result should have been cached
+				srv.Close()
+				cached, err := verifier.Verify(context.Background(), digest)
+				require.NoError(t, err)
+				require.Equal(t, annotations, cached)
+			},
+		},
+		{
+			name: "error getting signatures",
+			assertions: func(
+				t *testing.T,
+				srv *httptest.Server,
+				verifier SignatureVerifier,
+				digest string,
+				_ map[string]string,
+				_ error,
+			) {
+				srv.Close()
+				_, err := verifier.Verify(context.Background(), digest)
+				require.ErrorContains(t, err, "error verifying cosign signatures")
+				require.False(t, errors.Is(err, ErrUnverified))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := newTestRegistryServer(t)
+			digestRef := pushTestImage(t, srv, "repo")
+			if testCase.signed {
+				pushTestCosignSignature(t, digestRef, key, nil)
+			}
+			verifier, err := newSignatureVerifier(
+				kargoapi.ImageSubscription{
+					RepoURL: digestRef.Context().Name(),
+					Verification: &kargoapi.ImageVerification{
+						Cosign: &kargoapi.CosignVerification{
+							PublicKey: encodeTestPublicKey(t, key.Public()),
+						},
+					},
+				},
+				newTestVerifierRepositoryClient(t, digestRef),
+			)
+			require.NoError(t, err)
+			annotations, err := verifier.Verify(context.Background(), digestRef.DigestStr())
+			testCase.assertions(t, srv, verifier, digestRef.DigestStr(), annotations, err)
+		})
+	}
+}
+
+// newTestRegistryServer starts an in-memory registry that is shut down when
+// the test completes.
+func newTestRegistryServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// pushTestImage pushes a random image to the specified repository of the
+// provided registry and returns a reference to it by digest.
+func pushTestImage(t *testing.T, srv *httptest.Server, repo string) name.Digest {
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	digestRef, err := name.NewDigest(
+		fmt.Sprintf("%s/%s@%s", strings.TrimPrefix(srv.URL, "http://"), repo, digest),
+	)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(digestRef, img))
+	return digestRef
+}
+
+// newTestVerifierRepositoryClient returns a repositoryClient for the
+// repository of the provided reference.
+func newTestVerifierRepositoryClient(t *testing.T, ref name.Reference) *repositoryClient {
+	repoClient, err := newRepositoryClient(ref.Context().Name(), false, nil)
+	require.NoError(t, err)
+	return repoClient
+}
+
+// newTestCA returns a self-signed CA certificate for the provided key.
+func newTestCA(t *testing.T, key crypto.Signer) *x509.Certificate {
+	return newTestCertificate(
+		t,
+		&x509.Certificate{
+			Subject: pkix.Name{
+				Country:      []string{"US"},
+				Province:     []string{"WA"},
+				Organization: []string{"example.com"},
+				CommonName:   "test-ca",
+			},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		},
+		nil,
+		key.Public(),
+		key,
+	)
+}
+
+// newTestCertificate creates a certificate from the provided template, signed
+// by the provided parent. If parent is nil, the certificate is self-signed.
+// Unless set by the template, the certificate is valid from a week ago until a
+// week from now.
+func newTestCertificate(
+	t *testing.T,
+	template *x509.Certificate,
+	parent *x509.Certificate,
+	pub crypto.PublicKey,
+	parentKey crypto.Signer,
+) *x509.Certificate {
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template.SerialNumber = serial
+	if template.NotBefore.IsZero() {
+		template.NotBefore = time.Now().Add(-7 * 24 * time.Hour)
+	}
+	if template.NotAfter.IsZero() {
+		template.NotAfter = time.Now().Add(7 * 24 * time.Hour)
+	}
+	if parent == nil {
+		parent = template
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func encodeTestCertificate(certs ...*x509.Certificate) string {
+	var sb strings.Builder
+	for _, cert := range certs {
+		sb.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	}
+	return sb.String()
+}
+
+func encodeTestPublicKey(t *testing.T, pub crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -21,21 +21,6 @@ type tagBasedSelector struct {
 	discoveryLimit    int
 }
 
-// compileRegexes returns a slice of compiled regular expressions.
-func compileRegexes(regexStrs []string) ([]*regexp.Regexp, error) {
-	regexes := make([]*regexp.Regexp, len(regexStrs))
-	var err error
-	for i, regexStr := range regexStrs {
-		if regexes[i], err = regexp.Compile(regexStr); err != nil {
-			return nil, fmt.Errorf(
-				"error compiling regular expression %q: %w",
-				regexStr, err,
-			)
-		}
-	}
-	return regexes, nil
-}
-
 func newTagBasedSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
@@ -49,7 +34,7 @@ func newTagBasedSelector(
 		discoveryLimit: int(sub.DiscoveryLimit),
 	}
 
-	if s.allowTagsRegexes, err = compileRegexes(sub.AllowTagsRegexes); err != nil {
+	if s.allowTagsRegexes, err = CompileRegexes(sub.AllowTagsRegexes); err != nil {
 		return nil, fmt.Errorf("error compiling allow tags regex: %w", err)
 	}
 
@@ -66,7 +51,7 @@ func newTagBasedSelector(
 		s.allowTagsRegexes = append(s.allowTagsRegexes, allowTagsRegex)
 	}
 
-	if s.ignoreTagsRegexes, err = compileRegexes(sub.IgnoreTagsRegexes); err != nil {
+	if s.ignoreTagsRegexes, err = CompileRegexes(sub.IgnoreTagsRegexes); err != nil {
 		return nil, fmt.Errorf("error compiling ignore tags regex: %w", err)
 	}
 
@@ -77,7 +62,7 @@ func newTagBasedSelector(
 		for i, ignoreTag := range sub.IgnoreTags { // nolint: staticcheck
 			ignoreTagsRegexStrs[i] = fmt.Sprintf("^%s$", regexp.QuoteMeta(ignoreTag))
 		}
-		ignoreTagsRegexes, err := compileRegexes(ignoreTagsRegexStrs)
+		ignoreTagsRegexes, err := CompileRegexes(ignoreTagsRegexStrs)
 		if err != nil {
 			return nil, err
 		}
@@ -89,26 +74,7 @@ func newTagBasedSelector(
 
 // MatchesTag implements Selector.
 func (t *tagBasedSelector) MatchesTag(tag string) bool {
-	// handle ignoreTagsRegexes
-	for _, regex := range t.ignoreTagsRegexes {
-		if regex.MatchString(tag) {
-			return false
-		}
-	}
-
-	// if empty allowTagsRegexes, we match all tags
-	if len(t.allowTagsRegexes) == 0 {
-		return true
-	}
-
-	// check if tag matches any allowTagsRegexes
-	for _, regex := range t.allowTagsRegexes {
-		if regex.MatchString(tag) {
-			return true
-		}
-	}
-
-	return false
+	return MatchesRegexes(tag, t.allowTagsRegexes, t.ignoreTagsRegexes)
 }
 
 // getLoggerContext returns key/value pairs that can be used by any selector
@@ -135,9 +101,10 @@ func (t *tagBasedSelector) filterTags(tags []string) []string {
 }
 
 // getImagesByTags retrieves image metadata for the provided tags SEQUENTIALLY.
-// It discards any that does not match the selector's criteria. This repeats
-// until the list of provided tags has been exhausted or it has found an amount
-// of image metadata equal to the selector's discovery limit.
+// It discards any that does not match the selector's criteria or that cannot
+// be verified. This repeats until the list of provided tags has been exhausted
+// or it has found an amount of image metadata equal to the selector's
+// discovery limit.
 func (t *tagBasedSelector) getImagesByTags(
 	ctx context.Context,
 	tags []string,
@@ -154,7 +121,7 @@ func (t *tagBasedSelector) getImagesByTags(
 			break
 		}
 
-		image, err := t.repoClient.getImageByTag(ctx, tag, t.platform)
+		image, err := t.getImageByTag(ctx, tag)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving image with tag %q: %w", tag, err)
 		}
@@ -165,6 +132,23 @@ func (t *tagBasedSelector) getImagesByTags(
 			)
 			continue
 		}
+		matches, err := t.matchesExpression(*image)
+		if err != nil {
+			return nil, fmt.Errorf("error filtering image with tag %q: %w", tag, err)
+		}
+		if !matches {
+			logger.Trace(
+				"image was found, but did not match filter expression",
+				"tag", tag,
+			)
+			continue
+		}
+		if image, err = t.verify(ctx, *image); err != nil {
+			return nil, err
+		}
+		if image == nil {
+			continue
+		}
 
 		logger.Trace(
 			"discovered image",
@@ -0,0 +1,81 @@
+package image
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	libSemver "github.com/akuity/kargo/pkg/controller/semver"
+)
+
+// The functions in this file implement the tag matching and sorting semantics
+// of the image selectors in a manner that is independent of image tags, so
+// that they can also be applied to other versioned artifacts, such as the
+// objects in an S3-compatible bucket.
+
+// CompileRegexes returns a slice of compiled regular expressions.
+func CompileRegexes(regexStrs []string) ([]*regexp.Regexp, error) {
+	regexes := make([]*regexp.Regexp, len(regexStrs))
+	var err error
+	for i, regexStr := range regexStrs {
+		if regexes[i], err = regexp.Compile(regexStr); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				regexStr, err,
+			)
+		}
+	}
+	return regexes, nil
+}
+
+// MatchesRegexes returns a boolean value indicating whether the provided tag
+// is eligible for selection given the provided allow and ignore regular
+// expressions. A tag matching any of the ignore regular expressions is never
+// eligible. Otherwise, a tag is eligible if there are no allow regular
+// expressions or if it matches any of them.
+func MatchesRegexes(tag string, allowRegexes, ignoreRegexes []*regexp.Regexp) bool {
+	for _, regex := range ignoreRegexes {
+		if regex.MatchString(tag) {
+			return false
+		}
+	}
+	if len(allowRegexes) == 0 {
+		return true
+	}
+	for _, regex := range allowRegexes {
+		if regex.MatchString(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesSemver parses the provided tag as a semantic version and returns it,
+// along with a boolean value indicating whether it satisfies the provided
+// constraint, if any. If the tag is not parseable as a semantic version, nil
+// and false are returned.
+func MatchesSemver(
+	tag string,
+	strict bool,
+	constraint *semver.Constraints,
+) (*semver.Version, bool) {
+	sv := libSemver.Parse(tag, strict)
+	if sv == nil {
+		return nil, false
+	}
+	return sv, constraint == nil || constraint.Check(sv)
+}
+
+// CompareSemvers is a comparison function for sorting semantic versions from
+// greatest to least. Equivalent semantic versions are compared lexically by
+// their original string representation, e.g. "1.0.0" > "1.0", to guarantee a
+// deterministic order. The semver package's built-in comparison does not do
+// this!
+func CompareSemvers(lhs, rhs *semver.Version) int {
+	if comp := rhs.Compare(lhs); comp != 0 {
+		return comp
+	}
+	return strings.Compare(rhs.Original(), lhs.Original())
+}
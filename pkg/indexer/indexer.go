@@ -509,6 +509,9 @@ func WarehousesBySubscribedURLs(obj client.Object) []string {
 		if sub.Image != nil && sub.Image.RepoURL != "" {
 			repoURLs = append(repoURLs, urls.NormalizeImage(sub.Image.RepoURL))
 		}
+		if sub.OCI != nil && sub.OCI.RepoURL != "" {
+			repoURLs = append(repoURLs, urls.NormalizeImage(sub.OCI.RepoURL))
+		}
 	}
 	return repoURLs
 }
@@ -981,6 +981,11 @@ func TestWarehousesByRepoURL(t *testing.T) {
 								RepoURL: "https://example.com/charts/alpine-0.1.2",
 							},
 						},
+						{
+							OCI: &kargoapi.OCISubscription{
+								RepoURL: "ghcr.io/example/module",
+							},
+						},
 					},
 				},
 			},
@@ -988,6 +993,7 @@ func TestWarehousesByRepoURL(t *testing.T) {
 				"https://github.com/username/repo",
 				"https://example.com/charts/alpine-0.1.2",
 				"https://registry.hub.docker.com/u/svendowideit/testhook/",
+				"ghcr.io/example/module",
 			},
 		},
 		{
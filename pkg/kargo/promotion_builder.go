@@ -16,13 +16,16 @@ import (
 )
 
 const (
-	// PromotionAliasSeparator is the separator used in the Promotion step alias
-	// to separate the task alias from the step alias.
-	PromotionAliasSeparator = "::"
-
 	// nameSeparator is the separator used in the Promotion name.
 	nameSeparator = "."
 
+	// onFailureAliasPrefix is the prefix of the default alias of onFailure
+	// steps.
+	onFailureAliasPrefix = "on-failure-"
+
+	// finallyAliasPrefix is the prefix of the default alias of finally steps.
+	finallyAliasPrefix = "finally-"
+
 	// ulidLength is the length of the ULID string.
 	ulidLength = ulid.EncodedSize
 
@@ -83,25 +86,66 @@ func (b *PromotionBuilder) Build(
 			Annotations: annotations,
 		},
 		Spec: kargoapi.PromotionSpec{
-			Stage:   stage.Name,
-			Freight: freight,
-			Vars:    vars,
-			Steps:   stage.Spec.PromotionTemplate.Spec.Steps,
+			Stage:     stage.Name,
+			Freight:   freight,
+			Vars:      vars,
+			Steps:     stage.Spec.PromotionTemplate.Spec.Steps,
+			OnFailure: stage.Spec.PromotionTemplate.Spec.OnFailure,
+			Finally:   stage.Spec.PromotionTemplate.Spec.Finally,
 		},
 	}
 	return &promotion, nil
 }
 
 // InflateSteps inflates the Promotion steps by resolving any references to
-// PromotionTasks and expanding them into their individual steps. The inflated
-// steps are then set on the Promotion, replacing the original steps.
+// PromotionTasks and expanding them into their individual steps. Step groups
+// and forEach steps, including those of PromotionTasks, are then expanded into
+// their individual steps as well. The inflated steps are then set on the
+// Promotion, replacing the original steps.
+//
+// The onFailure and finally steps of the Promotion are inflated in the same
+// way. The onFailure and finally steps of any PromotionTasks referenced by the
+// main steps are added to those of the Promotion, ahead of the Promotion's own.
 func (b *PromotionBuilder) InflateSteps(ctx context.Context, promo *kargoapi.Promotion) error {
-	steps := make([]kargoapi.PromotionStep, 0, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
+	steps, taskOnFailure, taskFinally, err := b.inflateSectionSteps(ctx, promo, "", promo.Spec.Steps)
+	if err != nil {
+		return err
+	}
+	onFailure, _, _, err := b.inflateSectionSteps(ctx, promo, onFailureAliasPrefix, promo.Spec.OnFailure)
+	if err != nil {
+		return fmt.Errorf("inflate onFailure steps: %w", err)
+	}
+	finally, _, _, err := b.inflateSectionSteps(ctx, promo, finallyAliasPrefix, promo.Spec.Finally)
+	if err != nil {
+		return fmt.Errorf("inflate finally steps: %w", err)
+	}
+	promo.Spec.Steps = steps
+	promo.Spec.OnFailure = append(taskOnFailure, onFailure...)
+	promo.Spec.Finally = append(taskFinally, finally...)
+	return nil
+}
+
+// inflateSectionSteps inflates and expands the given steps of a section of the
+// Promotion. Steps without an alias are given a default alias, prefixed with
+// the given alias prefix. For the main steps, the prefix is empty, and the
+// onFailure and finally steps of any referenced PromotionTasks are returned
+// as well. For any other section, referencing a PromotionTask with onFailure
+// or finally steps of its own is an error.
+func (b *PromotionBuilder) inflateSectionSteps(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	aliasPrefix string,
+	sectionSteps []kargoapi.PromotionStep,
+) (steps, onFailure, finally []kargoapi.PromotionStep, err error) {
+	steps = make([]kargoapi.PromotionStep, 0, len(sectionSteps))
+	for i, step := range sectionSteps {
+		alias := step.As
+		if alias == "" {
+			alias = aliasPrefix + step.GetAlias(i)
+		}
 		switch {
 		case step.Task != nil:
-			alias := step.GetAlias(i)
-			taskSteps, err := b.inflateTaskSteps(
+			task, err := b.inflateTaskSteps(
 				ctx,
 				promo.Namespace,
 				alias,
@@ -109,27 +153,46 @@ func (b *PromotionBuilder) InflateSteps(ctx context.Context, promo *kargoapi.Pro
 				step,
 			)
 			if err != nil {
-				return fmt.Errorf("inflate tasks steps for task %q (%q): %w", step.Task.Name, alias, err)
+				return nil, nil, nil, fmt.Errorf(
+					"inflate tasks steps for task %q (%q): %w", step.Task.Name, alias, err,
+				)
 			}
-			steps = append(steps, taskSteps...)
+			if aliasPrefix != "" && (len(task.OnFailure) > 0 || len(task.Finally) > 0) {
+				return nil, nil, nil, fmt.Errorf(
+					"task %q (%q) has onFailure or finally steps and can only be referenced from the main steps",
+					step.Task.Name, alias,
+				)
+			}
+			steps = append(steps, task.Steps...)
+			onFailure = append(onFailure, task.OnFailure...)
+			finally = append(finally, task.Finally...)
 		default:
-			step.As = step.GetAlias(i)
+			step.As = alias
 			steps = append(steps, step)
 		}
 	}
-	promo.Spec.Steps = steps
-	return nil
+	if steps, err = b.expandSteps(ctx, promo, steps); err != nil {
+		return nil, nil, nil, err
+	}
+	if onFailure, err = b.expandSteps(ctx, promo, onFailure); err != nil {
+		return nil, nil, nil, err
+	}
+	if finally, err = b.expandSteps(ctx, promo, finally); err != nil {
+		return nil, nil, nil, err
+	}
+	return steps, onFailure, finally, nil
 }
 
 // inflateTaskSteps inflates the PromotionSteps for the given PromotionStep
 // that references a (Cluster)PromotionTask. The task is retrieved and its
-// steps are inflated with the given task inputs.
+// steps, including its onFailure and finally steps, are inflated with the
+// given task inputs.
 func (b *PromotionBuilder) inflateTaskSteps(
 	ctx context.Context,
 	project, taskAlias string,
 	promoVars []kargoapi.ExpressionVariable,
 	taskStep kargoapi.PromotionStep,
-) ([]kargoapi.PromotionStep, error) {
+) (*kargoapi.PromotionTaskSpec, error) {
 	task, err := b.getTaskSpec(ctx, project, taskStep.Task)
 	if err != nil {
 		return nil, err
@@ -140,23 +203,43 @@ func (b *PromotionBuilder) inflateTaskSteps(
 		return nil, err
 	}
 
+	return &kargoapi.PromotionTaskSpec{
+		Steps:     inflateTaskSectionSteps(taskAlias, "", vars, task.Steps),
+		OnFailure: inflateTaskSectionSteps(taskAlias, onFailureAliasPrefix, vars, task.OnFailure),
+		Finally:   inflateTaskSectionSteps(taskAlias, finallyAliasPrefix, vars, task.Finally),
+	}, nil
+}
+
+// inflateTaskSectionSteps inflates the given steps of a section of a
+// PromotionTask with the given variables. Steps without an alias are given a
+// default alias, prefixed with the given alias prefix.
+func inflateTaskSectionSteps(
+	taskAlias, aliasPrefix string,
+	vars []kargoapi.ExpressionVariable,
+	taskSteps []kargoapi.PromotionStep,
+) []kargoapi.PromotionStep {
 	var steps []kargoapi.PromotionStep
-	for i := range task.Steps {
+	for i := range taskSteps {
 		// Copy the step as-is.
-		step := &task.Steps[i]
+		step := &taskSteps[i]
+
+		alias := step.As
+		if alias == "" {
+			alias = aliasPrefix + step.GetAlias(i)
+		}
 
 		// Ensures we have a unique alias for each step within the context of
 		// the Promotion.
-		step.As = generatePromotionTaskStepAlias(taskAlias, step.GetAlias(i))
+		step.As = generatePromotionTaskStepAlias(taskAlias, alias)
 
 		// With the variables validated and mapped, they are now available to
 		// the Config of the step during the Promotion execution.
-		step.Vars = append(vars, step.Vars...)
+		step.Vars = append(append([]kargoapi.ExpressionVariable{}, vars...), step.Vars...)
 
 		// Append the inflated step to the list of steps.
 		steps = append(steps, *step)
 	}
-	return steps, nil
+	return steps
 }
 
 // getTaskSpec retrieves the PromotionTaskSpec for the given PromotionTaskReference.
@@ -219,7 +302,7 @@ func generatePromotionName(stageName, freight string) string {
 // generatePromotionTaskStepAlias generates an alias for a PromotionTask step
 // by combining the task alias and the step alias.
 func generatePromotionTaskStepAlias(taskAlias, stepAlias string) string {
-	return fmt.Sprintf("%s%s%s", taskAlias, PromotionAliasSeparator, stepAlias)
+	return fmt.Sprintf("%s%s%s", taskAlias, api.PromotionAliasSeparator, stepAlias)
 }
 
 // promotionTaskVarsToStepVars validates the presence of the PromotionTask
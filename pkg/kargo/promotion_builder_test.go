@@ -96,6 +96,18 @@ func TestPromotionBuilder_Build(t *testing.T) {
 									Uses: "fake-step",
 								},
 							},
+							OnFailure: []kargoapi.PromotionStep{
+								{
+									As:   "revert",
+									Uses: "fake-revert-step",
+								},
+							},
+							Finally: []kargoapi.PromotionStep{
+								{
+									As:   "cleanup",
+									Uses: "fake-cleanup-step",
+								},
+							},
 						},
 					},
 				},
@@ -126,6 +138,10 @@ func TestPromotionBuilder_Build(t *testing.T) {
 				require.Len(t, promotion.Spec.Steps, 1)
 				assert.Equal(t, "step1", promotion.Spec.Steps[0].As)
 				assert.Equal(t, "fake-step", promotion.Spec.Steps[0].Uses)
+				require.Len(t, promotion.Spec.OnFailure, 1)
+				assert.Equal(t, "revert", promotion.Spec.OnFailure[0].As)
+				require.Len(t, promotion.Spec.Finally, 1)
+				assert.Equal(t, "cleanup", promotion.Spec.Finally[0].As)
 
 				// Check name format
 				assert.Contains(t, promotion.Name, "test-stage")
@@ -384,6 +400,141 @@ func TestPromotionBuilder_InflateSteps(t *testing.T) {
 				}, steps[1].Vars)
 			},
 		},
+		{
+			name: "step groups and forEach steps are expanded",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses: "fake-step",
+							ForEach: &kargoapi.PromotionStepForEach{
+								Items: `${{ ["a", "b"] }}`,
+							},
+						},
+						{
+							As: "task",
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "test-task",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-task",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Steps: []kargoapi.PromotionStep{
+							{
+								As: "sync",
+								Group: &kargoapi.PromotionStepGroup{
+									Steps: []kargoapi.PromotionStepGroupMember{
+										{Uses: "fake-step"},
+										{As: "other", Uses: "other-fake-step"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 4)
+
+				assert.Equal(t, "step-1-0", steps[0].As)
+				assert.Equal(t, "step-1", steps[0].Expansion.Parent)
+				assert.Equal(t, "step-1-1", steps[1].As)
+				assert.Equal(t, "step-1", steps[1].Expansion.Parent)
+
+				assert.Equal(t, "task::sync-1", steps[2].As)
+				assert.Equal(t, "fake-step", steps[2].Uses)
+				assert.Equal(t, "task::sync", steps[2].Expansion.Parent)
+				assert.Equal(t, "task::other", steps[3].As)
+				assert.Equal(t, "other-fake-step", steps[3].Uses)
+				assert.Equal(t, "task::sync", steps[3].Expansion.Parent)
+			},
+		},
+		{
+			name: "forEach items may use expression functions",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage:   "test-stage",
+					Freight: "test-freight",
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "tag", Value: `${{ imageFrom("fake-image").Tag }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses: "fake-step",
+							ForEach: &kargoapi.PromotionStepForEach{
+								Items: `${{ [vars.tag, semverParse(vars.tag).Major()] }}`,
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-stage",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.StageSpec{
+						RequestedFreight: []kargoapi.FreightRequest{{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+						}},
+					},
+				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-warehouse",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{{
+							Image: &kargoapi.ImageSubscription{
+								RepoURL: "fake-image",
+							},
+						}},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-freight",
+						Namespace: "test-project",
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+					Images: []kargoapi.Image{{
+						RepoURL: "fake-image",
+						Tag:     "2.3.4",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 2)
+				assert.Equal(t, `"2.3.4"`, string(steps[0].Expansion.Item.Raw))
+				assert.Equal(t, `2`, string(steps[1].Expansion.Item.Raw))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -401,6 +552,152 @@ func TestPromotionBuilder_InflateSteps(t *testing.T) {
 	}
 }
 
+func TestPromotionBuilder_InflateSteps_sections(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(s))
+
+	taskWithSections := &kargoapi.PromotionTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "task-with-sections",
+			Namespace: "test-project",
+		},
+		Spec: kargoapi.PromotionTaskSpec{
+			Vars: []kargoapi.ExpressionVariable{
+				{Name: "input1", Value: "value1"},
+			},
+			Steps: []kargoapi.PromotionStep{
+				{Uses: "fake-step"},
+			},
+			OnFailure: []kargoapi.PromotionStep{
+				{Uses: "fake-revert-step"},
+			},
+			Finally: []kargoapi.PromotionStep{
+				{As: "cleanup", Uses: "fake-cleanup-step"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		promo      kargoapi.Promotion
+		objects    []client.Object
+		assertions func(*testing.T, kargoapi.PromotionSpec, error)
+	}{
+		{
+			name: "direct onFailure and finally steps",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{Uses: "fake-step"},
+					},
+					OnFailure: []kargoapi.PromotionStep{
+						{Uses: "fake-revert-step"},
+						{As: "notify", Uses: "fake-notify-step"},
+					},
+					Finally: []kargoapi.PromotionStep{
+						{Uses: "fake-cleanup-step"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, spec kargoapi.PromotionSpec, err error) {
+				require.NoError(t, err)
+
+				require.Len(t, spec.Steps, 1)
+				assert.Equal(t, "step-1", spec.Steps[0].As)
+
+				require.Len(t, spec.OnFailure, 2)
+				assert.Equal(t, "on-failure-step-1", spec.OnFailure[0].As)
+				assert.Equal(t, "notify", spec.OnFailure[1].As)
+
+				require.Len(t, spec.Finally, 1)
+				assert.Equal(t, "finally-step-1", spec.Finally[0].As)
+			},
+		},
+		{
+			name: "task onFailure and finally steps precede those of the promotion",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "task-with-sections",
+							},
+						},
+					},
+					OnFailure: []kargoapi.PromotionStep{
+						{Uses: "fake-notify-step"},
+					},
+				},
+			},
+			objects: []client.Object{taskWithSections},
+			assertions: func(t *testing.T, spec kargoapi.PromotionSpec, err error) {
+				require.NoError(t, err)
+
+				require.Len(t, spec.Steps, 1)
+				assert.Equal(t, "task-1::step-1", spec.Steps[0].As)
+
+				require.Len(t, spec.OnFailure, 2)
+				assert.Equal(t, "task-1::on-failure-step-1", spec.OnFailure[0].As)
+				assert.Equal(t, "fake-revert-step", spec.OnFailure[0].Uses)
+				assert.Equal(t, []kargoapi.ExpressionVariable{
+					{Name: "input1", Value: "value1"},
+				}, spec.OnFailure[0].Vars)
+				assert.Equal(t, "on-failure-step-1", spec.OnFailure[1].As)
+
+				require.Len(t, spec.Finally, 1)
+				assert.Equal(t, "task-1::cleanup", spec.Finally[0].As)
+			},
+		},
+		{
+			name: "task with sections referenced from onFailure steps returns error",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{Uses: "fake-step"},
+					},
+					OnFailure: []kargoapi.PromotionStep{
+						{
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "task-with-sections",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{taskWithSections},
+			assertions: func(t *testing.T, _ kargoapi.PromotionSpec, err error) {
+				assert.ErrorContains(t, err, "can only be referenced from the main steps")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(s).
+				WithObjects(tt.objects...).
+				Build()
+
+			b := NewPromotionBuilder(c)
+			p := tt.promo.DeepCopy()
+			err := b.InflateSteps(context.Background(), p)
+			tt.assertions(t, p.Spec, err)
+		})
+	}
+}
+
 func TestPromotionBuilder_inflateTaskSteps(t *testing.T) {
 	s := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(s))
@@ -611,7 +908,11 @@ func TestPromotionBuilder_inflateTaskSteps(t *testing.T) {
 				Build()
 
 			b := NewPromotionBuilder(c)
-			steps, err := b.inflateTaskSteps(context.Background(), tt.project, tt.taskAlias, tt.promoVars, tt.taskStep)
+			task, err := b.inflateTaskSteps(context.Background(), tt.project, tt.taskAlias, tt.promoVars, tt.taskStep)
+			var steps []kargoapi.PromotionStep
+			if task != nil {
+				steps = task.Steps
+			}
 			tt.assertions(t, steps, err)
 		})
 	}
@@ -0,0 +1,253 @@
+package kargo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/expressions"
+	exprfn "github.com/akuity/kargo/pkg/expressions/function"
+)
+
+// expandSteps expands any step groups and forEach steps in the given list of
+// steps, which must already have their aliases set, into their individual
+// steps. All other steps are returned as-is.
+func (b *PromotionBuilder) expandSteps(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	steps []kargoapi.PromotionStep,
+) ([]kargoapi.PromotionStep, error) {
+	var exprOpts []expr.Option
+	expanded := make([]kargoapi.PromotionStep, 0, len(steps))
+	for _, step := range steps {
+		switch {
+		case step.Group != nil:
+			expanded = append(expanded, expandStepGroup(step)...)
+		case step.ForEach != nil:
+			if exprOpts == nil {
+				var err error
+				if exprOpts, err = b.forEachExprOptions(ctx, promo); err != nil {
+					return nil, err
+				}
+			}
+			iterations, err := expandForEachStep(promo, step, exprOpts...)
+			if err != nil {
+				return nil, fmt.Errorf("expand forEach step %q: %w", step.As, err)
+			}
+			expanded = append(expanded, iterations...)
+		default:
+			expanded = append(expanded, step)
+		}
+	}
+	return expanded, nil
+}
+
+// expandStepGroup expands the members of the given step group into individual
+// steps. Members without an alias of their own are given the alias of the
+// group suffixed with their (1-based) index. Members with an alias of their
+// own are placed in the same namespace as the group, if it has one, so that
+// members of groups that are part of a PromotionTask remain unique within the
+// context of the Promotion.
+func expandStepGroup(group kargoapi.PromotionStep) []kargoapi.PromotionStep {
+	parallelism := group.Group.Parallelism
+	if parallelism < 1 {
+		parallelism = int64(len(group.Group.Steps))
+	}
+
+	var namespace string
+	if parts := strings.Split(group.As, api.PromotionAliasSeparator); len(parts) == 2 {
+		namespace = parts[0]
+	}
+
+	steps := make([]kargoapi.PromotionStep, 0, len(group.Group.Steps))
+	for i, member := range group.Group.Steps {
+		alias := fmt.Sprintf("%s-%d", group.As, i+1)
+		if member.As != "" {
+			alias = member.As
+			if namespace != "" {
+				alias = generatePromotionTaskStepAlias(namespace, member.As)
+			}
+		}
+		steps = append(steps, kargoapi.PromotionStep{
+			Uses:            member.Uses,
+			As:              alias,
+			If:              member.If,
+			ContinueOnError: member.ContinueOnError,
+			Retry:           member.Retry,
+			Vars:            append(append([]kargoapi.ExpressionVariable{}, group.Vars...), member.Vars...),
+			Config:          member.Config,
+			Expansion: &kargoapi.PromotionStepExpansion{
+				Parent:      group.As,
+				Parallelism: parallelism,
+			},
+		})
+	}
+	return steps
+}
+
+// expandForEachStep evaluates the items expression of the given forEach step
+// and expands the step into one step per item. Each expanded step is given
+// the alias of the forEach step suffixed with the (0-based) index of its item.
+//
+// Because the items are evaluated when the Promotion is created, the
+// expression has access to the context of the Promotion, to its variables and
+// those of the step, and to the given expression options, but not to the
+// outputs of other steps.
+func expandForEachStep(
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionStep,
+	exprOpts ...expr.Option,
+) ([]kargoapi.PromotionStep, error) {
+	items, err := evaluateForEachItems(promo, step, exprOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := step.ForEach.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	steps := make([]kargoapi.PromotionStep, 0, len(items))
+	for i, item := range items {
+		itemBytes, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("marshal item %d: %w", i, err)
+		}
+		index := int64(i)
+		iteration := *step.DeepCopy()
+		iteration.As = fmt.Sprintf("%s-%d", step.As, i)
+		iteration.ForEach = nil
+		iteration.Expansion = &kargoapi.PromotionStepExpansion{
+			Parent:      step.As,
+			Parallelism: parallelism,
+			Index:       &index,
+			Item:        &apiextensionsv1.JSON{Raw: itemBytes},
+		}
+		steps = append(steps, iteration)
+	}
+	return steps, nil
+}
+
+// evaluateForEachItems evaluates the items expression of the given forEach
+// step. The expression must evaluate to a list, or to a string containing a
+// JSON list.
+func evaluateForEachItems(
+	promo *kargoapi.Promotion,
+	step kargoapi.PromotionStep,
+	exprOpts ...expr.Option,
+) ([]any, error) {
+	env := map[string]any{
+		"ctx": map[string]any{
+			"project":   promo.Namespace,
+			"promotion": promo.Name,
+			"stage":     promo.Spec.Stage,
+			"targetFreight": map[string]any{
+				"name": promo.Spec.Freight,
+			},
+			"meta": map[string]any{
+				"promotion": map[string]any{
+					"actor": api.CreateActorAnnotationValue(promo),
+				},
+			},
+		},
+	}
+
+	// Promotion variables are evaluated first, followed by the variables of
+	// the step, mirroring the order in which they are evaluated when the step
+	// is executed.
+	vars := make(map[string]any)
+	env["vars"] = vars
+	for _, v := range append(append([]kargoapi.ExpressionVariable{}, promo.Spec.Vars...), step.Vars...) {
+		value, err := expressions.EvaluateTemplate(v.Value, env, exprOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate variable %q: %w", v.Name, err)
+		}
+		vars[v.Name] = value
+	}
+
+	result, err := expressions.EvaluateTemplate(step.ForEach.Items, env, exprOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate items: %w", err)
+	}
+	if str, ok := result.(string); ok {
+		if err = json.Unmarshal([]byte(str), &result); err != nil {
+			return nil, errors.New("items did not evaluate to a list")
+		}
+	}
+	items, ok := result.([]any)
+	if !ok {
+		return nil, fmt.Errorf("items evaluated to %T instead of a list", result)
+	}
+	return items, nil
+}
+
+// forEachExprOptions returns the expression functions available to the items
+// expressions of forEach steps of the given Promotion. These are the same
+// functions that are available to the variables of the steps when they are
+// executed, except for those that assess the status of preceding steps. The
+// Freight functions resolve artifacts from the Freight being promoted, along
+// with any other Freight currently in use by the Stage.
+func (b *PromotionBuilder) forEachExprOptions(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) ([]expr.Option, error) {
+	stage, err := api.GetStage(ctx, b.client, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Stage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	freight, err := api.GetFreight(ctx, b.client, types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Freight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var freightReqs []kargoapi.FreightRequest
+	freightCol := &kargoapi.FreightCollection{}
+	if stage != nil {
+		for _, req := range stage.Spec.RequestedFreight {
+			freightReqs = append(freightReqs, *req.DeepCopy())
+		}
+		if current := stage.Status.FreightHistory.Current(); current != nil {
+			for _, req := range stage.Spec.RequestedFreight {
+				if ref, ok := current.Freight[req.Origin.String()]; ok {
+					freightCol.UpdateOrPush(ref)
+				}
+			}
+		}
+	}
+	if freight != nil {
+		freightCol.UpdateOrPush(kargoapi.FreightReference{
+			Name:      freight.Name,
+			Origin:    freight.Origin,
+			Commits:   freight.Commits,
+			Images:    freight.Images,
+			Charts:    freight.Charts,
+			Artifacts: freight.Artifacts,
+			Objects:   freight.Objects,
+		})
+	}
+
+	opts := exprfn.FreightOperations(
+		ctx,
+		b.client,
+		promo.Namespace,
+		freightReqs,
+		freightCol.References(),
+	)
+	opts = append(opts, exprfn.DataOperations(ctx, b.client, nil, promo.Namespace)...)
+	return append(opts, exprfn.UtilityOperations()...), nil
+}
@@ -0,0 +1,247 @@
+package kargo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_expandStepGroup(t *testing.T) {
+	testCases := []struct {
+		name       string
+		group      kargoapi.PromotionStep
+		assertions func(*testing.T, []kargoapi.PromotionStep)
+	}{
+		{
+			name: "default parallelism",
+			group: kargoapi.PromotionStep{
+				As: "sync",
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "app", Value: "fake-app"},
+				},
+				Group: &kargoapi.PromotionStepGroup{
+					Steps: []kargoapi.PromotionStepGroupMember{
+						{
+							Uses: "fake-step",
+							Vars: []kargoapi.ExpressionVariable{
+								{Name: "app", Value: "other-app"},
+							},
+						},
+						{
+							As:              "other",
+							Uses:            "other-fake-step",
+							If:              "${{ true }}",
+							ContinueOnError: true,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep) {
+				require.Equal(t, []kargoapi.PromotionStep{
+					{
+						Uses: "fake-step",
+						As:   "sync-1",
+						Vars: []kargoapi.ExpressionVariable{
+							{Name: "app", Value: "fake-app"},
+							{Name: "app", Value: "other-app"},
+						},
+						Expansion: &kargoapi.PromotionStepExpansion{
+							Parent:      "sync",
+							Parallelism: 2,
+						},
+					},
+					{
+						Uses:            "other-fake-step",
+						As:              "other",
+						If:              "${{ true }}",
+						ContinueOnError: true,
+						Vars: []kargoapi.ExpressionVariable{
+							{Name: "app", Value: "fake-app"},
+						},
+						Expansion: &kargoapi.PromotionStepExpansion{
+							Parent:      "sync",
+							Parallelism: 2,
+						},
+					},
+				}, steps)
+			},
+		},
+		{
+			name: "explicit parallelism",
+			group: kargoapi.PromotionStep{
+				As: "sync",
+				Group: &kargoapi.PromotionStepGroup{
+					Parallelism: 1,
+					Steps: []kargoapi.PromotionStepGroupMember{
+						{Uses: "fake-step"},
+						{Uses: "fake-step"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep) {
+				require.Len(t, steps, 2)
+				for _, step := range steps {
+					require.Equal(t, int64(1), step.Expansion.Parallelism)
+				}
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, expandStepGroup(testCase.group))
+		})
+	}
+}
+
+func Test_expandForEachStep(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage: "fake-stage",
+			Vars: []kargoapi.ExpressionVariable{
+				{Name: "regions", Value: `${{ ["us", "eu"] }}`},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		step       kargoapi.PromotionStep
+		assertions func(*testing.T, []kargoapi.PromotionStep, error)
+	}{
+		{
+			name: "items from promotion variables",
+			step: kargoapi.PromotionStep{
+				As:   "update",
+				Uses: "fake-step",
+				ForEach: &kargoapi.PromotionStepForEach{
+					Items:       "${{ vars.regions }}",
+					Parallelism: 2,
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.PromotionStep{
+					{
+						Uses: "fake-step",
+						As:   "update-0",
+						Expansion: &kargoapi.PromotionStepExpansion{
+							Parent:      "update",
+							Parallelism: 2,
+							Index:       ptr.To[int64](0),
+							Item:        &apiextensionsv1.JSON{Raw: []byte(`"us"`)},
+						},
+					},
+					{
+						Uses: "fake-step",
+						As:   "update-1",
+						Expansion: &kargoapi.PromotionStepExpansion{
+							Parent:      "update",
+							Parallelism: 2,
+							Index:       ptr.To[int64](1),
+							Item:        &apiextensionsv1.JSON{Raw: []byte(`"eu"`)},
+						},
+					},
+				}, steps)
+			},
+		},
+		{
+			name: "items from step variables and context",
+			step: kargoapi.PromotionStep{
+				As:   "update",
+				Uses: "fake-step",
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "apps", Value: `${{ map(vars.regions, ctx.stage + "-" + #) }}`},
+				},
+				ForEach: &kargoapi.PromotionStepForEach{
+					Items: "${{ vars.apps }}",
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 2)
+				require.Equal(t, `"fake-stage-us"`, string(steps[0].Expansion.Item.Raw))
+				require.Equal(t, `"fake-stage-eu"`, string(steps[1].Expansion.Item.Raw))
+				require.Equal(t, int64(1), steps[0].Expansion.Parallelism)
+				// The variables of the step are retained, as they are evaluated
+				// again when the step is executed.
+				require.Len(t, steps[0].Vars, 1)
+				require.Nil(t, steps[0].ForEach)
+			},
+		},
+		{
+			name: "items from variables using expression functions",
+			step: kargoapi.PromotionStep{
+				As:   "update",
+				Uses: "fake-step",
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "apps", Value: `${{ quote(map(vars.regions, ctx.stage + "-" + #)) }}`},
+				},
+				ForEach: &kargoapi.PromotionStepForEach{
+					Items: "${{ vars.apps }}",
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 2)
+				require.Equal(t, `"fake-stage-us"`, string(steps[0].Expansion.Item.Raw))
+				require.Equal(t, `"fake-stage-eu"`, string(steps[1].Expansion.Item.Raw))
+			},
+		},
+		{
+			name: "items of objects",
+			step: kargoapi.PromotionStep{
+				As:   "update",
+				Uses: "fake-step",
+				ForEach: &kargoapi.PromotionStepForEach{
+					Items: `[{"image": "fake-image"}]`,
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 1)
+				require.JSONEq(t, `{"image": "fake-image"}`, string(steps[0].Expansion.Item.Raw))
+			},
+		},
+		{
+			name: "items do not evaluate to a list",
+			step: kargoapi.PromotionStep{
+				As:   "update",
+				Uses: "fake-step",
+				ForEach: &kargoapi.PromotionStepForEach{
+					Items: "${{ ctx.stage }}",
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				require.ErrorContains(t, err, "items did not evaluate to a list")
+			},
+		},
+		{
+			name: "items evaluation error",
+			step: kargoapi.PromotionStep{
+				As:   "update",
+				Uses: "fake-step",
+				ForEach: &kargoapi.PromotionStepForEach{
+					Items: "${{ outputs.foo.bar }}",
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				require.ErrorContains(t, err, "evaluate items")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			steps, err := expandForEachStep(promo, testCase.step)
+			testCase.assertions(t, steps, err)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package kubeclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KubeconfigSecretKey is the key in a Secret under which a kubeconfig for a
+// remote cluster is stored.
+const KubeconfigSecretKey = "kubeconfig"
+
+// kubeconfigClientCache caches clients created from kubeconfig Secrets, keyed
+// by the UID and resourceVersion of the Secret, so that a client is only built
+// anew when the Secret has changed. Entries expire so that clients for deleted
+// or updated Secrets do not accumulate.
+var kubeconfigClientCache = cache.New(30*time.Minute, time.Hour)
+
+type kubeconfigClient struct {
+	client    client.Client
+	namespace string
+}
+
+// NewClientFromKubeconfigSecret returns a client for the cluster described by
+// the kubeconfig stored in the specified Secret, along with the namespace of
+// the kubeconfig's current context. See NewClientFromKubeconfig for the
+// restrictions placed on the kubeconfig. Clients are cached for as long as the
+// Secret remains unchanged.
+func NewClientFromKubeconfigSecret(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	name string,
+) (client.Client, string, error) {
+	secret, err := getKubeconfigSecret(ctx, c, namespace, name)
+	if err != nil {
+		return nil, "", err
+	}
+	cacheKey := fmt.Sprintf("%s:%s", secret.UID, secret.ResourceVersion)
+	if entry, ok := kubeconfigClientCache.Get(cacheKey); ok {
+		if cached, ok := entry.(kubeconfigClient); ok {
+			return cached.client, cached.namespace, nil
+		}
+	}
+	targetClient, targetNamespace, err := NewClientFromKubeconfig(secret.Data[KubeconfigSecretKey])
+	if err != nil {
+		return nil, "", fmt.Errorf(
+			"error creating client from kubeconfig in Secret %q: %w", name, err,
+		)
+	}
+	kubeconfigClientCache.Set(
+		cacheKey,
+		kubeconfigClient{client: targetClient, namespace: targetNamespace},
+		cache.DefaultExpiration,
+	)
+	return targetClient, targetNamespace, nil
+}
+
+// GetKubeconfigFromSecret returns the kubeconfig stored in the specified
+// Secret under the KubeconfigSecretKey key.
+func GetKubeconfigFromSecret(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	name string,
+) ([]byte, error) {
+	secret, err := getKubeconfigSecret(ctx, c, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return secret.Data[KubeconfigSecretKey], nil
+}
+
+// getKubeconfigSecret returns the specified Secret if it has a non-empty value
+// for the KubeconfigSecretKey key.
+func getKubeconfigSecret(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	name string,
+) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: name},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting kubeconfig Secret %q in namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+	if len(secret.Data[KubeconfigSecretKey]) == 0 {
+		return nil, fmt.Errorf(
+			"kubeconfig Secret %q in namespace %q has no value for key %q",
+			name, namespace, KubeconfigSecretKey,
+		)
+	}
+	return secret, nil
+}
+
+// NewClientFromKubeconfig returns a client for the cluster described by the
+// provided kubeconfig, along with the namespace of the kubeconfig's current
+// context. Because such kubeconfigs are typically supplied by Project users,
+// they may not reference files or executables on the local file system.
+func NewClientFromKubeconfig(kubeconfig []byte) (client.Client, string, error) {
+	rawCfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("error parsing kubeconfig: %w", err)
+	}
+	if err = validateKubeconfig(rawCfg); err != nil {
+		return nil, "", err
+	}
+	clientCfg := clientcmd.NewDefaultClientConfig(*rawCfg, &clientcmd.ConfigOverrides{})
+	restCfg, err := clientCfg.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("error building REST config from kubeconfig: %w", err)
+	}
+	namespace, _, err := clientCfg.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("error determining namespace from kubeconfig: %w", err)
+	}
+	c, err := client.New(restCfg, client.Options{})
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating client: %w", err)
+	}
+	return c, namespace, nil
+}
+
+// validateKubeconfig returns an error if the provided kubeconfig references
+// files or executables, which would otherwise be read or executed with the
+// privileges of the current process.
+func validateKubeconfig(cfg *clientcmdapi.Config) error {
+	for name, cluster := range cfg.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf(
+				"cluster %q references a certificate authority file; "+
+					"use certificate-authority-data instead", name,
+			)
+		}
+	}
+	for name, authInfo := range cfg.AuthInfos {
+		switch {
+		case authInfo.ClientCertificate != "" || authInfo.ClientKey != "":
+			return fmt.Errorf(
+				"user %q references a client certificate or key file; "+
+					"use client-certificate-data and client-key-data instead", name,
+			)
+		case authInfo.TokenFile != "":
+			return fmt.Errorf(
+				"user %q references a token file; use token instead", name,
+			)
+		case authInfo.Exec != nil:
+			return fmt.Errorf("user %q uses an exec credential plugin, which is not supported", name)
+		case authInfo.AuthProvider != nil:
+			return fmt.Errorf("user %q uses an auth provider, which is not supported", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package kubeclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetKubeconfigFromSecret(t *testing.T) {
+	c := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "valid"},
+			Data:       map[string][]byte{KubeconfigSecretKey: []byte("fake-kubeconfig")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "empty"},
+		},
+	).Build()
+
+	kubeconfig, err := GetKubeconfigFromSecret(context.Background(), c, "fake-project", "valid")
+	require.NoError(t, err)
+	require.Equal(t, []byte("fake-kubeconfig"), kubeconfig)
+
+	_, err = GetKubeconfigFromSecret(context.Background(), c, "fake-project", "empty")
+	require.ErrorContains(t, err, `has no value for key "kubeconfig"`)
+
+	_, err = GetKubeconfigFromSecret(context.Background(), c, "fake-project", "missing")
+	require.ErrorContains(t, err, `error getting kubeconfig Secret "missing"`)
+}
+
+func TestNewClientFromKubeconfigSecret(t *testing.T) {
+	const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: target
+  cluster:
+    server: https://127.0.0.1:1
+users:
+- name: kargo
+  user:
+    token: fake-token
+contexts:
+- name: target
+  context:
+    cluster: target
+    user: kargo
+    namespace: %s
+current-context: target
+`
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "target",
+			UID:       types.UID("fake-uid"),
+		},
+		Data: map[string][]byte{
+			KubeconfigSecretKey: []byte(fmt.Sprintf(kubeconfig, "first")),
+		},
+	}
+	c := fake.NewClientBuilder().WithObjects(secret).Build()
+	ctx := context.Background()
+
+	client1, namespace, err := NewClientFromKubeconfigSecret(ctx, c, "fake-project", "target")
+	require.NoError(t, err)
+	require.Equal(t, "first", namespace)
+
+	// An unchanged Secret yields the cached client
+	client2, namespace, err := NewClientFromKubeconfigSecret(ctx, c, "fake-project", "target")
+	require.NoError(t, err)
+	require.Equal(t, "first", namespace)
+	require.Same(t, client1, client2)
+
+	// A changed Secret yields a new client
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(secret), secret))
+	secret.Data[KubeconfigSecretKey] = []byte(fmt.Sprintf(kubeconfig, "second"))
+	require.NoError(t, c.Update(ctx, secret))
+	client3, namespace, err := NewClientFromKubeconfigSecret(ctx, c, "fake-project", "target")
+	require.NoError(t, err)
+	require.Equal(t, "second", namespace)
+	require.NotSame(t, client1, client3)
+
+	_, _, err = NewClientFromKubeconfigSecret(ctx, c, "fake-project", "missing")
+	require.ErrorContains(t, err, `error getting kubeconfig Secret "missing"`)
+}
+
+func TestNewClientFromKubeconfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		kubeconfig string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:       "invalid kubeconfig",
+			kubeconfig: "{",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing kubeconfig")
+			},
+		},
+		{
+			name: "exec plugin",
+			kubeconfig: `apiVersion: v1
+kind: Config
+clusters:
+- name: target
+  cluster:
+    server: https://127.0.0.1:1
+users:
+- name: kargo
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: sh
+contexts:
+- name: target
+  context:
+    cluster: target
+    user: kargo
+current-context: target
+`,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "uses an exec credential plugin")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, namespace, err := NewClientFromKubeconfig([]byte(testCase.kubeconfig))
+			testCase.assertions(t, namespace, err)
+		})
+	}
+}
+
+func Test_validateKubeconfig(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     *clientcmdapi.Config
+		errText string
+	}{
+		{
+			name: "valid",
+			cfg: &clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"target": {Server: "https://example.com", CertificateAuthorityData: []byte("ca")},
+				},
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"kargo": {Token: "fake-token"},
+				},
+			},
+		},
+		{
+			name: "certificate authority file",
+			cfg: &clientcmdapi.Config{
+				Clusters: map[string]*clientcmdapi.Cluster{
+					"target": {CertificateAuthority: "/etc/ca.crt"},
+				},
+			},
+			errText: "references a certificate authority file",
+		},
+		{
+			name: "client key file",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"kargo": {ClientKey: "/etc/tls.key"},
+				},
+			},
+			errText: "references a client certificate or key file",
+		},
+		{
+			name: "token file",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"kargo": {TokenFile: "/var/run/secrets/token"},
+				},
+			},
+			errText: "references a token file",
+		},
+		{
+			name: "exec plugin",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"kargo": {Exec: &clientcmdapi.ExecConfig{Command: "sh"}},
+				},
+			},
+			errText: "uses an exec credential plugin",
+		},
+		{
+			name: "auth provider",
+			cfg: &clientcmdapi.Config{
+				AuthInfos: map[string]*clientcmdapi.AuthInfo{
+					"kargo": {AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "gcp"}},
+				},
+			},
+			errText: "uses an auth provider",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateKubeconfig(testCase.cfg)
+			if testCase.errText == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, testCase.errText)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/expressions"
+)
+
+const (
+	// SecretKeyURL is the key in a notification Secret's data map whose value
+	// is the URL to which notifications are delivered.
+	SecretKeyURL = "url"
+	// SecretKeyAuthorization is the key in a generic webhook notification
+	// Secret's data map whose value, if present, is used as the value of the
+	// Authorization header of each request.
+	SecretKeyAuthorization = "authorization"
+
+	// defaultMaxAttempts is the maximum number of delivery attempts made when
+	// a NotificationConfig does not specify one.
+	defaultMaxAttempts = 3
+
+	// minRetryDelay is the delay following the first failed delivery attempt.
+	// The delay doubles following each subsequent failed attempt.
+	minRetryDelay = time.Second
+	// maxRetryDelay is the maximum delay between delivery attempts.
+	maxRetryDelay = 30 * time.Second
+)
+
+// Notifier is an interface for components that deliver notifications of Kargo
+// events to a single destination.
+type Notifier interface {
+	// Notify makes a single attempt to deliver a notification of the provided
+	// event. Errors that should not be retried are wrapped in a terminalError.
+	Notify(ctx context.Context, evt event.Meta) error
+}
+
+// terminalError wraps an error that should not result in another delivery
+// attempt.
+type terminalError struct {
+	err error
+}
+
+func (e *terminalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *terminalError) Unwrap() error {
+	return e.err
+}
+
+// NewNotifier returns a Notifier for the provided NotificationConfig. The
+// provided Secret is the one referenced by the config's target.
+func NewNotifier(
+	cfg kargoapi.NotificationConfig,
+	secret *corev1.Secret,
+) (Notifier, error) {
+	if secret == nil {
+		return nil, errors.New("secret is nil")
+	}
+	url := string(secret.Data[SecretKeyURL])
+	if url == "" {
+		return nil, fmt.Errorf(
+			"secret %q has no value for key %q", secret.Name, SecretKeyURL,
+		)
+	}
+	switch {
+	case cfg.Slack != nil:
+		return &slackNotifier{
+			poster: newPoster(url, "", false),
+			text:   cfg.Slack.Text,
+		}, nil
+	case cfg.Teams != nil:
+		return &teamsNotifier{
+			poster: newPoster(url, "", false),
+			text:   cfg.Teams.Text,
+		}, nil
+	case cfg.Webhook != nil:
+		return &webhookNotifier{
+			poster: newPoster(
+				url,
+				string(secret.Data[SecretKeyAuthorization]),
+				cfg.Webhook.InsecureSkipTLSVerify,
+			),
+			body: cfg.Webhook.Body,
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"notification %q does not specify a supported target", cfg.Name,
+		)
+	}
+}
+
+// IsTerminal returns a bool indicating whether the provided error, returned
+// by a Notifier, indicates that no further attempts should be made to deliver
+// the notification.
+func IsTerminal(err error) bool {
+	var termErr *terminalError
+	return errors.As(err, &termErr)
+}
+
+// MaxAttempts returns the maximum number of attempts that should be made to
+// deliver a single notification to the destination described by the provided
+// NotificationConfig.
+func MaxAttempts(cfg kargoapi.NotificationConfig) int32 {
+	if cfg.MaxAttempts <= 0 {
+		return defaultMaxAttempts
+	}
+	return cfg.MaxAttempts
+}
+
+// RetryDelay returns the delay that should elapse before another attempt is
+// made to deliver a notification, given the number of attempts that have
+// already failed. Delays increase exponentially, up to a maximum.
+func RetryDelay(failedAttempts int32) time.Duration {
+	delay := minRetryDelay
+	for i := int32(1); i < failedAttempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// Matches returns a bool indicating whether the provided event should be
+// delivered to the destination described by the provided NotificationConfig.
+func Matches(cfg kargoapi.NotificationConfig, evt event.Meta) bool {
+	if len(cfg.EventTypes) > 0 && !slices.Contains(cfg.EventTypes, evt.Type()) {
+		return false
+	}
+	if len(cfg.Stages) > 0 {
+		env, err := newEnv(evt)
+		if err != nil {
+			return false
+		}
+		stage, _ := env["event"].(map[string]any)["stageName"].(string)
+		if !slices.Contains(cfg.Stages, stage) {
+			return false
+		}
+	}
+	return true
+}
+
+// newEnv returns the environment against which notification templates are
+// evaluated. The event is exposed as a map under the "event" key, with its
+// type and kind added as "type" and "kind" fields respectively.
+func newEnv(evt event.Meta) (map[string]any, error) {
+	evtJSON, err := json.Marshal(evt)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling event: %w", err)
+	}
+	evtMap := map[string]any{}
+	if err = json.Unmarshal(evtJSON, &evtMap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling event: %w", err)
+	}
+	evtMap["type"] = string(evt.Type())
+	evtMap["kind"] = evt.Kind()
+	evtMap["project"] = evt.GetProject()
+	evtMap["id"] = evt.GetID()
+	if _, ok := evtMap["name"]; !ok {
+		evtMap["name"] = evt.GetName()
+	}
+	return map[string]any{"event": evtMap}, nil
+}
+
+// defaultText returns a short, human-readable summary of the provided event.
+func defaultText(evt event.Meta) string {
+	text := fmt.Sprintf(
+		"[%s] %s: %s %q",
+		evt.GetProject(), evt.Type(), evt.Kind(), evt.GetName(),
+	)
+	if msgEvt, ok := evt.(event.Message); ok && msgEvt.GetMessage() != "" {
+		text = fmt.Sprintf("%s: %s", text, msgEvt.GetMessage())
+	}
+	return text
+}
+
+// renderText evaluates the provided text template against the provided event.
+// If the template is empty, a default summary of the event is returned
+// instead.
+func renderText(template string, evt event.Meta) (string, error) {
+	if template == "" {
+		return defaultText(evt), nil
+	}
+	env, err := newEnv(evt)
+	if err != nil {
+		return "", err
+	}
+	text, err := expressions.EvaluateTemplate(template, env)
+	if err != nil {
+		return "", fmt.Errorf("error rendering text: %w", err)
+	}
+	return fmt.Sprint(text), nil
+}
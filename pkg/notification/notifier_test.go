@@ -0,0 +1,321 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/event"
+)
+
+func newTestEvent() *event.PromotionFailed {
+	return &event.PromotionFailed{
+		Common: event.Common{
+			Project: "fake-project",
+			Message: "Promotion failed",
+			ID:      "fake-id",
+		},
+		Promotion: event.Promotion{
+			Name:      "fake-promotion",
+			StageName: "fake-stage",
+		},
+	}
+}
+
+func TestNewNotifier(t *testing.T) {
+	testSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-secret"},
+		Data: map[string][]byte{
+			SecretKeyURL:           []byte("https://example.com/hook"),
+			SecretKeyAuthorization: []byte("Bearer fake-token"),
+		},
+	}
+	testCases := []struct {
+		name       string
+		cfg        kargoapi.NotificationConfig
+		secret     *corev1.Secret
+		assertions func(*testing.T, Notifier, error)
+	}{
+		{
+			name: "nil secret",
+			cfg: kargoapi.NotificationConfig{
+				Slack: &kargoapi.SlackNotificationConfig{},
+			},
+			assertions: func(t *testing.T, _ Notifier, err error) {
+				require.ErrorContains(t, err, "secret is nil")
+			},
+		},
+		{
+			name: "secret missing URL",
+			cfg: kargoapi.NotificationConfig{
+				Slack: &kargoapi.SlackNotificationConfig{},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-secret"},
+			},
+			assertions: func(t *testing.T, _ Notifier, err error) {
+				require.ErrorContains(t, err, `has no value for key "url"`)
+			},
+		},
+		{
+			name:   "no target",
+			cfg:    kargoapi.NotificationConfig{Name: "fake-notification"},
+			secret: testSecret,
+			assertions: func(t *testing.T, _ Notifier, err error) {
+				require.ErrorContains(t, err, "does not specify a supported target")
+			},
+		},
+		{
+			name: "slack",
+			cfg: kargoapi.NotificationConfig{
+				Slack: &kargoapi.SlackNotificationConfig{},
+			},
+			secret: testSecret,
+			assertions: func(t *testing.T, n Notifier, err error) {
+				require.NoError(t, err)
+				s, ok := n.(*slackNotifier)
+				require.True(t, ok)
+				require.Equal(t, "https://example.com/hook", s.url)
+				require.Empty(t, s.authorization)
+			},
+		},
+		{
+			name: "teams",
+			cfg: kargoapi.NotificationConfig{
+				Teams: &kargoapi.TeamsNotificationConfig{},
+			},
+			secret: testSecret,
+			assertions: func(t *testing.T, n Notifier, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &teamsNotifier{}, n)
+			},
+		},
+		{
+			name: "webhook",
+			cfg: kargoapi.NotificationConfig{
+				Webhook: &kargoapi.WebhookNotificationConfig{},
+			},
+			secret: testSecret,
+			assertions: func(t *testing.T, n Notifier, err error) {
+				require.NoError(t, err)
+				w, ok := n.(*webhookNotifier)
+				require.True(t, ok)
+				require.Equal(t, "Bearer fake-token", w.authorization)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			n, err := NewNotifier(testCase.cfg, testCase.secret)
+			testCase.assertions(t, n, err)
+		})
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	require.False(t, IsTerminal(errors.New("boom")))
+	require.True(t, IsTerminal(&terminalError{err: errors.New("bad request")}))
+	require.True(t, IsTerminal(fmt.Errorf("wrapped: %w", &terminalError{err: errors.New("bad request")})))
+}
+
+func TestMaxAttempts(t *testing.T) {
+	require.Equal(t, int32(defaultMaxAttempts), MaxAttempts(kargoapi.NotificationConfig{}))
+	require.Equal(t, int32(5), MaxAttempts(kargoapi.NotificationConfig{MaxAttempts: 5}))
+}
+
+func TestRetryDelay(t *testing.T) {
+	testCases := []struct {
+		failedAttempts int32
+		expected       time.Duration
+	}{
+		{failedAttempts: 1, expected: time.Second},
+		{failedAttempts: 2, expected: 2 * time.Second},
+		{failedAttempts: 3, expected: 4 * time.Second},
+		{failedAttempts: 5, expected: 16 * time.Second},
+		{failedAttempts: 6, expected: 30 * time.Second},
+		{failedAttempts: 10, expected: 30 * time.Second},
+	}
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprint(testCase.failedAttempts), func(t *testing.T) {
+			require.Equal(t, testCase.expected, RetryDelay(testCase.failedAttempts))
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     kargoapi.NotificationConfig
+		matches bool
+	}{
+		{
+			name:    "no filters",
+			matches: true,
+		},
+		{
+			name: "event type does not match",
+			cfg: kargoapi.NotificationConfig{
+				EventTypes: []kargoapi.EventType{kargoapi.EventTypePromotionSucceeded},
+			},
+			matches: false,
+		},
+		{
+			name: "stage does not match",
+			cfg: kargoapi.NotificationConfig{
+				EventTypes: []kargoapi.EventType{kargoapi.EventTypePromotionFailed},
+				Stages:     []string{"other-stage"},
+			},
+			matches: false,
+		},
+		{
+			name: "event type and stage match",
+			cfg: kargoapi.NotificationConfig{
+				EventTypes: []kargoapi.EventType{kargoapi.EventTypePromotionFailed},
+				Stages:     []string{"fake-stage"},
+			},
+			matches: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.matches, Matches(testCase.cfg, newTestEvent()))
+		})
+	}
+}
+
+func TestNotifiers(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		notifier   func(*poster) Notifier
+		assertions func(*testing.T, *http.Request, map[string]any, error)
+	}{
+		{
+			name:       "slack with default text",
+			statusCode: http.StatusOK,
+			notifier: func(p *poster) Notifier {
+				return &slackNotifier{poster: p}
+			},
+			assertions: func(t *testing.T, _ *http.Request, body map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`[fake-project] PromotionFailed: Promotion "fake-promotion": Promotion failed`,
+					body["text"],
+				)
+			},
+		},
+		{
+			name:       "slack with templated text",
+			statusCode: http.StatusOK,
+			notifier: func(p *poster) Notifier {
+				return &slackNotifier{
+					poster: p,
+					text:   "Promotion to ${{ event.stageName }} failed",
+				}
+			},
+			assertions: func(t *testing.T, _ *http.Request, body map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Promotion to fake-stage failed", body["text"])
+			},
+		},
+		{
+			name:       "teams",
+			statusCode: http.StatusOK,
+			notifier: func(p *poster) Notifier {
+				return &teamsNotifier{poster: p}
+			},
+			assertions: func(t *testing.T, _ *http.Request, body map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "MessageCard", body["@type"])
+				require.Equal(t, "PromotionFailed in Project fake-project", body["title"])
+			},
+		},
+		{
+			name:       "webhook with default body",
+			statusCode: http.StatusNoContent,
+			notifier: func(p *poster) Notifier {
+				p.authorization = "Bearer fake-token"
+				return &webhookNotifier{poster: p}
+			},
+			assertions: func(t *testing.T, req *http.Request, body map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Bearer fake-token", req.Header.Get("Authorization"))
+				require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+				require.Equal(t, "PromotionFailed", body["type"])
+				require.Equal(t, "Promotion", body["kind"])
+				require.Equal(t, "fake-stage", body["stageName"])
+			},
+		},
+		{
+			name:       "webhook with templated body",
+			statusCode: http.StatusOK,
+			notifier: func(p *poster) Notifier {
+				return &webhookNotifier{
+					poster: p,
+					body:   `{"summary": "${{ event.type }}", "stage": "${{ event.stageName }}"}`,
+				}
+			},
+			assertions: func(t *testing.T, _ *http.Request, body map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{"summary": "PromotionFailed", "stage": "fake-stage"},
+					body,
+				)
+			},
+		},
+		{
+			name:       "retryable error",
+			statusCode: http.StatusServiceUnavailable,
+			notifier: func(p *poster) Notifier {
+				return &slackNotifier{poster: p}
+			},
+			assertions: func(t *testing.T, _ *http.Request, _ map[string]any, err error) {
+				require.ErrorContains(t, err, "received unexpected status code 503")
+				var termErr *terminalError
+				require.False(t, errors.As(err, &termErr))
+			},
+		},
+		{
+			name:       "terminal error",
+			statusCode: http.StatusNotFound,
+			notifier: func(p *poster) Notifier {
+				return &slackNotifier{poster: p}
+			},
+			assertions: func(t *testing.T, _ *http.Request, _ map[string]any, err error) {
+				require.ErrorContains(t, err, "received unexpected status code 404")
+				var termErr *terminalError
+				require.True(t, errors.As(err, &termErr))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var req *http.Request
+			var body map[string]any
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req = r
+				b, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(b, &body))
+				w.WriteHeader(testCase.statusCode)
+			}))
+			t.Cleanup(srv.Close)
+			n := testCase.notifier(newPoster(srv.URL, "", false))
+			err := n.Notify(context.Background(), newTestEvent())
+			testCase.assertions(t, req, body, err)
+		})
+	}
+}
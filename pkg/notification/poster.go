@@ -0,0 +1,84 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// maxResponseBodySize is the maximum number of bytes of an unsuccessful
+// response's body that will be included in an error message.
+const maxResponseBodySize = 1 << 10
+
+// poster POSTs JSON payloads to a single URL.
+type poster struct {
+	url           string
+	authorization string
+	httpClient    *http.Client
+}
+
+// newPoster returns a poster for the provided URL. If authorization is
+// non-empty, it is used as the value of the Authorization header of each
+// request.
+func newPoster(url, authorization string, insecureSkipTLSVerify bool) *poster {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	return &poster{
+		url:           url,
+		authorization: authorization,
+		httpClient: &http.Client{
+			Transport: httpTransport,
+			Timeout:   30 * time.Second,
+		},
+	}
+}
+
+// post POSTs the provided JSON payload. Responses with a 429 or 5xx status code
+// result in a retryable error. Any other unsuccessful response results in a
+// terminalError.
+func (p *poster) post(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		p.url,
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return &terminalError{err: fmt.Errorf("error creating request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.authorization != "" {
+		req.Header.Set("Authorization", p.authorization)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	err = fmt.Errorf(
+		"received unexpected status code %d: %s",
+		resp.StatusCode, bytes.TrimSpace(body),
+	)
+	if resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError {
+		return err
+	}
+	return &terminalError{err: err}
+}
@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/akuity/kargo/pkg/event"
+)
+
+// slackNotifier is a Notifier that delivers notifications to a Slack incoming
+// webhook.
+type slackNotifier struct {
+	*poster
+	text string
+}
+
+// slackMessage is the payload of a message sent to a Slack incoming webhook.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// Notify implements Notifier.
+func (s *slackNotifier) Notify(ctx context.Context, evt event.Meta) error {
+	text, err := renderText(s.text, evt)
+	if err != nil {
+		return &terminalError{err: err}
+	}
+	payload, err := json.Marshal(slackMessage{Text: text})
+	if err != nil {
+		return &terminalError{err: fmt.Errorf("error marshaling Slack message: %w", err)}
+	}
+	return s.post(ctx, payload)
+}
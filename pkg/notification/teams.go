@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/akuity/kargo/pkg/event"
+)
+
+// teamsNotifier is a Notifier that delivers notifications to a Microsoft Teams
+// incoming webhook.
+type teamsNotifier struct {
+	*poster
+	text string
+}
+
+// teamsMessageCard is the payload of a legacy actionable message card, which
+// is accepted by Microsoft Teams incoming webhooks.
+type teamsMessageCard struct {
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Text    string `json:"text"`
+}
+
+// Notify implements Notifier.
+func (t *teamsNotifier) Notify(ctx context.Context, evt event.Meta) error {
+	text, err := renderText(t.text, evt)
+	if err != nil {
+		return &terminalError{err: err}
+	}
+	payload, err := json.Marshal(teamsMessageCard{
+		Type:    "MessageCard",
+		Context: "https://schema.org/extensions",
+		Summary: string(evt.Type()),
+		Title:   fmt.Sprintf("%s in Project %s", evt.Type(), evt.GetProject()),
+		Text:    text,
+	})
+	if err != nil {
+		return &terminalError{err: fmt.Errorf("error marshaling Teams message: %w", err)}
+	}
+	return t.post(ctx, payload)
+}
@@ -0,0 +1,35 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/expressions"
+)
+
+// webhookNotifier is a Notifier that POSTs notifications as JSON to an
+// arbitrary HTTP endpoint.
+type webhookNotifier struct {
+	*poster
+	body string
+}
+
+// Notify implements Notifier.
+func (w *webhookNotifier) Notify(ctx context.Context, evt event.Meta) error {
+	env, err := newEnv(evt)
+	if err != nil {
+		return &terminalError{err: err}
+	}
+	var payload []byte
+	if w.body == "" {
+		payload, err = json.Marshal(env["event"])
+	} else {
+		payload, err = expressions.EvaluateJSONTemplate([]byte(w.body), env)
+	}
+	if err != nil {
+		return &terminalError{err: fmt.Errorf("error rendering request body: %w", err)}
+	}
+	return w.post(ctx, payload)
+}
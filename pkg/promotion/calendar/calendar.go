@@ -0,0 +1,175 @@
+// Package calendar evaluates the PromotionCalendars that govern when Freight
+// may be promoted to a Stage.
+package calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Time zones must be resolvable even without system tzdata
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/pattern"
+)
+
+// MaxWindowDuration is the maximum Duration of a PromotionWindow.
+const MaxWindowDuration = 31 * 24 * time.Hour
+
+// ForStage returns a PromotionCalendar combining the windows and freezes
+// defined by the provided Stage with those of every PromotionCalendarPolicy
+// in the Stage's ProjectConfig that selects the Stage.
+func ForStage(
+	ctx context.Context,
+	c client.Client,
+	stage *kargoapi.Stage,
+) (*kargoapi.PromotionCalendar, error) {
+	cal := &kargoapi.PromotionCalendar{}
+	if stage.Spec.PromotionCalendar != nil {
+		cal.Windows = append(cal.Windows, stage.Spec.PromotionCalendar.Windows...)
+		cal.Freezes = append(cal.Freezes, stage.Spec.PromotionCalendar.Freezes...)
+	}
+	projectCfg, err := api.GetProjectConfig(ctx, c, stage.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if projectCfg == nil {
+		return cal, nil
+	}
+	for _, policy := range projectCfg.Spec.PromotionCalendars {
+		selected, err := selectsStage(policy.StageSelector, stage)
+		if err != nil {
+			return nil, err
+		}
+		if selected {
+			cal.Windows = append(cal.Windows, policy.Windows...)
+			cal.Freezes = append(cal.Freezes, policy.Freezes...)
+		}
+	}
+	return cal, nil
+}
+
+// selectsStage returns whether the provided selector matches the provided
+// Stage. A nil selector matches all Stages.
+func selectsStage(
+	selector *kargoapi.PromotionPolicySelector,
+	stage *kargoapi.Stage,
+) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+	if selector.Name != "" {
+		m, err := pattern.ParseNamePattern(selector.Name)
+		if err != nil {
+			return false, fmt.Errorf(
+				"error parsing Stage name pattern %q: %w", selector.Name, err,
+			)
+		}
+		if !m.Matches(stage.Name) {
+			return false, nil
+		}
+	}
+	if selector.LabelSelector != nil {
+		s, err := metav1.LabelSelectorAsSelector(selector.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("error parsing Stage label selector: %w", err)
+		}
+		if !s.Matches(labels.Set(stage.Labels)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Check returns a non-empty, human-readable reason if the provided
+// PromotionCalendar does not allow promotions at the provided time. Freight
+// may be promoted only if no freeze is in effect, no "Deny" window is open,
+// and, if any "Allow" windows are defined, at least one of them is open. An
+// error is returned if any window is invalid.
+func Check(cal *kargoapi.PromotionCalendar, now time.Time) (string, error) {
+	if cal == nil {
+		return "", nil
+	}
+	for _, freeze := range cal.Freezes {
+		if freeze.Start != nil && now.Before(freeze.Start.Time) {
+			continue
+		}
+		if now.Before(freeze.End.Time) {
+			return fmt.Sprintf(
+				"promotions are frozen until %s: %s",
+				freeze.End.UTC().Format(time.RFC3339),
+				freeze.Reason,
+			), nil
+		}
+	}
+	var hasAllowWindows, inAllowWindow bool
+	for _, window := range cal.Windows {
+		end, open, err := isOpen(window, now)
+		if err != nil {
+			return "", err
+		}
+		switch window.Kind {
+		case kargoapi.PromotionWindowKindDeny:
+			if open {
+				return fmt.Sprintf(
+					"promotions are denied by window %q until %s",
+					window.Schedule,
+					end.UTC().Format(time.RFC3339),
+				), nil
+			}
+		default:
+			hasAllowWindows = true
+			inAllowWindow = inAllowWindow || open
+		}
+	}
+	if hasAllowWindows && !inAllowWindow {
+		return "promotions are only allowed during Allow windows and none are open", nil
+	}
+	return "", nil
+}
+
+// isOpen returns whether the provided window is open at the provided time
+// and, if so, when it will close.
+func isOpen(window kargoapi.PromotionWindow, now time.Time) (time.Time, bool, error) {
+	sched, loc, err := parseWindow(window)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	start, ok := sched.lastStart(now.In(loc), window.Duration.Duration)
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	return start.Add(window.Duration.Duration), true, nil
+}
+
+// ValidateWindow returns an error if the provided window's schedule, duration,
+// or time zone is invalid.
+func ValidateWindow(window kargoapi.PromotionWindow) error {
+	_, _, err := parseWindow(window)
+	return err
+}
+
+func parseWindow(window kargoapi.PromotionWindow) (*schedule, *time.Location, error) {
+	sched, err := parseSchedule(window.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	if window.Duration.Duration <= 0 {
+		return nil, nil, errors.New("duration must be greater than zero")
+	}
+	if window.Duration.Duration > MaxWindowDuration {
+		return nil, nil, fmt.Errorf("duration must not exceed %s", MaxWindowDuration)
+	}
+	loc := time.UTC
+	if window.TimeZone != "" {
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("invalid time zone %q: %w", window.TimeZone, err)
+		}
+	}
+	return sched, loc, nil
+}
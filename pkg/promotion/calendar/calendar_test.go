@@ -0,0 +1,248 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestForStage(t *testing.T) {
+	const testProject = "fake-project"
+
+	stageWindow := kargoapi.PromotionWindow{
+		Kind:     kargoapi.PromotionWindowKindAllow,
+		Schedule: "0 9 * * MON-FRI",
+		Duration: metav1.Duration{Duration: 8 * time.Hour},
+	}
+	projectFreeze := kargoapi.PromotionFreeze{Reason: "Holidays"}
+	prodWindow := kargoapi.PromotionWindow{
+		Kind:     kargoapi.PromotionWindowKindDeny,
+		Schedule: "0 17 * * FRI",
+		Duration: metav1.Duration{Duration: 64 * time.Hour},
+	}
+
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "test",
+			Labels:    map[string]string{"env": "test"},
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionCalendar: &kargoapi.PromotionCalendar{
+				Windows: []kargoapi.PromotionWindow{stageWindow},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	t.Run("no ProjectConfig", func(t *testing.T) {
+		cal, err := ForStage(
+			context.Background(),
+			fake.NewClientBuilder().WithScheme(scheme).Build(),
+			stage,
+		)
+		require.NoError(t, err)
+		require.Equal(t, []kargoapi.PromotionWindow{stageWindow}, cal.Windows)
+		require.Empty(t, cal.Freezes)
+	})
+
+	t.Run("with ProjectConfig", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      testProject,
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionCalendars: []kargoapi.PromotionCalendarPolicy{
+						{
+							// Selects all Stages
+							Freezes: []kargoapi.PromotionFreeze{projectFreeze},
+						},
+						{
+							StageSelector: &kargoapi.PromotionPolicySelector{
+								Name: "glob:prod-*",
+							},
+							Windows: []kargoapi.PromotionWindow{prodWindow},
+						},
+						{
+							StageSelector: &kargoapi.PromotionPolicySelector{
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"env": "test"},
+								},
+							},
+							Windows: []kargoapi.PromotionWindow{prodWindow},
+						},
+					},
+				},
+			},
+		).Build()
+		cal, err := ForStage(context.Background(), c, stage)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]kargoapi.PromotionWindow{stageWindow, prodWindow},
+			cal.Windows,
+		)
+		require.Equal(t, []kargoapi.PromotionFreeze{projectFreeze}, cal.Freezes)
+	})
+}
+
+func TestCheck(t *testing.T) {
+	// 2025-01-06 was a Monday
+	mondayNoon := time.Date(2025, time.January, 6, 12, 0, 0, 0, time.UTC)
+
+	businessHours := kargoapi.PromotionWindow{
+		Kind:     kargoapi.PromotionWindowKindAllow,
+		Schedule: "0 9 * * MON-FRI",
+		Duration: metav1.Duration{Duration: 8 * time.Hour},
+		TimeZone: "America/New_York",
+	}
+
+	testCases := []struct {
+		name       string
+		cal        *kargoapi.PromotionCalendar
+		now        time.Time
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "nil calendar",
+			now:  mondayNoon,
+			assertions: func(t *testing.T, reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name: "freeze in effect",
+			cal: &kargoapi.PromotionCalendar{
+				Freezes: []kargoapi.PromotionFreeze{{
+					Reason: "Incident 123",
+					End:    metav1.NewTime(mondayNoon.Add(time.Hour)),
+				}},
+			},
+			now: mondayNoon,
+			assertions: func(t *testing.T, reason string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"promotions are frozen until 2025-01-06T13:00:00Z: Incident 123",
+					reason,
+				)
+			},
+		},
+		{
+			name: "freeze not yet in effect or already over",
+			cal: &kargoapi.PromotionCalendar{
+				Freezes: []kargoapi.PromotionFreeze{
+					{
+						Reason: "Holidays",
+						Start:  &metav1.Time{Time: mondayNoon.Add(time.Hour)},
+						End:    metav1.NewTime(mondayNoon.Add(2 * time.Hour)),
+					},
+					{
+						Reason: "Incident 123",
+						End:    metav1.NewTime(mondayNoon),
+					},
+				},
+			},
+			now: mondayNoon,
+			assertions: func(t *testing.T, reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name: "deny window open",
+			cal: &kargoapi.PromotionCalendar{
+				Windows: []kargoapi.PromotionWindow{{
+					Kind:     kargoapi.PromotionWindowKindDeny,
+					Schedule: "30 11 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				}},
+			},
+			now: mondayNoon,
+			assertions: func(t *testing.T, reason string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`promotions are denied by window "30 11 * * *" until 2025-01-06T12:30:00Z`,
+					reason,
+				)
+			},
+		},
+		{
+			name: "inside allow window in another time zone",
+			cal: &kargoapi.PromotionCalendar{
+				Windows: []kargoapi.PromotionWindow{businessHours},
+			},
+			// 9:30 AM in New York
+			now: mondayNoon.Add(2*time.Hour + 30*time.Minute),
+			assertions: func(t *testing.T, reason string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, reason)
+			},
+		},
+		{
+			name: "outside allow window",
+			cal: &kargoapi.PromotionCalendar{
+				Windows: []kargoapi.PromotionWindow{businessHours},
+			},
+			// 7 AM in New York
+			now: mondayNoon,
+			assertions: func(t *testing.T, reason string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, reason, "only allowed during Allow windows")
+			},
+		},
+		{
+			name: "invalid window",
+			cal: &kargoapi.PromotionCalendar{
+				Windows: []kargoapi.PromotionWindow{{
+					Kind:     kargoapi.PromotionWindowKindDeny,
+					Schedule: "* * * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+					TimeZone: "Mars/Olympus_Mons",
+				}},
+			},
+			now: mondayNoon,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "invalid time zone")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reason, err := Check(testCase.cal, testCase.now)
+			testCase.assertions(t, reason, err)
+		})
+	}
+}
+
+func TestValidateWindow(t *testing.T) {
+	require.NoError(t, ValidateWindow(kargoapi.PromotionWindow{
+		Schedule: "0 9 * * MON-FRI",
+		Duration: metav1.Duration{Duration: time.Hour},
+		TimeZone: "Europe/Berlin",
+	}))
+	require.ErrorContains(t, ValidateWindow(kargoapi.PromotionWindow{
+		Schedule: "0 9 * * MON-FRI",
+	}), "duration must be greater than zero")
+	require.ErrorContains(t, ValidateWindow(kargoapi.PromotionWindow{
+		Schedule: "0 9 * * MON-FRI",
+		Duration: metav1.Duration{Duration: MaxWindowDuration + time.Minute},
+	}), "duration must not exceed")
+	require.ErrorContains(t, ValidateWindow(kargoapi.PromotionWindow{
+		Schedule: "bogus",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}), "must have exactly five fields")
+}
@@ -0,0 +1,177 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// schedule is a parsed, standard, five field cron expression.
+type schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domStar and dowStar record whether the day of month and day of week
+	// fields were unrestricted. Per cron convention, when both are restricted,
+	// a time matches if EITHER matches.
+	domStar bool
+	dowStar bool
+}
+
+// scheduleField describes the bounds and any symbolic names accepted by one
+// field of a cron expression.
+type scheduleField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = scheduleField{name: "minute", min: 0, max: 59}
+	hourField   = scheduleField{name: "hour", min: 0, max: 23}
+	domField    = scheduleField{name: "day of month", min: 1, max: 31}
+	monthField  = scheduleField{
+		name: "month",
+		min:  1,
+		max:  12,
+		names: map[string]int{
+			"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+			"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+		},
+	}
+	// Both 0 and 7 are accepted for Sunday. 7 is folded into 0 after parsing.
+	dowField = scheduleField{
+		name: "day of week",
+		min:  0,
+		max:  7,
+		names: map[string]int{
+			"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+		},
+	}
+)
+
+// parseSchedule parses a standard, five field cron expression. Each field may
+// be "*", a single value, a range ("1-5"), or a comma-separated list of these,
+// optionally followed by a step ("*/15" or "0-30/10"). Months and days of the
+// week may be given by their three letter English abbreviations.
+func parseSchedule(expr string) (*schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf(
+			"schedule %q must have exactly five fields; found %d", expr, len(fields),
+		)
+	}
+	s := &schedule{}
+	var err error
+	if s.minute, _, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dom, s.domStar, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dow, s.dowStar, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow = (s.dow &^ (1 << 7)) | 1
+	}
+	return s, nil
+}
+
+// parse parses a single field of a cron expression into a bitset of the
+// values it matches. It also returns whether the field was unrestricted.
+func (f scheduleField) parse(expr string) (uint64, bool, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, false, fmt.Errorf(
+					"invalid step %q in %s field %q", stepExpr, f.name, expr,
+				)
+			}
+		}
+		start, end := f.min, f.max
+		if rangeExpr != "*" {
+			startExpr, endExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if start, err = f.value(startExpr); err != nil {
+				return 0, false, fmt.Errorf("%w in %s field %q", err, f.name, expr)
+			}
+			end = start
+			if isRange {
+				if end, err = f.value(endExpr); err != nil {
+					return 0, false, fmt.Errorf("%w in %s field %q", err, f.name, expr)
+				}
+			} else if hasStep {
+				// e.g. "5/15" means every 15 starting at 5
+				end = f.max
+			}
+			if end < start {
+				return 0, false, fmt.Errorf(
+					"invalid range %q in %s field %q", rangeExpr, f.name, expr,
+				)
+			}
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v) // nolint: gosec
+		}
+	}
+	return bits, expr == "*", nil
+}
+
+// value parses a single value of a field, which may be a number or, for some
+// fields, a name.
+func (f scheduleField) value(expr string) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q", expr)
+	}
+	return v, nil
+}
+
+// matches returns whether the provided time, truncated to the minute, matches
+// the schedule. The time is evaluated in its own location.
+func (s *schedule) matches(t time.Time) bool {
+	if s.minute&(1<<uint(t.Minute())) == 0 || // nolint: gosec
+		s.hour&(1<<uint(t.Hour())) == 0 || // nolint: gosec
+		s.month&(1<<uint(t.Month())) == 0 { // nolint: gosec
+		return false
+	}
+	domMatch := s.dom&(1<<uint(t.Day())) != 0     // nolint: gosec
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0 // nolint: gosec
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// lastStart returns the most recent time, no later than t and strictly later
+// than t minus the provided lookback, at which the schedule matched. If there
+// was no such time, false is returned.
+func (s *schedule) lastStart(t time.Time, lookback time.Duration) (time.Time, bool) {
+	cur := t.Truncate(time.Minute)
+	earliest := t.Add(-lookback)
+	for cur.After(earliest) {
+		if s.matches(cur) {
+			return cur, true
+		}
+		cur = cur.Add(-time.Minute)
+	}
+	return time.Time{}, false
+}
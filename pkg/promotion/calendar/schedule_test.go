@@ -0,0 +1,146 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSchedule(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expr       string
+		assertions func(*testing.T, *schedule, error)
+	}{
+		{
+			name: "wrong number of fields",
+			expr: "0 9 * *",
+			assertions: func(t *testing.T, _ *schedule, err error) {
+				require.ErrorContains(t, err, "must have exactly five fields")
+			},
+		},
+		{
+			name: "value out of range",
+			expr: "60 * * * *",
+			assertions: func(t *testing.T, _ *schedule, err error) {
+				require.ErrorContains(t, err, `invalid value "60" in minute field`)
+			},
+		},
+		{
+			name: "invalid step",
+			expr: "*/0 * * * *",
+			assertions: func(t *testing.T, _ *schedule, err error) {
+				require.ErrorContains(t, err, `invalid step "0" in minute field`)
+			},
+		},
+		{
+			name: "inverted range",
+			expr: "* 17-9 * * *",
+			assertions: func(t *testing.T, _ *schedule, err error) {
+				require.ErrorContains(t, err, `invalid range "17-9" in hour field`)
+			},
+		},
+		{
+			name: "unknown name",
+			expr: "* * * * FUN",
+			assertions: func(t *testing.T, _ *schedule, err error) {
+				require.ErrorContains(t, err, `invalid value "FUN" in day of week field`)
+			},
+		},
+		{
+			name: "lists, ranges, steps, and names",
+			expr: "0,30 9-17/4 * jan-MAR MON-FRI",
+			assertions: func(t *testing.T, s *schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint64(1|1<<30), s.minute)
+				require.Equal(t, uint64(1<<9|1<<13|1<<17), s.hour)
+				require.Equal(t, uint64(1<<1|1<<2|1<<3), s.month)
+				require.Equal(t, uint64(1<<1|1<<2|1<<3|1<<4|1<<5), s.dow)
+				require.True(t, s.domStar)
+				require.False(t, s.dowStar)
+			},
+		},
+		{
+			name: "7 is Sunday",
+			expr: "0 0 * * 7",
+			assertions: func(t *testing.T, s *schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), s.dow)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := parseSchedule(testCase.expr)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func Test_schedule_matches(t *testing.T) {
+	// 2025-01-06 was a Monday
+	monday9am := time.Date(2025, time.January, 6, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		expr    string
+		t       time.Time
+		matches bool
+	}{
+		{
+			name:    "weekday morning matches",
+			expr:    "0 9 * * MON-FRI",
+			t:       monday9am,
+			matches: true,
+		},
+		{
+			name:    "weekend morning does not match",
+			expr:    "0 9 * * MON-FRI",
+			t:       monday9am.AddDate(0, 0, -1),
+			matches: false,
+		},
+		{
+			name:    "wrong minute does not match",
+			expr:    "0 9 * * MON-FRI",
+			t:       monday9am.Add(time.Minute),
+			matches: false,
+		},
+		{
+			name:    "either day of month or day of week matches",
+			expr:    "0 9 1 * SUN",
+			t:       monday9am.AddDate(0, 0, -1),
+			matches: true,
+		},
+		{
+			name:    "neither day of month nor day of week matches",
+			expr:    "0 9 1 * SUN",
+			t:       monday9am,
+			matches: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := parseSchedule(testCase.expr)
+			require.NoError(t, err)
+			require.Equal(t, testCase.matches, s.matches(testCase.t))
+		})
+	}
+}
+
+func Test_schedule_lastStart(t *testing.T) {
+	s, err := parseSchedule("0 9 * * *")
+	require.NoError(t, err)
+	nineAM := time.Date(2025, time.January, 6, 9, 0, 0, 0, time.UTC)
+
+	start, ok := s.lastStart(nineAM.Add(90*time.Minute), 2*time.Hour)
+	require.True(t, ok)
+	require.Equal(t, nineAM, start)
+
+	// The window closes exactly at the end of the lookback
+	_, ok = s.lastStart(nineAM.Add(2*time.Hour), 2*time.Hour)
+	require.False(t, ok)
+
+	_, ok = s.lastStart(nineAM.Add(-time.Minute), 2*time.Hour)
+	require.False(t, ok)
+}
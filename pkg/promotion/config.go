@@ -2,7 +2,10 @@ package promotion
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
+	"github.com/xeipuuv/gojsonschema"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -49,3 +52,26 @@ func ConfigToStruct[T any](c Config) (T, error) {
 
 	return result, nil
 }
+
+// ValidateConfig validates the given configuration against the JSON schema
+// provided by the schema loader. It returns an error if the validation fails or
+// if there is an error during validation. The step kind is only used to provide
+// context in the returned error.
+func ValidateConfig(
+	schemaLoader gojsonschema.JSONLoader,
+	config Config,
+	stepKind string,
+) error {
+	result, err := gojsonschema.Validate(schemaLoader, gojsonschema.NewGoLoader(config))
+	if err != nil {
+		return fmt.Errorf("could not validate %s config: %w", stepKind, err)
+	}
+	if !result.Valid() {
+		errs := make([]error, len(result.Errors()))
+		for i, err := range result.Errors() {
+			errs[i] = errors.New(err.String())
+		}
+		return fmt.Errorf("invalid %s config: %w", stepKind, errors.Join(errs...))
+	}
+	return nil
+}
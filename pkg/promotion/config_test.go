@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 func TestConfig_DeepCopy(t *testing.T) {
@@ -139,3 +140,50 @@ func TestConfig_ToJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateConfig(t *testing.T) {
+	schemaLoader := gojsonschema.NewStringLoader(`{
+		"type": "object",
+		"additionalProperties": false,
+		"required": ["path"],
+		"properties": {
+			"path": {"type": "string", "minLength": 1}
+		}
+	}`)
+
+	tests := []struct {
+		name       string
+		config     Config
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:   "valid config",
+			config: Config{"path": "foo"},
+			assertions: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "missing required field",
+			config: Config{},
+			assertions: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "invalid fake-step config")
+				assert.ErrorContains(t, err, "path is required")
+			},
+		},
+		{
+			name:   "unexpected field",
+			config: Config{"path": "foo", "bar": true},
+			assertions: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "invalid fake-step config")
+				assert.ErrorContains(t, err, "Additional property bar is not allowed")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.assertions(t, ValidateConfig(schemaLoader, tt.config, "fake-step"))
+		})
+	}
+}
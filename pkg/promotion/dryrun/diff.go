@@ -0,0 +1,164 @@
+package dryrun
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// bareRepoDirPrefix is the prefix of the directories in the working directory
+// that hold the bare clones of the repositories cloned by git-clone steps.
+const bareRepoDirPrefix = "repo-"
+
+// FileDiff describes the changes made to a single file in the working
+// directory.
+type FileDiff struct {
+	// Path is the path of the file, relative to the working directory.
+	Path string
+	// Diff is the unified diff of the changes made to the file.
+	Diff string
+}
+
+// snapshot holds the contents of the files in a directory, by path relative
+// to the directory.
+type snapshot map[string][]byte
+
+// takeSnapshot returns a snapshot of the files in the provided directory.
+// Git metadata, including the bare repositories cloned by git-clone steps,
+// is excluded.
+func takeSnapshot(dir string) (snapshot, error) {
+	s := snapshot{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Name() == ".git" {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if filepath.Dir(rel) == "." && strings.HasPrefix(d.Name(), bareRepoDirPrefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		if s[filepath.ToSlash(rel)], err = os.ReadFile(path); err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading files in %s: %w", dir, err)
+	}
+	return s, nil
+}
+
+// updateBaseline adds the files in the provided directory that are not yet
+// part of the baseline to it.
+func (r *recorder) updateBaseline(dir string) error {
+	s, err := takeSnapshot(dir)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.baseline == nil {
+		r.baseline = snapshot{}
+	}
+	for path, content := range s {
+		if _, ok := r.baseline[path]; !ok {
+			r.baseline[path] = content
+		}
+	}
+	return nil
+}
+
+// diff returns the changes made to the files in the provided directory since
+// they became part of the baseline.
+func (r *recorder) diff(dir string) ([]FileDiff, error) {
+	current, err := takeSnapshot(dir)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	paths := make([]string, 0, len(current)+len(r.baseline))
+	for path := range current {
+		paths = append(paths, path)
+	}
+	for path := range r.baseline {
+		if _, ok := current[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	var diffs []FileDiff
+	for _, path := range paths {
+		before, existed := r.baseline[path]
+		after, exists := current[path]
+		if existed && exists && bytes.Equal(before, after) {
+			continue
+		}
+		fromFile, toFile := "a/"+path, "b/"+path
+		if !existed {
+			fromFile = "/dev/null"
+		}
+		if !exists {
+			toFile = "/dev/null"
+		}
+		if isBinary(before) || isBinary(after) {
+			diffs = append(diffs, FileDiff{
+				Path: path,
+				Diff: fmt.Sprintf("Binary files %s and %s differ\n", fromFile, toFile),
+			})
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(before),
+			B:        splitLines(after),
+			FromFile: fromFile,
+			ToFile:   toFile,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error computing diff of %s: %w", path, err)
+		}
+		diffs = append(diffs, FileDiff{Path: path, Diff: diff})
+	}
+	return diffs, nil
+}
+
+// splitLines splits the provided content into lines, each of which retains
+// its line ending.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// isBinary returns true if the provided content appears to be binary.
+func isBinary(content []byte) bool {
+	return bytes.IndexByte(content, 0) >= 0
+}
@@ -0,0 +1,416 @@
+// Package dryrun provides support for executing the steps of a Promotion
+// locally, without a Kargo control plane, for the purpose of testing a
+// promotion template or PromotionTask.
+//
+// A dry run builds a Promotion from a Stage (or a PromotionTask) and the
+// Freight to promote, and executes its steps using a promotion.LocalEngine in
+// a temporary working directory. Steps with side effects outside of that
+// working directory (e.g. pushing to a Git repository or updating an Argo CD
+// Application) are stubbed: their configuration is evaluated and recorded,
+// but they are not executed. Only the kinds of steps known to be confined to
+// the working directory are executed; steps of any other kind, including
+// those of plugins, are stubbed. The configuration and output of every step, as
+// well as the changes made to the files in the working directory, are
+// returned in a Report.
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/kargo"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+const (
+	// promotionName is the name of the Promotion built for a dry run.
+	promotionName = "dry-run"
+
+	// actor is the actor of the Promotion built for a dry run.
+	actor = "dry-run"
+
+	// taskStepAlias is the alias of the step referencing the PromotionTask
+	// that is executed when a dry run targets a PromotionTask.
+	taskStepAlias = "task"
+
+	// defaultRetryAfter is the interval after which a Promotion with a step
+	// that is still running is resumed, if the step does not suggest one.
+	defaultRetryAfter = 5 * time.Second
+)
+
+// DefaultExecutedStepKinds are the kinds of the built-in steps whose effects
+// are confined to the working directory of a Promotion, and which are
+// therefore executed by default. Steps of any other kind are stubbed.
+var DefaultExecutedStepKinds = []string{
+	"compose-output",
+	"copy",
+	"delete",
+	"git-clear",
+	"git-clone",
+	"git-commit",
+	"helm-template",
+	"helm-update-chart",
+	"http-download",
+	"json-parse",
+	"json-update",
+	"kustomize-build",
+	"kustomize-set-image",
+	"oci-download",
+	"set-metadata",
+	"untar",
+	"yaml-merge",
+	"yaml-parse",
+	"yaml-update",
+}
+
+// Input is the input of a dry run.
+type Input struct {
+	// Project is the Project in which the Promotion is executed. If not set,
+	// the namespace of the Stage is used.
+	Project string
+	// Stage is the Stage to promote the Freight to. The steps of its
+	// promotion template are executed, unless Task is set.
+	Stage *kargoapi.Stage
+	// Task is a reference to a (Cluster)PromotionTask to execute instead of
+	// the steps of the promotion template of the Stage.
+	Task *kargoapi.PromotionTaskReference
+	// Freight is the Freight to promote. The first Freight is the target
+	// Freight of the Promotion. Any others are made available to the steps
+	// as if they were part of the current Freight of the Stage.
+	Freight []kargoapi.Freight
+	// Vars are additional variables for the Promotion. These take precedence
+	// over the variables of the Stage.
+	Vars []kargoapi.ExpressionVariable
+}
+
+// Options represents options for a Runner.
+type Options struct {
+	// ExecutedStepKinds are the kinds of steps that are executed. Steps of any
+	// other kind are stubbed. If nil, DefaultExecutedStepKinds is used.
+	ExecutedStepKinds []string
+	// StubOutputs are the outputs returned by stubbed steps, by step alias.
+	StubOutputs map[string]map[string]any
+	// CredentialsDB is the database used to look up credentials for
+	// repositories. If nil, no credentials are used.
+	CredentialsDB credentials.Database
+}
+
+// Report is the result of a dry run.
+type Report struct {
+	// Promotion is the Promotion that was built for the dry run, with its
+	// steps inflated.
+	Promotion *kargoapi.Promotion
+	// Result is the final result of the execution of the steps.
+	Result promotion.Result
+	// Steps holds a record of each executed step, in order of execution.
+	Steps []StepRecord
+	// Diff holds the changes made to the files in the working directory,
+	// ordered by path.
+	Diff []FileDiff
+}
+
+// StepRecord is the record of the execution of a single step.
+type StepRecord struct {
+	// Alias is the alias of the step.
+	Alias string
+	// Kind is the kind of the step.
+	Kind string
+	// Section is the section of the Promotion the step is part of.
+	Section promotion.StepSection
+	// Stubbed indicates whether the step was stubbed instead of executed.
+	Stubbed bool
+	// Config is the configuration of the step after expression evaluation.
+	Config promotion.Config
+	// Status is the status the step finished with.
+	Status kargoapi.PromotionStepStatus
+	// Message is the message the step finished with, if any.
+	Message string
+	// Output is the output of the step, if any.
+	Output map[string]any
+	// Err is the error returned by the step, if any.
+	Err error
+}
+
+// Runner executes the steps of a Promotion locally.
+type Runner struct {
+	client      client.Client
+	credsDB     credentials.Database
+	registry    promotion.StepRunnerRegistry
+	executed    []string
+	stubOutputs map[string]map[string]any
+}
+
+// NewRunner returns a Runner which uses the provided client to resolve
+// PromotionTasks and to serve the expression functions of the steps. The
+// client is typically one returned by NewClient.
+func NewRunner(c client.Client, opts *Options) *Runner {
+	if opts == nil {
+		opts = &Options{}
+	}
+	r := &Runner{
+		client:      c,
+		credsDB:     opts.CredentialsDB,
+		registry:    promotion.DefaultStepRunnerRegistry,
+		executed:    opts.ExecutedStepKinds,
+		stubOutputs: opts.StubOutputs,
+	}
+	if r.executed == nil {
+		r.executed = DefaultExecutedStepKinds
+	}
+	if r.credsDB == nil {
+		r.credsDB = noCredentialsDB{}
+	}
+	return r
+}
+
+// NewClient returns an in-memory client holding the provided objects, for
+// use by a Runner. The objects may be Kargo resources or core Kubernetes
+// resources, such as the ConfigMaps and Secrets referenced by expressions.
+func NewClient(objects ...client.Object) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Kubernetes core API to scheme: %w", err)
+	}
+	if err := kargoapi.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Kargo API to scheme: %w", err)
+	}
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(objects...).
+		Build(), nil
+}
+
+// Run builds a Promotion from the provided Input and executes its steps in
+// a temporary working directory, which is removed afterward. A Report is
+// returned for any Promotion that could be executed, regardless of whether
+// it succeeded.
+func (r *Runner) Run(ctx context.Context, in Input) (*Report, error) {
+	promo, err := r.buildPromotion(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	workDir, err := os.MkdirTemp("", "kargo-dry-run-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	// Every run has a registry of its own, so that the step runners recording
+	// to the recorder of one run are never used by another.
+	rec := &recorder{stubOutputs: r.stubOutputs}
+	registry := promotion.MustNewStepRunnerRegistry()
+	steps := promotion.NewSteps(promo)
+	for _, step := range steps {
+		r.registerStepRunner(registry, rec, step.Kind)
+		rec.sections.Store(step.Alias, step.Section)
+	}
+
+	promoCtx := promotion.NewContext(
+		promo,
+		in.Stage,
+		promotion.WithActor(actor),
+		promotion.WithWorkDir(workDir),
+	)
+	engine := promotion.NewLocalEngineWithRegistry(
+		registry,
+		r.client,
+		nil,
+		r.credsDB,
+		promotion.DefaultExprDataCacheFn,
+	)
+
+	var res promotion.Result
+	for {
+		// The engine returns an error for any Promotion that errored. Only
+		// errors that prevented the steps from being executed at all are
+		// treated as such; the others are reported as part of the Result.
+		res, err = engine.Promote(ctx, promoCtx, steps)
+		if err != nil && res.Status != kargoapi.PromotionPhaseErrored {
+			return nil, fmt.Errorf("error executing Promotion steps: %w", err)
+		}
+		if res.Status != kargoapi.PromotionPhaseRunning {
+			break
+		}
+		retryAfter := defaultRetryAfter
+		if res.RetryAfter != nil {
+			retryAfter = *res.RetryAfter
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryAfter):
+		}
+		promoCtx.StartFromStep = res.CurrentStep
+		promoCtx.StepExecutionMetadata = res.StepExecutionMetadata
+		promoCtx.State = res.State
+		promoCtx.OnFailure = res.OnFailure
+		promoCtx.Finally = res.Finally
+	}
+
+	diff, err := rec.diff(workDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Promotion: promo,
+		Result:    res,
+		Steps:     rec.steps,
+		Diff:      diff,
+	}, nil
+}
+
+// buildPromotion builds the Promotion for the provided Input, in the same
+// way a Promotion is built for a Stage, and inflates its steps.
+func (r *Runner) buildPromotion(ctx context.Context, in Input) (*kargoapi.Promotion, error) {
+	if len(in.Freight) == 0 {
+		return nil, errors.New("freight is required")
+	}
+	target := in.Freight[0]
+
+	project := in.Project
+	if project == "" && in.Stage != nil {
+		project = in.Stage.Namespace
+	}
+	if project == "" {
+		return nil, errors.New("project is required")
+	}
+
+	var promo *kargoapi.Promotion
+	switch {
+	case in.Task != nil:
+		promo = &kargoapi.Promotion{
+			Spec: kargoapi.PromotionSpec{
+				Freight: target.Name,
+				Steps: []kargoapi.PromotionStep{{
+					As:   taskStepAlias,
+					Task: in.Task,
+				}},
+			},
+		}
+		if in.Stage != nil {
+			promo.Spec.Stage = in.Stage.Name
+			promo.Spec.Vars = slices.Clone(in.Stage.Spec.Vars)
+		}
+	case in.Stage != nil:
+		stage := in.Stage.DeepCopy()
+		stage.Namespace = project
+		var err error
+		if promo, err = kargo.NewPromotionBuilder(r.client).Build(ctx, *stage, target.Name); err != nil {
+			return nil, fmt.Errorf("error building Promotion: %w", err)
+		}
+	default:
+		return nil, errors.New("either a stage or a task is required")
+	}
+	promo.Name = promotionName
+	promo.Namespace = project
+	promo.Spec.Vars = append(promo.Spec.Vars, in.Vars...)
+
+	if err := kargo.NewPromotionBuilder(r.client).InflateSteps(ctx, promo); err != nil {
+		return nil, fmt.Errorf("error inflating Promotion steps: %w", err)
+	}
+
+	freight := make([]kargoapi.FreightReference, 0, len(in.Freight))
+	for _, f := range in.Freight {
+		freight = append(freight, kargoapi.FreightReference{
+			Name:      f.Name,
+			Commits:   f.Commits,
+			Images:    f.Images,
+			Charts:    f.Charts,
+			Artifacts: f.Artifacts,
+			Objects:   f.Objects,
+			Origin:    f.Origin,
+		})
+	}
+	promo.Status.Freight = &freight[0]
+	promo.Status.FreightCollection = &kargoapi.FreightCollection{}
+	// The target Freight is pushed last, so that it takes precedence over any
+	// other Freight from the same origin.
+	promo.Status.FreightCollection.UpdateOrPush(freight[1:]...)
+	promo.Status.FreightCollection.UpdateOrPush(freight[0])
+
+	return promo, nil
+}
+
+// registerStepRunner registers a step runner for the provided step kind with
+// the provided registry, unless one is registered already. The step runner
+// records the execution of each step of that kind with the provided recorder.
+// Steps of kinds that are executed are delegated to the step runner the
+// Runner's own registry holds for that kind. Steps of any other kind, and of
+// kinds the Runner's registry holds no step runner for, are stubbed.
+func (r *Runner) registerStepRunner(
+	registry promotion.StepRunnerRegistry,
+	rec *recorder,
+	kind string,
+) {
+	if _, err := registry.Get(kind); err == nil {
+		return
+	}
+	reg, err := r.registry.Get(kind)
+	executed := err == nil && slices.Contains(r.executed, kind)
+	registry.MustRegister(promotion.StepRunnerRegistration{
+		Name:     kind,
+		Metadata: reg.Metadata,
+		Value: func(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+			runner := &recordingRunner{
+				kind:     kind,
+				recorder: rec,
+			}
+			if executed {
+				runner.runner = reg.Value(caps)
+			}
+			return runner
+		},
+	})
+}
+
+// noCredentialsDB is an implementation of credentials.Database that never
+// returns any credentials.
+type noCredentialsDB struct{}
+
+// Get implements credentials.Database.
+func (noCredentialsDB) Get(
+	context.Context,
+	string,
+	credentials.Type,
+	string,
+) (*credentials.Credentials, error) {
+	return nil, nil
+}
+
+// recorder records the execution of steps and tracks the state of the files
+// in the working directory prior to their modification.
+type recorder struct {
+	stubOutputs map[string]map[string]any
+
+	// sections holds the section of each step, by alias.
+	sections sync.Map
+
+	mu       sync.Mutex
+	steps    []StepRecord
+	baseline snapshot
+}
+
+// record records the execution of a step.
+func (r *recorder) record(rec StepRecord) {
+	if section, ok := r.sections.Load(rec.Alias); ok {
+		rec.Section = section.(promotion.StepSection) // nolint: forcetypeassert
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.steps = append(r.steps, rec)
+}
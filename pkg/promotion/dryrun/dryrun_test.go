@@ -0,0 +1,420 @@
+package dryrun
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+const (
+	testStepKindWriteFile  = "fake-write-file"
+	testStepKindSideEffect = "fake-side-effect"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: testStepKindWriteFile,
+			Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+				return &promotion.MockStepRunner{
+					RunFunc: func(_ context.Context, stepCtx *promotion.StepContext) (promotion.StepResult, error) {
+						path := filepath.Join(stepCtx.WorkDir, stepCtx.Config["path"].(string)) // nolint: forcetypeassert
+						content := stepCtx.Config["content"].(string)                           // nolint: forcetypeassert
+						if err := os.WriteFile(path, []byte(content+"\n"), 0o600); err != nil {
+							return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+						}
+						return promotion.StepResult{
+							Status: kargoapi.PromotionStepStatusSucceeded,
+							Output: map[string]any{"path": stepCtx.Config["path"]},
+						}, nil
+					},
+				}
+			},
+		},
+	)
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: testStepKindSideEffect,
+			Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+				return &promotion.MockStepRunner{
+					RunResult: promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+					RunErr:    &promotion.TerminalError{Err: assert.AnError},
+				}
+			},
+		},
+	)
+}
+
+func TestRunner_Run(t *testing.T) {
+	testFreight := kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-freight",
+			Namespace: "fake-project",
+		},
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Images: []kargoapi.Image{{
+			RepoURL: "example.com/fake-image",
+			Tag:     "v1.0.0",
+		}},
+	}
+
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-project",
+		},
+		Spec: kargoapi.StageSpec{
+			Vars: []kargoapi.ExpressionVariable{
+				{Name: "greeting", Value: "hello"},
+			},
+			PromotionTemplate: &kargoapi.PromotionTemplate{
+				Spec: kargoapi.PromotionTemplateSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses: testStepKindWriteFile,
+							As:   "write",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"path":"out.txt","content":"${{ vars.greeting }} ${{ ctx.targetFreight.name }}"}`),
+							},
+						},
+						{
+							Uses: testStepKindSideEffect,
+							As:   "notify",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"file":"${{ outputs.write.path }}"}`),
+							},
+						},
+					},
+					Finally: []kargoapi.PromotionStep{
+						{
+							Uses: testStepKindSideEffect,
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"id":"${{ outputs.notify.id }}"}`),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		opts       *Options
+		input      Input
+		assertions func(*testing.T, *Report, error)
+	}{
+		{
+			name:  "no freight",
+			input: Input{Stage: testStage},
+			assertions: func(t *testing.T, _ *Report, err error) {
+				require.ErrorContains(t, err, "freight is required")
+			},
+		},
+		{
+			name: "no stage or task",
+			input: Input{
+				Project: "fake-project",
+				Freight: []kargoapi.Freight{testFreight},
+			},
+			assertions: func(t *testing.T, _ *Report, err error) {
+				require.ErrorContains(t, err, "either a stage or a task is required")
+			},
+		},
+		{
+			name: "stage with stubbed steps",
+			opts: &Options{
+				ExecutedStepKinds: []string{testStepKindWriteFile},
+				StubOutputs: map[string]map[string]any{
+					"notify": {"id": "fake-id"},
+				},
+			},
+			input: Input{
+				Stage:   testStage,
+				Freight: []kargoapi.Freight{testFreight},
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "greeting", Value: "hi"},
+				},
+			},
+			assertions: func(t *testing.T, report *Report, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, report)
+
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, report.Result.Status)
+
+				require.Len(t, report.Steps, 3)
+
+				assert.Equal(t, "write", report.Steps[0].Alias)
+				assert.Equal(t, testStepKindWriteFile, report.Steps[0].Kind)
+				assert.False(t, report.Steps[0].Stubbed)
+				assert.Equal(t, promotion.Config{
+					"path":    "out.txt",
+					"content": "hi fake-freight",
+				}, report.Steps[0].Config)
+				assert.Equal(t, map[string]any{"path": "out.txt"}, report.Steps[0].Output)
+
+				assert.Equal(t, "notify", report.Steps[1].Alias)
+				assert.True(t, report.Steps[1].Stubbed)
+				assert.Equal(t, promotion.Config{"file": "out.txt"}, report.Steps[1].Config)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, report.Steps[1].Status)
+				assert.Equal(t, map[string]any{"id": "fake-id"}, report.Steps[1].Output)
+
+				assert.Equal(t, "finally-step-1", report.Steps[2].Alias)
+				assert.Equal(t, promotion.StepSectionFinally, report.Steps[2].Section)
+				assert.Equal(t, promotion.Config{"id": "fake-id"}, report.Steps[2].Config)
+
+				require.Len(t, report.Diff, 1)
+				assert.Equal(t, "out.txt", report.Diff[0].Path)
+				assert.Contains(t, report.Diff[0].Diff, "--- /dev/null")
+				assert.Contains(t, report.Diff[0].Diff, "+++ b/out.txt")
+				assert.Contains(t, report.Diff[0].Diff, "+hi fake-freight")
+			},
+		},
+		{
+			name: "failed step",
+			opts: &Options{
+				ExecutedStepKinds: []string{testStepKindWriteFile, testStepKindSideEffect},
+			},
+			input: Input{
+				Stage:   testStage,
+				Freight: []kargoapi.Freight{testFreight},
+			},
+			assertions: func(t *testing.T, report *Report, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, report)
+
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, report.Result.Status)
+
+				require.Len(t, report.Steps, 3)
+				assert.False(t, report.Steps[1].Stubbed)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, report.Steps[1].Status)
+				assert.ErrorContains(t, report.Steps[1].Err, assert.AnError.Error())
+			},
+		},
+		{
+			name: "steps of other kinds are stubbed by default",
+			input: Input{
+				Stage:   testStage,
+				Freight: []kargoapi.Freight{testFreight},
+			},
+			assertions: func(t *testing.T, report *Report, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, report)
+
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, report.Result.Status)
+
+				require.Len(t, report.Steps, 3)
+				for _, step := range report.Steps {
+					assert.True(t, step.Stubbed)
+				}
+				assert.Empty(t, report.Diff)
+			},
+		},
+		{
+			name: "steps of unknown kinds are stubbed",
+			input: Input{
+				Stage: &kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionTemplate: &kargoapi.PromotionTemplate{
+							Spec: kargoapi.PromotionTemplateSpec{
+								Steps: []kargoapi.PromotionStep{{
+									Uses: "fake-plugin-step",
+									As:   "plugin",
+								}},
+							},
+						},
+					},
+				},
+				Freight: []kargoapi.Freight{testFreight},
+			},
+			assertions: func(t *testing.T, report *Report, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, report)
+
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, report.Result.Status)
+
+				require.Len(t, report.Steps, 1)
+				assert.Equal(t, "fake-plugin-step", report.Steps[0].Kind)
+				assert.True(t, report.Steps[0].Stubbed)
+			},
+		},
+		{
+			name: "promotion task",
+			opts: &Options{
+				ExecutedStepKinds: []string{testStepKindWriteFile},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-task",
+						Namespace: "fake-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Vars: []kargoapi.ExpressionVariable{
+							{Name: "file"},
+						},
+						Steps: []kargoapi.PromotionStep{{
+							Uses: testStepKindWriteFile,
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"path":"${{ vars.file }}","content":"${{ imageFrom('example.com/fake-image', warehouse('fake-warehouse')).Tag }}"}`),
+							},
+						}},
+					},
+				},
+			},
+			input: Input{
+				Project: "fake-project",
+				Task:    &kargoapi.PromotionTaskReference{Name: "fake-task"},
+				Freight: []kargoapi.Freight{testFreight},
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "file", Value: "version.txt"},
+				},
+			},
+			assertions: func(t *testing.T, report *Report, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, report)
+
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, report.Result.Status)
+
+				require.Len(t, report.Steps, 1)
+				assert.Equal(t, "task::step-1", report.Steps[0].Alias)
+				assert.Equal(t, promotion.Config{
+					"path":    "version.txt",
+					"content": "v1.0.0",
+				}, report.Steps[0].Config)
+
+				require.Len(t, report.Diff, 1)
+				assert.Equal(t, "version.txt", report.Diff[0].Path)
+				assert.Contains(t, report.Diff[0].Diff, "+v1.0.0")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := NewClient(testCase.objects...)
+			require.NoError(t, err)
+			report, err := NewRunner(c, testCase.opts).Run(context.Background(), testCase.input)
+			testCase.assertions(t, report, err)
+		})
+	}
+}
+
+func TestRunner_Run_concurrent(t *testing.T) {
+	testFreight := kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-freight",
+			Namespace: "fake-project",
+		},
+	}
+	newStage := func(content string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-stage",
+				Namespace: "fake-project",
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionTemplate: &kargoapi.PromotionTemplate{
+					Spec: kargoapi.PromotionTemplateSpec{
+						Steps: []kargoapi.PromotionStep{{
+							Uses: testStepKindWriteFile,
+							As:   "write",
+							Config: &apiextensionsv1.JSON{
+								Raw: []byte(`{"path":"out.txt","content":"` + content + `"}`),
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	c, err := NewClient()
+	require.NoError(t, err)
+
+	const runs = 10
+	reports := make([]*Report, runs)
+	errs := make([]error, runs)
+	var wg sync.WaitGroup
+	for i := range runs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runner := NewRunner(c, &Options{
+				ExecutedStepKinds: []string{testStepKindWriteFile},
+			})
+			reports[i], errs[i] = runner.Run(context.Background(), Input{
+				Stage:   newStage(strconv.Itoa(i)),
+				Freight: []kargoapi.Freight{testFreight},
+			})
+		}()
+	}
+	wg.Wait()
+
+	for i := range runs {
+		require.NoError(t, errs[i])
+		require.Len(t, reports[i].Steps, 1)
+		assert.Equal(t, promotion.Config{
+			"path":    "out.txt",
+			"content": strconv.Itoa(i),
+		}, reports[i].Steps[0].Config)
+	}
+}
+
+func TestRecorder_diff(t *testing.T) {
+	dir := t.TempDir()
+	write := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, path)), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte(content), 0o600))
+	}
+
+	write("repo/unchanged.txt", "unchanged\n")
+	write("repo/modified.txt", "a\nb\nc\n")
+	write("repo/deleted.txt", "deleted\n")
+	write("repo/.git", "gitdir: ../repo-123/repo\n")
+	write("repo-123/repo/HEAD", "ref: refs/heads/main\n")
+
+	r := &recorder{}
+	require.NoError(t, r.updateBaseline(dir))
+
+	write("repo/modified.txt", "a\nB\nc\n")
+	require.NoError(t, os.Remove(filepath.Join(dir, "repo/deleted.txt")))
+	write("repo/added.txt", "added\n")
+	write("repo-123/repo/HEAD", "ref: refs/heads/other\n")
+
+	diffs, err := r.diff(dir)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+
+	assert.Equal(t, "repo/added.txt", diffs[0].Path)
+	assert.Equal(t, "--- /dev/null\n+++ b/repo/added.txt\n@@ -0,0 +1 @@\n+added\n", diffs[0].Diff)
+
+	assert.Equal(t, "repo/deleted.txt", diffs[1].Path)
+	assert.Equal(t, "--- a/repo/deleted.txt\n+++ /dev/null\n@@ -1 +0,0 @@\n-deleted\n", diffs[1].Diff)
+
+	assert.Equal(t, "repo/modified.txt", diffs[2].Path)
+	assert.Equal(
+		t,
+		"--- a/repo/modified.txt\n+++ b/repo/modified.txt\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		diffs[2].Diff,
+	)
+}
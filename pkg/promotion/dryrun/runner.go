@@ -0,0 +1,123 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	// stepKindGitClone is the kind of the built-in step that clones a Git
+	// repository into the working directory.
+	stepKindGitClone = "git-clone"
+
+	// stepKindGitPush is the kind of the built-in step that pushes commits
+	// to a remote Git repository.
+	stepKindGitPush = "git-push"
+)
+
+// recordingRunner is an implementation of the promotion.StepRunner interface
+// that records the execution of a step with a recorder. If it wraps a
+// StepRunner, it delegates the execution of the step to it. Otherwise, the
+// step is stubbed.
+type recordingRunner struct {
+	kind     string
+	runner   promotion.StepRunner
+	recorder *recorder
+}
+
+// Run implements the promotion.StepRunner interface.
+func (r *recordingRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	res, err := r.run(ctx, stepCtx)
+
+	rec := StepRecord{
+		Alias:   stepCtx.Alias,
+		Kind:    r.kind,
+		Stubbed: r.runner == nil,
+		Config:  stepCtx.Config.DeepCopy(),
+		Status:  res.Status,
+		Message: res.Message,
+		Output:  res.Output,
+		Err:     err,
+	}
+	r.recorder.record(rec)
+
+	// The files checked out by a git-clone step are the baseline against
+	// which the changes made by subsequent steps are determined.
+	if r.kind == stepKindGitClone && err == nil {
+		if err = r.recorder.updateBaseline(stepCtx.WorkDir); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+	}
+	return res, err
+}
+
+func (r *recordingRunner) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if r.runner != nil {
+		return r.runner.Run(ctx, stepCtx)
+	}
+	res := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+	if output, ok := r.recorder.stubOutputs[stepCtx.Alias]; ok {
+		res.Output = output
+		return res, nil
+	}
+	if r.kind == stepKindGitPush {
+		output, err := stubGitPushOutput(stepCtx)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		res.Output = output
+	}
+	return res, nil
+}
+
+// stubGitPushOutput returns the output of a stubbed git-push step, which
+// reports the commit at the head of the working tree as the commit that
+// was pushed. This allows subsequent steps to reference the commit as they
+// would after an actual push.
+func stubGitPushOutput(stepCtx *promotion.StepContext) (map[string]any, error) {
+	cfg, err := promotion.ConfigToStruct[builtin.GitPushConfig](stepCtx.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error converting config of step %q: %w", stepCtx.Alias, err)
+	}
+	path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error joining path %s with work dir %s: %w",
+			cfg.Path, stepCtx.WorkDir, err,
+		)
+	}
+	workTree, err := git.LoadWorkTree(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+	branch := cfg.TargetBranch
+	switch {
+	case cfg.GenerateTargetBranch:
+		branch = fmt.Sprintf("kargo/promotion/%s", stepCtx.Promotion)
+	case branch == "":
+		if branch, err = workTree.CurrentBranch(); err != nil {
+			return nil, fmt.Errorf("error getting current branch: %w", err)
+		}
+	}
+	commit, err := workTree.LastCommitID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting last commit ID: %w", err)
+	}
+	return map[string]any{
+		"branch": branch,
+		"commit": commit,
+	}, nil
+}
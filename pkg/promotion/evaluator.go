@@ -2,6 +2,7 @@ package promotion
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strings"
@@ -11,9 +12,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/expressions"
 	exprfn "github.com/akuity/kargo/pkg/expressions/function"
-	"github.com/akuity/kargo/pkg/kargo"
 )
 
 // StepEvaluator handles the evaluation and processing of Promotion steps,
@@ -89,6 +90,25 @@ func ExprEnvWithTaskOutputs(alias string, outputs State) ExprEnvOption {
 	}
 }
 
+// ExprEnvWithIteration returns a ExprEnvOption that adds the item and index of
+// the forEach iteration the provided Step was expanded for, if any, to the
+// expression language environment of the Step.
+func ExprEnvWithIteration(step Step) ExprEnvOption {
+	return func(env map[string]any) {
+		if step.Expansion == nil || step.Expansion.Index == nil {
+			return
+		}
+		env["index"] = *step.Expansion.Index
+		var item any
+		if step.Expansion.Item != nil {
+			// The item was marshaled when the step was expanded, so this cannot
+			// reasonably fail.
+			_ = json.Unmarshal(step.Expansion.Item.Raw, &item)
+		}
+		env["item"] = item
+	}
+}
+
 // BuildExprEnv builds an environment map for evaluating expressions in
 // Promotion steps. The environment includes context information, such as the
 // Project, Promotion, Stage, and target Freight reference.
@@ -170,6 +190,7 @@ func (p *StepEvaluator) Vars(ctx context.Context, promoCtx Context, step Step) (
 				ExprEnvWithStepMetas(promoCtx),
 				ExprEnvWithOutputs(promoCtx.State),
 				ExprEnvWithTaskOutputs(step.Alias, promoCtx.State),
+				ExprEnvWithIteration(step),
 				ExprEnvWithVars(vars),
 			),
 			exprOpts...,
@@ -206,6 +227,7 @@ func (p *StepEvaluator) ShouldSkip(ctx context.Context, promoCtx Context, step S
 		ExprEnvWithStepMetas(promoCtx),
 		ExprEnvWithOutputs(promoCtx.State),
 		ExprEnvWithTaskOutputs(step.Alias, promoCtx.State),
+		ExprEnvWithIteration(step),
 		ExprEnvWithVars(vars),
 	)
 
@@ -257,6 +279,7 @@ func (p *StepEvaluator) Config(ctx context.Context, promoCtx Context, step Step)
 		ExprEnvWithStepMetas(promoCtx),
 		ExprEnvWithOutputs(promoCtx.State),
 		ExprEnvWithTaskOutputs(step.Alias, promoCtx.State),
+		ExprEnvWithIteration(step),
 		ExprEnvWithVars(vars),
 	)
 
@@ -345,7 +368,7 @@ func getTaskOutputs(alias string, state State) State {
 // The namespace part is the part before the first "::" separator. Typically,
 // this is used for steps inflated from a task.
 func getAliasNamespace(alias string) string {
-	parts := strings.Split(alias, kargo.PromotionAliasSeparator)
+	parts := strings.Split(alias, api.PromotionAliasSeparator)
 	if len(parts) != 2 {
 		return ""
 	}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -385,6 +387,68 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with iteration option",
+			opts: []ExprEnvOption{
+				ExprEnvWithIteration(Step{
+					Expansion: &kargoapi.PromotionStepExpansion{
+						Parent: "update",
+						Index:  ptr.To[int64](1),
+						Item:   &apiextensionsv1.JSON{Raw: []byte(`{"image":"fake-image"}`)},
+					},
+				}),
+			},
+			expected: map[string]any{
+				"ctx": map[string]any{
+					"project":   "",
+					"promotion": "",
+					"stage":     "",
+					"targetFreight": map[string]any{
+						"name": "",
+						"origin": map[string]any{
+							"name": "",
+						},
+					},
+					"meta": map[string]any{
+						"promotion": map[string]any{
+							"actor": "",
+						},
+					},
+				},
+				"index": int64(1),
+				"item": map[string]any{
+					"image": "fake-image",
+				},
+			},
+		},
+		{
+			name: "with iteration option - not an iteration",
+			opts: []ExprEnvOption{
+				ExprEnvWithIteration(Step{
+					Expansion: &kargoapi.PromotionStepExpansion{
+						Parent: "sync",
+					},
+				}),
+			},
+			expected: map[string]any{
+				"ctx": map[string]any{
+					"project":   "",
+					"promotion": "",
+					"stage":     "",
+					"targetFreight": map[string]any{
+						"name": "",
+						"origin": map[string]any{
+							"name": "",
+						},
+					},
+					"meta": map[string]any{
+						"promotion": map[string]any{
+							"actor": "",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "empty context",
 			promoCtx: Context{
@@ -1046,6 +1110,32 @@ func TestStepEvaluator_Config(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test iteration",
+			// Test that expressions can reference the item and index of a
+			// forEach iteration
+			step: Step{
+				Alias: "update-2",
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "image", Value: "${{ item.image }}"},
+				},
+				Expansion: &kargoapi.PromotionStepExpansion{
+					Parent: "update",
+					Index:  ptr.To[int64](2),
+					Item:   &apiextensionsv1.JSON{Raw: []byte(`{"image":"fake-image","tag":"v1"}`)},
+				},
+				Config: []byte(`{
+					"image": "${{ vars.image }}",
+					"tag": "${{ item.tag }}",
+					"index": "${{ index }}"
+				}`),
+			},
+			expectedCfg: Config{
+				"image": "fake-image",
+				"tag":   "v1",
+				"index": 2,
+			},
+		},
 		{
 			name: "test vars with literal values",
 			// Test that vars can be assigned literal values
@@ -16,7 +16,7 @@ import (
 
 // ReservedStepAliasRegex is a regular expression that matches step aliases that
 // are reserved for internal use.
-var ReservedStepAliasRegex = regexp.MustCompile(`^(step|task)-\d+$`)
+var ReservedStepAliasRegex = regexp.MustCompile(`^((on-failure|finally)-)?(step|task)-\d+$`)
 
 // ExprDataCacheFn is a function that returns a new cache to use in expression
 // functions that consult the Kubernetes API.
@@ -51,10 +51,28 @@ func NewLocalEngine(
 	argocdClient client.Client,
 	credsDB credentials.Database,
 	cacheFunc ExprDataCacheFn,
+) *LocalEngine {
+	return NewLocalEngineWithRegistry(
+		DefaultStepRunnerRegistry,
+		kargoClient,
+		argocdClient,
+		credsDB,
+		cacheFunc,
+	)
+}
+
+// NewLocalEngineWithRegistry returns an implementation of the Engine interface
+// that uses the StepRunners of the provided registry locally.
+func NewLocalEngineWithRegistry(
+	registry StepRunnerRegistry,
+	kargoClient client.Client,
+	argocdClient client.Client,
+	credsDB credentials.Database,
+	cacheFunc ExprDataCacheFn,
 ) *LocalEngine {
 	return &LocalEngine{
 		orchestator: NewLocalOrchestrator(
-			DefaultStepRunnerRegistry,
+			registry,
 			kargoClient,
 			argocdClient,
 			credsDB,
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"sync"
+	"time"
 
 	gocache "github.com/patrickmn/go-cache"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +49,13 @@ func NewLocalOrchestrator(
 // statuses, and error handling. The method returns a Result that contains
 // the final status of the Promotion after executing all steps, including
 // any health checks that were performed during the execution.
+//
+// Once the main steps have completed, any onFailure steps are executed if
+// the main steps did not succeed, followed by any finally steps. Their
+// execution is recorded separately from that of the main steps. If the
+// Context indicates that the execution of the onFailure or finally steps
+// has already begun, the main steps are not executed again. This is also
+// how the onFailure and finally steps of an aborted Promotion are executed.
 func (o *LocalOrchestrator) ExecuteSteps(
 	ctx context.Context,
 	promoCtx Context,
@@ -57,6 +66,114 @@ func (o *LocalOrchestrator) ExecuteSteps(
 		promoCtx.State = make(State)
 	}
 
+	mainSteps, onFailureSteps, finallySteps := splitStepSections(steps)
+
+	var result Result
+	if promoCtx.OnFailure == nil && promoCtx.Finally == nil {
+		result = o.executeSteps(ctx, promoCtx, mainSteps)
+		if result.Status == kargoapi.PromotionPhaseRunning {
+			return result, nil
+		}
+	} else {
+		status, msg := DetermineFinalPhase(mainSteps, promoCtx.StepExecutionMetadata)
+		result = Result{
+			Status:                status,
+			Message:               msg,
+			CurrentStep:           promoCtx.StartFromStep,
+			StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+			State:                 promoCtx.State,
+		}
+	}
+
+	return o.executeCleanupSteps(ctx, promoCtx, result, onFailureSteps, finallySteps), nil
+}
+
+// executeCleanupSteps executes the provided onFailure steps, if the provided
+// Result of the main steps is not successful, followed by the provided
+// finally steps. The progress of each is tracked in the Context and recorded
+// in the returned Result.
+//
+// The onFailure steps do not affect the final phase of the Promotion. The
+// finally steps only do so if the main steps succeeded and the finally steps
+// did not.
+func (o *LocalOrchestrator) executeCleanupSteps(
+	ctx context.Context,
+	promoCtx Context,
+	result Result,
+	onFailureSteps, finallySteps []Step,
+) Result {
+	status, msg := result.Status, result.Message
+	result.OnFailure, result.Finally = promoCtx.OnFailure, promoCtx.Finally
+
+	running := func(retryAfter *time.Duration) Result {
+		result.Status = kargoapi.PromotionPhaseRunning
+		result.Message = ""
+		result.RetryAfter = retryAfter
+		return result
+	}
+
+	if status != kargoapi.PromotionPhaseSucceeded && len(onFailureSteps) > 0 &&
+		(result.OnFailure == nil || result.OnFailure.Phase == kargoapi.PromotionPhaseRunning) {
+		var retryAfter *time.Duration
+		result.OnFailure, retryAfter = o.executeStepSection(ctx, promoCtx, result.OnFailure, onFailureSteps)
+		if result.OnFailure.Phase == kargoapi.PromotionPhaseRunning {
+			return running(retryAfter)
+		}
+	}
+
+	if len(finallySteps) > 0 &&
+		(result.Finally == nil || result.Finally.Phase == kargoapi.PromotionPhaseRunning) {
+		var retryAfter *time.Duration
+		result.Finally, retryAfter = o.executeStepSection(ctx, promoCtx, result.Finally, finallySteps)
+		if result.Finally.Phase == kargoapi.PromotionPhaseRunning {
+			return running(retryAfter)
+		}
+	}
+
+	result.Status, result.Message = status, msg
+	if status == kargoapi.PromotionPhaseSucceeded && result.Finally != nil &&
+		result.Finally.Phase != kargoapi.PromotionPhaseSucceeded {
+		result.Status = result.Finally.Phase
+		result.Message = fmt.Sprintf("finally steps did not succeed: %s", result.Finally.Message)
+	}
+	return result
+}
+
+// executeStepSection executes the provided steps of a section of the
+// Promotion, resuming from the provided status of the section if it is
+// non-nil. The steps share their state with the rest of the Promotion, but
+// their execution metadata is kept separately. It returns the new status of
+// the section and, if the section is still running, the suggested interval
+// after which to resume it.
+func (o *LocalOrchestrator) executeStepSection(
+	ctx context.Context,
+	promoCtx Context,
+	status *kargoapi.PromotionStepSectionStatus,
+	steps []Step,
+) (*kargoapi.PromotionStepSectionStatus, *time.Duration) {
+	sectionCtx := promoCtx
+	sectionCtx.StartFromStep = 0
+	sectionCtx.StepExecutionMetadata = nil
+	if status != nil {
+		sectionCtx.StartFromStep = status.CurrentStep
+		sectionCtx.StepExecutionMetadata = status.StepExecutionMetadata
+	}
+	res := o.executeSteps(ctx, sectionCtx, steps)
+	return &kargoapi.PromotionStepSectionStatus{
+		Phase:                 res.Status,
+		Message:               res.Message,
+		CurrentStep:           res.CurrentStep,
+		StepExecutionMetadata: res.StepExecutionMetadata,
+	}, res.RetryAfter
+}
+
+// executeSteps executes the provided steps in sequence, starting from the
+// step specified in the Context, and returns the Result of their execution.
+func (o *LocalOrchestrator) executeSteps(
+	ctx context.Context,
+	promoCtx Context,
+	steps []Step,
+) Result {
 	var healthChecks []health.Criteria
 
 	// Execute each step in sequence, starting from the step specified in
@@ -83,11 +200,24 @@ func (o *LocalOrchestrator) ExecuteSteps(
 				StepExecutionMetadata: promoCtx.StepExecutionMetadata,
 				State:                 promoCtx.State,
 				HealthChecks:          healthChecks,
-			}, nil
+			}
 		default:
 			// Continue execution if the context is still active.
 		}
 
+		// Steps expanded from the same step group or forEach step may be
+		// executed concurrently.
+		if end := parallelBatchEnd(steps, i); end > i+1 {
+			res, done := o.executeParallelSteps(ctx, &promoCtx, steps, i, end)
+			healthChecks = append(healthChecks, res.HealthChecks...)
+			if !done {
+				res.HealthChecks = healthChecks
+				return res
+			}
+			i = end - 1
+			continue
+		}
+
 		processor := NewStepEvaluator(o.client, o.newCache())
 
 		// Evaluate the "if" condition for the step to determine if it should
@@ -169,7 +299,7 @@ func (o *LocalOrchestrator) ExecuteSteps(
 				State:                 promoCtx.State,
 				HealthChecks:          healthChecks,
 				RetryAfter:            result.RetryAfter,
-			}, nil
+			}
 		}
 
 		// If the step succeeded, we can add any health checks to the list.
@@ -190,7 +320,196 @@ func (o *LocalOrchestrator) ExecuteSteps(
 		StepExecutionMetadata: promoCtx.StepExecutionMetadata,
 		State:                 promoCtx.State,
 		HealthChecks:          healthChecks,
-	}, nil
+	}
+}
+
+// parallelBatchEnd returns the (exclusive) end index of the batch of steps
+// that starts at the provided index and may be executed concurrently. A batch
+// consists of adjacent steps expanded from the same parent with a parallelism
+// greater than one. For any other step, the returned index is that of the next
+// step.
+func parallelBatchEnd(steps []Step, start int64) int64 {
+	first := steps[start]
+	if first.parallelism() < 2 {
+		return start + 1
+	}
+	end := start + 1
+	for end < int64(len(steps)) &&
+		steps[end].Expansion != nil &&
+		steps[end].Expansion.Parent == first.Expansion.Parent {
+		end++
+	}
+	return end
+}
+
+// parallelStep tracks the execution of a single step of a batch of steps that
+// are executed concurrently.
+type parallelStep struct {
+	step    Step
+	reg     StepRunnerRegistration
+	stepCtx *StepContext
+	result  StepResult
+	err     error
+}
+
+// executeParallelSteps executes the steps in the range [start, end), which
+// must all have been expanded from the same parent, with at most the parent's
+// parallelism of them running at any given time. Steps that are waiting for
+// something to happen, or that are being retried, count towards the limit
+// until they finish.
+//
+// Only the execution of the step runners happens concurrently. The evaluation
+// of each step's expressions and the processing of each step's result happen
+// one step at a time, in order, so that steps never observe each other's
+// changes to the Promotion's state in the middle of their execution.
+//
+// It returns true if all steps in the range have finished. Otherwise, it
+// returns a Result for a Promotion that is still running, with the current
+// step set to the first step in the range that has not finished.
+func (o *LocalOrchestrator) executeParallelSteps(
+	ctx context.Context,
+	promoCtx *Context,
+	steps []Step,
+	start, end int64,
+) (Result, bool) {
+	parallelism := steps[start].parallelism()
+
+	// Create the execution metadata for every step in the range up front, so
+	// that pointers to it remain valid while the steps are executed.
+	metas := make([]*StepMetadata, end-start)
+	for i := start; i < end; i++ {
+		promoCtx.GetStepExecutionMetadata(steps[i])
+	}
+	for i := start; i < end; i++ {
+		metas[i-start] = (*StepMetadata)(promoCtx.GetStepExecutionMetadata(steps[i]))
+	}
+
+	var healthChecks []health.Criteria
+	var retryAfter *time.Duration
+	attempted := make([]bool, end-start)
+	for {
+		// Select the steps to execute in this pass. These are all steps that
+		// have started but not finished, plus as many steps that have not
+		// started as the parallelism allows.
+		var running int64
+		for i := start; i < end; i++ {
+			if meta := metas[i-start]; meta.StartedAt != nil && !isParallelStepDone(meta) {
+				running++
+			}
+		}
+		var batch []*parallelStep
+		for i := start; i < end; i++ {
+			meta := metas[i-start]
+			if attempted[i-start] || isParallelStepDone(meta) {
+				continue
+			}
+			step := steps[i]
+			processor := NewStepEvaluator(o.client, o.newCache())
+			if meta.StartedAt == nil {
+				if running >= parallelism {
+					continue
+				}
+				promoCtx.SetCurrentStep(step)
+				skip, err := processor.ShouldSkip(ctx, *promoCtx, step)
+				switch {
+				case err != nil:
+					meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+						"error checking if step %q should be skipped: %s", step.Alias, err,
+					)
+					continue
+				case skip:
+					meta.WithStatus(kargoapi.PromotionStepStatusSkipped)
+					continue
+				}
+			}
+			reg, err := o.registry.Get(step.Kind)
+			if err != nil {
+				meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+					"error getting runner for step kind %q", step.Kind,
+				)
+				continue
+			}
+			if meta.StartedAt == nil {
+				meta.Started()
+				running++
+			}
+			stepCtx, err := processor.BuildStepContext(ctx, *promoCtx, step)
+			if err != nil {
+				meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+					"failed to build step context: %s", err,
+				).Finished()
+				running--
+				continue
+			}
+			attempted[i-start] = true
+			batch = append(batch, &parallelStep{
+				step:    step,
+				reg:     reg,
+				stepCtx: stepCtx,
+			})
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		// Execute the selected steps concurrently.
+		var wg sync.WaitGroup
+		for _, ps := range batch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ps.result, ps.err = o.executor.ExecuteStep(ctx, StepExecutionRequest{
+					Context: *ps.stepCtx,
+					Step:    ps.step,
+				})
+			}()
+		}
+		wg.Wait()
+
+		// Process the results in order.
+		for _, ps := range batch {
+			meta := promoCtx.SetCurrentStep(ps.step)
+			o.propagateStepOutput(*promoCtx, ps.step, ps.reg.Metadata, ps.result)
+			if !ps.result.Status.Valid() {
+				meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+					"step %q returned an invalid status: %s", ps.step.Alias, ps.result.Status,
+				).Finished()
+				continue
+			}
+			err := o.reconcileResultWithMetadata(*promoCtx, ps.step, ps.result, ps.err)
+			if !o.determineStepCompletion(*promoCtx, ps.step, ps.reg.Metadata, err) {
+				if ps.result.RetryAfter != nil &&
+					(retryAfter == nil || *ps.result.RetryAfter < *retryAfter) {
+					retryAfter = ps.result.RetryAfter
+				}
+				continue
+			}
+			if meta.Status == kargoapi.PromotionStepStatusSucceeded && ps.result.HealthCheck != nil {
+				healthChecks = append(healthChecks, *ps.result.HealthCheck)
+			}
+		}
+	}
+
+	for i := start; i < end; i++ {
+		if !isParallelStepDone(metas[i-start]) {
+			return Result{
+				Status:                kargoapi.PromotionPhaseRunning,
+				CurrentStep:           i,
+				StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+				State:                 promoCtx.State,
+				HealthChecks:          healthChecks,
+				RetryAfter:            retryAfter,
+			}, false
+		}
+	}
+	return Result{HealthChecks: healthChecks}, true
+}
+
+// isParallelStepDone returns true if the step with the provided metadata, which
+// is part of a batch of steps that are executed concurrently, has finished or
+// was never started because it was skipped or could not be evaluated.
+func isParallelStepDone(meta *StepMetadata) bool {
+	return meta.FinishedAt != nil || (meta.StartedAt == nil && meta.Status != "")
 }
 
 func (o *LocalOrchestrator) propagateStepOutput(
@@ -3,6 +3,7 @@ package promotion
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,6 +17,14 @@ import (
 )
 
 func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
+	parallel := func(parent string, parallelism int64, index *int64) *kargoapi.PromotionStepExpansion {
+		return &kargoapi.PromotionStepExpansion{
+			Parent:      parent,
+			Parallelism: parallelism,
+			Index:       index,
+		}
+	}
+
 	tests := []struct {
 		name          string
 		registrations []StepRunnerRegistration
@@ -508,6 +517,224 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				assert.Nil(t, result.StepExecutionMetadata[2].FinishedAt)
 			},
 		},
+		{
+			name: "execute parallel steps concurrently",
+			registrations: func() []StepRunnerRegistration {
+				// Each step waits for all steps to have started, which
+				// only happens if they are executed concurrently.
+				var started sync.WaitGroup
+				started.Add(3)
+				return []StepRunnerRegistration{{
+					Name: "barrier-step",
+					Value: func(_ StepRunnerCapabilities) StepRunner {
+						return &MockStepRunner{
+							RunFunc: func(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+								started.Done()
+								done := make(chan struct{})
+								go func() {
+									started.Wait()
+									close(done)
+								}()
+								select {
+								case <-done:
+								case <-time.After(5 * time.Second):
+									return StepResult{
+										Status: kargoapi.PromotionStepStatusErrored,
+									}, errors.New("steps were not executed concurrently")
+								}
+								return StepResult{
+									Status: kargoapi.PromotionStepStatusSucceeded,
+									Output: map[string]any{"alias": stepCtx.Alias},
+								}, nil
+							},
+						}
+					},
+				}}
+			}(),
+			steps: []Step{
+				{Kind: "barrier-step", Alias: "sync-1", Expansion: parallel("sync", 3, nil)},
+				{Kind: "barrier-step", Alias: "sync-2", Expansion: parallel("sync", 3, nil)},
+				{Kind: "barrier-step", Alias: "sync-3", Expansion: parallel("sync", 3, nil)},
+				{Kind: "success-step", Alias: "after"},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Empty(t, result.Message)
+				assert.Equal(t, int64(3), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 4)
+				for i, alias := range []string{"sync-1", "sync-2", "sync-3", "after"} {
+					assert.Equal(t, alias, result.StepExecutionMetadata[i].Alias)
+					assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[i].Status)
+					assert.NotNil(t, result.StepExecutionMetadata[i].StartedAt)
+					assert.NotNil(t, result.StepExecutionMetadata[i].FinishedAt)
+				}
+
+				// Verify the output of each step is addressable by its alias
+				assert.Equal(t, State{
+					"sync-1": map[string]any{"alias": "sync-1"},
+					"sync-2": map[string]any{"alias": "sync-2"},
+					"sync-3": map[string]any{"alias": "sync-3"},
+					"after":  map[string]any{"key": "value"},
+				}, result.State)
+			},
+		},
+		{
+			name: "parallel steps are bounded by parallelism",
+			steps: []Step{
+				{Kind: "running-step", Alias: "update-0", Expansion: parallel("update", 2, ptr.To[int64](0))},
+				{Kind: "running-step", Alias: "update-1", Expansion: parallel("update", 2, ptr.To[int64](1))},
+				{Kind: "running-step", Alias: "update-2", Expansion: parallel("update", 2, ptr.To[int64](2))},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.Status)
+				assert.Equal(t, int64(0), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 3)
+
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.StepExecutionMetadata[0].Status)
+				assert.NotNil(t, result.StepExecutionMetadata[0].StartedAt)
+				assert.Nil(t, result.StepExecutionMetadata[0].FinishedAt)
+
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.StepExecutionMetadata[1].Status)
+				assert.NotNil(t, result.StepExecutionMetadata[1].StartedAt)
+				assert.Nil(t, result.StepExecutionMetadata[1].FinishedAt)
+
+				// The third step must wait for one of the others to finish
+				assert.Empty(t, result.StepExecutionMetadata[2].Status)
+				assert.Nil(t, result.StepExecutionMetadata[2].StartedAt)
+			},
+		},
+		{
+			name: "failed parallel step fails promotion",
+			steps: []Step{
+				{Kind: "success-step", Alias: "sync-1", Expansion: parallel("sync", 2, nil)},
+				{Kind: "error-step", Alias: "sync-2", Expansion: parallel("sync", 2, nil)},
+				{Kind: "success-step", Alias: "after"},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, "something went wrong")
+
+				require.Len(t, result.StepExecutionMetadata, 3)
+
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[0].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[1].Status)
+				assert.NotNil(t, result.StepExecutionMetadata[1].FinishedAt)
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, result.StepExecutionMetadata[2].Status)
+			},
+		},
+		{
+			name: "onFailure and finally steps are executed when a step fails",
+			steps: []Step{
+				{Kind: "error-step", Alias: "step1"},
+				{Kind: "success-step", Alias: "revert", Section: StepSectionOnFailure},
+				{Kind: "success-step", Alias: "cleanup", Section: StepSectionFinally},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, "something went wrong")
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[0].Status)
+
+				require.NotNil(t, result.OnFailure)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.OnFailure.Phase)
+				require.Len(t, result.OnFailure.StepExecutionMetadata, 1)
+				assert.Equal(t, "revert", result.OnFailure.StepExecutionMetadata[0].Alias)
+
+				require.NotNil(t, result.Finally)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Finally.Phase)
+				require.Len(t, result.Finally.StepExecutionMetadata, 1)
+				assert.Equal(t, "cleanup", result.Finally.StepExecutionMetadata[0].Alias)
+
+				// The onFailure and finally steps share the state of the main steps
+				assert.Equal(t, map[string]any{"key": "value"}, result.State["revert"])
+				assert.Equal(t, map[string]any{"key": "value"}, result.State["cleanup"])
+			},
+		},
+		{
+			name: "onFailure steps are not executed when all steps succeed",
+			steps: []Step{
+				{Kind: "success-step", Alias: "step1"},
+				{Kind: "success-step", Alias: "revert", Section: StepSectionOnFailure},
+				{Kind: "success-step", Alias: "cleanup", Section: StepSectionFinally},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Empty(t, result.Message)
+				assert.Nil(t, result.OnFailure)
+
+				require.NotNil(t, result.Finally)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Finally.Phase)
+			},
+		},
+		{
+			name: "failed finally steps fail an otherwise successful promotion",
+			steps: []Step{
+				{Kind: "success-step", Alias: "step1"},
+				{Kind: "terminal-error-step", Alias: "cleanup", Section: StepSectionFinally},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, "finally steps did not succeed")
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[0].Status)
+
+				require.NotNil(t, result.Finally)
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Finally.Phase)
+			},
+		},
+		{
+			name: "onFailure step is still running",
+			steps: []Step{
+				{Kind: "error-step", Alias: "step1"},
+				{Kind: "running-step", Alias: "revert", Section: StepSectionOnFailure},
+				{Kind: "success-step", Alias: "cleanup", Section: StepSectionFinally},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.Status)
+				assert.Equal(t, int64(0), result.CurrentStep)
+
+				require.NotNil(t, result.OnFailure)
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.OnFailure.Phase)
+				assert.Nil(t, result.Finally)
+			},
+		},
+		{
+			name: "onFailure and finally steps of an aborted promotion",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:   "step1",
+					Status:  kargoapi.PromotionStepStatusAborted,
+					Message: "Promotion terminated per user request",
+				}},
+				OnFailure: &kargoapi.PromotionStepSectionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+				},
+			},
+			steps: []Step{
+				{Kind: "running-step", Alias: "step1"},
+				{Kind: "success-step", Alias: "step2"},
+				{Kind: "success-step", Alias: "revert", Section: StepSectionOnFailure},
+				{Kind: "success-step", Alias: "cleanup", Section: StepSectionFinally},
+			},
+			assertions: func(t *testing.T, result Result) {
+				assert.Equal(t, kargoapi.PromotionPhaseAborted, result.Status)
+
+				// The main steps must not have been executed any further
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusAborted, result.StepExecutionMetadata[0].Status)
+
+				require.NotNil(t, result.OnFailure)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.OnFailure.Phase)
+				require.NotNil(t, result.Finally)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Finally.Phase)
+
+				assert.NotContains(t, result.State, "step2")
+			},
+		},
 	}
 
 	for _, tt := range tests {
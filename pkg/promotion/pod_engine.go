@@ -0,0 +1,640 @@
+package promotion
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/kubernetes"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// PodInputPath is the path at which the input of a promotion Pod is made
+	// available to it.
+	PodInputPath = podInputDir + "/" + podInputFile
+
+	podContainerName = "promotion"
+	podInputDir      = "/etc/kargo/promotion"
+	podInputFile     = "input.json"
+	// maxPodInputBytes caps the size of the input of a promotion Pod. The input
+	// is held by a Secret, the data of which may not exceed 1MiB. Some room is
+	// left for the Secret's metadata.
+	maxPodInputBytes = 1<<20 - 16<<10
+	podWorkDir       = "/workspace"
+	// podResultPrefix prefixes the lines of a promotion Pod's log that contain
+	// a snapshot of the Result of the Promotion.
+	podResultPrefix = "kargo-promotion-result: "
+	// podDefaultRetryInterval is how long a promotion Pod waits before resuming
+	// a Promotion that is waiting for something to happen, if no step suggested
+	// an interval.
+	podDefaultRetryInterval = 30 * time.Second
+	// maxPodResultBytes caps the size of a single snapshot of the Result of a
+	// Promotion reported by a promotion Pod.
+	maxPodResultBytes = 16 << 20
+)
+
+// podPropagatedEnvVars lists the environment variables of the controller that
+// are propagated to promotion Pods.
+var podPropagatedEnvVars = []string{
+	"LOG_LEVEL",
+	"LOG_FORMAT",
+	"GITCLIENT_NAME",
+	"GITCLIENT_EMAIL",
+	"ALLOW_CREDENTIALS_OVER_HTTP",
+	"ARGOCD_INTEGRATION_ENABLED",
+	"ARGOCD_NAMESPACE",
+	"HTTP_PROXY",
+	"HTTPS_PROXY",
+	"NO_PROXY",
+	"http_proxy",
+	"https_proxy",
+	"no_proxy",
+}
+
+// PodEngineConfig represents configuration for the PodEngine.
+type PodEngineConfig struct {
+	// Image is the image used by promotion Pods. It must contain the Kargo
+	// binary. If empty, the execution of Promotions in Pods is disabled.
+	Image string `envconfig:"PROMOTION_POD_IMAGE"`
+	// ImagePullPolicy is the pull policy for the image used by promotion Pods.
+	ImagePullPolicy corev1.PullPolicy `envconfig:"PROMOTION_POD_IMAGE_PULL_POLICY" default:"IfNotPresent"`
+	// ServiceAccountName is the name of the ServiceAccount, in each Project
+	// namespace, used by promotion Pods.
+	ServiceAccountName string `envconfig:"PROMOTION_RUNNER_SERVICE_ACCOUNT_NAME" default:"kargo-promotion-runner"`
+	// PollInterval is how often the progress of a promotion Pod is checked.
+	PollInterval time.Duration `envconfig:"PROMOTION_POD_POLL_INTERVAL" default:"10s"`
+	// Timeout is the maximum duration of a promotion Pod.
+	Timeout time.Duration `envconfig:"PROMOTION_POD_TIMEOUT" default:"1h"`
+}
+
+// PodEngineConfigFromEnv returns a new PodEngineConfig populated from
+// environment variables.
+func PodEngineConfigFromEnv() PodEngineConfig {
+	cfg := PodEngineConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// PodInput is the input of a promotion Pod.
+type PodInput struct {
+	// Context is the context of the Promotion.
+	Context Context
+	// Steps are the steps of the Promotion.
+	Steps []Step
+}
+
+// PodEngine is an implementation of the Engine interface that executes the
+// steps of each Promotion in a short-lived Pod, managed by a Job, in the
+// Project namespace. This isolates the execution of the steps of a Promotion
+// from the controller and from the Promotions of other Projects.
+//
+// The Pod reports a snapshot of the Result of the Promotion after each
+// execution of its steps. PodEngine returns the latest snapshot while the Pod
+// is running and the final Result once the Pod has completed, after which the
+// Job is deleted.
+type PodEngine struct {
+	cfg    PodEngineConfig
+	client client.Client
+	// controllerOnlySteps lists the kinds of steps that can only be executed
+	// within the controller, e.g. because they are implemented by plugins
+	// served by the controller's sidecars.
+	controllerOnlySteps []string
+
+	getPodLogFn func(ctx context.Context, job *batchv1.Job) (io.ReadCloser, error)
+}
+
+// NewPodEngine returns an implementation of the Engine interface that executes
+// the steps of each Promotion in a short-lived Pod in the Project namespace.
+// Promotions containing any of the provided kinds of steps, which can only be
+// executed within the controller, are rejected.
+func NewPodEngine(
+	cfg PodEngineConfig,
+	kargoClient client.Client,
+	podsClient corev1client.PodsGetter,
+	controllerOnlySteps []string,
+) *PodEngine {
+	e := &PodEngine{
+		cfg:                 cfg,
+		client:              kargoClient,
+		controllerOnlySteps: controllerOnlySteps,
+	}
+	e.getPodLogFn = func(ctx context.Context, job *batchv1.Job) (io.ReadCloser, error) {
+		return getPodLog(ctx, podsClient, job)
+	}
+	return e
+}
+
+// Promote implements the Engine interface.
+func (e *PodEngine) Promote(
+	ctx context.Context,
+	promoCtx Context,
+	steps []Step,
+) (Result, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	job := &batchv1.Job{}
+	if err := e.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: promoCtx.Project,
+			Name:      podJobName(promoCtx.Promotion),
+		},
+		job,
+	); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return Result{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error getting promotion Job: %w", err)
+		}
+		if err = e.validateSteps(steps); err != nil {
+			return Result{
+				Status:  kargoapi.PromotionPhaseErrored,
+				Message: err.Error(),
+			}, err
+		}
+		if err = e.startJob(ctx, promoCtx, steps); err != nil {
+			return Result{Status: kargoapi.PromotionPhaseErrored}, err
+		}
+		return e.pendingResult(promoCtx), nil
+	}
+
+	if job.DeletionTimestamp != nil {
+		// A Job from an earlier execution of the Promotion is still being
+		// deleted. Wait for it to be gone before starting a new one.
+		return e.pendingResult(promoCtx), nil
+	}
+
+	completed, failureMsg := podJobCompletion(job)
+
+	res, found, err := e.getLatestResult(ctx, job)
+	if err != nil {
+		// This is likely transient. Try again next time.
+		logger.Error(err, "error getting result of promotion Job", "job", job.Name)
+		return e.pendingResult(promoCtx), nil
+	}
+
+	if !completed {
+		if !found {
+			return e.pendingResult(promoCtx), nil
+		}
+		// Health checks are only returned with the final Result so that they
+		// are not recorded more than once.
+		res.Status = kargoapi.PromotionPhaseRunning
+		res.HealthChecks = nil
+		res.RetryAfter = &e.cfg.PollInterval
+		return res, nil
+	}
+
+	if err = e.deleteJob(ctx, job); err != nil {
+		logger.Error(err, "error deleting promotion Job", "job", job.Name)
+	}
+
+	switch {
+	case !found:
+		msg := fmt.Sprintf("promotion Job %q did not report a result", job.Name)
+		if failureMsg != "" {
+			msg = fmt.Sprintf("%s: %s", msg, failureMsg)
+		}
+		res = e.pendingResult(promoCtx)
+		res.Status = kargoapi.PromotionPhaseErrored
+		res.Message = msg
+		res.RetryAfter = nil
+	case res.Status == kargoapi.PromotionPhaseRunning:
+		// The Pod exited before the Promotion reached a terminal phase. This
+		// happens when it exceeds its deadline or is killed.
+		res.Status = kargoapi.PromotionPhaseErrored
+		if failureMsg == "" {
+			failureMsg = "exited before the Promotion completed"
+		}
+		res.Message = fmt.Sprintf("promotion Job %q failed: %s", job.Name, failureMsg)
+		res.HealthChecks = nil
+		res.RetryAfter = nil
+	}
+
+	if res.Status == kargoapi.PromotionPhaseErrored {
+		return res, errors.New(res.Message)
+	}
+	return res, nil
+}
+
+// Cancel deletes the Job executing the specified Promotion, if any.
+func (e *PodEngine) Cancel(ctx context.Context, project, promotion string) error {
+	return e.deleteJob(
+		ctx,
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: project,
+				Name:      podJobName(promotion),
+			},
+		},
+	)
+}
+
+// pendingResult returns a Result indicating that the Promotion is running but
+// that no progress has been reported since the provided Context was built.
+func (e *PodEngine) pendingResult(promoCtx Context) Result {
+	return Result{
+		Status:                kargoapi.PromotionPhaseRunning,
+		CurrentStep:           promoCtx.StartFromStep,
+		StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+		State:                 promoCtx.State,
+		RetryAfter:            &e.cfg.PollInterval,
+		OnFailure:             promoCtx.OnFailure,
+		Finally:               promoCtx.Finally,
+	}
+}
+
+// validateSteps returns an error if any of the provided steps can only be
+// executed within the controller.
+func (e *PodEngine) validateSteps(steps []Step) error {
+	for _, step := range steps {
+		if slices.Contains(e.controllerOnlySteps, step.Kind) {
+			return fmt.Errorf(
+				"step %q of kind %q cannot be executed in a promotion Pod; "+
+					"Promotions that use it must be executed by the controller",
+				step.Alias, step.Kind,
+			)
+		}
+	}
+	return nil
+}
+
+// startJob creates a Job that executes the provided steps in the provided
+// Context, along with a Secret that holds the input of the Job's Pod.
+func (e *PodEngine) startJob(
+	ctx context.Context,
+	promoCtx Context,
+	steps []Step,
+) error {
+	promo := &kargoapi.Promotion{}
+	if err := e.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: promoCtx.Project,
+			Name:      promoCtx.Promotion,
+		},
+		promo,
+	); err != nil {
+		return fmt.Errorf(
+			"error getting Promotion %q in namespace %q: %w",
+			promoCtx.Promotion, promoCtx.Project, err,
+		)
+	}
+	job, input, err := e.buildJob(promo, promoCtx, steps)
+	if err != nil {
+		return err
+	}
+	// The Secret is created before the Job so that the Job's Pod never waits
+	// for its input. A Secret left behind by an earlier execution of the
+	// Promotion is brought up to date.
+	if err = e.client.Create(ctx, input); apierrors.IsAlreadyExists(err) {
+		err = e.client.Patch(ctx, input, client.Merge)
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"error creating promotion input Secret %q in namespace %q: %w",
+			input.Name, input.Namespace, err,
+		)
+	}
+	if err = e.client.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf(
+			"error creating promotion Job %q in namespace %q: %w",
+			job.Name, job.Namespace, err,
+		)
+	}
+	logging.LoggerFromContext(ctx).Debug("created promotion Job", "job", job.Name)
+	return nil
+}
+
+// buildJob builds the Job that executes the provided steps in the provided
+// Context and the Secret that holds the input of the Job's Pod. Both are owned
+// by the Promotion, so that they are garbage collected along with it. The
+// Secret is also deleted along with the Job.
+func (e *PodEngine) buildJob(
+	promo *kargoapi.Promotion,
+	promoCtx Context,
+	steps []Step,
+) (*batchv1.Job, *corev1.Secret, error) {
+	promoCtx.WorkDir = podWorkDir
+	input, err := json.Marshal(PodInput{Context: promoCtx, Steps: steps})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshaling promotion Pod input: %w", err)
+	}
+	if len(input) > maxPodInputBytes {
+		return nil, nil, fmt.Errorf(
+			"input of promotion Pod is %d bytes, which exceeds the maximum of %d "+
+				"bytes; reduce the size of the Promotion's steps and their outputs "+
+				"or have it executed by the controller",
+			len(input), maxPodInputBytes,
+		)
+	}
+
+	var env []corev1.EnvVar
+	for _, name := range podPropagatedEnvVars {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, corev1.EnvVar{Name: name, Value: value})
+		}
+	}
+
+	objMeta := metav1.ObjectMeta{
+		Namespace: promo.Namespace,
+		Name:      podJobName(promo.Name),
+		Labels: map[string]string{
+			kargoapi.LabelKeyStage: kubernetes.ShortenLabelValue(promo.Spec.Stage),
+		},
+		Annotations: map[string]string{
+			kargoapi.AnnotationKeyPromotion: promo.Name,
+		},
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Promotion",
+			Name:       promo.Name,
+			UID:        promo.UID,
+		}},
+	}
+	if promo.Spec.Stage != objMeta.Labels[kargoapi.LabelKeyStage] {
+		objMeta.Annotations[kargoapi.AnnotationKeyStage] = promo.Spec.Stage
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: *objMeta.DeepCopy(),
+		Data: map[string][]byte{
+			podInputFile: input,
+		},
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: objMeta,
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: e.cfg.ServiceAccountName,
+					Containers: []corev1.Container{{
+						Name:            podContainerName,
+						Image:           e.cfg.Image,
+						ImagePullPolicy: e.cfg.ImagePullPolicy,
+						Command:         []string{"/sbin/tini", "--", "/usr/local/bin/kargo"},
+						Args:            []string{"promotion-runner"},
+						Env:             env,
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      "input",
+								MountPath: podInputDir,
+								ReadOnly:  true,
+							},
+							{
+								Name:      "workspace",
+								MountPath: podWorkDir,
+							},
+						},
+					}},
+					Volumes: []corev1.Volume{
+						{
+							Name: "input",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: secret.Name,
+								},
+							},
+						},
+						{
+							Name: "workspace",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if e.cfg.Timeout > 0 {
+		job.Spec.ActiveDeadlineSeconds = ptr.To(int64(e.cfg.Timeout.Seconds()))
+	}
+	return job, secret, nil
+}
+
+// getLatestResult returns the latest snapshot of the Result of the Promotion
+// reported by the Pod of the provided Job. The returned boolean is false if
+// the Pod has not reported a Result yet.
+//
+// The whole log is read, however long, so that the latest snapshot is found
+// no matter how much the Promotion's steps have logged. Only the latest
+// snapshot is retained.
+func (e *PodEngine) getLatestResult(
+	ctx context.Context,
+	job *batchv1.Job,
+) (Result, bool, error) {
+	log, err := e.getPodLogFn(ctx, job)
+	if err != nil {
+		return Result{}, false, err
+	}
+	if log == nil {
+		return Result{}, false, nil
+	}
+	defer log.Close()
+	var latest []byte
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxPodResultBytes)
+	for scanner.Scan() {
+		if line, ok := strings.CutPrefix(scanner.Text(), podResultPrefix); ok {
+			latest = []byte(line)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return Result{}, false, fmt.Errorf("error reading log of promotion Job %q: %w", job.Name, err)
+	}
+	if latest == nil {
+		return Result{}, false, nil
+	}
+	res := Result{}
+	if err = json.Unmarshal(latest, &res); err != nil {
+		return Result{}, false, fmt.Errorf(
+			"error unmarshaling result reported by promotion Job %q: %w", job.Name, err,
+		)
+	}
+	return res, true, nil
+}
+
+// deleteJob deletes the provided Job, along with its Pods and the Secret that
+// holds their input.
+func (e *PodEngine) deleteJob(ctx context.Context, job *batchv1.Job) error {
+	if err := e.client.Delete(
+		ctx,
+		job,
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf(
+			"error deleting promotion Job %q in namespace %q: %w",
+			job.Name, job.Namespace, err,
+		)
+	}
+	if err := e.client.Delete(
+		ctx,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: job.Namespace,
+				Name:      job.Name,
+			},
+		},
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf(
+			"error deleting promotion input Secret %q in namespace %q: %w",
+			job.Name, job.Namespace, err,
+		)
+	}
+	return nil
+}
+
+// podJobName returns the name of the Job that executes the specified
+// Promotion.
+func podJobName(promotion string) string {
+	// Job names are used as label values by the Job controller, so they must
+	// obey the same length limit.
+	return kubernetes.ShortenLabelValue(promotion)
+}
+
+// podJobCompletion returns whether the provided Job has completed and, if it
+// failed, a message explaining why.
+func podJobCompletion(job *batchv1.Job) (bool, string) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, ""
+		case batchv1.JobFailed:
+			if cond.Message != "" {
+				return true, cond.Message
+			}
+			return true, cond.Reason
+		}
+	}
+	return false, ""
+}
+
+// getPodLog returns a stream of the log of the most recently created Pod of
+// the provided Job. It returns a nil stream if the Job has no Pods yet. The
+// caller is responsible for closing the stream.
+func getPodLog(
+	ctx context.Context,
+	podsClient corev1client.PodsGetter,
+	job *batchv1.Job,
+) (io.ReadCloser, error) {
+	pods, err := podsClient.Pods(job.Namespace).List(
+		ctx,
+		metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", batchv1.JobNameLabel, job.Name),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing Pods of promotion Job %q: %w", job.Name, err)
+	}
+	pods.Items = slices.DeleteFunc(pods.Items, func(pod corev1.Pod) bool {
+		return pod.Status.Phase == corev1.PodPending
+	})
+	if len(pods.Items) == 0 {
+		return nil, nil
+	}
+	pod := slices.MaxFunc(pods.Items, func(lhs, rhs corev1.Pod) int {
+		return lhs.CreationTimestamp.Compare(rhs.CreationTimestamp.Time)
+	})
+	stream, err := podsClient.Pods(job.Namespace).GetLogs(
+		pod.Name,
+		&corev1.PodLogOptions{Container: podContainerName},
+	).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting log of Pod %q: %w", pod.Name, err)
+	}
+	return stream, nil
+}
+
+// ReadPodInput reads the input of a promotion Pod from the specified path.
+func ReadPodInput(path string) (PodInput, error) {
+	input := PodInput{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return input, fmt.Errorf("error reading promotion Pod input: %w", err)
+	}
+	if err = json.Unmarshal(data, &input); err != nil {
+		return input, fmt.Errorf("error unmarshaling promotion Pod input: %w", err)
+	}
+	return input, nil
+}
+
+// RunInPod executes the steps of a Promotion from within a promotion Pod using
+// the provided Engine. A snapshot of the Result of the Promotion is written to
+// the provided Writer after each execution of the steps. While the Promotion
+// is running, e.g. because a step is waiting for something to happen, the
+// steps are executed again after a delay, resuming from where they left off.
+// This continues until the Promotion reaches a terminal phase or the provided
+// context is canceled.
+func RunInPod(
+	ctx context.Context,
+	engine Engine,
+	input PodInput,
+	out io.Writer,
+) error {
+	promoCtx := input.Context
+	var healthChecks []health.Criteria
+	for {
+		res, err := engine.Promote(ctx, promoCtx, input.Steps)
+		if err != nil && res.Message == "" {
+			res.Message = err.Error()
+		}
+		healthChecks = append(healthChecks, res.HealthChecks...)
+		if res.Status == kargoapi.PromotionPhaseRunning {
+			res.HealthChecks = nil
+		} else {
+			res.HealthChecks = healthChecks
+		}
+		resJSON, err := json.Marshal(res)
+		if err != nil {
+			return fmt.Errorf("error marshaling promotion result: %w", err)
+		}
+		if _, err = fmt.Fprintf(out, "%s%s\n", podResultPrefix, resJSON); err != nil {
+			return fmt.Errorf("error writing promotion result: %w", err)
+		}
+		if res.Status != kargoapi.PromotionPhaseRunning {
+			return nil
+		}
+
+		retryAfter := podDefaultRetryInterval
+		if res.RetryAfter != nil {
+			retryAfter = *res.RetryAfter
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryAfter):
+		}
+
+		promoCtx.StartFromStep = res.CurrentStep
+		promoCtx.StepExecutionMetadata = res.StepExecutionMetadata
+		promoCtx.State = res.State
+		promoCtx.OnFailure = res.OnFailure
+		promoCtx.Finally = res.Finally
+	}
+}
@@ -0,0 +1,407 @@
+package promotion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func TestPodEngine_Promote(t *testing.T) {
+	const testProject = "fake-project"
+	const testPromotion = "fake-promotion"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      testPromotion,
+			UID:       "fake-uid",
+		},
+		Spec: kargoapi.PromotionSpec{Stage: "fake-stage"},
+	}
+
+	testJob := func(conditions ...batchv1.JobCondition) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      testPromotion,
+			},
+			Status: batchv1.JobStatus{Conditions: conditions},
+		}
+	}
+	completed := batchv1.JobCondition{
+		Type:   batchv1.JobComplete,
+		Status: corev1.ConditionTrue,
+	}
+	failed := batchv1.JobCondition{
+		Type:    batchv1.JobFailed,
+		Status:  corev1.ConditionTrue,
+		Reason:  "DeadlineExceeded",
+		Message: "Job was active longer than specified deadline",
+	}
+
+	testLog := func(results ...Result) io.ReadCloser {
+		buf := &bytes.Buffer{}
+		buf.WriteString("some unrelated log line\n")
+		for _, res := range results {
+			resJSON, err := json.Marshal(res)
+			require.NoError(t, err)
+			fmt.Fprintf(buf, "%s%s\n", podResultPrefix, resJSON)
+		}
+		return io.NopCloser(buf)
+	}
+
+	promoCtx := Context{
+		Project:       testProject,
+		Promotion:     testPromotion,
+		Stage:         "fake-stage",
+		WorkDir:       "/local/work/dir",
+		StartFromStep: 1,
+		State:         State{"foo": "bar"},
+	}
+	steps := []Step{{Kind: "fake-step", Config: []byte(`{"foo":"bar"}`)}}
+
+	testCases := []struct {
+		name        string
+		objects     []client.Object
+		steps       []Step
+		getPodLogFn func(context.Context, *batchv1.Job) (io.ReadCloser, error)
+		assertions  func(*testing.T, client.Client, Result, error)
+	}{
+		{
+			name: "error getting Promotion",
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "error getting Promotion")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:    "starts Job",
+			objects: []client.Object{testPromo},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, int64(1), res.CurrentStep)
+				require.Equal(t, State{"foo": "bar"}, res.State)
+				require.NotNil(t, res.RetryAfter)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testProject, Name: testPromotion},
+					job,
+				))
+				require.Len(t, job.OwnerReferences, 1)
+				require.Equal(t, testPromo.UID, job.OwnerReferences[0].UID)
+				require.Equal(t, "fake-stage", job.Labels[kargoapi.LabelKeyStage])
+				require.Equal(t, testPromotion, job.Annotations[kargoapi.AnnotationKeyPromotion])
+
+				podSpec := job.Spec.Template.Spec
+				require.Equal(t, "fake-service-account", podSpec.ServiceAccountName)
+				require.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+				require.Len(t, podSpec.Containers, 1)
+				require.Equal(t, "fake-image", podSpec.Containers[0].Image)
+				require.Equal(t, []string{"promotion-runner"}, podSpec.Containers[0].Args)
+				require.Equal(t, int64(3600), *job.Spec.ActiveDeadlineSeconds)
+
+				require.Len(t, podSpec.Volumes, 2)
+				require.Equal(t, testPromotion, podSpec.Volumes[0].Secret.SecretName)
+
+				secret := &corev1.Secret{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testProject, Name: testPromotion},
+					secret,
+				))
+				require.Len(t, secret.OwnerReferences, 1)
+				require.Equal(t, testPromo.UID, secret.OwnerReferences[0].UID)
+				input := PodInput{}
+				require.NoError(t, json.Unmarshal(secret.Data[podInputFile], &input))
+				require.Equal(t, podWorkDir, input.Context.WorkDir)
+				require.Equal(t, int64(1), input.Context.StartFromStep)
+				require.Equal(t, steps, input.Steps)
+			},
+		},
+		{
+			name: "replaces stale input Secret",
+			objects: []client.Object{
+				testPromo,
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      testPromotion,
+					},
+					Data: map[string][]byte{podInputFile: []byte("{}")},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, _ Result, err error) {
+				require.NoError(t, err)
+				secret := &corev1.Secret{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testProject, Name: testPromotion},
+					secret,
+				))
+				input := PodInput{}
+				require.NoError(t, json.Unmarshal(secret.Data[podInputFile], &input))
+				require.Equal(t, steps, input.Steps)
+			},
+		},
+		{
+			name:    "input too large",
+			objects: []client.Object{testPromo},
+			steps: []Step{{
+				Kind:   "fake-step",
+				Config: []byte(fmt.Sprintf(`{"foo":%q}`, strings.Repeat("a", maxPodInputBytes))),
+			}},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "exceeds the maximum")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+				requireJobDeleted(t, c)
+			},
+		},
+		{
+			name:    "step can only be executed by the controller",
+			objects: []client.Object{testPromo},
+			steps:   []Step{{Kind: "fake-plugin-step", Alias: "step-1"}},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "cannot be executed in a promotion Pod")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+				require.Contains(t, res.Message, "fake-plugin-step")
+				requireJobDeleted(t, c)
+			},
+		},
+		{
+			name:    "Job running without result",
+			objects: []client.Object{testPromo, testJob()},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return nil, nil
+			},
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, int64(1), res.CurrentStep)
+			},
+		},
+		{
+			name:    "error getting log of running Job",
+			objects: []client.Object{testPromo, testJob()},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+			},
+		},
+		{
+			name:    "Job running with result",
+			objects: []client.Object{testPromo, testJob()},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return testLog(
+					Result{Status: kargoapi.PromotionPhaseRunning, CurrentStep: 1},
+					Result{
+						Status:       kargoapi.PromotionPhaseRunning,
+						CurrentStep:  2,
+						State:        State{"step-1": map[string]any{"foo": "bar"}},
+						HealthChecks: []health.Criteria{{Kind: "fake"}},
+					},
+				), nil
+			},
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, int64(2), res.CurrentStep)
+				require.Equal(t, State{"step-1": map[string]any{"foo": "bar"}}, res.State)
+				require.Nil(t, res.HealthChecks)
+			},
+		},
+		{
+			name:    "Job running with result after lengthy log",
+			objects: []client.Object{testPromo, testJob()},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return io.NopCloser(io.MultiReader(
+					testLog(Result{Status: kargoapi.PromotionPhaseRunning, CurrentStep: 1}),
+					strings.NewReader(strings.Repeat("chatty log line\n", 2*maxPodResultBytes/16)),
+					testLog(Result{Status: kargoapi.PromotionPhaseRunning, CurrentStep: 2}),
+				)), nil
+			},
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(2), res.CurrentStep)
+			},
+		},
+		{
+			name:    "Job completed without result",
+			objects: []client.Object{testPromo, testJob(completed)},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return testLog(), nil
+			},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "did not report a result")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+				requireJobDeleted(t, c)
+			},
+		},
+		{
+			name:    "Job failed while Promotion was running",
+			objects: []client.Object{testPromo, testJob(failed)},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return testLog(Result{Status: kargoapi.PromotionPhaseRunning, CurrentStep: 1}), nil
+			},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "longer than specified deadline")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+				require.Equal(t, int64(1), res.CurrentStep)
+				requireJobDeleted(t, c)
+			},
+		},
+		{
+			name:    "Promotion errored",
+			objects: []client.Object{testPromo, testJob(failed)},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return testLog(Result{
+					Status:  kargoapi.PromotionPhaseErrored,
+					Message: "something went wrong",
+				}), nil
+			},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+				requireJobDeleted(t, c)
+			},
+		},
+		{
+			name:    "Promotion succeeded",
+			objects: []client.Object{testPromo, testJob(completed)},
+			getPodLogFn: func(context.Context, *batchv1.Job) (io.ReadCloser, error) {
+				return testLog(
+					Result{Status: kargoapi.PromotionPhaseRunning, CurrentStep: 1},
+					Result{
+						Status:       kargoapi.PromotionPhaseSucceeded,
+						CurrentStep:  2,
+						HealthChecks: []health.Criteria{{Kind: "fake"}},
+					},
+				), nil
+			},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+				require.Equal(t, []health.Criteria{{Kind: "fake"}}, res.HealthChecks)
+				requireJobDeleted(t, c)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			e := &PodEngine{
+				cfg: PodEngineConfig{
+					Image:              "fake-image",
+					ServiceAccountName: "fake-service-account",
+					PollInterval:       10 * time.Second,
+					Timeout:            time.Hour,
+				},
+				client:              c,
+				controllerOnlySteps: []string{"fake-plugin-step"},
+				getPodLogFn:         testCase.getPodLogFn,
+			}
+			testSteps := steps
+			if testCase.steps != nil {
+				testSteps = testCase.steps
+			}
+			res, err := e.Promote(context.Background(), promoCtx, testSteps)
+			testCase.assertions(t, c, res, err)
+		})
+	}
+}
+
+func requireJobDeleted(t *testing.T, c client.Client) {
+	t.Helper()
+	key := types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"}
+	err := c.Get(context.Background(), key, &batchv1.Job{})
+	require.True(t, apierrors.IsNotFound(err))
+	err = c.Get(context.Background(), key, &corev1.Secret{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestRunInPod(t *testing.T) {
+	retryAfter := time.Millisecond
+	var calls []Context
+	engine := &MockEngine{
+		PromoteFn: func(_ context.Context, promoCtx Context, _ []Step) (Result, error) {
+			calls = append(calls, promoCtx)
+			if len(calls) == 1 {
+				return Result{
+					Status:       kargoapi.PromotionPhaseRunning,
+					CurrentStep:  1,
+					State:        State{"foo": "bar"},
+					HealthChecks: []health.Criteria{{Kind: "first"}},
+					RetryAfter:   &retryAfter,
+				}, nil
+			}
+			return Result{
+				Status:       kargoapi.PromotionPhaseSucceeded,
+				CurrentStep:  2,
+				HealthChecks: []health.Criteria{{Kind: "second"}},
+			}, nil
+		},
+	}
+	out := &bytes.Buffer{}
+	err := RunInPod(
+		context.Background(),
+		engine,
+		PodInput{Context: Context{Project: "fake-project"}},
+		out,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, calls, 2)
+	require.Equal(t, int64(1), calls[1].StartFromStep)
+	require.Equal(t, State{"foo": "bar"}, calls[1].State)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	var results []Result
+	for _, line := range lines {
+		resJSON, ok := strings.CutPrefix(line, podResultPrefix)
+		require.True(t, ok)
+		res := Result{}
+		require.NoError(t, json.Unmarshal([]byte(resJSON), &res))
+		results = append(results, res)
+	}
+	require.Equal(t, kargoapi.PromotionPhaseRunning, results[0].Status)
+	require.Empty(t, results[0].HealthChecks)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, results[1].Status)
+	require.Equal(
+		t,
+		[]health.Criteria{{Kind: "first"}, {Kind: "second"}},
+		results[1].HealthChecks,
+	)
+}
@@ -67,6 +67,13 @@ type Context struct {
 	Vars []kargoapi.ExpressionVariable
 	// Actor is the name of the actor triggering the Promotion.
 	Actor string
+	// OnFailure tracks the execution of the onFailure steps of the promotion
+	// process. If it, or Finally, is non-nil, the main steps of the promotion
+	// process are considered to have been executed (or aborted) already.
+	OnFailure *kargoapi.PromotionStepSectionStatus
+	// Finally tracks the execution of the finally steps of the promotion
+	// process.
+	Finally *kargoapi.PromotionStepSectionStatus
 
 	// currentStepMetadata is a pointer to the StepMetadata for the
 	// current step being executed. It is used to track the execution state of
@@ -101,6 +108,12 @@ type Result struct {
 	// reporting itself to be in a Running status should be retried. Note: This is
 	// unrelated to retrying upon non-terminal failures.
 	RetryAfter *time.Duration
+	// OnFailure describes the execution of the onFailure steps of the
+	// promotion process, if they have been executed.
+	OnFailure *kargoapi.PromotionStepSectionStatus
+	// Finally describes the execution of the finally steps of the promotion
+	// process, if they have been executed.
+	Finally *kargoapi.PromotionStepSectionStatus
 }
 
 // ContextOption is a function that configures a Context.
@@ -143,6 +156,8 @@ func NewContext(
 		StepExecutionMetadata: promo.Status.StepExecutionMetadata,
 		State:                 State(promo.Status.GetState()),
 		Vars:                  promo.Spec.Vars,
+		OnFailure:             promo.Status.OnFailure,
+		Finally:               promo.Status.Finally,
 	}
 
 	if stage != nil && len(stage.Spec.RequestedFreight) > 0 {
@@ -230,6 +245,8 @@ func (c *Context) DeepCopy() Context {
 		State:                 c.State.DeepCopy(),
 		Vars:                  slices.Clone(c.Vars),
 		Actor:                 c.Actor,
+		OnFailure:             c.OnFailure.DeepCopy(),
+		Finally:               c.Finally.DeepCopy(),
 	}
 
 	if c.FreightRequests != nil {
@@ -271,18 +288,67 @@ type Step struct {
 	// Config is an opaque JSON to be passed to the StepRunner executing this
 	// step.
 	Config []byte
+	// Expansion describes how this step was expanded from a step group or a
+	// forEach step, if it was.
+	Expansion *kargoapi.PromotionStepExpansion
+	// Section is the section of the user-defined promotion process this step
+	// belongs to.
+	Section StepSection
+}
+
+// StepSection identifies a section of the steps of a user-defined promotion
+// process.
+type StepSection string
+
+const (
+	// StepSectionMain is the section of the steps that make up the promotion
+	// process proper.
+	StepSectionMain StepSection = ""
+	// StepSectionOnFailure is the section of the steps that are executed if
+	// any of the main steps fail or error, or if they are aborted.
+	StepSectionOnFailure StepSection = "onFailure"
+	// StepSectionFinally is the section of the steps that are executed after
+	// the main steps and any onFailure steps, regardless of their outcome.
+	StepSectionFinally StepSection = "finally"
+)
+
+// parallelism returns the maximum number of steps expanded from the same
+// parent as this Step that may be executed concurrently. It returns 1 for
+// steps that were not expanded from a parent.
+func (s Step) parallelism() int64 {
+	if s.Expansion == nil || s.Expansion.Parallelism < 1 {
+		return 1
+	}
+	return s.Expansion.Parallelism
 }
 
 // NewSteps creates a slice of Steps from the provided Promotion. Each Step in
-// the slice corresponds to a step defined in the Promotion's spec.
+// the slice corresponds to a step defined in the Promotion's spec. The main
+// steps come first, followed by the onFailure and finally steps.
 func NewSteps(promo *kargoapi.Promotion) []Step {
-	result := make([]Step, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
+	result := make(
+		[]Step,
+		0,
+		len(promo.Spec.Steps)+len(promo.Spec.OnFailure)+len(promo.Spec.Finally),
+	)
+	result = appendSteps(result, StepSectionMain, promo.Spec.Steps)
+	result = appendSteps(result, StepSectionOnFailure, promo.Spec.OnFailure)
+	return appendSteps(result, StepSectionFinally, promo.Spec.Finally)
+}
+
+// appendSteps appends a Step for each of the provided PromotionSteps, as part
+// of the given section, to the provided slice of Steps.
+func appendSteps(
+	steps []Step,
+	section StepSection,
+	promoSteps []kargoapi.PromotionStep,
+) []Step {
+	for _, step := range promoSteps {
 		var rawConfig []byte
 		if step.Config != nil {
 			rawConfig = step.Config.Raw
 		}
-		result[i] = Step{
+		steps = append(steps, Step{
 			Kind:            step.Uses,
 			Alias:           step.As,
 			If:              step.If,
@@ -290,9 +356,27 @@ func NewSteps(promo *kargoapi.Promotion) []Step {
 			Retry:           step.Retry,
 			Vars:            step.Vars,
 			Config:          rawConfig,
+			Expansion:       step.Expansion,
+			Section:         section,
+		})
+	}
+	return steps
+}
+
+// splitStepSections splits the provided Steps into the main, onFailure and
+// finally steps.
+func splitStepSections(steps []Step) (main, onFailure, finally []Step) {
+	for _, step := range steps {
+		switch step.Section {
+		case StepSectionOnFailure:
+			onFailure = append(onFailure, step)
+		case StepSectionFinally:
+			finally = append(finally, step)
+		default:
+			main = append(main, step)
 		}
 	}
-	return result
+	return main, onFailure, finally
 }
 
 // StepMetadata is a type that represents metadata about the execution of a
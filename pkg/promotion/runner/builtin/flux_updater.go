@@ -0,0 +1,288 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindFluxUpdate = "flux-update"
+
+	// healthCheckKindFlux is the kind of the health check registered by the
+	// flux-update step.
+	healthCheckKindFlux = "flux"
+
+	stateKeyResources = "resources"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindFluxUpdate,
+			Metadata: promotion.StepRunnerMetadata{
+				DefaultTimeout: 5 * time.Minute,
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Value: newFluxUpdater,
+		},
+	)
+}
+
+// fluxUpdater is an implementation of the promotion.StepRunner interface that
+// pins Flux sources and HelmReleases to specific revisions and waits for Flux
+// to reconcile them.
+type fluxUpdater struct {
+	kargoClient  client.Client
+	schemaLoader gojsonschema.JSONLoader
+
+	newTargetClientFn func(
+		ctx context.Context,
+		kargoClient client.Client,
+		namespace string,
+		secretName string,
+	) (client.Client, string, error)
+}
+
+// newFluxUpdater returns an implementation of the promotion.StepRunner
+// interface that pins Flux sources and HelmReleases to specific revisions and
+// waits for Flux to reconcile them.
+func newFluxUpdater(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &fluxUpdater{
+		kargoClient:       caps.KargoClient,
+		schemaLoader:      getConfigSchemaLoader(stepKindFluxUpdate),
+		newTargetClientFn: kubeclient.NewClientFromKubeconfigSecret,
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (f *fluxUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := f.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return f.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the configuration against a JSON schema and converts it
+// into a builtin.FluxUpdateConfig struct.
+func (f *fluxUpdater) convert(cfg promotion.Config) (builtin.FluxUpdateConfig, error) {
+	return validateAndConvert[builtin.FluxUpdateConfig](f.schemaLoader, cfg, stepKindFluxUpdate)
+}
+
+func (f *fluxUpdater) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.FluxUpdateConfig,
+) (promotion.StepResult, error) {
+	fluxClient, defaultNamespace, err := f.newTargetClientFn(
+		ctx,
+		f.kargoClient,
+		stepCtx.Project,
+		cfg.KubeconfigSecret,
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	// The name of the Promotion is used as the value of the reconciliation
+	// request so that retries of this step do not request reconciliation anew
+	// and so that it can be determined when Flux has handled the request.
+	requestedAt := stepCtx.Promotion
+
+	logger := logging.LoggerFromContext(ctx)
+	resChecks := make([]checkers.FluxResourceHealthCheck, len(cfg.Resources))
+	var pending []string
+	for i, update := range cfg.Resources {
+		namespace := update.Namespace
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+		resChecks[i] = checkers.FluxResourceHealthCheck{
+			Kind:            string(update.Kind),
+			Namespace:       namespace,
+			Name:            update.Name,
+			DesiredRevision: desiredFluxRevision(update),
+		}
+		obj, err := f.updateResource(ctx, fluxClient, update, namespace, requestedAt)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		logger.Debug(
+			"requested reconciliation of Flux resource",
+			"kind", update.Kind,
+			"namespace", namespace,
+			"name", update.Name,
+		)
+		state, _, issue := checkers.EvaluateFluxResource(
+			obj,
+			resChecks[i].DesiredRevision,
+			requestedAt,
+		)
+		switch state {
+		case kargoapi.HealthStateHealthy:
+		case kargoapi.HealthStateUnhealthy:
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("reconciliation failed: %s", issue)
+		default:
+			pending = append(pending, issue)
+		}
+	}
+
+	if len(pending) > 0 {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusRunning,
+			Message: fmt.Sprintf(
+				"waiting for %d Flux resource(s) to be reconciled: %s",
+				len(pending), strings.Join(pending, "; "),
+			),
+		}, nil
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			stateKeyResources: resChecks,
+		},
+		HealthCheck: &health.Criteria{
+			Kind: healthCheckKindFlux,
+			Input: health.Input{
+				"kubeconfigSecret": cfg.KubeconfigSecret,
+				"resources":        resChecks,
+			},
+		},
+	}, nil
+}
+
+// updateResource pins the specified Flux resource to the revision described
+// by the provided update and requests its reconciliation. It returns the
+// updated resource.
+func (f *fluxUpdater) updateResource(
+	ctx context.Context,
+	c client.Client,
+	update builtin.FluxResourceUpdate,
+	namespace string,
+	requestedAt string,
+) (*unstructured.Unstructured, error) {
+	gvk, err := checkers.FluxGroupVersionKind(string(update.Kind))
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	desc := fmt.Sprintf("%s %q in namespace %q", update.Kind, update.Name, namespace)
+	if err = c.Get(
+		ctx,
+		client.ObjectKey{Namespace: namespace, Name: update.Name},
+		obj,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("unable to find %s", desc)
+		}
+		return nil, fmt.Errorf("error getting %s: %w", desc, err)
+	}
+
+	patch := client.MergeFrom(obj.DeepCopy())
+	if err = setFluxRevision(obj, update); err != nil {
+		return nil, fmt.Errorf("error updating %s: %w", desc, err)
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[checkers.FluxReconcileRequestAnnotation] = requestedAt
+	obj.SetAnnotations(annotations)
+	if err = c.Patch(ctx, obj, patch); err != nil {
+		return nil, fmt.Errorf("error patching %s: %w", desc, err)
+	}
+	return obj, nil
+}
+
+// setFluxRevision modifies the spec of the provided Flux resource to pin it to
+// the revision described by the provided update. Resources for which the
+// update specifies no revision are left unchanged.
+func setFluxRevision(obj *unstructured.Unstructured, update builtin.FluxResourceUpdate) error {
+	switch update.Kind {
+	case builtin.GitRepository:
+		if update.Commit == "" && update.Tag == "" {
+			return nil
+		}
+		// A branch may be retained alongside a commit to permit shallow
+		// clones, but all other references would take precedence over or
+		// conflict with the new one.
+		ref := map[string]any{}
+		if update.Commit != "" {
+			if branch, ok, _ := unstructured.NestedString(
+				obj.Object, "spec", "ref", "branch",
+			); ok && branch != "" {
+				ref["branch"] = branch
+			}
+			ref["commit"] = update.Commit
+		} else {
+			ref["tag"] = update.Tag
+		}
+		return unstructured.SetNestedMap(obj.Object, ref, "spec", "ref")
+	case builtin.OCIRepository:
+		if update.Digest == "" && update.Tag == "" {
+			return nil
+		}
+		ref := map[string]any{}
+		if update.Digest != "" {
+			ref["digest"] = update.Digest
+		} else {
+			ref["tag"] = update.Tag
+		}
+		return unstructured.SetNestedMap(obj.Object, ref, "spec", "ref")
+	case builtin.HelmRelease:
+		if update.ChartVersion == "" {
+			return nil
+		}
+		if _, ok, _ := unstructured.NestedMap(obj.Object, "spec", "chart", "spec"); !ok {
+			return fmt.Errorf(
+				"HelmRelease does not define a chart template; if it uses " +
+					"spec.chartRef, update the referenced source instead",
+			)
+		}
+		return unstructured.SetNestedField(
+			obj.Object, update.ChartVersion, "spec", "chart", "spec", "version",
+		)
+	}
+	return nil
+}
+
+// desiredFluxRevision returns the revision the Flux resource described by the
+// provided update is expected to reach, or an empty string if no specific
+// revision is expected.
+func desiredFluxRevision(update builtin.FluxResourceUpdate) string {
+	switch {
+	case update.Commit != "":
+		return update.Commit
+	case update.Digest != "":
+		return update.Digest
+	case update.Tag != "":
+		return update.Tag
+	default:
+		return update.ChartVersion
+	}
+}
@@ -0,0 +1,438 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_fluxUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "kubeconfigSecret and resources not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): kubeconfigSecret is required",
+				"(root): resources is required",
+			},
+		},
+		{
+			name: "resources is empty",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources":        []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"resources: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "unsupported kind",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{{
+					"kind": "Bucket",
+					"name": "my-app",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.kind: resources.0.kind must be one of the following",
+			},
+		},
+		{
+			name: "GitRepository with commit and tag",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{{
+					"kind":   "GitRepository",
+					"name":   "my-app",
+					"commit": "abc123",
+					"tag":    "v1.0.0",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0: Must not validate the schema (not)",
+			},
+		},
+		{
+			name: "GitRepository with digest",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{{
+					"kind":   "GitRepository",
+					"name":   "my-app",
+					"digest": "sha256:abc123",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.digest: resources.0.digest must be one of the following",
+			},
+		},
+		{
+			name: "OCIRepository with digest and tag",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{{
+					"kind":   "OCIRepository",
+					"name":   "my-app",
+					"digest": "sha256:abc123",
+					"tag":    "v1.0.0",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0: Must not validate the schema (not)",
+			},
+		},
+		{
+			name: "HelmRelease with tag",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{{
+					"kind": "HelmRelease",
+					"name": "my-app",
+					"tag":  "v1.0.0",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.tag: resources.0.tag must be one of the following",
+			},
+		},
+		{
+			name: "Kustomization with chartVersion",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{{
+					"kind":         "Kustomization",
+					"name":         "my-app",
+					"chartVersion": "1.0.0",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.chartVersion: resources.0.chartVersion must be one of the following",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"kubeconfigSecret": "flux",
+				"resources": []promotion.Config{
+					{
+						"kind":   "GitRepository",
+						"name":   "my-app",
+						"commit": "abc123",
+					},
+					{
+						"kind":   "OCIRepository",
+						"name":   "my-app",
+						"digest": "sha256:abc123",
+					},
+					{
+						"kind":         "HelmRelease",
+						"name":         "my-app",
+						"chartVersion": "1.0.0",
+					},
+					{
+						"kind":      "Kustomization",
+						"namespace": "flux-system",
+						"name":      "my-app",
+					},
+				},
+			},
+		},
+	}
+
+	r := newFluxUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*fluxUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_fluxUpdater_run(t *testing.T) {
+	const (
+		testNamespace = "flux-system"
+		testPromotion = "fake-promotion"
+	)
+
+	newFluxObject := func(kind string, spec map[string]any, status map[string]any) *unstructured.Unstructured {
+		gvk, err := checkers.FluxGroupVersionKind(kind)
+		require.NoError(t, err)
+		obj := &unstructured.Unstructured{Object: map[string]any{}}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetNamespace(testNamespace)
+		obj.SetName("my-app")
+		obj.SetGeneration(1)
+		if spec != nil {
+			obj.Object["spec"] = spec
+		}
+		if status != nil {
+			obj.Object["status"] = status
+		}
+		return obj
+	}
+	readyStatus := func(extra map[string]any) map[string]any {
+		status := map[string]any{
+			"observedGeneration":     int64(1),
+			"lastHandledReconcileAt": testPromotion,
+			"conditions": []any{
+				map[string]any{
+					"type":               "Ready",
+					"status":             "True",
+					"reason":             "Succeeded",
+					"message":            "reconciliation succeeded",
+					"lastTransitionTime": "2024-01-01T00:00:00Z",
+				},
+			},
+		}
+		for k, v := range extra {
+			status[k] = v
+		}
+		return status
+	}
+
+	tests := []struct {
+		name       string
+		objects    []client.Object
+		clientErr  error
+		cfg        builtin.FluxUpdateConfig
+		assertions func(*testing.T, client.Client, promotion.StepResult, error)
+	}{
+		{
+			name:      "error creating target client",
+			clientErr: errors.New("something went wrong"),
+			cfg: builtin.FluxUpdateConfig{
+				KubeconfigSecret: "flux",
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind: builtin.Kustomization,
+					Name: "my-app",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "resource not found",
+			cfg: builtin.FluxUpdateConfig{
+				KubeconfigSecret: "flux",
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind: builtin.Kustomization,
+					Name: "my-app",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `unable to find Kustomization "my-app"`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "HelmRelease using chartRef",
+			objects: []client.Object{
+				newFluxObject("HelmRelease", map[string]any{
+					"chartRef": map[string]any{"kind": "OCIRepository", "name": "my-app"},
+				}, nil),
+			},
+			cfg: builtin.FluxUpdateConfig{
+				KubeconfigSecret: "flux",
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind:         builtin.HelmRelease,
+					Name:         "my-app",
+					ChartVersion: "1.2.3",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "update the referenced source instead")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "pins revisions and waits for reconciliation",
+			objects: []client.Object{
+				newFluxObject("GitRepository", map[string]any{
+					"url": "https://github.com/example/repo.git",
+					"ref": map[string]any{"branch": "main", "semver": ">=1.0.0"},
+				}, nil),
+				newFluxObject("HelmRelease", map[string]any{
+					"chart": map[string]any{
+						"spec": map[string]any{"chart": "my-app", "version": "1.0.0"},
+					},
+				}, nil),
+			},
+			cfg: builtin.FluxUpdateConfig{
+				KubeconfigSecret: "flux",
+				Resources: []builtin.FluxResourceUpdate{
+					{
+						Kind:   builtin.GitRepository,
+						Name:   "my-app",
+						Commit: "abc123",
+					},
+					{
+						Kind:         builtin.HelmRelease,
+						Name:         "my-app",
+						ChartVersion: "1.2.3",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.Contains(t, res.Message, "waiting for 2 Flux resource(s) to be reconciled")
+				require.Nil(t, res.HealthCheck)
+
+				repo := &unstructured.Unstructured{}
+				repo.SetGroupVersionKind(schema.GroupVersionKind{
+					Group: "source.toolkit.fluxcd.io", Version: "v1", Kind: "GitRepository",
+				})
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: "my-app"},
+					repo,
+				))
+				ref, _, _ := unstructured.NestedMap(repo.Object, "spec", "ref")
+				require.Equal(t, map[string]any{"branch": "main", "commit": "abc123"}, ref)
+				require.Equal(
+					t,
+					testPromotion,
+					repo.GetAnnotations()[checkers.FluxReconcileRequestAnnotation],
+				)
+
+				release := &unstructured.Unstructured{}
+				release.SetGroupVersionKind(schema.GroupVersionKind{
+					Group: "helm.toolkit.fluxcd.io", Version: "v2", Kind: "HelmRelease",
+				})
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: "my-app"},
+					release,
+				))
+				version, _, _ := unstructured.NestedString(
+					release.Object, "spec", "chart", "spec", "version",
+				)
+				require.Equal(t, "1.2.3", version)
+			},
+		},
+		{
+			name: "reconciliation failed",
+			objects: []client.Object{
+				newFluxObject("OCIRepository", map[string]any{
+					"url": "oci://ghcr.io/example/manifests",
+				}, map[string]any{
+					"observedGeneration":     int64(1),
+					"lastHandledReconcileAt": testPromotion,
+					"conditions": []any{
+						map[string]any{
+							"type":               "Ready",
+							"status":             "False",
+							"reason":             "OCIArtifactPullFailed",
+							"message":            "manifest unknown",
+							"lastTransitionTime": "2024-01-01T00:00:00Z",
+						},
+					},
+				}),
+			},
+			cfg: builtin.FluxUpdateConfig{
+				KubeconfigSecret: "flux",
+				Resources: []builtin.FluxResourceUpdate{{
+					Kind: builtin.OCIRepository,
+					Name: "my-app",
+					Tag:  "v1.0.0",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "reconciliation failed")
+				require.ErrorContains(t, err, "manifest unknown")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "reconciled",
+			objects: []client.Object{
+				newFluxObject("OCIRepository", map[string]any{
+					"url": "oci://ghcr.io/example/manifests",
+				}, readyStatus(map[string]any{
+					"artifact": map[string]any{"revision": "v1.0.0@sha256:abc123"},
+				})),
+				newFluxObject("Kustomization", map[string]any{
+					"path": "./",
+				}, readyStatus(nil)),
+			},
+			cfg: builtin.FluxUpdateConfig{
+				KubeconfigSecret: "flux",
+				Resources: []builtin.FluxResourceUpdate{
+					{
+						Kind:   builtin.OCIRepository,
+						Name:   "my-app",
+						Digest: "sha256:abc123",
+					},
+					{
+						Kind: builtin.Kustomization,
+						Name: "my-app",
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				expectedChecks := []checkers.FluxResourceHealthCheck{
+					{
+						Kind:            "OCIRepository",
+						Namespace:       testNamespace,
+						Name:            "my-app",
+						DesiredRevision: "sha256:abc123",
+					},
+					{
+						Kind:      "Kustomization",
+						Namespace: testNamespace,
+						Name:      "my-app",
+					},
+				}
+				require.Equal(t, expectedChecks, res.Output[stateKeyResources])
+				require.NotNil(t, res.HealthCheck)
+				require.Equal(t, healthCheckKindFlux, res.HealthCheck.Kind)
+				require.Equal(t, "flux", res.HealthCheck.Input["kubeconfigSecret"])
+				require.Equal(t, expectedChecks, res.HealthCheck.Input["resources"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fluxClient := fake.NewClientBuilder().
+				WithScheme(runtime.NewScheme()).
+				WithObjects(tt.objects...).
+				Build()
+			runner := &fluxUpdater{
+				newTargetClientFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+				) (client.Client, string, error) {
+					return fluxClient, testNamespace, tt.clientErr
+				},
+			}
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project:   "fake-project",
+					Promotion: testPromotion,
+				},
+				tt.cfg,
+			)
+			tt.assertions(t, fluxClient, res, err)
+		})
+	}
+}
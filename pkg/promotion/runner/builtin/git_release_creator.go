@@ -0,0 +1,230 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/gitprovider"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/urls"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+
+	_ "github.com/akuity/kargo/pkg/gitprovider/azure"     // Azure provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/bitbucket" // Bitbucket provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitea"     // Gitea provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/github"    // GitHub provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitlab"    // GitLab provider registration
+)
+
+const (
+	stepKindGitCreateRelease = "git-create-release"
+
+	// stateKeyRelease is the key used to store information about a release in
+	// the shared State.
+	stateKeyRelease = "release"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindGitCreateRelease,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+			},
+			Value: newGitReleaseCreator,
+		},
+	)
+}
+
+// gitReleaseCreator is an implementation of the promotion.StepRunner interface
+// that creates a release on a Git provider.
+type gitReleaseCreator struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newGitReleaseCreator returns an implementation of the promotion.StepRunner
+// interface that creates a release on a Git provider.
+func newGitReleaseCreator(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitReleaseCreator{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindGitCreateRelease),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitReleaseCreator) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := g.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return g.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the configuration against a JSON schema and converts it
+// into a builtin.GitCreateReleaseConfig struct.
+func (g *gitReleaseCreator) convert(
+	cfg promotion.Config,
+) (builtin.GitCreateReleaseConfig, error) {
+	return validateAndConvert[builtin.GitCreateReleaseConfig](
+		g.schemaLoader, cfg, stepKindGitCreateRelease,
+	)
+}
+
+func (g *gitReleaseCreator) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.GitCreateReleaseConfig,
+) (promotion.StepResult, error) {
+	// Short-circuit if shared state has output from a previous execution of
+	// this step that contains a release. Most Git providers refuse to create a
+	// second release for the same tag.
+	if output, ok := stepCtx.SharedState.Get(stepCtx.Alias); ok {
+		if outputMap, ok := output.(map[string]any); ok {
+			if _, ok = outputMap[stateKeyRelease]; ok {
+				return promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: outputMap,
+				}, nil
+			}
+		}
+	}
+
+	gpOpts := &gitprovider.Options{
+		InsecureSkipTLSVerify: cfg.InsecureSkipTLSVerify,
+	}
+	creds, err := g.credsDB.Get(
+		ctx,
+		stepCtx.Project,
+		credentials.TypeGit,
+		cfg.RepoURL,
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting credentials for %s: %w", cfg.RepoURL, err)
+	}
+	if creds != nil {
+		gpOpts.Token = creds.Password
+	}
+	if cfg.Provider != nil {
+		gpOpts.Name = string(*cfg.Provider)
+	}
+	gitProv, err := gitprovider.New(cfg.RepoURL, gpOpts)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating git provider service: %w", err)
+	}
+
+	notes := cfg.Notes
+	if notes == "" {
+		notes = buildReleaseNotes(stepCtx, gitProv, cfg.RepoURL)
+	}
+
+	release, err := gitProv.CreateRelease(
+		ctx,
+		&gitprovider.CreateReleaseOpts{
+			Tag:         cfg.Tag,
+			Target:      cfg.Target,
+			Name:        cfg.Name,
+			Description: notes,
+			Draft:       cfg.Draft,
+			Prerelease:  cfg.Prerelease,
+		},
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating release for tag %q: %w", cfg.Tag, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			stateKeyRelease: map[string]any{
+				"tag":  release.Tag,
+				"name": release.Name,
+				"url":  release.URL,
+			},
+		},
+	}, nil
+}
+
+// buildReleaseNotes generates release notes that list the commits referenced
+// by the Freight being promoted. Commits from the repository the release is
+// being created in are linked to, when possible.
+func buildReleaseNotes(
+	stepCtx *promotion.StepContext,
+	gitProv gitprovider.Interface,
+	repoURL string,
+) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(
+		"Promoted to Stage `%s` by Promotion `%s`.\n",
+		stepCtx.Stage, stepCtx.Promotion,
+	))
+
+	normalizedRepoURL := urls.NormalizeGit(repoURL)
+	var commitLines []string
+	for _, ref := range stepCtx.Freight.References() {
+		for _, commit := range ref.Commits {
+			commitLines = append(
+				commitLines,
+				releaseNotesCommitLine(commit, gitProv, normalizedRepoURL),
+			)
+		}
+	}
+	if len(commitLines) > 0 {
+		sb.WriteString("\n## Commits\n\n")
+		for _, line := range commitLines {
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+	}
+
+	if stepCtx.UIBaseURL != "" {
+		sb.WriteString(fmt.Sprintf(
+			"\n[View in Kargo UI](%s/project/%s/stage/%s)\n",
+			stepCtx.UIBaseURL,
+			stepCtx.Project,
+			stepCtx.Stage,
+		))
+	}
+	return sb.String()
+}
+
+// releaseNotesCommitLine formats the provided commit as a Markdown list item.
+func releaseNotesCommitLine(
+	commit kargoapi.GitCommit,
+	gitProv gitprovider.Interface,
+	normalizedRepoURL string,
+) string {
+	id := commit.ID
+	if len(id) > 7 {
+		id = id[:7]
+	}
+	id = fmt.Sprintf("`%s`", id)
+	if commit.ID != "" && urls.NormalizeGit(commit.RepoURL) == normalizedRepoURL {
+		if commitURL, err := gitProv.GetCommitURL(commit.RepoURL, commit.ID); err == nil {
+			id = fmt.Sprintf("[%s](%s)", id, commitURL)
+		}
+	}
+	line := fmt.Sprintf("- %s: %s", commit.RepoURL, id)
+	if subject, _, _ := strings.Cut(commit.Message, "\n"); subject != "" {
+		line = fmt.Sprintf("%s %s", line, subject)
+	}
+	if commit.Author != "" {
+		line = fmt.Sprintf("%s (%s)", line, commit.Author)
+	}
+	return line
+}
@@ -0,0 +1,308 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/gitprovider"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_gitReleaseCreator_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "repoURL not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): repoURL is required",
+			},
+		},
+		{
+			name: "repoURL is empty string",
+			config: promotion.Config{
+				"repoURL": "",
+			},
+			expectedProblems: []string{
+				"repoURL: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "tag not specified",
+			config: promotion.Config{
+				"repoURL": "https://github.com/example/repo.git",
+			},
+			expectedProblems: []string{
+				"(root): tag is required",
+			},
+		},
+		{
+			name: "provider is an invalid value",
+			config: promotion.Config{
+				"provider": "bogus",
+			},
+			expectedProblems: []string{
+				"provider: provider must be one of the following:",
+			},
+		},
+		{
+			name: "valid minimal config",
+			config: promotion.Config{
+				"repoURL": "https://github.com/example/repo.git",
+				"tag":     "v1.0.0",
+			},
+		},
+		{
+			name: "valid with all options",
+			config: promotion.Config{
+				"repoURL":    "https://github.com/example/repo.git",
+				"provider":   "github",
+				"tag":        "v1.0.0",
+				"target":     "main",
+				"name":       "Release v1.0.0",
+				"notes":      "Lots of good stuff",
+				"draft":      true,
+				"prerelease": true,
+			},
+		},
+	}
+
+	r := newGitReleaseCreator(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	runner, ok := r.(*gitReleaseCreator)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_gitReleaseCreator_run(t *testing.T) {
+	const testRepoURL = "https://github.com/example/repo.git"
+
+	testCases := []struct {
+		name       string
+		provider   *gitprovider.Fake
+		stepCtx    *promotion.StepContext
+		config     builtin.GitCreateReleaseConfig
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name: "release already created by a previous execution",
+			stepCtx: &promotion.StepContext{
+				Alias: "release",
+				SharedState: promotion.State{
+					"release": map[string]any{
+						stateKeyRelease: map[string]any{"tag": "v1.0.0"},
+					},
+				},
+			},
+			provider: &gitprovider.Fake{},
+			config:   builtin.GitCreateReleaseConfig{Tag: "v1.0.0"},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					map[string]any{"tag": "v1.0.0"},
+					res.Output[stateKeyRelease],
+				)
+			},
+		},
+		{
+			name:    "error creating release",
+			stepCtx: &promotion.StepContext{},
+			provider: &gitprovider.Fake{
+				CreateReleaseFn: func(
+					context.Context,
+					*gitprovider.CreateReleaseOpts,
+				) (*gitprovider.Release, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			config: builtin.GitCreateReleaseConfig{
+				Tag:   "v1.0.0",
+				Notes: "Fake notes",
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error creating release")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:    "success with explicit notes",
+			stepCtx: &promotion.StepContext{},
+			provider: &gitprovider.Fake{
+				CreateReleaseFn: func(
+					_ context.Context,
+					opts *gitprovider.CreateReleaseOpts,
+				) (*gitprovider.Release, error) {
+					if opts.Description != "Fake notes" || !opts.Draft ||
+						opts.Target != "main" || opts.Name != "Release v1.0.0" {
+						return nil, fmt.Errorf("unexpected options: %+v", opts)
+					}
+					return &gitprovider.Release{
+						Tag:  opts.Tag,
+						Name: opts.Name,
+						URL:  "https://github.com/example/repo/releases/tag/v1.0.0",
+					}, nil
+				},
+			},
+			config: builtin.GitCreateReleaseConfig{
+				Tag:    "v1.0.0",
+				Target: "main",
+				Name:   "Release v1.0.0",
+				Notes:  "Fake notes",
+				Draft:  true,
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					map[string]any{
+						"tag":  "v1.0.0",
+						"name": "Release v1.0.0",
+						"url":  "https://github.com/example/repo/releases/tag/v1.0.0",
+					},
+					res.Output[stateKeyRelease],
+				)
+			},
+		},
+		{
+			name: "success with generated notes",
+			stepCtx: &promotion.StepContext{
+				Stage:     "fake-stage",
+				Promotion: "fake-promotion",
+				Freight: kargoapi.FreightCollection{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/fake-warehouse": {
+							Commits: []kargoapi.GitCommit{{
+								RepoURL: testRepoURL,
+								ID:      "abcdef1234567890",
+								Message: "Fix everything\n\nFor real this time.",
+								Author:  "Tony Stark <tony@starkindustries.com>",
+							}},
+						},
+					},
+				},
+			},
+			provider: &gitprovider.Fake{
+				GetCommitURLFn: func(repoURL string, sha string) (string, error) {
+					return fmt.Sprintf("%s/commit/%s", repoURL, sha), nil
+				},
+				CreateReleaseFn: func(
+					_ context.Context,
+					opts *gitprovider.CreateReleaseOpts,
+				) (*gitprovider.Release, error) {
+					return &gitprovider.Release{
+						Tag: opts.Tag,
+						// Smuggle the notes out via the name so they can be checked
+						Name: opts.Description,
+					}, nil
+				},
+			},
+			config: builtin.GitCreateReleaseConfig{Tag: "v1.0.0"},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				release, ok := res.Output[stateKeyRelease].(map[string]any)
+				require.True(t, ok)
+				require.Equal(
+					t,
+					"Promoted to Stage `fake-stage` by Promotion `fake-promotion`.\n\n"+
+						"## Commits\n\n"+
+						"- "+testRepoURL+": [`abcdef1`]("+testRepoURL+"/commit/abcdef1234567890) "+
+						"Fix everything (Tony Stark <tony@starkindustries.com>)\n",
+					release["name"],
+				)
+			},
+		},
+	}
+
+	r := newGitReleaseCreator(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	runner, ok := r.(*gitReleaseCreator)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// Cannot register multiple providers with the same name, so this takes
+			// care of that problem
+			testGitProviderName := uuid.NewString()
+
+			gitprovider.Register(
+				testGitProviderName,
+				gitprovider.Registration{
+					NewProvider: func(
+						string,
+						*gitprovider.Options,
+					) (gitprovider.Interface, error) {
+						return testCase.provider, nil
+					},
+				},
+			)
+
+			cfg := testCase.config
+			cfg.Provider = ptr.To(builtin.Provider(testGitProviderName))
+			cfg.RepoURL = testRepoURL
+
+			res, err := runner.run(context.Background(), testCase.stepCtx, cfg)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_buildReleaseNotes(t *testing.T) {
+	gitProv := &gitprovider.Fake{
+		GetCommitURLFn: func(repoURL string, sha string) (string, error) {
+			return fmt.Sprintf("%s/commit/%s", repoURL, sha), nil
+		},
+	}
+	notes := buildReleaseNotes(
+		&promotion.StepContext{
+			UIBaseURL: "https://kargo.example.com",
+			Project:   "fake-project",
+			Stage:     "fake-stage",
+			Promotion: "fake-promotion",
+			Freight: kargoapi.FreightCollection{
+				Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/b": {
+						Commits: []kargoapi.GitCommit{{
+							RepoURL: "https://github.com/example/other.git",
+							ID:      "1234567890abcdef",
+							Message: "Bump version",
+						}},
+					},
+					"Warehouse/a": {
+						Commits: []kargoapi.GitCommit{{
+							RepoURL: "https://github.com/example/repo",
+							ID:      "abcdef1234567890",
+						}},
+					},
+				},
+			},
+		},
+		gitProv,
+		"https://github.com/example/repo.git",
+	)
+	require.Equal(
+		t,
+		"Promoted to Stage `fake-stage` by Promotion `fake-promotion`.\n\n"+
+			"## Commits\n\n"+
+			"- https://github.com/example/repo: "+
+			"[`abcdef1`](https://github.com/example/repo/commit/abcdef1234567890)\n"+
+			"- https://github.com/example/other.git: `1234567` Bump version\n"+
+			"\n[View in Kargo UI](https://kargo.example.com/project/fake-project/stage/fake-stage)\n",
+		notes,
+	)
+}
@@ -0,0 +1,195 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindGitTag = "git-tag"
+
+	// stateKeyTag is the key used to store the name of a tag in the shared
+	// State.
+	stateKeyTag = "tag"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindGitTag,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+			},
+			Value: newGitTagger,
+		},
+	)
+}
+
+// gitTagger is an implementation of the promotion.StepRunner interface that
+// creates an annotated tag in a local Git repository and pushes it to the
+// remote repository.
+type gitTagger struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newGitTagger returns an implementation of the promotion.StepRunner interface
+// that creates an annotated tag in a local Git repository and pushes it to the
+// remote repository.
+func newGitTagger(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitTagger{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindGitTag),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitTagger) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := g.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return g.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the configuration against a JSON schema and converts it
+// into a builtin.GitTagConfig struct.
+func (g *gitTagger) convert(cfg promotion.Config) (builtin.GitTagConfig, error) {
+	return validateAndConvert[builtin.GitTagConfig](g.schemaLoader, cfg, stepKindGitTag)
+}
+
+func (g *gitTagger) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.GitTagConfig,
+) (promotion.StepResult, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	// As in the git-push step, the working tree is loaded once to get the URL
+	// of the repository so that applicable credentials can be found, and then
+	// reloaded with those credentials.
+	path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+			"error joining path %s with work dir %s: %w",
+			cfg.Path, stepCtx.WorkDir, err,
+		)
+	}
+	loadOpts := &git.LoadWorkTreeOptions{}
+	workTree, err := git.LoadWorkTree(path, loadOpts)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+	creds, err := g.credsDB.Get(
+		ctx,
+		stepCtx.Project,
+		credentials.TypeGit,
+		workTree.URL(),
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting credentials for %s: %w", workTree.URL(), err)
+	}
+	if creds != nil {
+		loadOpts.Credentials = &git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
+		}
+	}
+	if workTree, err = git.LoadWorkTree(path, loadOpts); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+
+	commitID := cfg.Commit
+	if commitID == "" {
+		if commitID, err = workTree.LastCommitID(); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error getting last commit ID: %w", err)
+		}
+	}
+
+	// If the tag already exists, this may be a retry of a step that already
+	// succeeded. That is fine as long as the existing tag references the
+	// commit we were going to tag. Listing tags fetches them from the remote
+	// first, so this also accounts for tags that were already pushed.
+	tags, err := workTree.ListTags()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error listing tags: %w", err)
+	}
+	var exists bool
+	for _, t := range tags {
+		if t.Tag != cfg.Tag {
+			continue
+		}
+		if !strings.HasPrefix(t.CommitID, commitID) {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{
+					Err: fmt.Errorf(
+						"tag %q already exists and references commit %s instead of %s",
+						cfg.Tag, t.CommitID, commitID,
+					),
+				}
+		}
+		commitID = t.CommitID
+		exists = true
+		break
+	}
+
+	if !exists {
+		tagOpts := &git.TagOptions{
+			Message:  cfg.Message,
+			CommitID: commitID,
+		}
+		if cfg.Tagger != nil {
+			tagOpts.Tagger = &git.User{
+				Name:       cfg.Tagger.Name,
+				Email:      cfg.Tagger.Email,
+				SigningKey: cfg.Tagger.SigningKey,
+			}
+		}
+		if err = workTree.CreateTag(cfg.Tag, tagOpts); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error creating tag %q: %w", cfg.Tag, err)
+		}
+		logger.Debug("created tag", "tag", cfg.Tag, "commit", commitID)
+	} else {
+		logger.Debug("tag already exists", "tag", cfg.Tag, "commit", commitID)
+	}
+
+	// Pushing an existing tag that is already present on the remote is a
+	// no-op, so we always push.
+	if err = workTree.PushTag(cfg.Tag); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error pushing tag %q: %w", cfg.Tag, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			stateKeyTag:    cfg.Tag,
+			stateKeyCommit: commitID,
+		},
+	}, nil
+}
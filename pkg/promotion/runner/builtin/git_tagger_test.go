@@ -0,0 +1,220 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_gitTagger_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "tag not specified",
+			config: promotion.Config{
+				"path": "/fake/path",
+			},
+			expectedProblems: []string{
+				"(root): tag is required",
+			},
+		},
+		{
+			name: "tagger name not specified",
+			config: promotion.Config{
+				"path": "/fake/path",
+				"tag":  "v1.0.0",
+				"tagger": promotion.Config{
+					"email": "tony@starkindustries.com",
+				},
+			},
+			expectedProblems: []string{
+				"tagger: name is required",
+			},
+		},
+		{
+			name: "tagger email is invalid",
+			config: promotion.Config{
+				"path": "/fake/path",
+				"tag":  "v1.0.0",
+				"tagger": promotion.Config{
+					"name":  "Tony Stark",
+					"email": "tony",
+				},
+			},
+			expectedProblems: []string{
+				"tagger.email: Does not match format 'email'",
+			},
+		},
+		{
+			name: "valid minimal config",
+			config: promotion.Config{
+				"path": "/fake/path",
+				"tag":  "v1.0.0",
+			},
+		},
+		{
+			name: "valid with all options",
+			config: promotion.Config{
+				"path":    "/fake/path",
+				"tag":     "v1.0.0",
+				"message": "Release v1.0.0",
+				"commit":  "abc123",
+				"tagger": promotion.Config{
+					"name":       "Tony Stark",
+					"email":      "tony@starkindustries.com",
+					"signingKey": "fake-key",
+				},
+			},
+		},
+	}
+
+	r := newGitTagger(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	runner, ok := r.(*gitTagger)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_gitTagger_run(t *testing.T) {
+	// Set up a test Git server in-process
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	// This is the URL of the "remote" repository
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	// Seed the remote repository with a commit.
+	repo, err := git.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer repo.Close()
+	err = os.WriteFile(filepath.Join(repo.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	err = repo.AddAllAndCommit("Initial commit", nil)
+	require.NoError(t, err)
+	err = repo.Push(&git.PushOptions{TargetBranch: "master"})
+	require.NoError(t, err)
+	expectedCommit, err := repo.LastCommitID()
+	require.NoError(t, err)
+
+	// Finagle a local bare repo and working tree into place the way that
+	// gitCloner might have so we can verify gitTagger's ability to reload the
+	// working tree from the file system.
+	workDir := t.TempDir()
+	bareRepo, err := git.CloneBare(
+		testRepoURL,
+		nil,
+		&git.BareCloneOptions{
+			BaseDir: workDir,
+		},
+	)
+	require.NoError(t, err)
+	defer bareRepo.Close()
+	_, err = bareRepo.AddWorkTree(
+		filepath.Join(workDir, "master"),
+		&git.AddWorkTreeOptions{Ref: "master"},
+	)
+	require.NoError(t, err)
+
+	r := newGitTagger(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	runner, ok := r.(*gitTagger)
+	require.True(t, ok)
+
+	stepCtx := &promotion.StepContext{
+		Project:   "fake-project",
+		Stage:     "fake-stage",
+		Promotion: "fake-promotion",
+		WorkDir:   workDir,
+	}
+
+	res, err := runner.run(
+		context.Background(),
+		stepCtx,
+		builtin.GitTagConfig{
+			Path:    "master",
+			Tag:     "v1.0.0",
+			Message: "Release v1.0.0",
+			Tagger: &builtin.Tagger{
+				Name:  "Tony Stark",
+				Email: "tony@starkindustries.com",
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.Equal(t, "v1.0.0", res.Output[stateKeyTag])
+	require.Equal(t, expectedCommit, res.Output[stateKeyCommit])
+
+	// The tag should have been pushed to the remote.
+	tags, err := repo.ListTags()
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, "v1.0.0", tags[0].Tag)
+	require.Equal(t, expectedCommit, tags[0].CommitID)
+	require.Equal(t, "Release v1.0.0", tags[0].Annotation)
+	require.Equal(t, "Tony Stark <tony@starkindustries.com>", tags[0].Tagger)
+
+	// Running the step again should be a no-op.
+	res, err = runner.run(
+		context.Background(),
+		stepCtx,
+		builtin.GitTagConfig{
+			Path: "master",
+			Tag:  "v1.0.0",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.Equal(t, expectedCommit, res.Output[stateKeyCommit])
+
+	// Tagging a different commit with an existing tag should fail.
+	res, err = runner.run(
+		context.Background(),
+		stepCtx,
+		builtin.GitTagConfig{
+			Path:   "master",
+			Tag:    "v1.0.0",
+			Commit: "0000000000000000000000000000000000000000",
+		},
+	)
+	require.ErrorContains(t, err, "already exists")
+	require.True(t, promotion.IsTerminal(err))
+	require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/xeipuuv/gojsonschema"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/bucket"
+	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/io/fs"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/promotion"
@@ -52,13 +54,15 @@ var downloadBufferPool = sync.Pool{
 // downloads files from HTTP/HTTPS URLs.
 type httpDownloader struct {
 	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
 }
 
 // newHTTPDownloader returns an implementation of the promotion.StepRunner
 // interface that downloads files from HTTP/HTTPS URLs.
-func newHTTPDownloader(promotion.StepRunnerCapabilities) promotion.StepRunner {
+func newHTTPDownloader(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
 	return &httpDownloader{
 		schemaLoader: getConfigSchemaLoader(stepKindHTTPDownload),
+		credsDB:      caps.CredsDB,
 	}
 }
 
@@ -93,7 +97,7 @@ func (d *httpDownloader) run(
 		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
 	}
 
-	resp, err := d.performHTTPRequest(cfg)
+	resp, err := d.performHTTPRequest(ctx, stepCtx.Project, cfg)
 	if err != nil {
 		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
 	}
@@ -145,12 +149,20 @@ func (d *httpDownloader) checkFileOverwrite(absOutPath, outPath string, allowOve
 }
 
 // performHTTPRequest executes the HTTP request and returns the response.
-func (d *httpDownloader) performHTTPRequest(cfg builtin.HTTPDownloadConfig) (*http.Response, error) {
+func (d *httpDownloader) performHTTPRequest(
+	ctx context.Context,
+	project string,
+	cfg builtin.HTTPDownloadConfig,
+) (*http.Response, error) {
 	req, err := d.buildRequest(cfg)
 	if err != nil {
 		return nil, &promotion.TerminalError{Err: fmt.Errorf("error building HTTP request: %w", err)}
 	}
 
+	if err = d.signRequest(ctx, project, req, cfg.Region); err != nil {
+		return nil, fmt.Errorf("error signing HTTP request: %w", err)
+	}
+
 	client, err := d.buildHTTPClient(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error creating HTTP client: %w", err)
@@ -188,6 +200,40 @@ func (d *httpDownloader) buildRequest(cfg builtin.HTTPDownloadConfig) (*http.Req
 	return req, nil
 }
 
+// signRequest signs the request using AWS Signature Version 4 if bucket
+// credentials exist in the project for the S3-compatible bucket the requested
+// object would belong to. This permits downloading objects from private
+// buckets, such as those discovered by a Warehouse's bucket subscriptions.
+// Requests for which no such credentials exist are left unsigned.
+func (d *httpDownloader) signRequest(
+	ctx context.Context,
+	project string,
+	req *http.Request,
+	region string,
+) error {
+	if d.credsDB == nil {
+		return nil
+	}
+	bucketURL := bucket.URLForObject(req.URL)
+	if bucketURL == "" {
+		return nil
+	}
+	creds, err := d.credsDB.Get(ctx, project, credentials.TypeBucket, bucketURL)
+	if err != nil {
+		return fmt.Errorf("error obtaining credentials for bucket %q: %w", bucketURL, err)
+	}
+	if creds == nil {
+		return nil
+	}
+	logging.LoggerFromContext(ctx).Debug("signing request using bucket credentials", "bucket", bucketURL)
+	// The username and password of bucket credentials are an access key ID and
+	// secret access key, respectively.
+	return bucket.SignRequest(ctx, req, region, &bucket.Credentials{
+		AccessKeyID:     creds.Username,
+		SecretAccessKey: creds.Password,
+	})
+}
+
 // buildHTTPClient creates an HTTP client with the specified configuration.
 func (d *httpDownloader) buildHTTPClient(cfg builtin.HTTPDownloadConfig) (*http.Client, error) {
 	httpTransport := cleanhttp.DefaultTransport()
@@ -2,6 +2,7 @@ package builtin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
 )
@@ -398,6 +400,102 @@ func Test_httpDownloader_buildRequest(t *testing.T) {
 	require.Equal(t, "Bearer token123", req.Header.Get("Authorization"))
 }
 
+func Test_httpDownloader_signRequest(t *testing.T) {
+	const bucketURL = "https://s3.example.com/my-bucket"
+	tests := []struct {
+		name       string
+		credsDB    credentials.Database
+		url        string
+		assertions func(*testing.T, *http.Request, error)
+	}{
+		{
+			name: "no credentials database",
+			url:  bucketURL + "/my-app/1.0.0.tar.gz",
+			assertions: func(t *testing.T, req *http.Request, err error) {
+				require.NoError(t, err)
+				require.Empty(t, req.Header.Get("Authorization"))
+			},
+		},
+		{
+			name: "URL is not an object URL",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(context.Context, string, credentials.Type, string) (*credentials.Credentials, error) {
+					require.Fail(t, "credentials should not be looked up")
+					return nil, nil
+				},
+			},
+			url: "https://s3.example.com/my-bucket",
+			assertions: func(t *testing.T, req *http.Request, err error) {
+				require.NoError(t, err)
+				require.Empty(t, req.Header.Get("Authorization"))
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(context.Context, string, credentials.Type, string) (*credentials.Credentials, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			url: bucketURL + "/my-app/1.0.0.tar.gz",
+			assertions: func(t *testing.T, _ *http.Request, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for bucket")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "no credentials found",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(context.Context, string, credentials.Type, string) (*credentials.Credentials, error) {
+					return nil, nil
+				},
+			},
+			url: bucketURL + "/my-app/1.0.0.tar.gz",
+			assertions: func(t *testing.T, req *http.Request, err error) {
+				require.NoError(t, err)
+				require.Empty(t, req.Header.Get("Authorization"))
+			},
+		},
+		{
+			name: "credentials found",
+			credsDB: &credentials.FakeDB{
+				GetFn: func(
+					_ context.Context,
+					project string,
+					credType credentials.Type,
+					repoURL string,
+				) (*credentials.Credentials, error) {
+					require.Equal(t, "fake-project", project)
+					require.Equal(t, credentials.TypeBucket, credType)
+					require.Equal(t, bucketURL, repoURL)
+					return &credentials.Credentials{
+						Username: "access-key",
+						Password: "secret-key",
+					}, nil
+				},
+			},
+			url: bucketURL + "/my-app/1.0.0.tar.gz",
+			assertions: func(t *testing.T, req *http.Request, err error) {
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(
+					req.Header.Get("Authorization"),
+					"AWS4-HMAC-SHA256 Credential=access-key/",
+				))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+			d := &httpDownloader{credsDB: tt.credsDB}
+			err = d.signRequest(context.Background(), "fake-project", req, "")
+			tt.assertions(t, req, err)
+		})
+	}
+}
+
 func Test_httpDownloader_buildHTTPClient(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -0,0 +1,555 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindKubernetesApply = "kubernetes-apply"
+
+	// healthCheckKindKubernetes is the kind of the health check registered by
+	// the kubernetes-apply step.
+	healthCheckKindKubernetes = "kubernetes"
+
+	// defaultKubernetesApplyFieldManager is the field manager used for
+	// server-side apply when none is specified.
+	defaultKubernetesApplyFieldManager = "kargo"
+
+	stateKeyObjects = "objects"
+	stateKeyPruned  = "pruned"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindKubernetesApply,
+			Metadata: promotion.StepRunnerMetadata{
+				DefaultTimeout: 5 * time.Minute,
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Value: newKubernetesApplier,
+		},
+	)
+}
+
+// kubernetesApplier is an implementation of the promotion.StepRunner interface
+// that applies rendered manifests to a Kubernetes cluster using server-side
+// apply.
+type kubernetesApplier struct {
+	kargoClient  client.Client
+	schemaLoader gojsonschema.JSONLoader
+
+	newTargetClientFn func(
+		ctx context.Context,
+		kargoClient client.Client,
+		namespace string,
+		secretName string,
+	) (client.Client, string, error)
+}
+
+// newKubernetesApplier returns an implementation of the promotion.StepRunner
+// interface that applies rendered manifests to a Kubernetes cluster using
+// server-side apply.
+func newKubernetesApplier(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &kubernetesApplier{
+		kargoClient:       caps.KargoClient,
+		schemaLoader:      getConfigSchemaLoader(stepKindKubernetesApply),
+		newTargetClientFn: kubeclient.NewClientFromKubeconfigSecret,
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (k *kubernetesApplier) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := k.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return k.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the configuration against a JSON schema and converts it
+// into a builtin.KubernetesApplyConfig struct.
+func (k *kubernetesApplier) convert(cfg promotion.Config) (builtin.KubernetesApplyConfig, error) {
+	return validateAndConvert[builtin.KubernetesApplyConfig](k.schemaLoader, cfg, stepKindKubernetesApply)
+}
+
+func (k *kubernetesApplier) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.KubernetesApplyConfig,
+) (promotion.StepResult, error) {
+	var pruneSelector labels.Selector
+	if cfg.Prune != nil {
+		var err error
+		if pruneSelector, err = buildPruneSelector(cfg.Prune.Selector); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: fmt.Errorf("invalid prune selector: %w", err)}
+		}
+	}
+
+	var healthCheckSelector *metav1.LabelSelector
+	if cfg.HealthCheck != nil {
+		var err error
+		if healthCheckSelector, err = buildLabelSelector(
+			cfg.HealthCheck.Selector.MatchLabels,
+			cfg.HealthCheck.Selector.MatchExpressions,
+		); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: fmt.Errorf("invalid health check selector: %w", err)}
+		}
+	}
+
+	objs, err := readManifests(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{Err: fmt.Errorf("error reading manifests from %q: %w", cfg.Path, err)}
+	}
+	if len(objs) == 0 {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{Err: fmt.Errorf("no manifests found in %q", cfg.Path)}
+	}
+
+	targetClient, defaultNamespace, err := k.newTargetClientFn(
+		ctx,
+		k.kargoClient,
+		stepCtx.Project,
+		cfg.KubeconfigSecret,
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	if cfg.Namespace != "" {
+		defaultNamespace = cfg.Namespace
+	}
+
+	fieldManager := cfg.FieldManager
+	if fieldManager == "" {
+		fieldManager = defaultKubernetesApplyFieldManager
+	}
+	applyOpts := []client.ApplyOption{client.FieldOwner(fieldManager)}
+	if cfg.Force {
+		applyOpts = append(applyOpts, client.ForceOwnership)
+	}
+	if cfg.DryRun {
+		applyOpts = append(applyOpts, client.DryRunAll)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	for _, obj := range objs {
+		if err = defaultNamespaceFor(targetClient, obj, defaultNamespace); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		if err = targetClient.Apply(
+			ctx,
+			client.ApplyConfigurationFromUnstructured(obj),
+			applyOpts...,
+		); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error applying %s: %w", objectString(obj), err)
+		}
+		logger.Debug("applied object", "object", objectString(obj), "dryRun", cfg.DryRun)
+	}
+
+	var pruned []*unstructured.Unstructured
+	if pruneSelector != nil {
+		if pruned, err = k.prune(ctx, targetClient, objs, pruneSelector, cfg.DryRun); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+	}
+
+	if cfg.Wait && !cfg.DryRun {
+		notReady, err := k.getNotReady(ctx, targetClient, objs)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		if len(notReady) > 0 {
+			return promotion.StepResult{
+				Status: kargoapi.PromotionStepStatusRunning,
+				Message: fmt.Sprintf(
+					"waiting for %d object(s) to be rolled out: %s",
+					len(notReady), strings.Join(notReady, ", "),
+				),
+			}, nil
+		}
+	}
+
+	res := promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			stateKeyObjects: objectReferences(objs),
+			stateKeyPruned:  objectReferences(pruned),
+		},
+	}
+	if !cfg.DryRun {
+		res.HealthCheck = k.buildHealthCheck(cfg, objs, healthCheckSelector)
+	}
+	return res, nil
+}
+
+// buildHealthCheck returns criteria for a health check on the applied objects.
+// If a selector is provided, the objects to check are discovered using it
+// within each kind and namespace that objects were applied to. Otherwise, each
+// applied object is checked by name.
+func (k *kubernetesApplier) buildHealthCheck(
+	cfg builtin.KubernetesApplyConfig,
+	objs []*unstructured.Unstructured,
+	selector *metav1.LabelSelector,
+) *health.Criteria {
+	objHealthChecks := make([]checkers.KubernetesObjectHealthCheck, 0, len(objs))
+	for _, obj := range objs {
+		objHealthCheck := checkers.KubernetesObjectHealthCheck{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+		}
+		if selector == nil {
+			objHealthCheck.Name = obj.GetName()
+		} else {
+			objHealthCheck.Selector = selector
+			if slices.ContainsFunc(objHealthChecks, func(c checkers.KubernetesObjectHealthCheck) bool {
+				return c.APIVersion == objHealthCheck.APIVersion &&
+					c.Kind == objHealthCheck.Kind &&
+					c.Namespace == objHealthCheck.Namespace
+			}) {
+				continue
+			}
+		}
+		objHealthChecks = append(objHealthChecks, objHealthCheck)
+	}
+	return &health.Criteria{
+		Kind: healthCheckKindKubernetes,
+		Input: health.Input{
+			"kubeconfigSecret": cfg.KubeconfigSecret,
+			"objects":          objHealthChecks,
+		},
+	}
+}
+
+// prune deletes objects of the same kinds, in the same namespaces, as the
+// applied objects that match the provided selector but are not among the
+// applied objects. It returns the objects that were deleted.
+func (k *kubernetesApplier) prune(
+	ctx context.Context,
+	c client.Client,
+	applied []*unstructured.Unstructured,
+	selector labels.Selector,
+	dryRun bool,
+) ([]*unstructured.Unstructured, error) {
+	type scope struct {
+		gvk       schema.GroupVersionKind
+		namespace string
+	}
+	keep := make(map[string]struct{}, len(applied))
+	var scopes []scope
+	for _, obj := range applied {
+		keep[objectString(obj)] = struct{}{}
+		s := scope{gvk: obj.GroupVersionKind(), namespace: obj.GetNamespace()}
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+
+	deleteOpts := []client.DeleteOption{client.PropagationPolicy(metav1.DeletePropagationBackground)}
+	if dryRun {
+		deleteOpts = append(deleteOpts, client.DryRunAll)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	var pruned []*unstructured.Unstructured
+	for _, s := range scopes {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(s.gvk.GroupVersion().WithKind(s.gvk.Kind + "List"))
+		listOpts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+		if s.namespace != "" {
+			listOpts = append(listOpts, client.InNamespace(s.namespace))
+		}
+		if err := c.List(ctx, list, listOpts...); err != nil {
+			return nil, fmt.Errorf(
+				"error listing %s objects to prune: %w", s.gvk.Kind, err,
+			)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			obj.SetGroupVersionKind(s.gvk)
+			if _, ok := keep[objectString(obj)]; ok || obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if err := c.Delete(ctx, obj, deleteOpts...); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("error pruning %s: %w", objectString(obj), err)
+			}
+			logger.Debug("pruned object", "object", objectString(obj), "dryRun", dryRun)
+			pruned = append(pruned, obj)
+		}
+	}
+	return pruned, nil
+}
+
+// getNotReady returns a description of each of the provided objects that has
+// not yet been fully rolled out, as determined by its kstatus. An error is
+// returned if any object's rollout has failed.
+func (k *kubernetesApplier) getNotReady(
+	ctx context.Context,
+	c client.Client,
+	objs []*unstructured.Unstructured,
+) ([]string, error) {
+	var notReady []string
+	for _, obj := range objs {
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(obj.GroupVersionKind())
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+			if apierrors.IsNotFound(err) {
+				notReady = append(notReady, objectString(obj))
+				continue
+			}
+			return nil, fmt.Errorf("error getting %s: %w", objectString(obj), err)
+		}
+		res, err := status.Compute(live)
+		if err != nil {
+			return nil, fmt.Errorf("error computing status of %s: %w", objectString(obj), err)
+		}
+		switch res.Status {
+		case status.CurrentStatus:
+		case status.FailedStatus:
+			return nil, fmt.Errorf("rollout of %s failed: %s", objectString(obj), res.Message)
+		default:
+			notReady = append(notReady, objectString(obj))
+		}
+	}
+	return notReady, nil
+}
+
+// defaultNamespaceFor sets the namespace of the provided object to the
+// provided namespace if the object is namespaced and does not specify one.
+func defaultNamespaceFor(c client.Client, obj *unstructured.Unstructured, namespace string) error {
+	if obj.GetNamespace() != "" {
+		return nil
+	}
+	namespaced, err := c.IsObjectNamespaced(obj)
+	if err != nil {
+		return fmt.Errorf("error determining scope of %s: %w", objectString(obj), err)
+	}
+	if namespaced {
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
+// readManifests reads all Kubernetes objects from the file or directory at the
+// provided path, relative to the provided working directory. Namespaces and
+// CustomResourceDefinitions are ordered before all other objects so they exist
+// by the time objects that depend on them are applied.
+func readManifests(workDir, path string) ([]*unstructured.Unstructured, error) {
+	absPath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return nil, fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	fi, err := os.Stat(absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	if !fi.IsDir() {
+		files = []string{absPath}
+	} else if err = filepath.WalkDir(absPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, p)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var objs []*unstructured.Unstructured
+	for _, file := range files {
+		fileObjs, err := readManifestFile(file)
+		if err != nil {
+			rel, _ := filepath.Rel(workDir, file)
+			return nil, fmt.Errorf("error reading %q: %w", rel, err)
+		}
+		objs = append(objs, fileObjs...)
+	}
+
+	slices.SortStableFunc(objs, func(a, b *unstructured.Unstructured) int {
+		return applyPriority(a) - applyPriority(b)
+	})
+	return objs, nil
+}
+
+// readManifestFile reads all Kubernetes objects from a single, possibly
+// multi-document, YAML or JSON file.
+func readManifestFile(path string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var objs []*unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var obj map[string]any
+		if err = decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		u := &unstructured.Unstructured{Object: obj}
+		if !u.IsList() {
+			if err = validateManifest(u); err != nil {
+				return nil, err
+			}
+			objs = append(objs, u)
+			continue
+		}
+		if err = u.EachListItem(func(item runtime.Object) error {
+			itemU, ok := item.(*unstructured.Unstructured)
+			if !ok {
+				return fmt.Errorf("unexpected list item type %T", item)
+			}
+			if err := validateManifest(itemU); err != nil {
+				return err
+			}
+			objs = append(objs, itemU)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// validateManifest returns an error if the provided object lacks any of the
+// fields required to apply it.
+func validateManifest(obj *unstructured.Unstructured) error {
+	if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+		return errors.New("object is missing apiVersion or kind")
+	}
+	if obj.GetName() == "" {
+		return fmt.Errorf("%s object is missing metadata.name", obj.GetKind())
+	}
+	return nil
+}
+
+// applyPriority returns the relative order in which the provided object should
+// be applied. Lower values are applied first.
+func applyPriority(obj *unstructured.Unstructured) int {
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Namespace"}:
+		return 0
+	case schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// buildPruneSelector returns a labels.Selector built from the provided
+// selector configuration.
+func buildPruneSelector(selector builtin.PruneSelector) (labels.Selector, error) {
+	labelSelector, err := buildLabelSelector(selector.MatchLabels, selector.MatchExpressions)
+	if err != nil {
+		return nil, err
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+// buildLabelSelector returns a metav1.LabelSelector built from the provided
+// selection criteria. It returns an error if there are no criteria or if any
+// of them are invalid.
+func buildLabelSelector(
+	matchLabels map[string]string,
+	matchExpressions []builtin.MatchExpression,
+) (*metav1.LabelSelector, error) {
+	if len(matchLabels) == 0 && len(matchExpressions) == 0 {
+		return nil, errors.New("selector must have at least one match criterion")
+	}
+	labelSelector := &metav1.LabelSelector{MatchLabels: matchLabels}
+	for _, expr := range matchExpressions {
+		labelSelector.MatchExpressions = append(
+			labelSelector.MatchExpressions,
+			metav1.LabelSelectorRequirement{
+				Key:      expr.Key,
+				Operator: metav1.LabelSelectorOperator(expr.Operator),
+				Values:   expr.Values,
+			},
+		)
+	}
+	if _, err := metav1.LabelSelectorAsSelector(labelSelector); err != nil {
+		return nil, err
+	}
+	return labelSelector, nil
+}
+
+// objectReferences returns a JSON-friendly reference to each of the provided
+// objects, suitable for inclusion in step output.
+func objectReferences(objs []*unstructured.Unstructured) []any {
+	refs := make([]any, 0, len(objs))
+	for _, obj := range objs {
+		ref := map[string]any{
+			"apiVersion": obj.GetAPIVersion(),
+			"kind":       obj.GetKind(),
+			"name":       obj.GetName(),
+		}
+		if obj.GetNamespace() != "" {
+			ref["namespace"] = obj.GetNamespace()
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// objectString returns a human-readable identifier for the provided object.
+func objectString(obj *unstructured.Unstructured) string {
+	gk := obj.GroupVersionKind().GroupKind()
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %q", gk, obj.GetName())
+	}
+	return fmt.Sprintf("%s %q", gk, obj.GetNamespace()+"/"+obj.GetName())
+}
@@ -0,0 +1,587 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_kubernetesApplier_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path and kubeconfigSecret not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+				"(root): kubeconfigSecret is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path":             "",
+				"kubeconfigSecret": "target",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "prune without selector",
+			config: promotion.Config{
+				"path":             "manifests",
+				"kubeconfigSecret": "target",
+				"prune":            promotion.Config{},
+			},
+			expectedProblems: []string{
+				"prune: selector is required",
+			},
+		},
+		{
+			name: "prune with empty selector",
+			config: promotion.Config{
+				"path":             "manifests",
+				"kubeconfigSecret": "target",
+				"prune": promotion.Config{
+					"selector": promotion.Config{},
+				},
+			},
+			expectedProblems: []string{
+				"prune.selector: Must validate at least one schema (anyOf)",
+			},
+		},
+		{
+			name: "invalid matchExpressions operator",
+			config: promotion.Config{
+				"path":             "manifests",
+				"kubeconfigSecret": "target",
+				"prune": promotion.Config{
+					"selector": promotion.Config{
+						"matchExpressions": []promotion.Config{{
+							"key":      "app",
+							"operator": "Bogus",
+						}},
+					},
+				},
+			},
+			expectedProblems: []string{
+				"prune.selector.matchExpressions.0.operator: prune.selector.matchExpressions.0.operator must be one of the following",
+			},
+		},
+		{
+			name: "health check with empty selector",
+			config: promotion.Config{
+				"path":             "manifests",
+				"kubeconfigSecret": "target",
+				"healthCheck": promotion.Config{
+					"selector": promotion.Config{},
+				},
+			},
+			expectedProblems: []string{
+				"healthCheck.selector: Must validate at least one schema (anyOf)",
+			},
+		},
+		{
+			name: "valid minimal config",
+			config: promotion.Config{
+				"path":             "manifests",
+				"kubeconfigSecret": "target",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":             "manifests",
+				"kubeconfigSecret": "target",
+				"namespace":        "my-app",
+				"fieldManager":     "my-manager",
+				"force":            true,
+				"dryRun":           true,
+				"wait":             true,
+				"healthCheck": promotion.Config{
+					"selector": promotion.Config{
+						"matchExpressions": []promotion.Config{{
+							"key":      "app",
+							"operator": "Exists",
+						}},
+					},
+				},
+				"prune": promotion.Config{
+					"selector": promotion.Config{
+						"matchLabels": map[string]any{
+							"app": "my-app",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	r := newKubernetesApplier(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*kubernetesApplier)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_kubernetesApplier_run(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	kubeconfigSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "target",
+		},
+		Data: map[string][]byte{
+			kubeclient.KubeconfigSecretKey: []byte("fake-kubeconfig"),
+		},
+	}
+
+	const manifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  labels:
+    app: my-app
+data:
+  key: value
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: my-app
+`
+	const deploymentManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-app
+  namespace: my-app
+  labels:
+    app: my-app
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: my-app
+  template:
+    metadata:
+      labels:
+        app: my-app
+    spec:
+      containers:
+      - name: app
+        image: nginx
+`
+	staleConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-app",
+			Name:      "stale",
+			Labels:    map[string]string{"app": "my-app"},
+		},
+	}
+	unrelatedConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "my-app",
+			Name:      "unrelated",
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		files         map[string]string
+		kargoObjects  []client.Object
+		targetObjects []client.Object
+		interceptor   interceptor.Funcs
+		cfg           builtin.KubernetesApplyConfig
+		assertions    func(*testing.T, client.Client, promotion.StepResult, error)
+	}{
+		{
+			name:  "manifests not found",
+			files: map[string]string{},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "manifests",
+				KubeconfigSecret: "target",
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error reading manifests from "manifests"`)
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:  "kubeconfig Secret not found",
+			files: map[string]string{"manifests/all.yaml": manifests},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "manifests",
+				KubeconfigSecret: "target",
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `error getting kubeconfig Secret "target"`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "applies manifests and prunes",
+			files: map[string]string{
+				"manifests/all.yaml":        manifests,
+				"manifests/deployment.yaml": deploymentManifest,
+				"manifests/README.md":       "not a manifest",
+			},
+			kargoObjects:  []client.Object{kubeconfigSecret},
+			targetObjects: []client.Object{staleConfigMap, unrelatedConfigMap},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "manifests",
+				KubeconfigSecret: "target",
+				Namespace:        "my-app",
+				Prune: &builtin.Prune{
+					Selector: builtin.PruneSelector{
+						MatchLabels: map[string]string{"app": "my-app"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{"apiVersion": "v1", "kind": "Namespace", "name": "my-app"},
+						map[string]any{
+							"apiVersion": "v1",
+							"kind":       "ConfigMap",
+							"namespace":  "my-app",
+							"name":       "config",
+						},
+						map[string]any{
+							"apiVersion": "apps/v1",
+							"kind":       "Deployment",
+							"namespace":  "my-app",
+							"name":       "my-app",
+						},
+					},
+					res.Output[stateKeyObjects],
+				)
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{
+							"apiVersion": "v1",
+							"kind":       "ConfigMap",
+							"namespace":  "my-app",
+							"name":       "stale",
+						},
+					},
+					res.Output[stateKeyPruned],
+				)
+
+				require.NotNil(t, res.HealthCheck)
+				require.Equal(t, "kubernetes", res.HealthCheck.Kind)
+				require.Equal(t, "target", res.HealthCheck.Input["kubeconfigSecret"])
+				require.Contains(
+					t,
+					res.HealthCheck.Input["objects"],
+					checkers.KubernetesObjectHealthCheck{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  "my-app",
+						Name:       "my-app",
+					},
+				)
+
+				cm := &corev1.ConfigMap{}
+				require.NoError(
+					t,
+					c.Get(context.Background(), types.NamespacedName{Namespace: "my-app", Name: "config"}, cm),
+				)
+				require.Equal(t, "value", cm.Data["key"])
+				require.NoError(
+					t,
+					c.Get(context.Background(), types.NamespacedName{Namespace: "my-app", Name: "unrelated"}, cm),
+				)
+				err = c.Get(context.Background(), types.NamespacedName{Namespace: "my-app", Name: "stale"}, cm)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+		{
+			name:          "dry run",
+			files:         map[string]string{"manifests.yaml": manifests},
+			kargoObjects:  []client.Object{kubeconfigSecret},
+			targetObjects: []client.Object{staleConfigMap},
+			interceptor: interceptor.Funcs{
+				// The fake client does not support dry-run for server-side apply
+				Apply: func(
+					_ context.Context,
+					_ client.WithWatch,
+					_ runtime.ApplyConfiguration,
+					opts ...client.ApplyOption,
+				) error {
+					applyOpts := &client.ApplyOptions{}
+					applyOpts.ApplyOptions(opts)
+					require.Equal(t, []string{metav1.DryRunAll}, applyOpts.DryRun)
+					return nil
+				},
+			},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "manifests.yaml",
+				KubeconfigSecret: "target",
+				Namespace:        "my-app",
+				DryRun:           true,
+				Wait:             true,
+				Prune: &builtin.Prune{
+					Selector: builtin.PruneSelector{
+						MatchLabels: map[string]string{"app": "my-app"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Len(t, res.Output[stateKeyPruned], 1)
+				require.Nil(t, res.HealthCheck)
+
+				err = c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "my-app", Name: "config"},
+					&corev1.ConfigMap{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{Namespace: "my-app", Name: "stale"},
+						&corev1.ConfigMap{},
+					),
+				)
+			},
+		},
+		{
+			name: "health check discovers objects by selector",
+			files: map[string]string{
+				"manifests/all.yaml":        manifests,
+				"manifests/deployment.yaml": deploymentManifest,
+			},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "manifests",
+				KubeconfigSecret: "target",
+				Namespace:        "my-app",
+				HealthCheck: &builtin.HealthCheck{
+					Selector: builtin.HealthCheckSelector{
+						MatchLabels: map[string]string{"app": "my-app"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.NotNil(t, res.HealthCheck)
+				selector := &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "my-app"},
+				}
+				require.Equal(
+					t,
+					[]checkers.KubernetesObjectHealthCheck{
+						{
+							APIVersion: "v1",
+							Kind:       "Namespace",
+							Selector:   selector,
+						},
+						{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Namespace:  "my-app",
+							Selector:   selector,
+						},
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  "my-app",
+							Selector:   selector,
+						},
+					},
+					res.HealthCheck.Input["objects"],
+				)
+			},
+		},
+		{
+			name:  "invalid health check selector",
+			files: map[string]string{"manifests.yaml": manifests},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "manifests.yaml",
+				KubeconfigSecret: "target",
+				HealthCheck:      &builtin.HealthCheck{},
+			},
+			assertions: func(t *testing.T, _ client.Client, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid health check selector")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:         "waits for rollout",
+			files:        map[string]string{"deployment.yaml": deploymentManifest},
+			kargoObjects: []client.Object{kubeconfigSecret},
+			cfg: builtin.KubernetesApplyConfig{
+				Path:             "deployment.yaml",
+				KubeconfigSecret: "target",
+				Wait:             true,
+			},
+			assertions: func(t *testing.T, c client.Client, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.Contains(t, res.Message, `Deployment.apps "my-app/my-app"`)
+
+				deploy := &appsv1.Deployment{}
+				require.NoError(
+					t,
+					c.Get(context.Background(), types.NamespacedName{Namespace: "my-app", Name: "my-app"}, deploy),
+				)
+				require.Equal(t, "nginx", deploy.Spec.Template.Spec.Containers[0].Image)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for path, content := range testCase.files {
+				absPath := filepath.Join(workDir, path)
+				require.NoError(t, os.MkdirAll(filepath.Dir(absPath), 0o700))
+				require.NoError(t, os.WriteFile(absPath, []byte(content), 0o600))
+			}
+
+			targetClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+				WithObjects(testCase.targetObjects...).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			runner := &kubernetesApplier{
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.kargoObjects...).
+					Build(),
+				newTargetClientFn: func(
+					ctx context.Context,
+					kargoClient client.Client,
+					namespace string,
+					name string,
+				) (client.Client, string, error) {
+					// Ensure the kubeconfig Secret is looked up where expected
+					if _, err := kubeclient.GetKubeconfigFromSecret(ctx, kargoClient, namespace, name); err != nil {
+						return nil, "", err
+					}
+					return targetClient, "default", nil
+				},
+			}
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: testProject,
+					WorkDir: workDir,
+				},
+				testCase.cfg,
+			)
+			testCase.assertions(t, targetClient, res, err)
+		})
+	}
+}
+
+func Test_readManifests(t *testing.T) {
+	testCases := []struct {
+		name       string
+		content    string
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name: "list and empty documents",
+			content: `---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: a
+- apiVersion: apiextensions.k8s.io/v1
+  kind: CustomResourceDefinition
+  metadata:
+    name: widgets.example.com
+---
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: ns
+`,
+			assertions: func(t *testing.T, objs []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						`Namespace "ns"`,
+						`CustomResourceDefinition.apiextensions.k8s.io "widgets.example.com"`,
+						`ConfigMap "a"`,
+					},
+					objs,
+				)
+			},
+		},
+		{
+			name: "missing name",
+			content: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: a-
+`,
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "ConfigMap object is missing metadata.name")
+			},
+		},
+		{
+			name:    "missing kind",
+			content: `apiVersion: v1`,
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "object is missing apiVersion or kind")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			require.NoError(
+				t,
+				os.WriteFile(filepath.Join(workDir, "manifests.yaml"), []byte(testCase.content), 0o600),
+			)
+			objs, err := readManifests(workDir, "manifests.yaml")
+			var names []string
+			for _, obj := range objs {
+				names = append(names, objectString(obj))
+			}
+			testCase.assertions(t, names, err)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package builtin
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/xeipuuv/gojsonschema"
@@ -21,7 +20,7 @@ func validateAndConvert[T any](
 ) (T, error) {
 	var zero T
 
-	if err := validate(schemaLoader, gojsonschema.NewGoLoader(config), stepKind); err != nil {
+	if err := promotion.ValidateConfig(schemaLoader, config, stepKind); err != nil {
 		return zero, err
 	}
 
@@ -32,25 +31,3 @@ func validateAndConvert[T any](
 
 	return cfg, nil
 }
-
-// validate validates the given configuration document against the provided JSON
-// schema loader. It returns an error if the validation fails or if there is an
-// error during validation. If the validation is successful, it returns nil.
-func validate(
-	schemaLoader gojsonschema.JSONLoader,
-	docLoader gojsonschema.JSONLoader,
-	stepKind string,
-) error {
-	result, err := gojsonschema.Validate(schemaLoader, docLoader)
-	if err != nil {
-		return fmt.Errorf("could not validate %s config: %w", stepKind, err)
-	}
-	if !result.Valid() {
-		errs := make([]error, len(result.Errors()))
-		for i, err := range result.Errors() {
-			errs[i] = errors.New(err.String())
-		}
-		return fmt.Errorf("invalid %s config: %w", stepKind, errors.Join(errs...))
-	}
-	return nil
-}
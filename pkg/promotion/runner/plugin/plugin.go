@@ -0,0 +1,245 @@
+// Package plugin provides support for promotion steps implemented outside of
+// the Kargo controller process.
+//
+// A plugin is either an executable or an HTTP endpoint (typically served by a
+// sidecar container of the controller). Upon discovery, the controller asks
+// each plugin to describe itself. The plugin responds with a Descriptor that
+// declares the name, JSON config schema, metadata and required capabilities of
+// each step it implements. Each step is then registered with a
+// promotion.StepRunnerRegistry and invoked like any built-in step.
+//
+// Executables are invoked with a single "describe" or "run" argument. A
+// RunRequest is written to the stdin of a "run" invocation, which must write a
+// RunResponse to its stdout. A Descriptor must be written to the stdout of a
+// "describe" invocation. HTTP endpoints must respond to GET requests to
+// "<url>/describe" with a Descriptor and to POST requests to "<url>/run"
+// containing a RunRequest with a RunResponse.
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/component"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// Config represents configuration for step plugins.
+type Config struct {
+	// Plugins is a list of references to step plugins. Each reference is either
+	// the path of an executable or the base URL of an HTTP endpoint.
+	Plugins []string `envconfig:"STEP_PLUGINS"`
+	// AllowedCapabilities is the list of capabilities that plugins may require.
+	// The task-output-propagation capability is always allowed, as it does not
+	// grant a step access to anything. A plugin that requires any other
+	// capability not on this list is rejected. Because plugins run outside of
+	// the controller process, they cannot be handed the clients or credentials
+	// database that built-in steps receive. Allowing a capability only permits
+	// a plugin that requires it to be registered. The plugin itself remains
+	// responsible for obtaining the access it needs.
+	AllowedCapabilities []promotion.StepRunnerCapability `envconfig:"STEP_PLUGIN_ALLOWED_CAPABILITIES"`
+	// DiscoveryTimeout is the maximum duration of the discovery of a single
+	// plugin. Within this duration, a plugin that cannot be reached (e.g.
+	// because the sidecar container serving it has not started yet) is asked
+	// to describe itself again, with exponential backoff.
+	DiscoveryTimeout time.Duration `envconfig:"STEP_PLUGIN_DISCOVERY_TIMEOUT" default:"2m"`
+	// RunTimeout is the maximum duration of a single invocation of a plugin to
+	// run a step.
+	RunTimeout time.Duration `envconfig:"STEP_PLUGIN_RUN_TIMEOUT" default:"10m"`
+}
+
+// ConfigFromEnv returns a new Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Register discovers all plugins referenced by the provided Config and
+// registers each of the steps they implement with the provided registry. It
+// returns the names of the registered steps. Plugin steps are not permitted to
+// replace steps that are already registered.
+func Register(
+	ctx context.Context,
+	registry promotion.StepRunnerRegistry,
+	cfg Config,
+) ([]string, error) {
+	logger := logging.LoggerFromContext(ctx)
+	var names []string
+	for _, ref := range cfg.Plugins {
+		t := newTransport(ref)
+		regs, err := discover(ctx, t, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error discovering step plugin %s: %w", t, err)
+		}
+		for _, reg := range regs {
+			if _, err = registry.Get(reg.Name); err == nil {
+				return nil, fmt.Errorf(
+					"step plugin %s implements step %q, which is already registered",
+					t, reg.Name,
+				)
+			} else if !component.IsNotFoundError(err) {
+				return nil, fmt.Errorf("error checking registration of step %q: %w", reg.Name, err)
+			}
+			if err = registry.Register(reg); err != nil {
+				return nil, fmt.Errorf("error registering step %q of plugin %s: %w", reg.Name, t, err)
+			}
+			logger.Info("registered step plugin", "plugin", t.String(), "step", reg.Name)
+			names = append(names, reg.Name)
+		}
+	}
+	return names, nil
+}
+
+// discoveryBackoff is the backoff with which a plugin that cannot be reached
+// is asked to describe itself again during discovery.
+var discoveryBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Cap:      15 * time.Second,
+}
+
+// discover retrieves a Descriptor from the plugin reachable through the
+// provided transport and returns registrations for each of the steps it
+// implements.
+func discover(
+	ctx context.Context,
+	t transport,
+	cfg Config,
+) ([]promotion.StepRunnerRegistration, error) {
+	if cfg.DiscoveryTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.DiscoveryTimeout)
+		defer cancel()
+	}
+	desc, err := describe(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	if desc.ProtocolVersion != ProtocolVersion {
+		return nil, fmt.Errorf(
+			"unsupported protocol version %q; expected %q",
+			desc.ProtocolVersion, ProtocolVersion,
+		)
+	}
+	if len(desc.Steps) == 0 {
+		return nil, errors.New("plugin does not implement any steps")
+	}
+	regs := make([]promotion.StepRunnerRegistration, 0, len(desc.Steps))
+	for _, step := range desc.Steps {
+		if step.Name == "" {
+			return nil, errors.New("plugin describes a step without a name")
+		}
+		for _, capability := range step.RequiredCapabilities {
+			if capability != promotion.StepCapabilityTaskOutputPropagation &&
+				!slices.Contains(cfg.AllowedCapabilities, capability) {
+				return nil, fmt.Errorf(
+					"step %q requires capability %q, which is not allowed for plugins",
+					step.Name, capability,
+				)
+			}
+		}
+		md, err := step.metadata()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metadata of step %q: %w", step.Name, err)
+		}
+		var schemaLoader gojsonschema.JSONLoader
+		if len(step.ConfigSchema) > 0 {
+			schemaLoader = gojsonschema.NewBytesLoader(step.ConfigSchema)
+			if _, err = gojsonschema.NewSchema(schemaLoader); err != nil {
+				return nil, fmt.Errorf("invalid config schema for step %q: %w", step.Name, err)
+			}
+		}
+		runner := &stepRunner{
+			name:         step.Name,
+			transport:    t,
+			schemaLoader: schemaLoader,
+			timeout:      cfg.RunTimeout,
+		}
+		regs = append(regs, promotion.StepRunnerRegistration{
+			Name:     step.Name,
+			Metadata: md,
+			Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+				return runner
+			},
+		})
+	}
+	return regs, nil
+}
+
+// describe retrieves a Descriptor from the plugin reachable through the
+// provided transport. Failed attempts are retried with discoveryBackoff until
+// the provided context is done, so that plugins served by sidecar containers
+// that start after the controller are discovered once they are ready.
+func describe(ctx context.Context, t transport) (*Descriptor, error) {
+	logger := logging.LoggerFromContext(ctx)
+	backoff := discoveryBackoff
+	// The interval only grows for as long as there are Steps left. The number
+	// of attempts is bounded by the context instead.
+	backoff.Steps = math.MaxInt32
+	for {
+		desc, err := t.describe(ctx)
+		if err == nil {
+			return desc, nil
+		}
+		interval := backoff.Step()
+		logger.Info(
+			"step plugin could not be described; retrying",
+			"plugin", t.String(),
+			"error", err.Error(),
+			"retryAfter", interval.String(),
+		)
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(interval):
+		}
+	}
+}
+
+// stepRunner is an implementation of the promotion.StepRunner interface that
+// delegates the execution of a step to a plugin.
+type stepRunner struct {
+	name         string
+	transport    transport
+	schemaLoader gojsonschema.JSONLoader
+	timeout      time.Duration
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *stepRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if s.schemaLoader != nil {
+		if err := promotion.ValidateConfig(s.schemaLoader, stepCtx.Config, s.name); err != nil {
+			return promotion.StepResult{
+				Status: kargoapi.PromotionStepStatusFailed,
+			}, &promotion.TerminalError{Err: err}
+		}
+	}
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	resp, err := s.transport.run(ctx, &RunRequest{
+		Step:    s.name,
+		Context: newStepContext(stepCtx),
+	})
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+	return resp.result()
+}
@@ -0,0 +1,344 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// fakeTransport is a fake implementation of the transport interface.
+type fakeTransport struct {
+	describeFn func(context.Context) (*Descriptor, error)
+	runFn      func(context.Context, *RunRequest) (*RunResponse, error)
+}
+
+func (f *fakeTransport) describe(ctx context.Context) (*Descriptor, error) {
+	return f.describeFn(ctx)
+}
+
+func (f *fakeTransport) run(ctx context.Context, req *RunRequest) (*RunResponse, error) {
+	return f.runFn(ctx, req)
+}
+
+func (f *fakeTransport) String() string {
+	return "fake"
+}
+
+func Test_describe(t *testing.T) {
+	backoff := discoveryBackoff
+	t.Cleanup(func() { discoveryBackoff = backoff })
+	discoveryBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 2, Cap: 10 * time.Millisecond}
+
+	t.Run("plugin becomes reachable", func(t *testing.T) {
+		var attempts int
+		desc, err := describe(context.Background(), &fakeTransport{
+			describeFn: func(context.Context) (*Descriptor, error) {
+				if attempts++; attempts < 3 {
+					return nil, errors.New("connection refused")
+				}
+				return &Descriptor{ProtocolVersion: ProtocolVersion}, nil
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, ProtocolVersion, desc.ProtocolVersion)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("plugin never becomes reachable", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := describe(ctx, &fakeTransport{
+			describeFn: func(context.Context) (*Descriptor, error) {
+				return nil, errors.New("connection refused")
+			},
+		})
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func Test_discover(t *testing.T) {
+	testCases := []struct {
+		name       string
+		descriptor *Descriptor
+		cfg        Config
+		describeFn func(context.Context) (*Descriptor, error)
+		assertions func(*testing.T, []promotion.StepRunnerRegistration, error)
+	}{
+		{
+			name: "error describing plugin",
+			cfg:  Config{DiscoveryTimeout: 10 * time.Millisecond},
+			describeFn: func(context.Context) (*Descriptor, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:       "unsupported protocol version",
+			descriptor: &Descriptor{ProtocolVersion: "v0"},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "unsupported protocol version")
+			},
+		},
+		{
+			name:       "no steps",
+			descriptor: &Descriptor{ProtocolVersion: ProtocolVersion},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "plugin does not implement any steps")
+			},
+		},
+		{
+			name: "step without name",
+			descriptor: &Descriptor{
+				ProtocolVersion: ProtocolVersion,
+				Steps:           []StepDescriptor{{}},
+			},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "plugin describes a step without a name")
+			},
+		},
+		{
+			name: "capability not allowed",
+			descriptor: &Descriptor{
+				ProtocolVersion: ProtocolVersion,
+				Steps: []StepDescriptor{{
+					Name: "fake-step",
+					RequiredCapabilities: []promotion.StepRunnerCapability{
+						promotion.StepCapabilityAccessCredentials,
+					},
+				}},
+			},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "requires capability \"access-credentials\"")
+			},
+		},
+		{
+			name: "invalid default timeout",
+			descriptor: &Descriptor{
+				ProtocolVersion: ProtocolVersion,
+				Steps: []StepDescriptor{{
+					Name:           "fake-step",
+					DefaultTimeout: "forever",
+				}},
+			},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "invalid default timeout")
+			},
+		},
+		{
+			name: "invalid config schema",
+			descriptor: &Descriptor{
+				ProtocolVersion: ProtocolVersion,
+				Steps: []StepDescriptor{{
+					Name:         "fake-step",
+					ConfigSchema: json.RawMessage(`{"type": 42}`),
+				}},
+			},
+			assertions: func(t *testing.T, _ []promotion.StepRunnerRegistration, err error) {
+				require.ErrorContains(t, err, "invalid config schema for step \"fake-step\"")
+			},
+		},
+		{
+			name: "success",
+			descriptor: &Descriptor{
+				ProtocolVersion: ProtocolVersion,
+				Steps: []StepDescriptor{
+					{
+						Name:                  "fake-step",
+						ConfigSchema:          json.RawMessage(`{"type": "object"}`),
+						DefaultTimeout:        "5m",
+						DefaultErrorThreshold: 3,
+						RequiredCapabilities: []promotion.StepRunnerCapability{
+							promotion.StepCapabilityTaskOutputPropagation,
+							promotion.StepCapabilityAccessControlPlane,
+						},
+					},
+					{
+						Name: "other-fake-step",
+					},
+				},
+			},
+			cfg: Config{
+				AllowedCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			assertions: func(t *testing.T, regs []promotion.StepRunnerRegistration, err error) {
+				require.NoError(t, err)
+				require.Len(t, regs, 2)
+
+				require.Equal(t, "fake-step", regs[0].Name)
+				require.Equal(
+					t,
+					promotion.StepRunnerMetadata{
+						DefaultTimeout:        5 * time.Minute,
+						DefaultErrorThreshold: 3,
+						RequiredCapabilities: []promotion.StepRunnerCapability{
+							promotion.StepCapabilityTaskOutputPropagation,
+							promotion.StepCapabilityAccessControlPlane,
+						},
+					},
+					regs[0].Metadata,
+				)
+				runner, ok := regs[0].Value(promotion.StepRunnerCapabilities{}).(*stepRunner)
+				require.True(t, ok)
+				require.Equal(t, "fake-step", runner.name)
+				require.NotNil(t, runner.schemaLoader)
+
+				require.Equal(t, "other-fake-step", regs[1].Name)
+				runner, ok = regs[1].Value(promotion.StepRunnerCapabilities{}).(*stepRunner)
+				require.True(t, ok)
+				require.Nil(t, runner.schemaLoader)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			describeFn := testCase.describeFn
+			if describeFn == nil {
+				describeFn = func(context.Context) (*Descriptor, error) {
+					return testCase.descriptor, nil
+				}
+			}
+			regs, err := discover(
+				context.Background(),
+				&fakeTransport{describeFn: describeFn},
+				testCase.cfg,
+			)
+			testCase.assertions(t, regs, err)
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("step already registered", func(t *testing.T) {
+		registry := promotion.MustNewStepRunnerRegistry(
+			promotion.StepRunnerRegistration{Name: "fake-step"},
+		)
+		_, err := Register(
+			context.Background(),
+			registry,
+			Config{Plugins: []string{newFakePluginExecutable(t)}},
+		)
+		require.ErrorContains(t, err, "implements step \"fake-step\", which is already registered")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		registry := promotion.MustNewStepRunnerRegistry()
+		names, err := Register(
+			context.Background(),
+			registry,
+			Config{Plugins: []string{newFakePluginExecutable(t)}},
+		)
+		require.NoError(t, err)
+		require.Equal(t, []string{"fake-step"}, names)
+		reg, err := registry.Get("fake-step")
+		require.NoError(t, err)
+		// Defaulted by the registry
+		require.Equal(t, uint32(1), reg.Metadata.DefaultErrorThreshold)
+	})
+}
+
+func Test_stepRunner_Run(t *testing.T) {
+	testCases := []struct {
+		name       string
+		schema     string
+		config     promotion.Config
+		runFn      func(context.Context, *RunRequest) (*RunResponse, error)
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:   "invalid config",
+			schema: `{"type": "object", "required": ["path"]}`,
+			config: promotion.Config{},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid fake-step config")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "error invoking plugin",
+			runFn: func(context.Context, *RunRequest) (*RunResponse, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.False(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "plugin reports terminal error",
+			runFn: func(context.Context, *RunRequest) (*RunResponse, error) {
+				return &RunResponse{
+					Status:   kargoapi.PromotionStepStatusFailed,
+					Error:    "something went wrong",
+					Terminal: true,
+				}, nil
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:   "success",
+			schema: `{"type": "object", "required": ["path"]}`,
+			config: promotion.Config{"path": "foo"},
+			runFn: func(_ context.Context, req *RunRequest) (*RunResponse, error) {
+				if req.Step != "fake-step" || req.Context.Config["path"] != "foo" {
+					return nil, errors.New("unexpected request")
+				}
+				return &RunResponse{
+					Status:     kargoapi.PromotionStepStatusRunning,
+					Output:     map[string]any{"path": req.Context.WorkDir},
+					RetryAfter: "1m",
+					HealthCheck: &HealthCheck{
+						Kind:  "fake-check",
+						Input: map[string]any{"foo": "bar"},
+					},
+				}, nil
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.Equal(t, map[string]any{"path": "/fake/work/dir"}, res.Output)
+				require.NotNil(t, res.RetryAfter)
+				require.Equal(t, time.Minute, *res.RetryAfter)
+				require.NotNil(t, res.HealthCheck)
+				require.Equal(t, "fake-check", res.HealthCheck.Kind)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			runner := &stepRunner{
+				name:      "fake-step",
+				transport: &fakeTransport{runFn: testCase.runFn},
+			}
+			if testCase.schema != "" {
+				runner.schemaLoader = gojsonschema.NewStringLoader(testCase.schema)
+			}
+			res, err := runner.Run(
+				context.Background(),
+				&promotion.StepContext{
+					WorkDir: "/fake/work/dir",
+					Config:  testCase.config,
+				},
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
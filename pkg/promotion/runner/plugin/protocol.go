@@ -0,0 +1,169 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// ProtocolVersion is the version of the step plugin protocol implemented by
+// this package. Plugins must report this version when they are described.
+const ProtocolVersion = "v1alpha1"
+
+// Descriptor is returned by a plugin upon discovery. It describes all steps
+// implemented by the plugin.
+type Descriptor struct {
+	// ProtocolVersion is the version of the step plugin protocol implemented by
+	// the plugin.
+	ProtocolVersion string `json:"protocolVersion"`
+	// Steps describes the steps implemented by the plugin.
+	Steps []StepDescriptor `json:"steps"`
+}
+
+// StepDescriptor describes a single step implemented by a plugin.
+type StepDescriptor struct {
+	// Name is the name by which the step is referenced in promotion templates
+	// (i.e. the value of the "uses" field).
+	Name string `json:"name"`
+	// ConfigSchema is an optional JSON schema against which the configuration
+	// of the step is validated before the plugin is invoked.
+	ConfigSchema json.RawMessage `json:"configSchema,omitempty"`
+	// DefaultTimeout is the default soft maximum interval in which the step may
+	// be retried while it reports a Running status, expressed as a Go duration
+	// string (e.g. "5m").
+	DefaultTimeout string `json:"defaultTimeout,omitempty"`
+	// DefaultErrorThreshold is the number of consecutive times the step must
+	// fail before retries are abandoned.
+	DefaultErrorThreshold uint32 `json:"defaultErrorThreshold,omitempty"`
+	// RequiredCapabilities lists the capabilities required by the step.
+	RequiredCapabilities []promotion.StepRunnerCapability `json:"requiredCapabilities,omitempty"`
+}
+
+// metadata converts the StepDescriptor into promotion.StepRunnerMetadata.
+func (s StepDescriptor) metadata() (promotion.StepRunnerMetadata, error) {
+	md := promotion.StepRunnerMetadata{
+		DefaultErrorThreshold: s.DefaultErrorThreshold,
+		RequiredCapabilities:  s.RequiredCapabilities,
+	}
+	if s.DefaultTimeout != "" {
+		timeout, err := time.ParseDuration(s.DefaultTimeout)
+		if err != nil {
+			return md, fmt.Errorf("invalid default timeout %q: %w", s.DefaultTimeout, err)
+		}
+		md.DefaultTimeout = timeout
+	}
+	return md, nil
+}
+
+// RunRequest is sent to a plugin to execute a step.
+type RunRequest struct {
+	// Step is the name of the step to execute.
+	Step string `json:"step"`
+	// Context is the context in which the step is executed.
+	Context StepContext `json:"context"`
+}
+
+// StepContext is the serialized form of promotion.StepContext.
+type StepContext struct {
+	UIBaseURL        string                     `json:"uiBaseURL,omitempty"`
+	WorkDir          string                     `json:"workDir"`
+	SharedState      promotion.State            `json:"sharedState,omitempty"`
+	Alias            string                     `json:"alias"`
+	Config           promotion.Config           `json:"config,omitempty"`
+	Project          string                     `json:"project"`
+	Stage            string                     `json:"stage"`
+	Promotion        string                     `json:"promotion"`
+	PromotionActor   string                     `json:"promotionActor,omitempty"`
+	FreightRequests  []kargoapi.FreightRequest  `json:"freightRequests,omitempty"`
+	Freight          kargoapi.FreightCollection `json:"freight"`
+	TargetFreightRef kargoapi.FreightReference  `json:"targetFreightRef"`
+}
+
+// newStepContext returns the serialized form of the provided
+// promotion.StepContext.
+func newStepContext(stepCtx *promotion.StepContext) StepContext {
+	return StepContext{
+		UIBaseURL:        stepCtx.UIBaseURL,
+		WorkDir:          stepCtx.WorkDir,
+		SharedState:      stepCtx.SharedState,
+		Alias:            stepCtx.Alias,
+		Config:           stepCtx.Config,
+		Project:          stepCtx.Project,
+		Stage:            stepCtx.Stage,
+		Promotion:        stepCtx.Promotion,
+		PromotionActor:   stepCtx.PromotionActor,
+		FreightRequests:  stepCtx.FreightRequests,
+		Freight:          stepCtx.Freight,
+		TargetFreightRef: stepCtx.TargetFreightRef,
+	}
+}
+
+// RunResponse is returned by a plugin after executing a step.
+type RunResponse struct {
+	// Status is the outcome of the step.
+	Status kargoapi.PromotionStepStatus `json:"status"`
+	// Message optionally provides additional context about the outcome.
+	Message string `json:"message,omitempty"`
+	// Output is the output of the step, which is made available to subsequent
+	// steps.
+	Output map[string]any `json:"output,omitempty"`
+	// HealthCheck optionally identifies criteria for a health check.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+	// RetryAfter is an optional, suggested duration after which a step that
+	// reports a Running status should be retried, expressed as a Go duration
+	// string.
+	RetryAfter string `json:"retryAfter,omitempty"`
+	// Error describes an error encountered while executing the step.
+	Error string `json:"error,omitempty"`
+	// Terminal indicates the Error should not be retried.
+	Terminal bool `json:"terminal,omitempty"`
+}
+
+// HealthCheck is the serialized form of health.Criteria.
+type HealthCheck struct {
+	Kind  string         `json:"kind"`
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// result converts the RunResponse into a promotion.StepResult and an error,
+// if the plugin reported one.
+func (r RunResponse) result() (promotion.StepResult, error) {
+	res := promotion.StepResult{
+		Status:  r.Status,
+		Message: r.Message,
+		Output:  r.Output,
+	}
+	if r.HealthCheck != nil {
+		res.HealthCheck = &health.Criteria{
+			Kind:  r.HealthCheck.Kind,
+			Input: r.HealthCheck.Input,
+		}
+	}
+	if r.RetryAfter != "" {
+		retryAfter, err := time.ParseDuration(r.RetryAfter)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("plugin returned invalid retry after %q: %w", r.RetryAfter, err)
+		}
+		res.RetryAfter = &retryAfter
+	}
+	if r.Error != "" {
+		if res.Status == "" {
+			res.Status = kargoapi.PromotionStepStatusErrored
+		}
+		if r.Terminal {
+			return res, &promotion.TerminalError{Err: errors.New(r.Error)}
+		}
+		return res, errors.New(r.Error)
+	}
+	if res.Status == "" {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			errors.New("plugin returned no status")
+	}
+	return res, nil
+}
@@ -0,0 +1,205 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// describeCommand is the argument with which a plugin executable is invoked
+	// to describe itself.
+	describeCommand = "describe"
+	// runCommand is the argument with which a plugin executable is invoked to
+	// run a step.
+	runCommand = "run"
+
+	// maxResponseBytes caps the size of a response read from a plugin, and of
+	// the output a plugin executable writes to its stderr.
+	maxResponseBytes = 16 << 20
+)
+
+// transport is an interface for components that can exchange messages with a
+// plugin.
+type transport interface {
+	// describe retrieves a Descriptor from the plugin.
+	describe(context.Context) (*Descriptor, error)
+	// run asks the plugin to execute a step.
+	run(context.Context, *RunRequest) (*RunResponse, error)
+	// String returns a human-readable identifier for the plugin.
+	String() string
+}
+
+// newTransport returns a transport appropriate for the provided plugin
+// reference, which is either the base URL of an HTTP endpoint or the path of an
+// executable.
+func newTransport(ref string) transport {
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return &httpTransport{
+			baseURL: strings.TrimSuffix(ref, "/"),
+			client:  http.DefaultClient,
+		}
+	}
+	return &execTransport{path: ref}
+}
+
+// execTransport is a transport that invokes a plugin executable, writing the
+// request (if any) to its stdin and reading the response from its stdout.
+type execTransport struct {
+	path string
+}
+
+func (e *execTransport) describe(ctx context.Context) (*Descriptor, error) {
+	desc := &Descriptor{}
+	if err := e.invoke(ctx, "", describeCommand, nil, desc); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+func (e *execTransport) run(ctx context.Context, req *RunRequest) (*RunResponse, error) {
+	resp := &RunResponse{}
+	if err := e.invoke(ctx, req.Context.WorkDir, runCommand, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (e *execTransport) invoke(
+	ctx context.Context,
+	dir string,
+	command string,
+	req any,
+	resp any,
+) error {
+	cmd := exec.CommandContext(ctx, e.path, command) // nolint: gosec
+	cmd.Dir = dir
+	if req != nil {
+		reqBytes, err := json.Marshal(req)
+		if err != nil {
+			return fmt.Errorf("error marshaling request: %w", err)
+		}
+		cmd.Stdin = bytes.NewReader(reqBytes)
+	}
+	stdout := &limitedBuffer{limit: maxResponseBytes}
+	stderr := &limitedBuffer{limit: maxResponseBytes}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("error executing plugin %s %s: %w: %s", e.path, command, err, msg)
+		}
+		return fmt.Errorf("error executing plugin %s %s: %w", e.path, command, err)
+	}
+	if stdout.truncated {
+		return fmt.Errorf(
+			"response from plugin %s %s exceeds %d bytes",
+			e.path, command, maxResponseBytes,
+		)
+	}
+	if err := json.Unmarshal(stdout.Bytes(), resp); err != nil {
+		return fmt.Errorf("error unmarshaling response from plugin %s %s: %w", e.path, command, err)
+	}
+	return nil
+}
+
+func (e *execTransport) String() string {
+	return e.path
+}
+
+// limitedBuffer is an io.Writer that buffers up to limit bytes and discards
+// anything written beyond that, so that a misbehaving plugin executable
+// cannot exhaust the memory of the controller.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+// Write implements io.Writer. It never returns an error, so that the
+// process writing to the buffer is not interrupted once the limit is reached.
+func (l *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if room := l.limit - l.Len(); n > room {
+		l.truncated = true
+		p = p[:max(room, 0)]
+	}
+	_, _ = l.Buffer.Write(p)
+	return n, nil
+}
+
+// httpTransport is a transport that exchanges messages with a plugin over
+// HTTP. It is typically used with plugins that run in a sidecar container.
+type httpTransport struct {
+	baseURL string
+	client  *http.Client
+}
+
+func (h *httpTransport) describe(ctx context.Context) (*Descriptor, error) {
+	desc := &Descriptor{}
+	if err := h.invoke(ctx, http.MethodGet, describeCommand, nil, desc); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+func (h *httpTransport) run(ctx context.Context, req *RunRequest) (*RunResponse, error) {
+	resp := &RunResponse{}
+	if err := h.invoke(ctx, http.MethodPost, runCommand, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (h *httpTransport) invoke(
+	ctx context.Context,
+	method string,
+	command string,
+	req any,
+	resp any,
+) error {
+	url := h.baseURL + "/" + command
+	var body io.Reader
+	if req != nil {
+		reqBytes, err := json.Marshal(req)
+		if err != nil {
+			return fmt.Errorf("error marshaling request: %w", err)
+		}
+		body = bytes.NewReader(reqBytes)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return fmt.Errorf("error creating request to plugin %s: %w", url, err)
+	}
+	if req != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	httpResp, err := h.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("error sending request to plugin %s: %w", url, err)
+	}
+	defer httpResp.Body.Close()
+	respBytes, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("error reading response from plugin %s: %w", url, err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf(
+			"unexpected status code %d from plugin %s: %s",
+			httpResp.StatusCode, url, strings.TrimSpace(string(respBytes)),
+		)
+	}
+	if err = json.Unmarshal(respBytes, resp); err != nil {
+		return fmt.Errorf("error unmarshaling response from plugin %s: %w", url, err)
+	}
+	return nil
+}
+
+func (h *httpTransport) String() string {
+	return h.baseURL
+}
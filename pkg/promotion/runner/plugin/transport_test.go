@@ -0,0 +1,145 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// fakePluginScript is a shell script implementing a plugin that describes a
+// single step and echoes its working directory back as output when run.
+const fakePluginScript = `#!/bin/sh
+case "$1" in
+describe)
+  echo '{"protocolVersion":"v1alpha1","steps":[{"name":"fake-step"}]}'
+  ;;
+run)
+  cat > /dev/null
+  printf '{"status":"Succeeded","output":{"dir":"%s"}}' "$(pwd)"
+  ;;
+*)
+  echo "unknown command $1" >&2
+  exit 1
+  ;;
+esac
+`
+
+// newFakePluginExecutable writes fakePluginScript to a temporary directory and
+// returns the path of the resulting executable.
+func newFakePluginExecutable(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fake-plugin")
+	require.NoError(t, os.WriteFile(path, []byte(fakePluginScript), 0o700)) // nolint: gosec
+	return path
+}
+
+func Test_newTransport(t *testing.T) {
+	require.IsType(t, &httpTransport{}, newTransport("http://localhost:8080/"))
+	require.IsType(t, &httpTransport{}, newTransport("https://localhost:8080"))
+	require.IsType(t, &execTransport{}, newTransport("/usr/local/bin/fake-plugin"))
+}
+
+func Test_execTransport(t *testing.T) {
+	workDir := t.TempDir()
+	et := &execTransport{path: newFakePluginExecutable(t)}
+
+	desc, err := et.describe(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ProtocolVersion, desc.ProtocolVersion)
+	require.Len(t, desc.Steps, 1)
+	require.Equal(t, "fake-step", desc.Steps[0].Name)
+
+	resp, err := et.run(
+		context.Background(),
+		&RunRequest{
+			Step:    "fake-step",
+			Context: StepContext{WorkDir: workDir},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, resp.Status)
+	dir, err := filepath.EvalSymlinks(workDir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"dir": dir}, resp.Output)
+
+	_, err = (&execTransport{path: filepath.Join(workDir, "missing")}).describe(
+		context.Background(),
+	)
+	require.ErrorContains(t, err, "error executing plugin")
+}
+
+func Test_limitedBuffer(t *testing.T) {
+	b := &limitedBuffer{limit: 4}
+
+	n, err := b.Write([]byte("abc"))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.False(t, b.truncated)
+
+	n, err = b.Write([]byte("def"))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.True(t, b.truncated)
+	require.Equal(t, "abcd", b.String())
+
+	n, err = b.Write([]byte("ghi"))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, "abcd", b.String())
+}
+
+func Test_httpTransport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /describe", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(Descriptor{
+			ProtocolVersion: ProtocolVersion,
+			Steps:           []StepDescriptor{{Name: "fake-step"}},
+		})
+	})
+	mux.HandleFunc("POST /run", func(w http.ResponseWriter, r *http.Request) {
+		req := RunRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Step != "fake-step" {
+			http.Error(w, "unknown step", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(RunResponse{
+			Status: kargoapi.PromotionStepStatusSucceeded,
+			Output: map[string]any{"alias": req.Context.Alias},
+		})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	ht := newTransport(srv.URL + "/")
+
+	desc, err := ht.describe(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ProtocolVersion, desc.ProtocolVersion)
+	require.Len(t, desc.Steps, 1)
+
+	resp, err := ht.run(
+		context.Background(),
+		&RunRequest{
+			Step:    "fake-step",
+			Context: StepContext{Alias: "step-1"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, resp.Status)
+	require.Equal(t, map[string]any{"alias": "step-1"}, resp.Output)
+
+	_, err = ht.run(context.Background(), &RunRequest{Step: "unknown-step"})
+	require.ErrorContains(t, err, "unexpected status code 404")
+}
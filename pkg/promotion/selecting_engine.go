@@ -0,0 +1,107 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Canceler is an optional interface for Engines that execute steps
+// asynchronously and can stop the execution of the steps of a Promotion that
+// has been terminated.
+type Canceler interface {
+	// Cancel stops the execution of the steps of the specified Promotion in the
+	// specified Project, if any.
+	Cancel(ctx context.Context, project, promotion string) error
+}
+
+// SelectingEngine is an implementation of the Engine interface that delegates
+// to one of several Engines based on the execution mode selected by the
+// ProjectConfig of the Project a Promotion belongs to. If the ProjectConfig
+// does not exist or does not select a mode, a default mode is used.
+type SelectingEngine struct {
+	client      client.Client
+	defaultMode kargoapi.PromotionExecutionMode
+	engines     map[kargoapi.PromotionExecutionMode]Engine
+}
+
+// NewSelectingEngine returns an implementation of the Engine interface that
+// delegates to the Engine registered for the execution mode selected by each
+// Project, falling back to the provided default mode.
+func NewSelectingEngine(
+	kargoClient client.Client,
+	defaultMode kargoapi.PromotionExecutionMode,
+	engines map[kargoapi.PromotionExecutionMode]Engine,
+) *SelectingEngine {
+	return &SelectingEngine{
+		client:      kargoClient,
+		defaultMode: defaultMode,
+		engines:     engines,
+	}
+}
+
+// Promote implements the Engine interface.
+func (e *SelectingEngine) Promote(
+	ctx context.Context,
+	promoCtx Context,
+	steps []Step,
+) (Result, error) {
+	engine, err := e.engineFor(ctx, promoCtx.Project)
+	if err != nil {
+		return Result{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	return engine.Promote(ctx, promoCtx, steps)
+}
+
+// Cancel implements the Canceler interface. It cancels the execution of the
+// steps of the specified Promotion by every Engine that supports it, as the
+// execution mode of the Project may have changed since the Promotion started.
+func (e *SelectingEngine) Cancel(ctx context.Context, project, promotion string) error {
+	for mode, engine := range e.engines {
+		if canceler, ok := engine.(Canceler); ok {
+			if err := canceler.Cancel(ctx, project, promotion); err != nil {
+				return fmt.Errorf(
+					"error canceling Promotion %q in namespace %q in %s execution mode: %w",
+					promotion, project, mode, err,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// engineFor returns the Engine for the execution mode selected by the
+// specified Project.
+func (e *SelectingEngine) engineFor(
+	ctx context.Context,
+	project string,
+) (Engine, error) {
+	mode := e.defaultMode
+	projectCfg := &kargoapi.ProjectConfig{}
+	if err := e.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: project, Name: project},
+		projectCfg,
+	); client.IgnoreNotFound(err) != nil {
+		return nil, fmt.Errorf(
+			"error getting ProjectConfig %q in namespace %q: %w",
+			project, project, err,
+		)
+	} else if err == nil &&
+		projectCfg.Spec.PromotionExecution != nil &&
+		projectCfg.Spec.PromotionExecution.Mode != "" {
+		mode = projectCfg.Spec.PromotionExecution.Mode
+	}
+	engine, ok := e.engines[mode]
+	if !ok {
+		return nil, fmt.Errorf(
+			"promotion execution mode %q selected for Project %q is not enabled",
+			mode, project,
+		)
+	}
+	return engine, nil
+}
@@ -0,0 +1,116 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSelectingEngine_Promote(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	engines := map[kargoapi.PromotionExecutionMode]Engine{
+		kargoapi.PromotionExecutionModeController: &MockEngine{
+			PromoteFn: func(context.Context, Context, []Step) (Result, error) {
+				return Result{Status: kargoapi.PromotionPhaseSucceeded, Message: "controller"}, nil
+			},
+		},
+		kargoapi.PromotionExecutionModePod: &MockEngine{
+			PromoteFn: func(context.Context, Context, []Step) (Result, error) {
+				return Result{Status: kargoapi.PromotionPhaseSucceeded, Message: "pod"}, nil
+			},
+		},
+	}
+
+	projectCfg := func(mode kargoapi.PromotionExecutionMode) *kargoapi.ProjectConfig {
+		return &kargoapi.ProjectConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      testProject,
+			},
+			Spec: kargoapi.ProjectConfigSpec{
+				PromotionExecution: &kargoapi.PromotionExecutionConfig{Mode: mode},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		defaultMode kargoapi.PromotionExecutionMode
+		objects     []client.Object
+		engines     map[kargoapi.PromotionExecutionMode]Engine
+		assertions  func(*testing.T, Result, error)
+	}{
+		{
+			name:        "no ProjectConfig",
+			defaultMode: kargoapi.PromotionExecutionModePod,
+			engines:     engines,
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "pod", res.Message)
+			},
+		},
+		{
+			name:        "ProjectConfig selects mode",
+			defaultMode: kargoapi.PromotionExecutionModeController,
+			objects:     []client.Object{projectCfg(kargoapi.PromotionExecutionModePod)},
+			engines:     engines,
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "pod", res.Message)
+			},
+		},
+		{
+			name:        "ProjectConfig does not select mode",
+			defaultMode: kargoapi.PromotionExecutionModeController,
+			objects: []client.Object{&kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      testProject,
+				},
+			}},
+			engines: engines,
+			assertions: func(t *testing.T, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "controller", res.Message)
+			},
+		},
+		{
+			name:        "selected mode not enabled",
+			defaultMode: kargoapi.PromotionExecutionModeController,
+			objects:     []client.Object{projectCfg(kargoapi.PromotionExecutionModePod)},
+			engines: map[kargoapi.PromotionExecutionMode]Engine{
+				kargoapi.PromotionExecutionModeController: engines[kargoapi.PromotionExecutionModeController],
+			},
+			assertions: func(t *testing.T, res Result, err error) {
+				require.ErrorContains(t, err, "promotion execution mode \"Pod\"")
+				require.ErrorContains(t, err, "is not enabled")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := NewSelectingEngine(
+				fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+				testCase.defaultMode,
+				testCase.engines,
+			)
+			res, err := e.Promote(context.Background(), Context{Project: testProject}, nil)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
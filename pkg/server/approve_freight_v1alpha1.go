@@ -7,15 +7,17 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/event"
-	"github.com/akuity/kargo/pkg/kubeclient"
+	"github.com/akuity/kargo/pkg/indexer"
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/server/user"
 )
@@ -107,20 +109,66 @@ func (s *server) ApproveFreight(
 		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
-	newStatus := *freight.Status.DeepCopy()
-	if newStatus.ApprovedFor == nil {
-		newStatus.ApprovedFor = make(map[string]kargoapi.ApprovedStage)
+	var actor string
+	var groups []string
+	if u, ok := user.InfoFromContext(ctx); ok {
+		actor = api.FormatEventUserActor(u)
+		groups = userGroups(u)
+	}
+	approval := kargoapi.Approval{
+		Actor:      actor,
+		Groups:     groups,
+		ApprovedAt: &metav1.Time{Time: time.Now()},
 	}
-	newStatus.AddApprovedStage(stageName, time.Now())
 
-	if err := s.patchFreightStatusFn(ctx, freight, newStatus); err != nil {
-		return nil, fmt.Errorf("patch status: %w", err)
+	policy := stage.Spec.ApprovalPolicy
+	if policy != nil && policy.SeparationOfDuties {
+		if err := s.validateSeparationOfDuties(ctx, freight, stage, actor); err != nil {
+			return nil, err
+		}
+	}
+
+	// The approval is validated and recorded against the latest version of the
+	// Freight each time the update is attempted, so that approvals made
+	// concurrently by other users are neither lost nor double-counted.
+	var approved bool
+	if err := s.updateFreightStatusFn(
+		ctx,
+		freight,
+		func(freight *kargoapi.Freight) error {
+			approved = false
+			if freight.IsApprovedFor(stageName) {
+				return nil
+			}
+			if policy != nil {
+				if err := validateApproval(freight, stage, approval); err != nil {
+					return err
+				}
+			}
+			freight.Status.AddApproval(stageName, approval)
+			if policy == nil ||
+				policy.IsSatisfiedBy(freight.Status.Approvals[stageName].Approvals) {
+				freight.Status.AddApprovedStage(stageName, approval.ApprovedAt.Time)
+				approved = true
+			}
+			return nil
+		},
+	); err != nil {
+		var connErr *connect.Error
+		if errors.As(err, &connErr) {
+			return nil, connErr
+		}
+		return nil, fmt.Errorf("update status: %w", err)
+	}
+
+	// Only once the Freight is actually approved for the Stage is an event
+	// recorded. Individual approvals are recorded in the Freight's status.
+	if !approved {
+		return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 	}
 
-	var actor string
 	eventMsg := fmt.Sprintf("Freight approved for Stage %q", stageName)
-	if u, ok := user.InfoFromContext(ctx); ok {
-		actor = api.FormatEventUserActor(u)
+	if actor != "" {
 		eventMsg += fmt.Sprintf(" by %q", actor)
 	}
 
@@ -132,21 +180,117 @@ func (s *server) ApproveFreight(
 	return &connect.Response[svcv1alpha1.ApproveFreightResponse]{}, nil
 }
 
-func (s *server) patchFreightStatus(
+// validateApproval returns an error if the provided Approval of the provided
+// Freight is not permitted by the ApprovalPolicy of the provided Stage.
+func validateApproval(
+	freight *kargoapi.Freight,
+	stage *kargoapi.Stage,
+	approval kargoapi.Approval,
+) error {
+	policy := stage.Spec.ApprovalPolicy
+	if policy == nil {
+		return nil
+	}
+	if approval.Actor == "" || approval.Actor == kargoapi.EventActorUnknown {
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("approvals require a known actor"),
+		)
+	}
+	if freight.IsApprovedBy(stage.Name, approval.Actor) {
+		return connect.NewError(
+			connect.CodeAlreadyExists,
+			fmt.Errorf(
+				"freight %q has already been approved for Stage %q by %q",
+				freight.Name, stage.Name, approval.Actor,
+			),
+		)
+	}
+	if !policy.IsEligible(approval) {
+		return connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf(
+				"%q is not an approver for Stage %q",
+				approval.Actor, stage.Name,
+			),
+		)
+	}
+	return nil
+}
+
+// userGroups returns the groups that the provided user is a member of
+// according to the "groups" claim of their identity token.
+func userGroups(u user.Info) []string {
+	var groups []string
+	switch claim := u.Claims["groups"].(type) {
+	case []string:
+		groups = append(groups, claim...)
+	case []any:
+		for _, g := range claim {
+			if group, ok := g.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	case string:
+		groups = append(groups, claim)
+	}
+	return groups
+}
+
+// validateSeparationOfDuties returns an error if the provided actor created a
+// Promotion of the provided Freight to the provided Stage and may therefore
+// not also approve the Freight for the Stage.
+func (s *server) validateSeparationOfDuties(
 	ctx context.Context,
 	freight *kargoapi.Freight,
-	newStatus kargoapi.FreightStatus,
+	stage *kargoapi.Stage,
+	actor string,
 ) error {
-	if err := kubeclient.PatchStatus(
+	var promos kargoapi.PromotionList
+	if err := s.listPromotionsFn(
 		ctx,
-		s.client,
-		freight,
-		func(status *kargoapi.FreightStatus) {
-			*status = newStatus
-		},
+		&promos,
+		client.InNamespace(stage.Namespace),
+		client.MatchingFields{indexer.PromotionsByStageField: stage.Name},
 	); err != nil {
+		return fmt.Errorf("list promotions: %w", err)
+	}
+	if err := api.ValidateSeparationOfDuties(
+		promos.Items, freight.Name, stage.Name, actor,
+	); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return nil
+}
+
+// updateFreightStatus applies the provided function to the provided Freight
+// and patches the Freight's status with the result. The patch is conditional
+// upon the Freight's resourceVersion. On conflict, the Freight is refetched
+// and the function re-applied.
+func (s *server) updateFreightStatus(
+	ctx context.Context,
+	freight *kargoapi.Freight,
+	update func(*kargoapi.Freight) error,
+) error {
+	var refetch bool
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if refetch {
+			if err := s.client.Get(ctx, client.ObjectKeyFromObject(freight), freight); err != nil {
+				return err
+			}
+		}
+		refetch = true
+		patch := client.MergeFromWithOptions(
+			freight.DeepCopy(),
+			client.MergeFromWithOptimisticLock{},
+		)
+		if err := update(freight); err != nil {
+			return err
+		}
+		return s.client.Status().Patch(ctx, freight, patch)
+	}); err != nil {
 		return fmt.Errorf(
-			"error patching Freight %q status in namespace %q: %w",
+			"error updating Freight %q status in namespace %q: %w",
 			freight.Name,
 			freight.Namespace,
 			err,
@@ -8,21 +8,65 @@ import (
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	fakeevent "github.com/akuity/kargo/pkg/kubernetes/event/fake"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/server/user"
 )
 
 func TestApproveFreight(t *testing.T) {
+	policyStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			ApprovalPolicy: &kargoapi.ApprovalPolicy{
+				RequiredApprovals: 2,
+				Approvers: []kargoapi.Approver{
+					{User: "email:jane@example.com"},
+					{Group: "release-managers"},
+				},
+			},
+		},
+	}
+	separationStage := policyStage.DeepCopy()
+	separationStage.Spec.ApprovalPolicy.SeparationOfDuties = true
+	janesPromos := []kargoapi.Promotion{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-promotion",
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyCreateActor: "email:jane@example.com",
+			},
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}}
+	policyReq := &svcv1alpha1.ApproveFreightRequest{
+		Project: "fake-project",
+		Name:    "fake-freight",
+		Stage:   "fake-stage",
+	}
+	var patchedStatus kargoapi.FreightStatus
+
 	testCases := []struct {
 		name       string
 		req        *svcv1alpha1.ApproveFreightRequest
 		server     *server
+		user       *user.Info
 		assertions func(
 			*testing.T,
 			*fakeevent.EventRecorder,
@@ -297,10 +341,10 @@ func TestApproveFreight(t *testing.T) {
 				) error {
 					return nil
 				},
-				patchFreightStatusFn: func(
+				updateFreightStatusFn: func(
 					context.Context,
 					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+					func(*kargoapi.Freight) error,
 				) error {
 					return errors.New("something went wrong")
 				},
@@ -312,7 +356,7 @@ func TestApproveFreight(t *testing.T) {
 				err error,
 			) {
 				require.Error(t, err)
-				require.Equal(t, "patch status: something went wrong", err.Error())
+				require.Equal(t, "update status: something went wrong", err.Error())
 			},
 		},
 		{
@@ -351,12 +395,12 @@ func TestApproveFreight(t *testing.T) {
 				) error {
 					return nil
 				},
-				patchFreightStatusFn: func(
-					context.Context,
-					*kargoapi.Freight,
-					kargoapi.FreightStatus,
+				updateFreightStatusFn: func(
+					_ context.Context,
+					freight *kargoapi.Freight,
+					update func(*kargoapi.Freight) error,
 				) error {
-					return nil
+					return update(freight)
 				},
 			},
 			assertions: func(
@@ -372,16 +416,338 @@ func TestApproveFreight(t *testing.T) {
 				require.Equal(t, string(kargoapi.EventTypeFreightApproved), event.Reason)
 			},
 		},
+		{
+			name:   "approval policy requires a known actor",
+			req:    policyReq,
+			server: newApproveFreightServer(&kargoapi.Freight{}, policyStage, nil, &patchedStatus),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "actor has already approved",
+			req:  policyReq,
+			server: newApproveFreightServer(
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+					Status: kargoapi.FreightStatus{
+						Approvals: map[string]kargoapi.StageApprovals{
+							"fake-stage": {
+								Approvals: []kargoapi.Approval{{Actor: "email:jane@example.com"}},
+							},
+						},
+					},
+				},
+				policyStage,
+				nil,
+				&patchedStatus,
+			),
+			user: &user.Info{UsernameClaim: "email", Username: "jane@example.com"},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+			},
+		},
+		{
+			name:   "actor is not an approver",
+			req:    policyReq,
+			server: newApproveFreightServer(&kargoapi.Freight{}, policyStage, nil, &patchedStatus),
+			user:   &user.Info{UsernameClaim: "email", Username: "mallory@example.com"},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Promotion creator may not approve",
+			req:  policyReq,
+			server: newApproveFreightServer(
+				&kargoapi.Freight{ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"}},
+				separationStage,
+				janesPromos,
+				&patchedStatus,
+			),
+			user: &user.Info{UsernameClaim: "email", Username: "jane@example.com"},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, "may not also approve it")
+			},
+		},
+		{
+			name: "Promotion creator may approve without separation of duties",
+			req:  policyReq,
+			server: newApproveFreightServer(
+				&kargoapi.Freight{ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"}},
+				policyStage,
+				janesPromos,
+				&patchedStatus,
+			),
+			user: &user.Info{UsernameClaim: "email", Username: "jane@example.com"},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, patchedStatus.Approvals["fake-stage"].Approvals, 1)
+			},
+		},
+		{
+			name: "others may approve Freight promoted by someone else",
+			req:  policyReq,
+			server: newApproveFreightServer(
+				&kargoapi.Freight{ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"}},
+				separationStage,
+				janesPromos,
+				&patchedStatus,
+			),
+			user: &user.Info{
+				UsernameClaim: "email",
+				Username:      "john@example.com",
+				Claims:        map[string]any{"groups": []any{"release-managers"}},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				approvals := patchedStatus.Approvals["fake-stage"].Approvals
+				require.Len(t, approvals, 1)
+				require.Equal(t, "email:john@example.com", approvals[0].Actor)
+			},
+		},
+		{
+			name:   "approval recorded without satisfying policy",
+			req:    policyReq,
+			server: newApproveFreightServer(&kargoapi.Freight{}, policyStage, nil, &patchedStatus),
+			user:   &user.Info{UsernameClaim: "email", Username: "jane@example.com"},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				approvals := patchedStatus.Approvals["fake-stage"].Approvals
+				require.Len(t, approvals, 1)
+				require.Equal(t, "email:jane@example.com", approvals[0].Actor)
+				require.NotNil(t, approvals[0].ApprovedAt)
+				require.Empty(t, patchedStatus.ApprovedFor)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "approval satisfies policy",
+			req:  policyReq,
+			server: newApproveFreightServer(
+				&kargoapi.Freight{
+					Status: kargoapi.FreightStatus{
+						Approvals: map[string]kargoapi.StageApprovals{
+							"fake-stage": {
+								Approvals: []kargoapi.Approval{{Actor: "email:jane@example.com"}},
+							},
+						},
+					},
+				},
+				policyStage,
+				nil,
+				&patchedStatus,
+			),
+			user: &user.Info{
+				UsernameClaim: "email",
+				Username:      "john@example.com",
+				Claims:        map[string]any{"groups": []any{"release-managers"}},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.ApproveFreightResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				approvals := patchedStatus.Approvals["fake-stage"].Approvals
+				require.Len(t, approvals, 2)
+				require.Equal(t, "email:john@example.com", approvals[1].Actor)
+				require.Equal(t, []string{"release-managers"}, approvals[1].Groups)
+				require.Contains(t, patchedStatus.ApprovedFor, "fake-stage")
+				require.Len(t, recorder.Events, 1)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			recorder := fakeevent.NewEventRecorder(1)
 			testCase.server.sender = k8sevent.NewEventSender(recorder)
+			ctx := context.Background()
+			if testCase.user != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.user)
+			}
 			resp, err := testCase.server.ApproveFreight(
-				context.Background(),
+				ctx,
 				connect.NewRequest(testCase.req),
 			)
 			testCase.assertions(t, recorder, resp, err)
 		})
 	}
 }
+
+// newApproveFreightServer returns a server for which ApproveFreight finds the
+// provided Freight, Stage and Promotions and records the Freight's updated
+// status in the provided FreightStatus.
+func newApproveFreightServer(
+	freight *kargoapi.Freight,
+	stage *kargoapi.Stage,
+	promos []kargoapi.Promotion,
+	patchedStatus *kargoapi.FreightStatus,
+) *server {
+	return &server{
+		validateProjectExistsFn: func(context.Context, string) error {
+			return nil
+		},
+		getFreightByNameOrAliasFn: func(
+			context.Context,
+			client.Client,
+			string,
+			string,
+			string,
+		) (*kargoapi.Freight, error) {
+			return freight.DeepCopy(), nil
+		},
+		getStageFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Stage, error) {
+			return stage, nil
+		},
+		authorizeFn: func(
+			context.Context,
+			string,
+			schema.GroupVersionResource,
+			string,
+			client.ObjectKey,
+		) error {
+			return nil
+		},
+		listPromotionsFn: func(
+			_ context.Context,
+			list client.ObjectList,
+			_ ...client.ListOption,
+		) error {
+			list.(*kargoapi.PromotionList).Items = promos // nolint: forcetypeassert
+			return nil
+		},
+		updateFreightStatusFn: func(
+			_ context.Context,
+			freight *kargoapi.Freight,
+			update func(*kargoapi.Freight) error,
+		) error {
+			if err := update(freight); err != nil {
+				return err
+			}
+			*patchedStatus = freight.Status
+			return nil
+		},
+	}
+}
+
+func Test_server_updateFreightStatus(t *testing.T) {
+	ctx := context.Background()
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+		},
+	}
+	approve := func(actor string) func(*kargoapi.Freight) error {
+		return func(freight *kargoapi.Freight) error {
+			freight.Status.AddApproval("fake-stage", kargoapi.Approval{Actor: actor})
+			return nil
+		}
+	}
+
+	var conflicted bool
+	kubeClient, err := kubernetes.NewClient(
+		ctx,
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			SkipAuthorization: true,
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(freight).
+					WithStatusSubresource(freight).
+					WithInterceptorFuncs(interceptor.Funcs{
+						SubResourcePatch: func(
+							ctx context.Context,
+							c client.Client,
+							subResourceName string,
+							obj client.Object,
+							patch client.Patch,
+							opts ...client.SubResourcePatchOption,
+						) error {
+							// Simulate another approval being recorded
+							// between this one being read and written.
+							if !conflicted {
+								conflicted = true
+								concurrent := &kargoapi.Freight{}
+								if err := c.Get(ctx, client.ObjectKeyFromObject(obj), concurrent); err != nil {
+									return err
+								}
+								_ = approve("email:john@example.com")(concurrent)
+								if err := c.Status().Update(ctx, concurrent); err != nil {
+									return err
+								}
+							}
+							return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+						},
+					}).
+					Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+	s := &server{client: kubeClient}
+
+	staleFreight := &kargoapi.Freight{}
+	require.NoError(t, kubeClient.Get(ctx, client.ObjectKeyFromObject(freight), staleFreight))
+
+	err = s.updateFreightStatus(ctx, staleFreight, approve("email:jane@example.com"))
+	require.NoError(t, err)
+	require.True(t, conflicted)
+
+	updated := &kargoapi.Freight{}
+	require.NoError(t, kubeClient.Get(ctx, client.ObjectKeyFromObject(freight), updated))
+	require.Len(t, updated.Status.Approvals["fake-stage"].Approvals, 2)
+	require.True(t, updated.IsApprovedBy("fake-stage", "email:john@example.com"))
+	require.True(t, updated.IsApprovedBy("fake-stage", "email:jane@example.com"))
+}
@@ -27,6 +27,12 @@ var (
 		Kind:    "Project",
 	}
 
+	promotionGVK = schema.GroupVersionKind{
+		Group:   kargoapi.GroupVersion.Group,
+		Version: kargoapi.GroupVersion.Version,
+		Kind:    "Promotion",
+	}
+
 	secretGVK = schema.GroupVersionKind{
 		Group:   "",
 		Version: "v1",
@@ -142,3 +148,56 @@ func annotateProjectWithCreator(
 		obj.SetAnnotations(annotations)
 	}
 }
+
+// authorizePromotionCalendarOverride verifies, only if the provided unstructured
+// object represents a Promotion that requests a PromotionCalendar override,
+// that the user on whose behalf the API server is acting may override the
+// PromotionCalendars applicable to the Promotion's Stage. If so, the override
+// is marked as authorized and that user is recorded as its actor. The webhook
+// that later admits the Promotion sees only the API server's own
+// ServiceAccount and therefore relies on the marker instead of authorizing the
+// override itself.
+func (s *server) authorizePromotionCalendarOverride(
+	ctx context.Context,
+	obj *unstructured.Unstructured,
+) error {
+	if obj == nil || obj.GroupVersionKind() != promotionGVK {
+		return nil
+	}
+	annotations := obj.GetAnnotations()
+	if annotations[kargoapi.AnnotationKeyPromotionCalendarOverride] == "" {
+		delete(annotations, kargoapi.AnnotationKeyPromotionCalendarOverrideActor)
+		delete(annotations, kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized)
+		obj.SetAnnotations(annotations)
+		return nil
+	}
+	stage, _, err := unstructured.NestedString(obj.Object, "spec", "stage")
+	if err != nil {
+		return fmt.Errorf("error reading Stage of Promotion: %w", err)
+	}
+	if err = s.authorizeFn(
+		ctx,
+		"override-calendar",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		client.ObjectKey{
+			Namespace: obj.GetNamespace(),
+			Name:      stage,
+		},
+	); err != nil {
+		return err
+	}
+	annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized] = "true"
+	if userInfo, found := user.InfoFromContext(ctx); found {
+		annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor] =
+			api.FormatEventUserActor(userInfo)
+	} else {
+		delete(annotations, kargoapi.AnnotationKeyPromotionCalendarOverrideActor)
+	}
+	obj.SetAnnotations(annotations)
+	return nil
+}
@@ -74,11 +74,12 @@ func (s *server) validateCredentials(creds credentials) error {
 	switch creds.credType {
 	case kargoapi.LabelValueCredentialTypeGit,
 		kargoapi.LabelValueCredentialTypeHelm,
-		kargoapi.LabelValueCredentialTypeImage:
+		kargoapi.LabelValueCredentialTypeImage,
+		kargoapi.LabelValueCredentialTypeBucket:
 	default:
 		return connect.NewError(
 			connect.CodeInvalidArgument,
-			errors.New("type should be one of git, helm, or image"),
+			errors.New("type should be one of git, helm, image, or bucket"),
 		)
 	}
 	if creds.repoURL == "" {
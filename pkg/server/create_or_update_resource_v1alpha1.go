@@ -78,6 +78,14 @@ func (s *server) createOrUpdateResource(
 	// error from a webhook to obscure the fact that the resource already exists.
 	// So we'll explicitly check if the resource exists and then decide whether to
 	// create or update it.
+	if err := s.authorizePromotionCalendarOverride(ctx, obj); err != nil {
+		return &svcv1alpha1.CreateOrUpdateResourceResult{
+			Result: &svcv1alpha1.CreateOrUpdateResourceResult_Error{
+				Error: fmt.Errorf("authorize promotion calendar override: %w", err).Error(),
+			},
+		}, err
+	}
+
 	existingObj := obj.DeepCopy()
 	if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), existingObj); err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -110,6 +110,14 @@ func (s *server) createResource(
 	// created it.
 	annotateProjectWithCreator(ctx, obj)
 
+	if err = s.authorizePromotionCalendarOverride(ctx, obj); err != nil {
+		return &svcv1alpha1.CreateResourceResult{
+			Result: &svcv1alpha1.CreateResourceResult_Error{
+				Error: fmt.Errorf("authorize promotion calendar override: %w", err).Error(),
+			},
+		}, err
+	}
+
 	if err = cl.Create(ctx, obj); err != nil {
 		return &svcv1alpha1.CreateResourceResult{
 			Result: &svcv1alpha1.CreateResourceResult_Error{
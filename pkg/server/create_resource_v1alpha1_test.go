@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/server/user"
+)
+
+func TestCreateResource_PromotionCalendarOverride(t *testing.T) {
+	const manifest = `apiVersion: kargo.akuity.io/v1alpha1
+kind: Promotion
+metadata:
+  name: fake-promotion
+  namespace: fake-project
+  annotations:
+    kargo.akuity.io/promotion-calendar-override: Hotfix for incident 123
+    kargo.akuity.io/promotion-calendar-override-actor: someone-else
+spec:
+  stage: fake-stage
+  freight: fake-freight
+`
+	testCases := []struct {
+		name        string
+		authorizeFn func(
+			context.Context,
+			string,
+			schema.GroupVersionResource,
+			string,
+			client.ObjectKey,
+		) error
+		assertions func(*testing.T, client.Client, error)
+	}{
+		{
+			name: "user may not override calendar",
+			authorizeFn: func(
+				context.Context,
+				string,
+				schema.GroupVersionResource,
+				string,
+				client.ObjectKey,
+			) error {
+				return connect.NewError(
+					connect.CodePermissionDenied,
+					errors.New("not permitted"),
+				)
+			},
+			assertions: func(t *testing.T, cl client.Client, err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				err = cl.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+					&kargoapi.Promotion{},
+				)
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "user may override calendar",
+			authorizeFn: func(
+				_ context.Context,
+				verb string,
+				gvr schema.GroupVersionResource,
+				_ string,
+				key client.ObjectKey,
+			) error {
+				if verb != "override-calendar" || gvr.Resource != "stages" ||
+					key.Namespace != "fake-project" || key.Name != "fake-stage" {
+					return errors.New("unexpected authorization check")
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, cl client.Client, err error) {
+				require.NoError(t, err)
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, cl.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+					promo,
+				))
+				require.Equal(
+					t,
+					"email:jane@example.com",
+					promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor],
+				)
+				require.Equal(
+					t,
+					"true",
+					promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := user.ContextWithInfo(
+				context.Background(),
+				user.Info{
+					Claims: map[string]any{"email": "jane@example.com"},
+				},
+			)
+			cl, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().WithScheme(scheme).Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+			s := &server{
+				client:      cl,
+				authorizeFn: testCase.authorizeFn,
+			}
+			_, err = s.CreateResource(
+				ctx,
+				connect.NewRequest(&svcv1alpha1.CreateResourceRequest{
+					Manifest: []byte(manifest),
+				}),
+			)
+			testCase.assertions(t, cl, err)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/archive"
+)
+
+func (s *server) GetArchivedRecord(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetArchivedRecordRequest],
+) (*connect.Response[svcv1alpha1.GetArchivedRecordResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	kind := req.Msg.GetKind()
+	if err := validateFieldNotEmpty("kind", kind); err != nil {
+		return nil, err
+	}
+	if _, ok := archivedKindResources[kind]; !ok {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported kind %q", kind),
+		)
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateArchiveEnabled(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExists(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeArchivedRecordAccess(ctx, "get", project, kind, name); err != nil {
+		return nil, err
+	}
+
+	raw, err := s.archive.Get(ctx, project, kind, name)
+	if err != nil {
+		if errors.Is(err, archive.ErrNotFound) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf(
+					"no archived record of %s %q found in project %q",
+					kind, name, project,
+				),
+			)
+		}
+		return nil, fmt.Errorf("get archived record: %w", err)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.GetArchivedRecordResponse{
+		Raw: raw,
+	}), nil
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/archive"
+)
+
+func TestGetArchivedRecord(t *testing.T) {
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.GetArchivedRecordRequest
+		noArchive  bool
+		authorized bool
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.GetArchivedRecordResponse], error)
+	}{
+		{
+			name: "empty kind",
+			req: &svcv1alpha1.GetArchivedRecordRequest{
+				Project: "kargo-demo",
+				Name:    "test-promotion",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetArchivedRecordResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "unsupported kind",
+			req: &svcv1alpha1.GetArchivedRecordRequest{
+				Project: "kargo-demo",
+				Kind:    "Stage",
+				Name:    "test",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetArchivedRecordResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "archive not enabled",
+			req: &svcv1alpha1.GetArchivedRecordRequest{
+				Project: "kargo-demo",
+				Kind:    archive.KindPromotion,
+				Name:    "test-promotion",
+			},
+			noArchive: true,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetArchivedRecordResponse], err error) {
+				require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "not authorized",
+			req: &svcv1alpha1.GetArchivedRecordRequest{
+				Project: "kargo-demo",
+				Kind:    archive.KindPromotion,
+				Name:    "test-promotion",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetArchivedRecordResponse], err error) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "not found",
+			req: &svcv1alpha1.GetArchivedRecordRequest{
+				Project: "kargo-demo",
+				Kind:    archive.KindFreight,
+				Name:    "test-promotion",
+			},
+			authorized: true,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetArchivedRecordResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.GetArchivedRecordRequest{
+				Project: "kargo-demo",
+				Kind:    archive.KindPromotion,
+				Name:    "test-promotion",
+			},
+			authorized: true,
+			assertions: func(t *testing.T, r *connect.Response[svcv1alpha1.GetArchivedRecordResponse], err error) {
+				require.NoError(t, err)
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, yaml.Unmarshal(r.Msg.GetRaw(), promo))
+				require.Equal(t, "test-promotion", promo.Name)
+				require.Equal(t, "test", promo.Spec.Stage)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &server{
+				archive: newTestArchive(t),
+				externalValidateProjectFn: func(context.Context, client.Client, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					if !testCase.authorized {
+						return connect.NewError(connect.CodePermissionDenied, errors.New("not allowed"))
+					}
+					return nil
+				},
+			}
+			if testCase.noArchive {
+				s.archive = nil
+			}
+			res, err := s.GetArchivedRecord(context.Background(), connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
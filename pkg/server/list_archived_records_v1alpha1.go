@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/archive"
+)
+
+// archivedKindResources maps each kind of archived record to the resource
+// type a user must be permitted to read in order to read such records.
+var archivedKindResources = map[string]string{
+	archive.KindPromotion: "promotions",
+	archive.KindFreight:   "freights",
+}
+
+func (s *server) ListArchivedRecords(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ListArchivedRecordsRequest],
+) (*connect.Response[svcv1alpha1.ListArchivedRecordsResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	kinds := []string{archive.KindPromotion, archive.KindFreight}
+	if kind := req.Msg.GetKind(); kind != "" {
+		if _, ok := archivedKindResources[kind]; !ok {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("unsupported kind %q", kind),
+			)
+		}
+		kinds = []string{kind}
+	}
+
+	if err := s.validateArchiveEnabled(); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExists(ctx, project); err != nil {
+		return nil, err
+	}
+
+	for _, kind := range kinds {
+		if err := s.authorizeArchivedRecordAccess(ctx, "list", project, kind, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	records, err := s.archive.List(
+		ctx,
+		project,
+		archive.ListOptions{
+			Kind:    req.Msg.GetKind(),
+			Stage:   req.Msg.GetStage(),
+			Freight: req.Msg.GetFreight(),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list archived records: %w", err)
+	}
+
+	res := make([]*svcv1alpha1.ArchivedRecord, len(records))
+	for i, record := range records {
+		res[i] = &svcv1alpha1.ArchivedRecord{
+			Project: record.Project,
+			Kind:    record.Kind,
+			Name:    record.Name,
+			Date:    record.Date,
+			Stages:  record.Stages,
+			Freight: record.Freight,
+		}
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ListArchivedRecordsResponse{
+		Records: res,
+	}), nil
+}
+
+// validateArchiveEnabled returns an error if the server has not been
+// configured to read archived records.
+func (s *server) validateArchiveEnabled() error {
+	if s.archive == nil {
+		return connect.NewError(
+			connect.CodeUnimplemented,
+			errors.New("archival of Promotions and Freight is not enabled"),
+		)
+	}
+	return nil
+}
+
+// authorizeArchivedRecordAccess returns an error if the user is not permitted
+// to perform the specified verb on resources of the specified kind in the
+// specified Project. Archived records are subject to the same permissions as
+// the resources they were archived from.
+func (s *server) authorizeArchivedRecordAccess(
+	ctx context.Context,
+	verb string,
+	project string,
+	kind string,
+	name string,
+) error {
+	return s.authorizeFn(
+		ctx,
+		verb,
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: archivedKindResources[kind],
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      name,
+		},
+	)
+}
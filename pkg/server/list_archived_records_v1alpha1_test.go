@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/archive"
+)
+
+// newTestArchive returns an Archive backed by a temporary directory and
+// populated with one archived Promotion and one archived Freight in the
+// "kargo-demo" Project.
+func newTestArchive(t *testing.T) *archive.Archive {
+	t.Helper()
+	arch, err := archive.New(archive.Config{URL: t.TempDir()})
+	require.NoError(t, err)
+	created := metav1.NewTime(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, arch.Put(context.Background(), &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "kargo-demo",
+			Name:              "test-promotion",
+			CreationTimestamp: created,
+		},
+		Spec: kargoapi.PromotionSpec{Stage: "test", Freight: "test-freight"},
+	}))
+	require.NoError(t, arch.Put(context.Background(), &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "kargo-demo",
+			Name:              "test-freight",
+			CreationTimestamp: created,
+		},
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{"dev": {}},
+		},
+	}))
+	return arch
+}
+
+func TestListArchivedRecords(t *testing.T) {
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ListArchivedRecordsRequest
+		noArchive  bool
+		authorized bool
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], error)
+	}{
+		{
+			name: "empty project",
+			req:  &svcv1alpha1.ListArchivedRecordsRequest{},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "unsupported kind",
+			req: &svcv1alpha1.ListArchivedRecordsRequest{
+				Project: "kargo-demo",
+				Kind:    ptr.To("Stage"),
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.ErrorContains(t, err, `unsupported kind "Stage"`)
+			},
+		},
+		{
+			name:      "archive not enabled",
+			req:       &svcv1alpha1.ListArchivedRecordsRequest{Project: "kargo-demo"},
+			noArchive: true,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], err error) {
+				require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "not authorized",
+			req:  &svcv1alpha1.ListArchivedRecordsRequest{Project: "kargo-demo"},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], err error) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name:       "all records",
+			req:        &svcv1alpha1.ListArchivedRecordsRequest{Project: "kargo-demo"},
+			authorized: true,
+			assertions: func(t *testing.T, r *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, r.Msg.GetRecords(), 2)
+			},
+		},
+		{
+			name: "filtered by stage",
+			req: &svcv1alpha1.ListArchivedRecordsRequest{
+				Project: "kargo-demo",
+				Stage:   ptr.To("dev"),
+			},
+			authorized: true,
+			assertions: func(t *testing.T, r *connect.Response[svcv1alpha1.ListArchivedRecordsResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, r.Msg.GetRecords(), 1)
+				record := r.Msg.GetRecords()[0]
+				require.Equal(t, archive.KindFreight, record.GetKind())
+				require.Equal(t, "test-freight", record.GetName())
+				require.Equal(t, "2026-10-01", record.GetDate())
+				require.Equal(t, []string{"dev"}, record.GetStages())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &server{
+				archive: newTestArchive(t),
+				externalValidateProjectFn: func(context.Context, client.Client, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					if !testCase.authorized {
+						return connect.NewError(connect.CodePermissionDenied, errors.New("not allowed"))
+					}
+					return nil
+				},
+			}
+			if testCase.noArchive {
+				s.archive = nil
+			}
+			res, err := s.ListArchivedRecords(context.Background(), connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
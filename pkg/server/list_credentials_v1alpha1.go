@@ -43,6 +43,7 @@ func (s *server) ListCredentials(
 			kargoapi.LabelValueCredentialTypeGit,
 			kargoapi.LabelValueCredentialTypeHelm,
 			kargoapi.LabelValueCredentialTypeImage,
+			kargoapi.LabelValueCredentialTypeBucket,
 		})
 
 	if err != nil {
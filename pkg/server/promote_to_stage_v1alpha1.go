@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -43,6 +44,16 @@ func (s *server) PromoteToStage(
 		)
 	}
 
+	notBefore := req.Msg.GetNotBefore()
+	if notBefore != nil {
+		if err := notBefore.CheckValid(); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid notBefore: %w", err),
+			)
+		}
+	}
+
 	if err := s.validateProjectExistsFn(ctx, project); err != nil {
 		return nil, err
 	}
@@ -122,6 +133,9 @@ func (s *server) PromoteToStage(
 	if err != nil {
 		return nil, fmt.Errorf("build promotion: %w", err)
 	}
+	if notBefore != nil {
+		promotion.Spec.NotBefore = &metav1.Time{Time: notBefore.AsTime()}
+	}
 	if err := s.createPromotionFn(ctx, promotion); err != nil {
 		return nil, fmt.Errorf("create promotion: %w", err)
 	}
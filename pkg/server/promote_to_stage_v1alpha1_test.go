@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -509,6 +511,85 @@ func TestPromoteToStage(t *testing.T) {
 				require.Equal(t, string(kargoapi.EventTypePromotionCreated), event.Reason)
 			},
 		},
+		{
+			name: "success with notBefore",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				NotBefore: timestamppb.New(
+					time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+				),
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.NotNil(t, res.Msg.GetPromotion())
+				require.Equal(
+					t,
+					time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+					res.Msg.GetPromotion().Spec.NotBefore.UTC(),
+				)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeNormal, event.EventType)
+				require.Equal(t, string(kargoapi.EventTypePromotionCreated), event.Reason)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
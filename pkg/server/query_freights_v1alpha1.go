@@ -305,6 +305,9 @@ func getRepoAndTag(s *kargoapi.Freight) (string, string, *semver.Version) {
 		// path.Join accounts for the possibility that chart.Name is empty
 		repo = path.Join(s.Charts[0].RepoURL, s.Charts[0].Name)
 		tag = s.Charts[0].Version
+	} else if len(s.Artifacts) > 0 {
+		repo = s.Artifacts[0].RepoURL
+		tag = s.Artifacts[0].Tag
 	} else {
 		return "", "", nil
 	}
@@ -24,7 +24,7 @@ var (
 		"watch",
 	}
 
-	allStagesVerbs = append(allVerbs, "promote")
+	allStagesVerbs = append(allVerbs, "override-calendar", "promote")
 )
 
 func init() {
@@ -181,11 +181,11 @@ func buildRule(
 func validateResourceTypeName(resource string) error {
 	switch resource {
 	case "analysisruns", "analysistemplates", "configmaps", "events", "freights",
-		"freights/status", "projectconfigs", "promotions", "rolebindings", "roles",
-		"secrets", "serviceaccounts", "stages", "warehouses":
+		"freights/status", "projectconfigs", "promotionplans", "promotions",
+		"rolebindings", "roles", "secrets", "serviceaccounts", "stages", "warehouses":
 		return nil
 	case "analysisrun", "analysistemplate", "configmap", "event", "freight",
-		"projectconfig", "promotion", "role", "rolebinding", "secret",
+		"projectconfig", "promotion", "promotionplan", "role", "rolebinding", "secret",
 		"serviceaccount", "stage", "warehouse":
 		return apierrors.NewBadRequest(
 			fmt.Sprintf(`unrecognized resource type %q; did you mean "%ss"?`, resource, resource),
@@ -207,7 +207,8 @@ func getGroupName(resourceType string) string {
 		return ""
 	case "rolebindings", "roles":
 		return rbacv1.SchemeGroupVersion.Group
-	case "freights", "freights/status", "promotions", "stages", "warehouses":
+	case "freights", "freights/status", "promotionplans", "promotions", "stages",
+		"warehouses":
 		return kargoapi.GroupVersion.Group
 	case "analysisruns", "analysistemplates":
 		return rolloutsapi.GroupVersion.Group
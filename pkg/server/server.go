@@ -27,6 +27,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
 	rollouts "github.com/akuity/kargo/pkg/api/stubs/rollouts"
+	"github.com/akuity/kargo/pkg/archive"
 	"github.com/akuity/kargo/pkg/event"
 	httputil "github.com/akuity/kargo/pkg/http"
 	"github.com/akuity/kargo/pkg/logging"
@@ -51,6 +52,7 @@ type server struct {
 	rolesDB           rbac.RolesDatabase
 	serviceAccountsDB rbac.ServiceAccountsDatabase
 	sender            event.Sender
+	archive           *archive.Archive
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -124,10 +126,15 @@ type server struct {
 	) error
 
 	// Freight approval:
-	patchFreightStatusFn func(
+	listPromotionsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+	updateFreightStatusFn func(
 		ctx context.Context,
 		freight *kargoapi.Freight,
-		newStatus kargoapi.FreightStatus,
+		update func(*kargoapi.Freight) error,
 	) error
 
 	// Rollouts integration:
@@ -169,6 +176,7 @@ func NewServer(
 	rolesDB rbac.RolesDatabase,
 	serviceAccountsDB rbac.ServiceAccountsDatabase,
 	sender event.Sender,
+	arch *archive.Archive,
 ) Server {
 	s := &server{
 		cfg:               cfg,
@@ -176,6 +184,7 @@ func NewServer(
 		rolesDB:           rolesDB,
 		serviceAccountsDB: serviceAccountsDB,
 		sender:            sender,
+		archive:           arch,
 	}
 
 	s.validateProjectExistsFn = s.validateProjectExists
@@ -190,7 +199,8 @@ func NewServer(
 	s.getFreightFromWarehousesFn = s.getFreightFromWarehouses
 	s.getVerifiedFreightFn = s.getVerifiedFreight
 	s.patchFreightAliasFn = s.patchFreightAlias
-	s.patchFreightStatusFn = s.patchFreightStatus
+	s.listPromotionsFn = kubeClient.List
+	s.updateFreightStatusFn = s.updateFreightStatus
 	s.authorizeFn = kubeClient.Authorize
 	s.getAnalysisTemplateFn = rollouts.GetAnalysisTemplate
 	s.getClusterAnalysisTemplateFn = rollouts.GetClusterAnalysisTemplate
@@ -44,6 +44,7 @@ func TestNewServer(t *testing.T) {
 			rbac.ServiceAccountDatabaseConfigFromEnv(),
 		),
 		testSender,
+		nil,
 	).(*server)
 
 	require.True(t, ok)
@@ -64,7 +65,8 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getFreightFromWarehousesFn)
 	require.NotNil(t, s.getVerifiedFreightFn)
 	require.NotNil(t, s.patchFreightAliasFn)
-	require.NotNil(t, s.patchFreightStatusFn)
+	require.NotNil(t, s.listPromotionsFn)
+	require.NotNil(t, s.updateFreightStatusFn)
 	require.NotNil(t, s.authorizeFn)
 	require.NotNil(t, s.getAnalysisRunFn)
 }
@@ -56,6 +56,14 @@ func (s *server) updateResource(
 
 	// If we get to here, the resource already exists, so we can update it.
 
+	if err := s.authorizePromotionCalendarOverride(ctx, obj); err != nil {
+		return &svcv1alpha1.UpdateResourceResult{
+			Result: &svcv1alpha1.UpdateResourceResult_Error{
+				Error: fmt.Errorf("authorize promotion calendar override: %w", err).Error(),
+			},
+		}, err
+	}
+
 	obj.SetResourceVersion(existingObj.GetResourceVersion())
 	if err := s.client.Update(ctx, obj); err != nil {
 		return &svcv1alpha1.UpdateResourceResult{
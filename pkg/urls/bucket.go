@@ -0,0 +1,15 @@
+package urls
+
+import "strings"
+
+// NormalizeBucket normalizes a bucket URL for purposes of comparison. Leading
+// and trailing whitespace and any trailing slashes are removed and the URL is
+// lowercased.
+func NormalizeBucket(bucketURL string) string {
+	return strings.TrimRight(
+		strings.ToLower(
+			strings.TrimSpace(bucketURL),
+		),
+		"/",
+	)
+}
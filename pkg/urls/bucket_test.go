@@ -0,0 +1,46 @@
+package urls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeBucket(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty string",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "already normalized",
+			input:    "s3://fake-bucket",
+			expected: "s3://fake-bucket",
+		},
+		{
+			name:     "leading and trailing whitespace",
+			input:    "  s3://fake-bucket  ",
+			expected: "s3://fake-bucket",
+		},
+		{
+			name:     "mixed case",
+			input:    "S3://Fake-Bucket",
+			expected: "s3://fake-bucket",
+		},
+		{
+			name:     "trailing slash",
+			input:    "https://s3.example.com/fake-bucket/",
+			expected: "https://s3.example.com/fake-bucket",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, NormalizeBucket(testCase.input))
+		})
+	}
+}
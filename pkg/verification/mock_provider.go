@@ -0,0 +1,67 @@
+package verification
+
+import (
+	"context"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// MockProvider is a mock implementation of the Provider interface that can be
+// used to facilitate unit testing.
+type MockProvider struct {
+	StartFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freight kargoapi.FreightCollection,
+		vi kargoapi.VerificationInfo,
+	) (*kargoapi.VerificationInfo, error)
+	ResultFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		vi kargoapi.VerificationInfo,
+	) (*kargoapi.VerificationInfo, error)
+	AbortFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		vi kargoapi.VerificationInfo,
+	) (*kargoapi.VerificationInfo, error)
+}
+
+// Start implements the Provider interface.
+func (m *MockProvider) Start(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	vi kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	if m.StartFn == nil {
+		vi.Phase = kargoapi.VerificationPhaseRunning
+		return &vi, nil
+	}
+	return m.StartFn(ctx, stage, freight, vi)
+}
+
+// Result implements the Provider interface.
+func (m *MockProvider) Result(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	vi kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	if m.ResultFn == nil {
+		return &vi, nil
+	}
+	return m.ResultFn(ctx, stage, vi)
+}
+
+// Abort implements the Provider interface.
+func (m *MockProvider) Abort(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	vi kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	if m.AbortFn == nil {
+		vi.Phase = kargoapi.VerificationPhaseAborted
+		return &vi, nil
+	}
+	return m.AbortFn(ctx, stage, vi)
+}
@@ -0,0 +1,118 @@
+package verification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestMockProvider_Start(t *testing.T) {
+	t.Run("without function injection", func(t *testing.T) {
+		provider := &MockProvider{}
+		vi, err := provider.Start(
+			context.Background(),
+			&kargoapi.Stage{},
+			kargoapi.FreightCollection{},
+			kargoapi.VerificationInfo{ID: "fake-id"},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "fake-id", vi.ID)
+		assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+	})
+
+	t.Run("with function injection", func(t *testing.T) {
+		ctx := context.Background()
+		stage := &kargoapi.Stage{}
+		provider := &MockProvider{
+			StartFn: func(
+				givenCtx context.Context,
+				givenStage *kargoapi.Stage,
+				_ kargoapi.FreightCollection,
+				vi kargoapi.VerificationInfo,
+			) (*kargoapi.VerificationInfo, error) {
+				assert.Equal(t, ctx, givenCtx)
+				assert.Same(t, stage, givenStage)
+				vi.Phase = kargoapi.VerificationPhaseError
+				return &vi, nil
+			},
+		}
+		vi, err := provider.Start(
+			ctx,
+			stage,
+			kargoapi.FreightCollection{},
+			kargoapi.VerificationInfo{},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+	})
+}
+
+func TestMockProvider_Result(t *testing.T) {
+	t.Run("without function injection", func(t *testing.T) {
+		provider := &MockProvider{}
+		vi, err := provider.Result(
+			context.Background(),
+			&kargoapi.Stage{},
+			kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseRunning},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+	})
+
+	t.Run("with function injection", func(t *testing.T) {
+		provider := &MockProvider{
+			ResultFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				vi kargoapi.VerificationInfo,
+			) (*kargoapi.VerificationInfo, error) {
+				vi.Phase = kargoapi.VerificationPhaseSuccessful
+				return &vi, nil
+			},
+		}
+		vi, err := provider.Result(
+			context.Background(),
+			&kargoapi.Stage{},
+			kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseRunning},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Phase)
+	})
+}
+
+func TestMockProvider_Abort(t *testing.T) {
+	t.Run("without function injection", func(t *testing.T) {
+		provider := &MockProvider{}
+		vi, err := provider.Abort(
+			context.Background(),
+			&kargoapi.Stage{},
+			kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseRunning},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, kargoapi.VerificationPhaseAborted, vi.Phase)
+	})
+
+	t.Run("with function injection", func(t *testing.T) {
+		provider := &MockProvider{
+			AbortFn: func(
+				_ context.Context,
+				_ *kargoapi.Stage,
+				vi kargoapi.VerificationInfo,
+			) (*kargoapi.VerificationInfo, error) {
+				vi.Phase = kargoapi.VerificationPhaseError
+				return &vi, nil
+			},
+		}
+		vi, err := provider.Abort(
+			context.Background(),
+			&kargoapi.Stage{},
+			kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseRunning},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+	})
+}
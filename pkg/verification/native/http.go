@@ -0,0 +1,221 @@
+package native
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/go-cleanhttp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/io"
+)
+
+const (
+	maxResponseBytes = 2 << 20
+	requestTimeout   = 10 * time.Second
+)
+
+// measureHTTP sends a single request to the endpoint of the provided HTTP
+// check and evaluates the response.
+func (p *provider) measureHTTP(
+	ctx context.Context,
+	namespace string,
+	check *kargoapi.HTTPCheck,
+) (outcome, string, error) {
+	method := check.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	resp, body, err := p.do(
+		ctx,
+		namespace,
+		method,
+		check.URL,
+		check.Body,
+		check.Headers,
+		check.InsecureSkipTLSVerify,
+	)
+	if err != nil {
+		return outcomeFailed, "", err
+	}
+	responseEnv := map[string]any{
+		"status":  int64(resp.StatusCode),
+		"header":  resp.Header.Get,
+		"headers": resp.Header,
+		"body":    map[string]any{},
+	}
+	if len(body) > 0 && json.Valid(body) {
+		var parsedBody any
+		if err = json.Unmarshal(body, &parsedBody); err != nil {
+			return outcomeFailed, "", fmt.Errorf("error parsing response body: %w", err)
+		}
+		responseEnv["body"] = parsedBody
+	}
+	return evaluate(
+		check.SuccessExpression,
+		check.FailureExpression,
+		map[string]any{"response": responseEnv},
+		resp.StatusCode >= 200 && resp.StatusCode < 300,
+		fmt.Sprintf("HTTP (%d) response", resp.StatusCode),
+	)
+}
+
+// do sends a request with the provided method, URL, body, and headers and
+// returns the response along with its body, which is read in full, up to a
+// limit.
+func (p *provider) do(
+	ctx context.Context,
+	namespace string,
+	method string,
+	url string,
+	body string,
+	headers []kargoapi.HTTPCheckHeader,
+	insecureSkipTLSVerify bool,
+) (*http.Response, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBufferString(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: error creating HTTP request: %w", errInvalidCheck, err)
+	}
+	for _, header := range headers {
+		value, err := p.headerValue(ctx, namespace, header)
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Header.Add(header.Name, value)
+	}
+	transport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	respBody, err := io.LimitRead(resp.Body, maxResponseBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	return resp, respBody, nil
+}
+
+// headerValue returns the value of the provided header, retrieving it from a
+// Secret if necessary.
+func (p *provider) headerValue(
+	ctx context.Context,
+	namespace string,
+	header kargoapi.HTTPCheckHeader,
+) (string, error) {
+	if header.ValueFrom == nil {
+		return header.Value, nil
+	}
+	secret := &corev1.Secret{}
+	if err := p.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: header.ValueFrom.Name},
+		secret,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error getting Secret %q for header %q: %w",
+			header.ValueFrom.Name, header.Name, err,
+		)
+	}
+	value, ok := secret.Data[header.ValueFrom.Key]
+	if !ok {
+		return "", fmt.Errorf(
+			"Secret %q has no key %q for header %q", // nolint: staticcheck
+			header.ValueFrom.Name, header.ValueFrom.Key, header.Name,
+		)
+	}
+	return string(value), nil
+}
+
+// evaluate determines the outcome of a measurement by evaluating the provided
+// success and failure expressions against the provided environment:
+//
+//   - If the failure expression is specified and evaluates to true, the
+//     measurement failed.
+//   - If the success expression is specified and evaluates to true, the
+//     measurement succeeded.
+//   - If both are specified and neither evaluates to true, the measurement was
+//     inconclusive.
+//   - If only one is specified and it did not evaluate to true, the measurement
+//     succeeded or failed, respectively.
+//   - If neither is specified, the provided default determines the outcome.
+//
+// The provided subject is used to describe what was measured when explaining
+// an unsuccessful outcome.
+func evaluate(
+	successExpr string,
+	failureExpr string,
+	env map[string]any,
+	defaultSuccess bool,
+	subject string,
+) (outcome, string, error) {
+	if successExpr == "" && failureExpr == "" {
+		if defaultSuccess {
+			return outcomeSuccessful, "", nil
+		}
+		return outcomeFailed, fmt.Sprintf("%s was not successful", subject), nil
+	}
+	if failureExpr != "" {
+		failed, err := evaluateExpression(failureExpr, env)
+		if err != nil {
+			return outcomeFailed, "", err
+		}
+		if failed {
+			return outcomeFailed, fmt.Sprintf("%s met failure expression", subject), nil
+		}
+		if successExpr == "" {
+			return outcomeSuccessful, "", nil
+		}
+	}
+	succeeded, err := evaluateExpression(successExpr, env)
+	if err != nil {
+		return outcomeFailed, "", err
+	}
+	switch {
+	case succeeded:
+		return outcomeSuccessful, "", nil
+	case failureExpr != "":
+		return outcomeInconclusive,
+			fmt.Sprintf("%s met neither success nor failure expression", subject), nil
+	default:
+		return outcomeFailed, fmt.Sprintf("%s did not meet success expression", subject), nil
+	}
+}
+
+// evaluateExpression evaluates the provided expression against the provided
+// environment. Errors compiling the expression wrap errInvalidCheck. Errors
+// evaluating it do not, as they may depend upon the measurement.
+func evaluateExpression(expression string, env map[string]any) (bool, error) {
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return false, fmt.Errorf(
+			"%w: error compiling expression %q: %w", errInvalidCheck, expression, err,
+		)
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, fmt.Errorf("error evaluating expression %q: %w", expression, err)
+	}
+	b, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf(
+			"%w: expression %q did not evaluate to a boolean (got %T)",
+			errInvalidCheck, expression, result,
+		)
+	}
+	return b, nil
+}
@@ -0,0 +1,279 @@
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestProvider_measureHTTP(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		check      func(url string) *kargoapi.HTTPCheck
+		objects    []client.Object
+		assertions func(*testing.T, outcome, string, error)
+	}{
+		{
+			name: "non-2xx response without expressions",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			check: func(url string) *kargoapi.HTTPCheck {
+				return &kargoapi.HTTPCheck{URL: url}
+			},
+			assertions: func(t *testing.T, result outcome, reason string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeFailed, result)
+				assert.Equal(t, "HTTP (503) response was not successful", reason)
+			},
+		},
+		{
+			name: "2xx response without expressions",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			check: func(url string) *kargoapi.HTTPCheck {
+				return &kargoapi.HTTPCheck{URL: url}
+			},
+			assertions: func(t *testing.T, result outcome, _ string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeSuccessful, result)
+			},
+		},
+		{
+			name: "success expression evaluated against JSON body and headers",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer s3cr3t" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.Header().Set("X-Version", "v1.2.3")
+				_, _ = w.Write([]byte(`{"status":"ok","replicas":3}`))
+			},
+			check: func(url string) *kargoapi.HTTPCheck {
+				return &kargoapi.HTTPCheck{
+					URL:    url,
+					Method: http.MethodPost,
+					Headers: []kargoapi.HTTPCheckHeader{{
+						Name: "Authorization",
+						ValueFrom: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"},
+							Key:                  "token",
+						},
+					}},
+					SuccessExpression: `response.status == 200 && ` +
+						`response.body.status == "ok" && response.body.replicas >= 3 && ` +
+						`response.header("X-Version") == "v1.2.3"`,
+				}
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-secret",
+					},
+					Data: map[string][]byte{"token": []byte("Bearer s3cr3t")},
+				},
+			},
+			assertions: func(t *testing.T, result outcome, _ string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeSuccessful, result)
+			},
+		},
+		{
+			name: "header Secret not found",
+			check: func(url string) *kargoapi.HTTPCheck {
+				return &kargoapi.HTTPCheck{
+					URL: url,
+					Headers: []kargoapi.HTTPCheckHeader{{
+						Name: "Authorization",
+						ValueFrom: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"},
+							Key:                  "token",
+						},
+					}},
+				}
+			},
+			assertions: func(t *testing.T, _ outcome, _ string, err error) {
+				require.ErrorContains(t, err, `error getting Secret "fake-secret"`)
+			},
+		},
+		{
+			name: "header Secret missing key",
+			check: func(url string) *kargoapi.HTTPCheck {
+				return &kargoapi.HTTPCheck{
+					URL: url,
+					Headers: []kargoapi.HTTPCheckHeader{{
+						Name: "Authorization",
+						ValueFrom: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "fake-secret"},
+							Key:                  "token",
+						},
+					}},
+				}
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-secret",
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ outcome, _ string, err error) {
+				require.ErrorContains(t, err, `has no key "token"`)
+			},
+		},
+		{
+			name: "endpoint unreachable",
+			check: func(string) *kargoapi.HTTPCheck {
+				return &kargoapi.HTTPCheck{URL: "http://127.0.0.1:1"}
+			},
+			assertions: func(t *testing.T, _ outcome, _ string, err error) {
+				require.ErrorContains(t, err, "error sending HTTP request")
+				require.NotErrorIs(t, err, errInvalidCheck)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := testCase.handler
+			if handler == nil {
+				handler = func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}
+			}
+			srv := httptest.NewServer(handler)
+			t.Cleanup(srv.Close)
+			p := &provider{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			result, reason, err := p.measureHTTP(
+				context.Background(),
+				"fake-project",
+				testCase.check(srv.URL),
+			)
+			testCase.assertions(t, result, reason, err)
+		})
+	}
+}
+
+func Test_evaluate(t *testing.T) {
+	env := map[string]any{"value": 5}
+	testCases := []struct {
+		name           string
+		successExpr    string
+		failureExpr    string
+		defaultSuccess bool
+		assertions     func(*testing.T, outcome, string, error)
+	}{
+		{
+			name:           "no expressions; default success",
+			defaultSuccess: true,
+			assertions: func(t *testing.T, result outcome, _ string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeSuccessful, result)
+			},
+		},
+		{
+			name: "no expressions; default failure",
+			assertions: func(t *testing.T, result outcome, reason string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeFailed, result)
+				assert.Equal(t, "fake subject was not successful", reason)
+			},
+		},
+		{
+			name:        "failure expression met",
+			successExpr: "value > 0",
+			failureExpr: "value > 3",
+			assertions: func(t *testing.T, result outcome, reason string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeFailed, result)
+				assert.Equal(t, "fake subject met failure expression", reason)
+			},
+		},
+		{
+			name:        "only failure expression; not met",
+			failureExpr: "value > 10",
+			assertions: func(t *testing.T, result outcome, _ string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeSuccessful, result)
+			},
+		},
+		{
+			name:        "only success expression; not met",
+			successExpr: "value > 10",
+			assertions: func(t *testing.T, result outcome, reason string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeFailed, result)
+				assert.Equal(t, "fake subject did not meet success expression", reason)
+			},
+		},
+		{
+			name:        "neither expression met",
+			successExpr: "value > 10",
+			failureExpr: "value < 0",
+			assertions: func(t *testing.T, result outcome, reason string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeInconclusive, result)
+				assert.Equal(t, "fake subject met neither success nor failure expression", reason)
+			},
+		},
+		{
+			name:        "success expression met",
+			successExpr: "value == 5",
+			failureExpr: "value < 0",
+			assertions: func(t *testing.T, result outcome, _ string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeSuccessful, result)
+			},
+		},
+		{
+			name:        "invalid expression",
+			successExpr: "value >",
+			assertions: func(t *testing.T, _ outcome, _ string, err error) {
+				require.ErrorIs(t, err, errInvalidCheck)
+				require.ErrorContains(t, err, "error compiling expression")
+			},
+		},
+		{
+			name:        "non-boolean expression",
+			successExpr: "value + 1",
+			assertions: func(t *testing.T, _ outcome, _ string, err error) {
+				require.ErrorIs(t, err, errInvalidCheck)
+				require.ErrorContains(t, err, "did not evaluate to a boolean")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, reason, err := evaluate(
+				testCase.successExpr,
+				testCase.failureExpr,
+				env,
+				testCase.defaultSuccess,
+				"fake subject",
+			)
+			testCase.assertions(t, result, reason, err)
+		})
+	}
+}
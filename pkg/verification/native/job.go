@@ -0,0 +1,184 @@
+package native
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/kubernetes"
+)
+
+const jobContainerName = "check"
+
+// buildJob builds the Job for the provided Job check. The Job is labeled with
+// the Stage and Freight collection it verifies and is owned by the provided
+// owners, so that it is garbage collected along with them.
+func buildJob(
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	verificationID string,
+	check kargoapi.VerificationCheck,
+	owners []metav1.OwnerReference,
+) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: stage.Namespace,
+			// Job names are used as label values by the Job controller, so they
+			// must obey the same length limit.
+			Name: kubernetes.ShortenLabelValue(
+				fmt.Sprintf("%s-%s-%s", stage.Name, check.Name, verificationID),
+			),
+			Labels: map[string]string{
+				kargoapi.LabelKeyStage:             kubernetes.ShortenLabelValue(stage.Name),
+				kargoapi.LabelKeyFreightCollection: freight.ID,
+			},
+			OwnerReferences: owners,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: check.Job.ServiceAccountName,
+					Containers: []corev1.Container{{
+						Name:    jobContainerName,
+						Image:   check.Job.Image,
+						Command: check.Job.Command,
+						Args:    check.Job.Args,
+						Env:     check.Job.Env,
+					}},
+				},
+			},
+		},
+	}
+	if check.Job.ServiceAccountName == "" {
+		// The namespace's default ServiceAccount is not something that has been
+		// opted in to running checks, so the Job gets no credentials for the
+		// Kubernetes API.
+		job.Spec.Template.Spec.AutomountServiceAccountToken = ptr.To(false)
+	}
+	if check.Job.Timeout.Duration > 0 {
+		job.Spec.ActiveDeadlineSeconds = ptr.To(int64(check.Job.Timeout.Seconds()))
+	}
+	if stage.Name != job.Labels[kargoapi.LabelKeyStage] {
+		job.Annotations = map[string]string{kargoapi.AnnotationKeyStage: stage.Name}
+	}
+	return job
+}
+
+// checkServiceAccount returns an error if the named ServiceAccount does not
+// exist in the provided namespace or is not labeled as eligible to run the
+// Jobs of verification checks. An empty name is always permitted, as Jobs not
+// naming a ServiceAccount are run without credentials for the Kubernetes API.
+func (p *provider) checkServiceAccount(
+	ctx context.Context,
+	namespace string,
+	name string,
+) error {
+	if name == "" {
+		return nil
+	}
+	sa := &corev1.ServiceAccount{}
+	if err := p.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: name},
+		sa,
+	); err != nil {
+		return fmt.Errorf("error getting ServiceAccount %q: %w", name, err)
+	}
+	if sa.Labels[kargoapi.LabelKeyVerificationServiceAccount] != kargoapi.LabelValueTrue {
+		return fmt.Errorf(
+			"ServiceAccount %q is not labeled %s=%s",
+			name, kargoapi.LabelKeyVerificationServiceAccount, kargoapi.LabelValueTrue,
+		)
+	}
+	return nil
+}
+
+// freightOwnerReferences returns references to every Freight in the provided
+// collection, suitable for use as the owners of the Jobs that verify it.
+func (p *provider) freightOwnerReferences(
+	ctx context.Context,
+	namespace string,
+	freight kargoapi.FreightCollection,
+) ([]metav1.OwnerReference, error) {
+	owners := make([]metav1.OwnerReference, 0, len(freight.Freight))
+	for _, ref := range freight.Freight {
+		f := &kargoapi.Freight{}
+		if err := p.client.Get(
+			ctx,
+			types.NamespacedName{Namespace: namespace, Name: ref.Name},
+			f,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"error getting Freight %q in namespace %q: %w", ref.Name, namespace, err,
+			)
+		}
+		owners = append(owners, metav1.OwnerReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Freight",
+			Name:       f.Name,
+			UID:        f.UID,
+		})
+	}
+	return owners, nil
+}
+
+// syncJob updates the status of a Job check to reflect the state of its Job.
+func (p *provider) syncJob(
+	ctx context.Context,
+	namespace string,
+	status *kargoapi.VerificationCheckStatus,
+) {
+	job := &batchv1.Job{}
+	if err := p.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: status.JobName},
+		job,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			status.Phase = kargoapi.VerificationPhaseError
+			status.Message = fmt.Sprintf("Job %q no longer exists", status.JobName)
+		}
+		// Other errors are likely transient. Try again next time.
+		return
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			status.Phase = kargoapi.VerificationPhaseSuccessful
+			status.Message = ""
+			return
+		case batchv1.JobFailed:
+			status.Phase = kargoapi.VerificationPhaseFailed
+			status.Message = fmt.Sprintf("Job %q failed: %s", status.JobName, cond.Message)
+			return
+		}
+	}
+	status.Phase = kargoapi.VerificationPhaseRunning
+}
+
+// deleteJob deletes the Job with the provided name, along with its Pods.
+func (p *provider) deleteJob(ctx context.Context, namespace, name string) error {
+	if err := p.client.Delete(
+		ctx,
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		},
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error deleting Job %q in namespace %q: %w", name, namespace, err)
+	}
+	return nil
+}
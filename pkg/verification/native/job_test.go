@@ -0,0 +1,94 @@
+package native
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_buildJob(t *testing.T) {
+	owners := []metav1.OwnerReference{{
+		APIVersion: kargoapi.GroupVersion.String(),
+		Kind:       "Freight",
+		Name:       "fake-freight",
+		UID:        "fake-uid",
+	}}
+	freight := kargoapi.FreightCollection{ID: "fake-collection"}
+	check := kargoapi.VerificationCheck{
+		Name: "e2e",
+		Job: &kargoapi.JobCheck{
+			Image:              "fake-image",
+			Command:            []string{"/bin/sh"},
+			Args:               []string{"-c", "run-tests"},
+			Env:                []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+			ServiceAccountName: "fake-sa",
+			Timeout:            metav1.Duration{Duration: 5 * time.Minute},
+		},
+	}
+
+	t.Run("short Stage name", func(t *testing.T) {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+			},
+		}
+		job := buildJob(stage, freight, "fake-id", check, owners)
+		assert.Equal(t, "fake-project", job.Namespace)
+		assert.Equal(t, "fake-stage-e2e-fake-id", job.Name)
+		assert.Equal(t, "fake-stage", job.Labels[kargoapi.LabelKeyStage])
+		assert.Equal(t, "fake-collection", job.Labels[kargoapi.LabelKeyFreightCollection])
+		assert.Empty(t, job.Annotations)
+		assert.Equal(t, owners, job.OwnerReferences)
+		require.NotNil(t, job.Spec.BackoffLimit)
+		assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+		require.NotNil(t, job.Spec.ActiveDeadlineSeconds)
+		assert.Equal(t, int64(300), *job.Spec.ActiveDeadlineSeconds)
+
+		podSpec := job.Spec.Template.Spec
+		assert.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+		assert.Equal(t, "fake-sa", podSpec.ServiceAccountName)
+		assert.Nil(t, podSpec.AutomountServiceAccountToken)
+		require.Len(t, podSpec.Containers, 1)
+		assert.Equal(t, jobContainerName, podSpec.Containers[0].Name)
+		assert.Equal(t, "fake-image", podSpec.Containers[0].Image)
+		assert.Equal(t, check.Job.Command, podSpec.Containers[0].Command)
+		assert.Equal(t, check.Job.Args, podSpec.Containers[0].Args)
+		assert.Equal(t, check.Job.Env, podSpec.Containers[0].Env)
+	})
+
+	t.Run("no ServiceAccount", func(t *testing.T) {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+			},
+		}
+		check := *check.DeepCopy()
+		check.Job.ServiceAccountName = ""
+		podSpec := buildJob(stage, freight, "fake-id", check, owners).Spec.Template.Spec
+		assert.Empty(t, podSpec.ServiceAccountName)
+		require.NotNil(t, podSpec.AutomountServiceAccountToken)
+		assert.False(t, *podSpec.AutomountServiceAccountToken)
+	})
+
+	t.Run("long Stage name", func(t *testing.T) {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      strings.Repeat("a", 70),
+			},
+		}
+		job := buildJob(stage, freight, "fake-id", check, owners)
+		assert.LessOrEqual(t, len(job.Name), 63)
+		assert.LessOrEqual(t, len(job.Labels[kargoapi.LabelKeyStage]), 63)
+		assert.Equal(t, stage.Name, job.Annotations[kargoapi.AnnotationKeyStage])
+	})
+}
@@ -0,0 +1,128 @@
+package native
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// prometheusResponse is the envelope of every response of the Prometheus
+// HTTP API.
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// measurePrometheus evaluates the query of the provided Prometheus check and
+// evaluates the result.
+func (p *provider) measurePrometheus(
+	ctx context.Context,
+	namespace string,
+	check *kargoapi.PrometheusCheck,
+) (outcome, string, error) {
+	queryURL := strings.TrimSuffix(check.Address, "/") + "/api/v1/query?" +
+		url.Values{"query": []string{check.Query}}.Encode()
+	resp, body, err := p.do(
+		ctx,
+		namespace,
+		http.MethodGet,
+		queryURL,
+		"",
+		check.Headers,
+		check.InsecureSkipTLSVerify,
+	)
+	if err != nil {
+		return outcomeFailed, "", err
+	}
+	result, err := parsePrometheusResponse(resp.StatusCode, body)
+	if err != nil {
+		return outcomeFailed, "", err
+	}
+	return evaluate(
+		check.SuccessExpression,
+		check.FailureExpression,
+		map[string]any{"result": result},
+		false,
+		fmt.Sprintf("query result %v", result),
+	)
+}
+
+// parsePrometheusResponse parses the response to an instant query and returns
+// the value of each sample of a vector result or the single value of a scalar
+// result.
+func parsePrometheusResponse(statusCode int, body []byte) ([]float64, error) {
+	resp := prometheusResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf(
+			"error parsing Prometheus (%d) response: %w", statusCode, err,
+		)
+	}
+	if resp.Status != "success" {
+		err := fmt.Errorf(
+			"Prometheus query failed: %s: %s", // nolint: staticcheck
+			resp.ErrorType, resp.Error,
+		)
+		if resp.ErrorType == "bad_data" {
+			// The query itself is invalid.
+			err = fmt.Errorf("%w: %w", errInvalidCheck, err)
+		}
+		return nil, err
+	}
+	switch resp.Data.ResultType {
+	case "vector":
+		var samples []struct {
+			Value [2]any `json:"value"`
+		}
+		if err := json.Unmarshal(resp.Data.Result, &samples); err != nil {
+			return nil, fmt.Errorf("error parsing Prometheus vector result: %w", err)
+		}
+		values := make([]float64, len(samples))
+		for i, sample := range samples {
+			v, err := parseSampleValue(sample.Value)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	case "scalar":
+		var sample [2]any
+		if err := json.Unmarshal(resp.Data.Result, &sample); err != nil {
+			return nil, fmt.Errorf("error parsing Prometheus scalar result: %w", err)
+		}
+		v, err := parseSampleValue(sample)
+		if err != nil {
+			return nil, err
+		}
+		return []float64{v}, nil
+	default:
+		return nil, fmt.Errorf(
+			"%w: query must yield an instant vector or a scalar; got %s",
+			errInvalidCheck, resp.Data.ResultType,
+		)
+	}
+}
+
+// parseSampleValue parses the value of a [<timestamp>, "<value>"] pair.
+func parseSampleValue(sample [2]any) (float64, error) {
+	s, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected Prometheus sample value %v", sample[1])
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing Prometheus sample value %q: %w", s, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,172 @@
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestProvider_measurePrometheus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" || r.URL.Query().Get("query") != "up" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"unexpected query"}`))
+			return
+		}
+		_, _ = w.Write([]byte(
+			`{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{},"value":[1700000000,"0.99"]}]}}`,
+		))
+	}))
+	t.Cleanup(srv.Close)
+
+	testCases := []struct {
+		name       string
+		check      *kargoapi.PrometheusCheck
+		assertions func(*testing.T, outcome, string, error)
+	}{
+		{
+			name: "success expression met",
+			check: &kargoapi.PrometheusCheck{
+				Address:           srv.URL + "/",
+				Query:             "up",
+				SuccessExpression: "len(result) == 1 && result[0] >= 0.95",
+			},
+			assertions: func(t *testing.T, result outcome, _ string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeSuccessful, result)
+			},
+		},
+		{
+			name: "success expression not met",
+			check: &kargoapi.PrometheusCheck{
+				Address:           srv.URL,
+				Query:             "up",
+				SuccessExpression: "result[0] >= 0.999",
+			},
+			assertions: func(t *testing.T, result outcome, reason string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, outcomeFailed, result)
+				assert.Equal(t, "query result [0.99] did not meet success expression", reason)
+			},
+		},
+		{
+			name: "invalid query",
+			check: &kargoapi.PrometheusCheck{
+				Address:           srv.URL,
+				Query:             "not a query",
+				SuccessExpression: "true",
+			},
+			assertions: func(t *testing.T, _ outcome, _ string, err error) {
+				require.ErrorIs(t, err, errInvalidCheck)
+				require.ErrorContains(t, err, "unexpected query")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := &provider{client: fake.NewClientBuilder().Build()}
+			result, reason, err := p.measurePrometheus(
+				context.Background(),
+				"fake-project",
+				testCase.check,
+			)
+			testCase.assertions(t, result, reason, err)
+		})
+	}
+}
+
+func Test_parsePrometheusResponse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		assertions func(*testing.T, []float64, error)
+	}{
+		{
+			name:       "invalid JSON",
+			statusCode: http.StatusBadGateway,
+			body:       "<html>bad gateway</html>",
+			assertions: func(t *testing.T, _ []float64, err error) {
+				require.ErrorContains(t, err, "error parsing Prometheus (502) response")
+				require.NotErrorIs(t, err, errInvalidCheck)
+			},
+		},
+		{
+			name:       "query timed out",
+			statusCode: http.StatusServiceUnavailable,
+			body:       `{"status":"error","errorType":"timeout","error":"query timed out"}`,
+			assertions: func(t *testing.T, _ []float64, err error) {
+				require.ErrorContains(t, err, "Prometheus query failed: timeout: query timed out")
+				require.NotErrorIs(t, err, errInvalidCheck)
+			},
+		},
+		{
+			name:       "bad query",
+			statusCode: http.StatusBadRequest,
+			body:       `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			assertions: func(t *testing.T, _ []float64, err error) {
+				require.ErrorIs(t, err, errInvalidCheck)
+			},
+		},
+		{
+			name:       "vector result",
+			statusCode: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{"pod":"a"},"value":[1700000000,"1"]},` +
+				`{"metric":{"pod":"b"},"value":[1700000000,"2.5"]}]}}`,
+			assertions: func(t *testing.T, result []float64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []float64{1, 2.5}, result)
+			},
+		},
+		{
+			name:       "empty vector result",
+			statusCode: http.StatusOK,
+			body:       `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			assertions: func(t *testing.T, result []float64, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, result)
+			},
+		},
+		{
+			name:       "scalar result",
+			statusCode: http.StatusOK,
+			body:       `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"42"]}}`,
+			assertions: func(t *testing.T, result []float64, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, []float64{42}, result)
+			},
+		},
+		{
+			name:       "unparseable sample value",
+			statusCode: http.StatusOK,
+			body:       `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"forty-two"]}}`,
+			assertions: func(t *testing.T, _ []float64, err error) {
+				require.ErrorContains(t, err, "error parsing Prometheus sample value")
+			},
+		},
+		{
+			name:       "matrix result",
+			statusCode: http.StatusOK,
+			body:       `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			assertions: func(t *testing.T, _ []float64, err error) {
+				require.ErrorIs(t, err, errInvalidCheck)
+				require.ErrorContains(t, err, "got matrix")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := parsePrometheusResponse(testCase.statusCode, []byte(testCase.body))
+			testCase.assertions(t, result, err)
+		})
+	}
+}
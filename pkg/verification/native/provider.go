@@ -0,0 +1,309 @@
+// Package native implements verification.Provider using checks that Kargo
+// performs itself, without depending on Argo Rollouts.
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/verification"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	defaultCount    = 1
+)
+
+// errInvalidCheck is wrapped by errors that indicate a check is misconfigured
+// in a way that no number of further measurements could remedy.
+var errInvalidCheck = errors.New("invalid check")
+
+// outcome is the outcome of a single measurement taken by an HTTP or
+// Prometheus check.
+type outcome int
+
+const (
+	outcomeSuccessful outcome = iota
+	outcomeFailed
+	outcomeInconclusive
+)
+
+// measureFn takes a single measurement. In addition to the outcome, it returns
+// a human-readable explanation of any outcome other than a successful one.
+type measureFn func() (outcome, string, error)
+
+// provider is an implementation of verification.Provider that runs Kubernetes
+// Jobs, polls HTTP endpoints, and evaluates Prometheus queries.
+type provider struct {
+	client client.Client
+	nowFn  func() time.Time
+}
+
+// NewProvider returns an implementation of verification.Provider that runs
+// the Kargo-native checks defined by a Stage's Verification.
+func NewProvider(c client.Client) verification.Provider {
+	return &provider{
+		client: c,
+		nowFn:  time.Now,
+	}
+}
+
+// Start implements verification.Provider.
+func (p *provider) Start(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	vi kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	newVI := vi.DeepCopy()
+	newVI.Phase = kargoapi.VerificationPhaseRunning
+	if stage.Spec.Verification == nil {
+		return nil, fmt.Errorf("Stage %q does not define any checks", stage.Name) // nolint: staticcheck
+	}
+	owners, err := p.freightOwnerReferences(ctx, stage.Namespace, freight)
+	if err != nil {
+		return nil, err
+	}
+	newVI.Checks = make(
+		[]kargoapi.VerificationCheckStatus,
+		len(stage.Spec.Verification.Checks),
+	)
+	for i, check := range stage.Spec.Verification.Checks {
+		status := &newVI.Checks[i]
+		status.Name = check.Name
+		status.Phase = kargoapi.VerificationPhasePending
+		if check.Job == nil {
+			continue
+		}
+		if err := p.checkServiceAccount(
+			ctx,
+			stage.Namespace,
+			check.Job.ServiceAccountName,
+		); err != nil {
+			status.Phase = kargoapi.VerificationPhaseError
+			status.Message = err.Error()
+			continue
+		}
+		job := buildJob(stage, freight, newVI.ID, check, owners)
+		if err := p.client.Create(ctx, job); err != nil {
+			status.Phase = kargoapi.VerificationPhaseError
+			status.Message = fmt.Sprintf("error creating Job %q: %s", job.Name, err)
+			continue
+		}
+		status.Phase = kargoapi.VerificationPhaseRunning
+		status.JobName = job.Name
+	}
+	return p.Result(ctx, stage, *newVI)
+}
+
+// Result implements verification.Provider.
+func (p *provider) Result(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	vi kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	newVI := vi.DeepCopy()
+	if newVI.Phase.IsTerminal() {
+		return newVI, nil
+	}
+	now := p.nowFn()
+	for i := range newVI.Checks {
+		status := &newVI.Checks[i]
+		if status.Phase.IsTerminal() {
+			continue
+		}
+		check := findCheck(stage, status.Name)
+		switch {
+		case check == nil:
+			status.Phase = kargoapi.VerificationPhaseError
+			status.Message = "check is no longer defined by the Stage"
+		case check.Job != nil:
+			p.syncJob(ctx, stage.Namespace, status)
+		case check.HTTP != nil:
+			measure(status, *check, now, func() (outcome, string, error) {
+				return p.measureHTTP(ctx, stage.Namespace, check.HTTP)
+			})
+		case check.Prometheus != nil:
+			measure(status, *check, now, func() (outcome, string, error) {
+				return p.measurePrometheus(ctx, stage.Namespace, check.Prometheus)
+			})
+		}
+	}
+	summarize(newVI)
+	if newVI.Phase.IsTerminal() {
+		newVI.FinishTime = &metav1.Time{Time: now}
+		// Nothing else is going to look at checks that are still running.
+		p.abortChecks(
+			ctx,
+			stage.Namespace,
+			newVI,
+			"check was aborted because another check did not succeed",
+		)
+	}
+	return newVI, nil
+}
+
+// Abort implements verification.Provider.
+func (p *provider) Abort(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	vi kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	newVI := vi.DeepCopy()
+	if newVI.Phase.IsTerminal() {
+		return newVI, nil
+	}
+	p.abortChecks(ctx, stage.Namespace, newVI, "check was aborted by user")
+	newVI.Phase = kargoapi.VerificationPhaseAborted
+	newVI.Message = "Verification aborted by user"
+	newVI.FinishTime = &metav1.Time{Time: p.nowFn()}
+	return newVI, nil
+}
+
+// abortChecks marks every non-terminal check of the provided VerificationInfo
+// as aborted and deletes the Jobs of any such Job checks. Failure to delete a
+// Job is logged, but otherwise ignored.
+func (p *provider) abortChecks(
+	ctx context.Context,
+	namespace string,
+	vi *kargoapi.VerificationInfo,
+	message string,
+) {
+	for i := range vi.Checks {
+		status := &vi.Checks[i]
+		if status.Phase.IsTerminal() {
+			continue
+		}
+		status.Phase = kargoapi.VerificationPhaseAborted
+		status.Message = message
+		if status.JobName != "" {
+			if err := p.deleteJob(ctx, namespace, status.JobName); err != nil {
+				logging.LoggerFromContext(ctx).Error(
+					err, "error deleting Job of aborted check",
+					"job", status.JobName,
+				)
+			}
+		}
+	}
+}
+
+// measure takes a new measurement for the provided HTTP or Prometheus check
+// if its interval has elapsed since the last one and updates the check's
+// status accordingly.
+func measure(
+	status *kargoapi.VerificationCheckStatus,
+	check kargoapi.VerificationCheck,
+	now time.Time,
+	fn measureFn,
+) {
+	interval := check.Interval.Duration
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if status.LastMeasurementTime != nil &&
+		now.Before(status.LastMeasurementTime.Add(interval)) {
+		return
+	}
+	status.Phase = kargoapi.VerificationPhaseRunning
+	status.LastMeasurementTime = &metav1.Time{Time: now}
+
+	result, reason, err := fn()
+	switch {
+	case errors.Is(err, errInvalidCheck):
+		status.Phase = kargoapi.VerificationPhaseError
+		status.Message = err.Error()
+		return
+	case err != nil:
+		// Errors such as an unreachable endpoint may be transient, so they
+		// count against the failure limit rather than ending the check.
+		status.Failed++
+		status.Message = err.Error()
+	case result == outcomeInconclusive:
+		status.Phase = kargoapi.VerificationPhaseInconclusive
+		status.Message = reason
+		return
+	case result == outcomeFailed:
+		status.Failed++
+		status.Message = reason
+	default:
+		status.Successful++
+	}
+
+	count := check.Count
+	if count <= 0 {
+		count = defaultCount
+	}
+	switch {
+	case status.Failed > check.FailureLimit:
+		status.Phase = kargoapi.VerificationPhaseFailed
+		status.Message = fmt.Sprintf(
+			"%d measurement(s) failed, exceeding the limit of %d; last failure: %s",
+			status.Failed, check.FailureLimit, status.Message,
+		)
+	case status.Successful >= count:
+		status.Phase = kargoapi.VerificationPhaseSuccessful
+		status.Message = ""
+	}
+}
+
+// summarize sets the phase and message of the provided VerificationInfo
+// based on the phases of its checks. An Error in any check takes precedence
+// over a Failure, which takes precedence over checks that are still running.
+// Once all checks are complete, the verification is Inconclusive if any check
+// was and Successful otherwise.
+func summarize(vi *kargoapi.VerificationInfo) {
+	var errored, failed, inconclusive []string
+	var running bool
+	for _, status := range vi.Checks {
+		switch status.Phase {
+		case kargoapi.VerificationPhaseError:
+			errored = append(errored, fmt.Sprintf("check %q errored: %s", status.Name, status.Message))
+		case kargoapi.VerificationPhaseFailed:
+			failed = append(failed, fmt.Sprintf("check %q failed: %s", status.Name, status.Message))
+		case kargoapi.VerificationPhaseInconclusive:
+			inconclusive = append(inconclusive, fmt.Sprintf("check %q was inconclusive: %s", status.Name, status.Message))
+		case kargoapi.VerificationPhaseSuccessful:
+		default:
+			running = true
+		}
+	}
+	switch {
+	case len(errored) > 0:
+		vi.Phase = kargoapi.VerificationPhaseError
+		vi.Message = strings.Join(append(errored, failed...), "; ")
+	case len(failed) > 0:
+		vi.Phase = kargoapi.VerificationPhaseFailed
+		vi.Message = strings.Join(failed, "; ")
+	case running:
+		vi.Phase = kargoapi.VerificationPhaseRunning
+		vi.Message = ""
+	case len(inconclusive) > 0:
+		vi.Phase = kargoapi.VerificationPhaseInconclusive
+		vi.Message = strings.Join(inconclusive, "; ")
+	default:
+		vi.Phase = kargoapi.VerificationPhaseSuccessful
+		vi.Message = ""
+	}
+}
+
+// findCheck returns the check of the provided Stage with the provided name or
+// nil if there is no such check.
+func findCheck(stage *kargoapi.Stage, name string) *kargoapi.VerificationCheck {
+	if stage.Spec.Verification == nil {
+		return nil
+	}
+	for i := range stage.Spec.Verification.Checks {
+		if stage.Spec.Verification.Checks[i].Name == name {
+			return &stage.Spec.Verification.Checks[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,750 @@
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewProvider(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	p, ok := NewProvider(c).(*provider)
+	require.True(t, ok)
+	require.Same(t, c, p.client)
+	require.NotNil(t, p.nowFn)
+}
+
+func TestProvider_Start(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	now := time.Now()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	freight := kargoapi.FreightCollection{
+		ID: "fake-collection",
+		Freight: map[string]kargoapi.FreightReference{
+			"fake-warehouse": {Name: "fake-freight"},
+		},
+	}
+
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+			UID:       "fake-uid",
+		},
+	}
+
+	newJobStage := func(serviceAccountName string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				Verification: &kargoapi.Verification{
+					Checks: []kargoapi.VerificationCheck{{
+						Name: "e2e",
+						Job: &kargoapi.JobCheck{
+							Image:              "fake-image",
+							ServiceAccountName: serviceAccountName,
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, *kargoapi.VerificationInfo, error)
+	}{
+		{
+			name: "Stage does not define verification",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *kargoapi.VerificationInfo, err error) {
+				require.ErrorContains(t, err, "does not define any checks")
+			},
+		},
+		{
+			name: "error getting Freight",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Checks: []kargoapi.VerificationCheck{{
+							Name: "smoke",
+							HTTP: &kargoapi.HTTPCheck{URL: srv.URL},
+						}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *kargoapi.VerificationInfo, err error) {
+				require.ErrorContains(t, err, "error getting Freight")
+			},
+		},
+		{
+			name: "success",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Checks: []kargoapi.VerificationCheck{
+							{
+								Name: "smoke",
+								HTTP: &kargoapi.HTTPCheck{URL: srv.URL},
+							},
+							{
+								Name: "e2e",
+								Job: &kargoapi.JobCheck{
+									Image: "fake-image",
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-freight",
+						UID:       "fake-uid",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+				require.Len(t, vi.Checks, 2)
+
+				assert.Equal(t, "smoke", vi.Checks[0].Name)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Checks[0].Phase)
+				assert.Equal(t, int32(1), vi.Checks[0].Successful)
+
+				assert.Equal(t, "e2e", vi.Checks[1].Name)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Checks[1].Phase)
+				require.NotEmpty(t, vi.Checks[1].JobName)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{
+						Namespace: "fake-project",
+						Name:      vi.Checks[1].JobName,
+					},
+					job,
+				))
+				require.Len(t, job.OwnerReferences, 1)
+				assert.Equal(t, types.UID("fake-uid"), job.OwnerReferences[0].UID)
+			},
+		},
+		{
+			name:  "ServiceAccount is not eligible",
+			stage: newJobStage("unlabeled-sa"),
+			objects: []client.Object{
+				testFreight,
+				&corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "unlabeled-sa",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				require.Len(t, vi.Checks, 1)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Checks[0].Phase)
+				assert.Contains(t, vi.Checks[0].Message, "is not labeled")
+				assert.Empty(t, vi.Checks[0].JobName)
+
+				jobs := &batchv1.JobList{}
+				require.NoError(t, c.List(context.Background(), jobs))
+				assert.Empty(t, jobs.Items)
+			},
+		},
+		{
+			name:    "ServiceAccount does not exist",
+			stage:   newJobStage("missing-sa"),
+			objects: []client.Object{testFreight},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				require.Len(t, vi.Checks, 1)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Checks[0].Phase)
+				assert.Contains(t, vi.Checks[0].Message, "error getting ServiceAccount")
+			},
+		},
+		{
+			name:  "ServiceAccount is eligible",
+			stage: newJobStage("labeled-sa"),
+			objects: []client.Object{
+				testFreight,
+				&corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "labeled-sa",
+						Labels: map[string]string{
+							kargoapi.LabelKeyVerificationServiceAccount: kargoapi.LabelValueTrue,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				require.Len(t, vi.Checks, 1)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Checks[0].Phase)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{
+						Namespace: "fake-project",
+						Name:      vi.Checks[0].JobName,
+					},
+					job,
+				))
+				assert.Equal(t, "labeled-sa", job.Spec.Template.Spec.ServiceAccountName)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			p := &provider{
+				client: c,
+				nowFn:  func() time.Time { return now },
+			}
+			vi, err := p.Start(
+				context.Background(),
+				testCase.stage,
+				freight,
+				kargoapi.VerificationInfo{ID: "fake-id"},
+			)
+			testCase.assertions(t, c, vi, err)
+		})
+	}
+}
+
+func TestProvider_Result(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	now := time.Now()
+
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			Verification: &kargoapi.Verification{
+				Checks: []kargoapi.VerificationCheck{
+					{
+						Name: "e2e",
+						Job:  &kargoapi.JobCheck{Image: "fake-image"},
+					},
+					{
+						Name: "other-e2e",
+						Job:  &kargoapi.JobCheck{Image: "fake-image"},
+					},
+				},
+			},
+		},
+	}
+
+	newJob := func(name string, condType batchv1.JobConditionType) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+			},
+		}
+		if condType != "" {
+			job.Status.Conditions = []batchv1.JobCondition{{
+				Type:    condType,
+				Status:  corev1.ConditionTrue,
+				Message: "something happened",
+			}}
+		}
+		return job
+	}
+
+	testCases := []struct {
+		name       string
+		vi         kargoapi.VerificationInfo
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, *kargoapi.VerificationInfo, error)
+	}{
+		{
+			name: "verification is already terminal",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseSuccessful,
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Phase)
+				assert.Nil(t, vi.FinishTime)
+			},
+		},
+		{
+			name: "check is no longer defined",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckStatus{{
+					Name:  "removed",
+					Phase: kargoapi.VerificationPhaseRunning,
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "no longer defined")
+				assert.NotNil(t, vi.FinishTime)
+			},
+		},
+		{
+			name: "Jobs still running",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckStatus{
+					{
+						Name:    "e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "e2e-job",
+					},
+					{
+						Name:    "other-e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "other-e2e-job",
+					},
+				},
+			},
+			objects: []client.Object{
+				newJob("e2e-job", batchv1.JobComplete),
+				newJob("other-e2e-job", ""),
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Checks[0].Phase)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Checks[1].Phase)
+				assert.Nil(t, vi.FinishTime)
+			},
+		},
+		{
+			name: "all Jobs complete",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckStatus{
+					{
+						Name:    "e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "e2e-job",
+					},
+					{
+						Name:    "other-e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "other-e2e-job",
+					},
+				},
+			},
+			objects: []client.Object{
+				newJob("e2e-job", batchv1.JobComplete),
+				newJob("other-e2e-job", batchv1.JobComplete),
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Phase)
+				assert.NotNil(t, vi.FinishTime)
+			},
+		},
+		{
+			name: "failed Job aborts other checks",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckStatus{
+					{
+						Name:    "e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "e2e-job",
+					},
+					{
+						Name:    "other-e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "other-e2e-job",
+					},
+				},
+			},
+			objects: []client.Object{
+				newJob("e2e-job", batchv1.JobFailed),
+				newJob("other-e2e-job", ""),
+			},
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseFailed, vi.Phase)
+				assert.Contains(t, vi.Message, `check "e2e" failed`)
+				assert.Contains(t, vi.Message, "something happened")
+				assert.Equal(t, kargoapi.VerificationPhaseAborted, vi.Checks[1].Phase)
+				assert.NotNil(t, vi.FinishTime)
+
+				// The Job of the aborted check should have been deleted
+				err = c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "other-e2e-job"},
+					&batchv1.Job{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+		{
+			name: "Job no longer exists",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckStatus{{
+					Name:    "e2e",
+					Phase:   kargoapi.VerificationPhaseRunning,
+					JobName: "e2e-job",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "no longer exists")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			p := &provider{
+				client: c,
+				nowFn:  func() time.Time { return now },
+			}
+			vi, err := p.Result(context.Background(), stage, testCase.vi)
+			testCase.assertions(t, c, vi, err)
+		})
+	}
+}
+
+func TestProvider_Abort(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	now := time.Now()
+
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		vi         kargoapi.VerificationInfo
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, *kargoapi.VerificationInfo, error)
+	}{
+		{
+			name: "verification is already terminal",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseFailed,
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseFailed, vi.Phase)
+			},
+		},
+		{
+			name: "success",
+			vi: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckStatus{
+					{
+						Name:  "smoke",
+						Phase: kargoapi.VerificationPhaseSuccessful,
+					},
+					{
+						Name:    "e2e",
+						Phase:   kargoapi.VerificationPhaseRunning,
+						JobName: "e2e-job",
+					},
+				},
+			},
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "e2e-job",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.VerificationPhaseAborted, vi.Phase)
+				assert.Equal(t, "Verification aborted by user", vi.Message)
+				assert.NotNil(t, vi.FinishTime)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Checks[0].Phase)
+				assert.Equal(t, kargoapi.VerificationPhaseAborted, vi.Checks[1].Phase)
+
+				err = c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "e2e-job"},
+					&batchv1.Job{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			p := &provider{
+				client: c,
+				nowFn:  func() time.Time { return now },
+			}
+			vi, err := p.Abort(context.Background(), stage, testCase.vi)
+			testCase.assertions(t, c, vi, err)
+		})
+	}
+}
+
+func Test_measure(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name       string
+		status     kargoapi.VerificationCheckStatus
+		check      kargoapi.VerificationCheck
+		fn         measureFn
+		assertions func(*testing.T, kargoapi.VerificationCheckStatus, bool)
+	}{
+		{
+			name: "interval has not elapsed",
+			status: kargoapi.VerificationCheckStatus{
+				Phase:               kargoapi.VerificationPhaseRunning,
+				LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Second)},
+			},
+			check: kargoapi.VerificationCheck{
+				Interval: metav1.Duration{Duration: time.Minute},
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.False(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name:   "invalid check",
+			status: kargoapi.VerificationCheckStatus{},
+			fn: func() (outcome, string, error) {
+				return outcomeFailed, "", fmt.Errorf("%w: bad expression", errInvalidCheck)
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.True(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseError, status.Phase)
+				assert.Contains(t, status.Message, "bad expression")
+				assert.Equal(t, int32(0), status.Failed)
+			},
+		},
+		{
+			name:   "error within failure limit",
+			status: kargoapi.VerificationCheckStatus{},
+			check:  kargoapi.VerificationCheck{FailureLimit: 1},
+			fn: func() (outcome, string, error) {
+				return outcomeFailed, "", errors.New("connection refused")
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.True(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, status.Phase)
+				assert.Equal(t, int32(1), status.Failed)
+				assert.Equal(t, "connection refused", status.Message)
+				assert.Equal(t, now, status.LastMeasurementTime.Time)
+			},
+		},
+		{
+			name: "failure exceeding failure limit",
+			status: kargoapi.VerificationCheckStatus{
+				Phase:  kargoapi.VerificationPhaseRunning,
+				Failed: 1,
+			},
+			check: kargoapi.VerificationCheck{FailureLimit: 1},
+			fn: func() (outcome, string, error) {
+				return outcomeFailed, "response was bad", nil
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.True(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseFailed, status.Phase)
+				assert.Equal(t, int32(2), status.Failed)
+				assert.Equal(
+					t,
+					"2 measurement(s) failed, exceeding the limit of 1; last failure: response was bad",
+					status.Message,
+				)
+			},
+		},
+		{
+			name:   "inconclusive",
+			status: kargoapi.VerificationCheckStatus{},
+			fn: func() (outcome, string, error) {
+				return outcomeInconclusive, "not sure", nil
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.True(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseInconclusive, status.Phase)
+				assert.Equal(t, "not sure", status.Message)
+			},
+		},
+		{
+			name:   "success below count",
+			status: kargoapi.VerificationCheckStatus{},
+			check:  kargoapi.VerificationCheck{Count: 2},
+			fn: func() (outcome, string, error) {
+				return outcomeSuccessful, "", nil
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.True(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, status.Phase)
+				assert.Equal(t, int32(1), status.Successful)
+			},
+		},
+		{
+			name: "success reaching count",
+			status: kargoapi.VerificationCheckStatus{
+				Phase:               kargoapi.VerificationPhaseRunning,
+				Successful:          1,
+				LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			check: kargoapi.VerificationCheck{Count: 2},
+			fn: func() (outcome, string, error) {
+				return outcomeSuccessful, "", nil
+			},
+			assertions: func(t *testing.T, status kargoapi.VerificationCheckStatus, called bool) {
+				assert.True(t, called)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, status.Phase)
+				assert.Equal(t, int32(2), status.Successful)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var called bool
+			status := testCase.status
+			measure(&status, testCase.check, now, func() (outcome, string, error) {
+				called = true
+				return testCase.fn()
+			})
+			testCase.assertions(t, status, called)
+		})
+	}
+}
+
+func Test_summarize(t *testing.T) {
+	testCases := []struct {
+		name            string
+		checks          []kargoapi.VerificationCheckStatus
+		expectedPhase   kargoapi.VerificationPhase
+		expectedMessage string
+	}{
+		{
+			name: "error takes precedence",
+			checks: []kargoapi.VerificationCheckStatus{
+				{Name: "a", Phase: kargoapi.VerificationPhaseFailed, Message: "bad"},
+				{Name: "b", Phase: kargoapi.VerificationPhaseError, Message: "broken"},
+				{Name: "c", Phase: kargoapi.VerificationPhaseRunning},
+			},
+			expectedPhase:   kargoapi.VerificationPhaseError,
+			expectedMessage: `check "b" errored: broken; check "a" failed: bad`,
+		},
+		{
+			name: "failure takes precedence over running",
+			checks: []kargoapi.VerificationCheckStatus{
+				{Name: "a", Phase: kargoapi.VerificationPhaseFailed, Message: "bad"},
+				{Name: "b", Phase: kargoapi.VerificationPhaseRunning},
+			},
+			expectedPhase:   kargoapi.VerificationPhaseFailed,
+			expectedMessage: `check "a" failed: bad`,
+		},
+		{
+			name: "running takes precedence over inconclusive",
+			checks: []kargoapi.VerificationCheckStatus{
+				{Name: "a", Phase: kargoapi.VerificationPhaseInconclusive, Message: "unsure"},
+				{Name: "b", Phase: kargoapi.VerificationPhasePending},
+			},
+			expectedPhase: kargoapi.VerificationPhaseRunning,
+		},
+		{
+			name: "inconclusive",
+			checks: []kargoapi.VerificationCheckStatus{
+				{Name: "a", Phase: kargoapi.VerificationPhaseInconclusive, Message: "unsure"},
+				{Name: "b", Phase: kargoapi.VerificationPhaseSuccessful},
+			},
+			expectedPhase:   kargoapi.VerificationPhaseInconclusive,
+			expectedMessage: `check "a" was inconclusive: unsure`,
+		},
+		{
+			name: "successful",
+			checks: []kargoapi.VerificationCheckStatus{
+				{Name: "a", Phase: kargoapi.VerificationPhaseSuccessful},
+				{Name: "b", Phase: kargoapi.VerificationPhaseSuccessful},
+			},
+			expectedPhase: kargoapi.VerificationPhaseSuccessful,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			vi := &kargoapi.VerificationInfo{Checks: testCase.checks}
+			summarize(vi)
+			assert.Equal(t, testCase.expectedPhase, vi.Phase)
+			assert.Equal(t, testCase.expectedMessage, vi.Message)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Package verification defines the interface implemented by components that
+// verify a Stage's current Freight.
+package verification
+
+import (
+	"context"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Provider is an interface for components that implement the verification of
+// a Stage's current Freight.
+//
+// The state of a verification process is recorded exclusively in the
+// kargoapi.VerificationInfo that describes it, which callers are expected to
+// persist as part of the Stage's status. Every method returns an updated copy
+// of that VerificationInfo and never modifies the one it is passed.
+type Provider interface {
+	// Start starts verifying the provided Freight collection, which must be the
+	// current Freight of the provided Stage. The provided VerificationInfo is
+	// used as a template for the returned one and must have a unique ID.
+	Start(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		freight kargoapi.FreightCollection,
+		vi kargoapi.VerificationInfo,
+	) (*kargoapi.VerificationInfo, error)
+	// Result makes any progress possible on the provided, previously started,
+	// verification process and returns its current state.
+	Result(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		vi kargoapi.VerificationInfo,
+	) (*kargoapi.VerificationInfo, error)
+	// Abort stops the provided, previously started, verification process.
+	Abort(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		vi kargoapi.VerificationInfo,
+	) (*kargoapi.VerificationInfo, error)
+}
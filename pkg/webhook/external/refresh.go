@@ -146,7 +146,7 @@ func shouldRefresh(
 	for _, s := range wh.Spec.Subscriptions {
 		switch {
 		case s.Git != nil && urls.NormalizeGit(s.Git.RepoURL) == repoURL:
-			selector, err := commit.NewSelector(ctx, *s.Git, nil)
+			selector, err := commit.NewSelector(ctx, *s.Git, nil, nil)
 			if err != nil {
 				return false, fmt.Errorf("error creating commit selector for Git subscription %q: %w",
 					s.Git.RepoURL, err,
@@ -169,6 +169,14 @@ func shouldRefresh(
 				)
 			}
 			shouldRefresh = slices.ContainsFunc(qualifiers, selector.MatchesVersion)
+		case s.OCI != nil && urls.NormalizeImage(s.OCI.RepoURL) == repoURL:
+			selector, err := image.NewArtifactSelector(ctx, *s.OCI, nil)
+			if err != nil {
+				return false, fmt.Errorf("error creating artifact selector for OCI subscription %q: %w",
+					s.OCI.RepoURL, err,
+				)
+			}
+			shouldRefresh = slices.ContainsFunc(qualifiers, selector.MatchesTag)
 		}
 		if shouldRefresh {
 			return true, nil
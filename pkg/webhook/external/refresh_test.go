@@ -294,6 +294,42 @@ func TestShouldRefresh(t *testing.T) {
 			qualifiers: []string{"invalid-tag"},
 			expect:     false,
 		},
+		{
+			name: "OCI subscription with matching qualifier",
+			wh: kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{{
+						OCI: &kargoapi.OCISubscription{
+							RepoURL:                   "ghcr.io/example/module",
+							ArtifactSelectionStrategy: kargoapi.ImageSelectionStrategySemVer,
+							Constraint:                "^1.0.0",
+							StrictSemvers:             true,
+						},
+					}},
+				},
+			},
+			repoURL:    "ghcr.io/example/module",
+			qualifiers: []string{"v1.0.0"},
+			expect:     true,
+		},
+		{
+			name: "OCI subscription with non-matching qualifier",
+			wh: kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{{
+						OCI: &kargoapi.OCISubscription{
+							RepoURL:                   "ghcr.io/example/module",
+							ArtifactSelectionStrategy: kargoapi.ImageSelectionStrategySemVer,
+							Constraint:                "^1.0.0",
+							StrictSemvers:             true,
+						},
+					}},
+				},
+			},
+			repoURL:    "ghcr.io/example/module",
+			qualifiers: []string{"v2.0.0"},
+			expect:     false,
+		},
 		{
 			name: "Chart subscription with matching qualifier",
 			wh: kargoapi.Warehouse{
@@ -71,5 +71,5 @@ func (w *webhook) validateSpec(
 	f *field.Path,
 	spec kargoapi.PromotionTaskSpec,
 ) field.ErrorList {
-	return libWebhook.ValidatePromotionSteps(f.Child("steps"), spec.Steps)
+	return libWebhook.ValidatePromotionStepSections(f, spec.Steps, spec.OnFailure, spec.Finally)
 }
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"slices"
 	"strings"
 
 	"github.com/technosophos/moniker"
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -21,6 +23,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/indexer"
 	libEvent "github.com/akuity/kargo/pkg/kubernetes/event"
@@ -66,8 +69,16 @@ type webhook struct {
 		...client.ListOption,
 	) error
 
+	listPromotionsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
 	getWarehouseFn func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Warehouse, error)
 
+	getStageFn func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Stage, error)
+
 	validateFreightArtifactsFn func(
 		*kargoapi.Freight,
 		*kargoapi.Warehouse,
@@ -80,11 +91,16 @@ func SetupWebhookWithManager(
 	ctx context.Context,
 	cfg libWebhook.Config,
 	mgr ctrl.Manager,
+	cloudEventsSender *cloudevents.Sender,
 ) error {
+	sender := cloudevents.WithSink(
+		k8sevent.NewEventSender(libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), "freight-webhook")),
+		cloudEventsSender,
+	)
 	w := newWebhook(
 		cfg,
 		mgr.GetClient(),
-		k8sevent.NewEventSender(libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), "freight-webhook")),
+		sender,
 	)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Freight{}).
@@ -108,7 +124,9 @@ func newWebhook(
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.listFreightFn = kubeClient.List
 	w.listStagesFn = kubeClient.List
+	w.listPromotionsFn = kubeClient.List
 	w.getWarehouseFn = api.GetWarehouse
+	w.getStageFn = api.GetStage
 	w.validateFreightArtifactsFn = validateFreightArtifacts
 	w.isRequestFromKargoControlplaneFn = libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
 	return w
@@ -173,13 +191,14 @@ func (w *webhook) ValidateCreate(
 		errs = append(errs, fieldErr)
 	}
 
-	if len(freight.Commits) == 0 && len(freight.Images) == 0 && len(freight.Charts) == 0 {
+	if len(freight.Commits) == 0 && len(freight.Images) == 0 &&
+		len(freight.Charts) == 0 && len(freight.Artifacts) == 0 && len(freight.Objects) == 0 {
 		errs = append(
 			errs,
 			field.Invalid(
 				field.NewPath(""),
 				freight,
-				"freight must contain at least one commit, image, or chart",
+				"freight must contain at least one commit, image, chart, OCI artifact, or bucket object",
 			),
 		)
 	}
@@ -289,6 +308,10 @@ func (w *webhook) ValidateUpdate(
 			fmt.Errorf("get admission request from context: %w", err),
 		)
 	}
+	if err = w.validateApprovals(ctx, req, oldFreight, newFreight); err != nil {
+		return nil, err
+	}
+
 	// Record Freight approved events if the request doesn't come from Kargo controlplane.
 	if !w.isRequestFromKargoControlplaneFn(req) {
 		for approvedStage := range newFreight.Status.ApprovedFor {
@@ -301,6 +324,156 @@ func (w *webhook) ValidateUpdate(
 	return nil, nil
 }
 
+// validateApprovals enforces the integrity of the individual approvals
+// recorded in the Freight's status and the ApprovalPolicy of every Stage for
+// which the Freight has received new approvals or has newly been approved.
+//
+// Approvals from the Kargo control plane have already been attributed to the
+// user on whose behalf it acted. Anyone else may only append a single
+// approval of their own and may not alter or remove existing ones.
+func (w *webhook) validateApprovals(
+	ctx context.Context,
+	req admission.Request,
+	oldFreight *kargoapi.Freight,
+	newFreight *kargoapi.Freight,
+) error {
+	isControlplane := w.isRequestFromKargoControlplaneFn(req)
+
+	if !isControlplane {
+		for stageName := range oldFreight.Status.Approvals {
+			if _, ok := newFreight.Status.Approvals[stageName]; !ok {
+				return apierrors.NewForbidden(
+					freightGroupResource,
+					newFreight.Name,
+					fmt.Errorf("approvals for Stage %q may not be removed", stageName),
+				)
+			}
+		}
+	}
+
+	// Determine which Stages' ApprovalPolicies need to be checked.
+	stageNames := map[string]struct{}{}
+	for stageName, approvals := range newFreight.Status.Approvals {
+		oldApprovals := oldFreight.GetApprovals(stageName)
+		newApprovals := approvals.Approvals
+		if len(newApprovals) == len(oldApprovals) &&
+			equality.Semantic.DeepEqual(newApprovals, oldApprovals) {
+			continue
+		}
+		stageNames[stageName] = struct{}{}
+		if isControlplane {
+			continue
+		}
+		if len(newApprovals) < len(oldApprovals) ||
+			!equality.Semantic.DeepEqual(newApprovals[:len(oldApprovals)], oldApprovals) {
+			return apierrors.NewForbidden(
+				freightGroupResource,
+				newFreight.Name,
+				fmt.Errorf("existing approvals for Stage %q may not be modified", stageName),
+			)
+		}
+		if len(newApprovals)-len(oldApprovals) > 1 {
+			return apierrors.NewForbidden(
+				freightGroupResource,
+				newFreight.Name,
+				fmt.Errorf("only one approval for Stage %q may be added at a time", stageName),
+			)
+		}
+		approval := newApprovals[len(newApprovals)-1]
+		if actor := api.FormatEventKubernetesUserActor(req.UserInfo); approval.Actor != actor {
+			return apierrors.NewForbidden(
+				freightGroupResource,
+				newFreight.Name,
+				fmt.Errorf("approval for Stage %q must be attributed to %q", stageName, actor),
+			)
+		}
+		for _, group := range approval.Groups {
+			if !slices.Contains(req.UserInfo.Groups, group) {
+				return apierrors.NewForbidden(
+					freightGroupResource,
+					newFreight.Name,
+					fmt.Errorf("%q is not a member of group %q", approval.Actor, group),
+				)
+			}
+		}
+	}
+	for stageName := range newFreight.Status.ApprovedFor {
+		if !oldFreight.IsApprovedFor(stageName) {
+			stageNames[stageName] = struct{}{}
+		}
+	}
+
+	for stageName := range stageNames {
+		stage, err := w.getStageFn(ctx, w.client, types.NamespacedName{
+			Namespace: newFreight.Namespace,
+			Name:      stageName,
+		})
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("get stage: %w", err))
+		}
+		if stage == nil || stage.Spec.ApprovalPolicy == nil {
+			continue
+		}
+		policy := stage.Spec.ApprovalPolicy
+		approvals := newFreight.GetApprovals(stageName)
+		oldApprovals := oldFreight.GetApprovals(stageName)
+		var promos *kargoapi.PromotionList
+		for i := len(oldApprovals); i < len(approvals); i++ {
+			approval := approvals[i]
+			if !policy.IsEligible(approval) {
+				return apierrors.NewForbidden(
+					freightGroupResource,
+					newFreight.Name,
+					fmt.Errorf("%q is not an approver for Stage %q", approval.Actor, stageName),
+				)
+			}
+			if policy.SeparationOfDuties {
+				if promos == nil {
+					promos = &kargoapi.PromotionList{}
+					if err = w.listPromotionsFn(
+						ctx,
+						promos,
+						client.InNamespace(newFreight.Namespace),
+					); err != nil {
+						return apierrors.NewInternalError(fmt.Errorf("list promotions: %w", err))
+					}
+				}
+				if err = api.ValidateSeparationOfDuties(
+					promos.Items, newFreight.Name, stageName, approval.Actor,
+				); err != nil {
+					return apierrors.NewForbidden(freightGroupResource, newFreight.Name, err)
+				}
+			}
+			if slices.ContainsFunc(
+				approvals[:i],
+				func(a kargoapi.Approval) bool { return a.Actor == approval.Actor },
+			) {
+				return apierrors.NewForbidden(
+					freightGroupResource,
+					newFreight.Name,
+					fmt.Errorf(
+						"freight has already been approved for Stage %q by %q",
+						stageName, approval.Actor,
+					),
+				)
+			}
+		}
+		if newFreight.IsApprovedFor(stageName) && !oldFreight.IsApprovedFor(stageName) &&
+			!policy.IsSatisfiedBy(approvals) {
+			return apierrors.NewForbidden(
+				freightGroupResource,
+				newFreight.Name,
+				fmt.Errorf(
+					"approvals for Stage %q do not yet satisfy its approval policy",
+					stageName,
+				),
+			)
+		}
+	}
+
+	return nil
+}
+
 func (w *webhook) ValidateDelete(
 	ctx context.Context,
 	obj runtime.Object,
@@ -360,15 +533,21 @@ func (a artifactType) FreightPath() string {
 		return "images"
 	case artifactTypeChart:
 		return "charts"
+	case artifactTypeOCI:
+		return "artifacts"
+	case artifactTypeBucket:
+		return "objects"
 	default:
 		return ""
 	}
 }
 
 const (
-	artifactTypeGit   artifactType = "git"
-	artifactTypeImage artifactType = "image"
-	artifactTypeChart artifactType = "chart"
+	artifactTypeGit    artifactType = "git"
+	artifactTypeImage  artifactType = "image"
+	artifactTypeChart  artifactType = "chart"
+	artifactTypeOCI    artifactType = "oci"
+	artifactTypeBucket artifactType = "bucket"
 )
 
 type artifactSubscription struct {
@@ -376,6 +555,12 @@ type artifactSubscription struct {
 	Type artifactType
 }
 
+// bucketSubscriptionURL returns a URL uniquely identifying a bucket
+// subscription by the bucket's URL and the subscription's key prefix.
+func bucketSubscriptionURL(bucketURL, prefix string) string {
+	return strings.TrimSuffix(bucketURL, "/") + "/" + prefix
+}
+
 // validateFreightArtifacts checks that the artifacts in the Freight are all
 // subscribed to by the Warehouse. It returns an error if:
 //
@@ -409,6 +594,18 @@ func validateFreightArtifacts(
 				Type: artifactTypeChart,
 			}] = false
 		}
+		if repo.OCI != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.OCI.RepoURL,
+				Type: artifactTypeOCI,
+			}] = false
+		}
+		if repo.Bucket != nil {
+			subscriptions[artifactSubscription{
+				URL:  bucketSubscriptionURL(repo.Bucket.URL, repo.Bucket.Prefix),
+				Type: artifactTypeBucket,
+			}] = false
+		}
 	}
 
 	var errs field.ErrorList
@@ -475,6 +672,60 @@ func validateFreightArtifacts(
 		)
 	}
 
+	for _, artifact := range freight.Artifacts {
+		sub := artifactSubscription{
+			URL:  artifact.RepoURL,
+			Type: artifactTypeOCI,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		errs = append(
+			errs,
+			field.Invalid(
+				field.NewPath("artifacts"),
+				artifact,
+				fmt.Sprintf("no subscription found for OCI repository in Warehouse %q", warehouse.Name),
+			),
+		)
+	}
+
+	for _, obj := range freight.Objects {
+		// Objects do not record the prefix of the subscription they were
+		// discovered by, so the object is attributed to the subscription to the
+		// same bucket having the longest prefix that the object's key begins with.
+		var sub *artifactSubscription
+		for _, repo := range warehouse.Spec.Subscriptions {
+			if repo.Bucket == nil ||
+				strings.TrimSuffix(repo.Bucket.URL, "/") != strings.TrimSuffix(obj.URL, "/") ||
+				!strings.HasPrefix(obj.Key, repo.Bucket.Prefix) {
+				continue
+			}
+			candidate := artifactSubscription{
+				URL:  bucketSubscriptionURL(repo.Bucket.URL, repo.Bucket.Prefix),
+				Type: artifactTypeBucket,
+			}
+			if sub == nil || len(candidate.URL) > len(sub.URL) {
+				sub = &candidate
+			}
+		}
+		if sub != nil {
+			subscriptions[*sub] = true
+			counts[*sub]++
+			continue
+		}
+		errs = append(
+			errs,
+			field.Invalid(
+				field.NewPath("objects"),
+				obj,
+				fmt.Sprintf("no subscription found for bucket object in Warehouse %q", warehouse.Name),
+			),
+		)
+	}
+
 	// Check that each subscription is found exactly once.
 	for sub, found := range subscriptions {
 		if !found {
@@ -544,5 +795,23 @@ func compareFreight(existing, updated *kargoapi.Freight) (*field.Path, any, bool
 		}
 	}
 
+	if len(existing.Artifacts) != len(updated.Artifacts) {
+		return field.NewPath("artifacts"), updated.Artifacts, false
+	}
+	for i, artifact := range existing.Artifacts {
+		if !artifact.DeepEquals(&updated.Artifacts[i]) {
+			return field.NewPath("artifacts").Index(i), updated.Artifacts[i], false
+		}
+	}
+
+	if len(existing.Objects) != len(updated.Objects) {
+		return field.NewPath("objects"), updated.Objects, false
+	}
+	for i, obj := range existing.Objects {
+		if !obj.DeepEquals(&updated.Objects[i]) {
+			return field.NewPath("objects").Index(i), updated.Objects[i], false
+		}
+	}
+
 	return nil, nil, true
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -310,7 +311,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				require.Contains(
 					t,
 					statusErr.ErrStatus.Message,
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, OCI artifact, or bucket object",
 				)
 			},
 		},
@@ -726,6 +727,13 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 				) error {
 					return nil
 				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
@@ -772,6 +780,13 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 				) error {
 					return nil
 				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
@@ -785,6 +800,209 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 				require.Empty(t, r.Events)
 			},
 		},
+		{
+			name: "approval attributed to another user",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:someone-else"},
+				)
+				return oldFreight, newFreight
+			},
+			webhook:  newApprovalPolicyWebhook(nil),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, `must be attributed to "kubernetes:fake-user"`)
+			},
+		},
+		{
+			name: "approval claims group the user is not a member of",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{
+						Actor:  "kubernetes:fake-user",
+						Groups: []string{"release-managers"},
+					},
+				)
+				return oldFreight, newFreight
+			},
+			webhook:  newApprovalPolicyWebhook(nil),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "is not a member of group")
+			},
+		},
+		{
+			name: "existing approvals removed",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				oldFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:other-user"},
+				)
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.Approvals = nil
+				return oldFreight, newFreight
+			},
+			webhook:  newApprovalPolicyWebhook(nil),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "may not be removed")
+			},
+		},
+		{
+			name: "approval from user who is not an approver",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:fake-user"},
+				)
+				return oldFreight, newFreight
+			},
+			webhook: newApprovalPolicyWebhook(&kargoapi.ApprovalPolicy{
+				RequiredApprovals: 1,
+				Approvers:         []kargoapi.Approver{{User: "kubernetes:jane"}},
+			}),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "is not an approver")
+			},
+		},
+		{
+			name: "approval from user who promoted the Freight",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:fake-user"},
+				)
+				return oldFreight, newFreight
+			},
+			webhook: func() *webhook {
+				w := newApprovalPolicyWebhook(&kargoapi.ApprovalPolicy{
+					RequiredApprovals:  1,
+					SeparationOfDuties: true,
+				})
+				w.listPromotionsFn = func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-promotion",
+							Annotations: map[string]string{
+								kargoapi.AnnotationKeyCreateActor: "kubernetes:fake-user",
+							},
+						},
+						Spec: kargoapi.PromotionSpec{
+							Stage:   "fake-stage",
+							Freight: newApprovalTestFreight().Name,
+						},
+					}}
+					return nil
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "may not also approve it")
+			},
+		},
+		{
+			name: "error listing promotions for separation of duties",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:fake-user"},
+				)
+				return oldFreight, newFreight
+			},
+			webhook: func() *webhook {
+				w := newApprovalPolicyWebhook(&kargoapi.ApprovalPolicy{
+					RequiredApprovals:  1,
+					SeparationOfDuties: true,
+				})
+				w.listPromotionsFn = func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsInternalError(err))
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "approved without satisfying approval policy",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:fake-user"},
+				)
+				newFreight.Status.AddApprovedStage("fake-stage", time.Now())
+				return oldFreight, newFreight
+			},
+			webhook: newApprovalPolicyWebhook(&kargoapi.ApprovalPolicy{
+				RequiredApprovals: 2,
+			}),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "do not yet satisfy")
+				require.Empty(t, r.Events)
+			},
+		},
+		{
+			name: "approved after satisfying approval policy",
+			setup: func() (*kargoapi.Freight, *kargoapi.Freight) {
+				oldFreight := newApprovalTestFreight()
+				oldFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:other-user"},
+				)
+				newFreight := oldFreight.DeepCopy()
+				newFreight.Status.AddApproval(
+					"fake-stage",
+					kargoapi.Approval{Actor: "kubernetes:fake-user"},
+				)
+				newFreight.Status.AddApprovedStage("fake-stage", time.Now())
+				return oldFreight, newFreight
+			},
+			webhook: newApprovalPolicyWebhook(&kargoapi.ApprovalPolicy{
+				RequiredApprovals: 2,
+			}),
+			userInfo: &authnv1.UserInfo{Username: "fake-user"},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, err error) {
+				require.NoError(t, err)
+				require.Len(t, r.Events, 1)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -809,6 +1027,50 @@ func Test_webhook_ValidateUpdate(t *testing.T) {
 	}
 }
 
+// newApprovalTestFreight returns Freight for testing the validation of
+// approvals.
+func newApprovalTestFreight() *kargoapi.Freight {
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+		},
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "fake-repo-url",
+			ID:      "fake-commit-id",
+		}},
+	}
+	freight.Name = api.GenerateFreightID(freight)
+	return freight
+}
+
+// newApprovalPolicyWebhook returns a webhook for which every check preceding
+// the validation of approvals in ValidateUpdate succeeds and for which every
+// Stage has the provided ApprovalPolicy.
+func newApprovalPolicyWebhook(policy *kargoapi.ApprovalPolicy) *webhook {
+	return &webhook{
+		listFreightFn: func(context.Context, client.ObjectList, ...client.ListOption) error {
+			return nil
+		},
+		getStageFn: func(
+			_ context.Context,
+			_ client.Client,
+			nn types.NamespacedName,
+		) (*kargoapi.Stage, error) {
+			return &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: nn.Namespace,
+					Name:      nn.Name,
+				},
+				Spec: kargoapi.StageSpec{ApprovalPolicy: policy},
+			}, nil
+		},
+		admissionRequestFromContextFn: admission.RequestFromContext,
+		isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
+			regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
+		),
+	}
+}
+
 func Test_webhook_ValidateDelete(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
@@ -941,6 +1203,49 @@ func Test_webhook_ValidateFreightArtifacts(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "Freight with OCI artifact not subscribed to by Warehouse",
+			freight: &kargoapi.Freight{
+				Artifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.ErrorContains(t, errs[0], "no subscription found for OCI repository")
+			},
+		},
+		{
+			name: "Freight with bucket object not subscribed to by Warehouse",
+			freight: &kargoapi.Freight{
+				Objects: []kargoapi.BucketObject{
+					{
+						URL: "https://s3.example.com/fake-bucket",
+						Key: "other/fake-key",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{
+						{
+							Bucket: &kargoapi.BucketSubscription{
+								URL:    "https://s3.example.com/fake-bucket",
+								Prefix: "builds/",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.ErrorContains(t, errs[0], "no subscription found for bucket object")
+				require.ErrorContains(t, errs[1], "no artifact found for subscription")
+			},
+		},
 		{
 			name: "Freight with duplicate image artifact for Warehouse subscription",
 			freight: &kargoapi.Freight{
@@ -1098,6 +1403,21 @@ func Test_webhook_ValidateFreightArtifacts(t *testing.T) {
 						RepoURL: "fake-another-chart-repo-url",
 					},
 				},
+				Artifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-oci-repo-url",
+					},
+				},
+				Objects: []kargoapi.BucketObject{
+					{
+						URL: "https://s3.example.com/fake-bucket",
+						Key: "builds/fake-app/fake-key",
+					},
+					{
+						URL: "https://s3.example.com/fake-bucket",
+						Key: "builds/fake-key",
+					},
+				},
 			},
 			warehouse: &kargoapi.Warehouse{
 				Spec: kargoapi.WarehouseSpec{
@@ -1139,6 +1459,23 @@ func Test_webhook_ValidateFreightArtifacts(t *testing.T) {
 								RepoURL: "fake-another-chart-repo-url",
 							},
 						},
+						{
+							OCI: &kargoapi.OCISubscription{
+								RepoURL: "fake-oci-repo-url",
+							},
+						},
+						{
+							Bucket: &kargoapi.BucketSubscription{
+								URL:    "https://s3.example.com/fake-bucket",
+								Prefix: "builds/",
+							},
+						},
+						{
+							Bucket: &kargoapi.BucketSubscription{
+								URL:    "https://s3.example.com/fake-bucket",
+								Prefix: "builds/fake-app/",
+							},
+						},
 					},
 				},
 			},
@@ -1266,6 +1603,50 @@ func Test_webhook_CompareFreight(t *testing.T) {
 				require.False(t, eq)
 			},
 		},
+		{
+			name: "different number of artifacts",
+			old:  &kargoapi.Freight{Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1"}}},
+			new: &kargoapi.Freight{
+				Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1"}, {RepoURL: "artifact2"}},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("artifacts"), path)
+				require.Equal(t, freight.Artifacts, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different artifact contents",
+			old:  &kargoapi.Freight{Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Digest: "digest1"}}},
+			new:  &kargoapi.Freight{Artifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Digest: "digest2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("artifacts").Index(0), path)
+				require.Equal(t, freight.Artifacts[0], val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different number of objects",
+			old:  &kargoapi.Freight{Objects: []kargoapi.BucketObject{{Key: "object1"}}},
+			new: &kargoapi.Freight{
+				Objects: []kargoapi.BucketObject{{Key: "object1"}, {Key: "object2"}},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("objects"), path)
+				require.Equal(t, freight.Objects, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different object contents",
+			old:  &kargoapi.Freight{Objects: []kargoapi.BucketObject{{Key: "object1", ETag: "etag1"}}},
+			new:  &kargoapi.Freight{Objects: []kargoapi.BucketObject{{Key: "object1", ETag: "etag2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("objects").Index(0), path)
+				require.Equal(t, freight.Objects[0], val)
+				require.False(t, eq)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2,6 +2,7 @@ package projectconfig
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -19,6 +20,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/pattern"
+	libWebhook "github.com/akuity/kargo/pkg/webhook/kubernetes"
 	"github.com/akuity/kargo/pkg/webhook/kubernetes/external"
 )
 
@@ -145,9 +147,79 @@ func (w *webhook) validateSpec(
 	); errs != nil {
 		fieldErrs = append(fieldErrs, errs...)
 	}
+
+	if errs := w.validateNotifications(
+		f.Child("notifications"),
+		spec.Notifications,
+	); errs != nil {
+		fieldErrs = append(fieldErrs, errs...)
+	}
+
+	for i, policy := range spec.PromotionCalendars {
+		policyPath := f.Child("promotionCalendars").Index(i)
+		fieldErrs = append(
+			fieldErrs,
+			libWebhook.ValidatePromotionWindows(
+				policyPath.Child("windows"),
+				policy.Windows,
+			)...,
+		)
+		fieldErrs = append(
+			fieldErrs,
+			libWebhook.ValidatePromotionFreezes(
+				policyPath.Child("freezes"),
+				policy.Freezes,
+			)...,
+		)
+	}
 	return fieldErrs
 }
 
+func (w *webhook) validateNotifications(
+	f *field.Path,
+	notifications []kargoapi.NotificationConfig,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]int, len(notifications))
+	for i, n := range notifications {
+		if j, ok := names[n.Name]; ok {
+			errs = append(errs, field.Invalid(
+				f.Index(i).Child("name"),
+				n.Name,
+				fmt.Sprintf("notification name already defined at %s", f.Index(j)),
+			))
+		} else {
+			names[n.Name] = i
+		}
+
+		var targets int
+		for _, set := range []bool{n.Slack != nil, n.Teams != nil, n.Webhook != nil} {
+			if set {
+				targets++
+			}
+		}
+		if targets != 1 {
+			errs = append(errs, field.Forbidden(
+				f.Index(i),
+				fmt.Sprintf(
+					"exactly one of slack, teams, or webhook must be defined, found %d",
+					targets,
+				),
+			))
+		}
+
+		if n.Webhook != nil && n.Webhook.Body != "" &&
+			!json.Valid([]byte(n.Webhook.Body)) {
+			errs = append(errs, field.Invalid(
+				f.Index(i).Child("webhook", "body"),
+				n.Webhook.Body,
+				"body must be valid JSON; are all expressions enclosed in quotes?",
+			))
+		}
+	}
+	return errs
+}
+
 func (w *webhook) validatePromotionPolicies(
 	f *field.Path,
 	promotionPolicies []kargoapi.PromotionPolicy,
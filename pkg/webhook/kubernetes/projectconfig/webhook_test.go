@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -586,6 +587,106 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					"cannot define a receiver that is of more than one type, found 3: [GitHub GitLab Quay]")
 			},
 		},
+		{
+			name: "invalid notifications",
+			projectConfig: &kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testProjectName,
+					Namespace: testProjectName,
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					Notifications: []kargoapi.NotificationConfig{
+						{
+							Name:  "my-notification",
+							Slack: &kargoapi.SlackNotificationConfig{},
+						},
+						{
+							Name:  "my-notification",
+							Slack: &kargoapi.SlackNotificationConfig{},
+							Teams: &kargoapi.TeamsNotificationConfig{},
+						},
+						{
+							Name: "my-webhook",
+							Webhook: &kargoapi.WebhookNotificationConfig{
+								Body: `{"stage": ${{ event.stageName }}}`,
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{testNs},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				assert.Empty(t, warnings)
+				require.Error(t, err)
+
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+
+				assert.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				assert.Equal(t, 3, len(statusErr.ErrStatus.Details.Causes))
+
+				// Sort errors for consistent testing
+				sort.Slice(statusErr.ErrStatus.Details.Causes, func(i, j int) bool {
+					return statusErr.ErrStatus.Details.Causes[i].Field < statusErr.ErrStatus.Details.Causes[j].Field
+				})
+
+				assert.Equal(t, "spec.notifications[1]", statusErr.ErrStatus.Details.Causes[0].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[0].Message,
+					"exactly one of slack, teams, or webhook must be defined, found 2")
+				assert.Equal(t, "spec.notifications[1].name", statusErr.ErrStatus.Details.Causes[1].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[1].Message,
+					"notification name already defined at spec.notifications[0]")
+				assert.Equal(t, "spec.notifications[2].webhook.body", statusErr.ErrStatus.Details.Causes[2].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[2].Message,
+					"body must be valid JSON")
+			},
+		},
+		{
+			name: "invalid promotion calendars",
+			projectConfig: &kargoapi.ProjectConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testProjectName,
+					Namespace: testProjectName,
+				},
+				Spec: kargoapi.ProjectConfigSpec{
+					PromotionCalendars: []kargoapi.PromotionCalendarPolicy{
+						{
+							Windows: []kargoapi.PromotionWindow{{
+								Kind:     kargoapi.PromotionWindowKindDeny,
+								Schedule: "0 17 * * FRI",
+								Duration: metav1.Duration{Duration: 64 * time.Hour},
+								TimeZone: "Mars/Olympus_Mons",
+							}},
+						},
+						{
+							Freezes: []kargoapi.PromotionFreeze{{
+								Reason: "Holidays",
+								Start:  &metav1.Time{Time: time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)},
+								End:    metav1.NewTime(time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)),
+							}},
+						},
+					},
+				},
+			},
+			objects: []client.Object{testNs},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				assert.Empty(t, warnings)
+				require.Error(t, err)
+
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+
+				assert.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
+				require.Equal(t, 2, len(statusErr.ErrStatus.Details.Causes))
+
+				assert.Equal(t, "spec.promotionCalendars[0].windows[0]", statusErr.ErrStatus.Details.Causes[0].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[0].Message,
+					`invalid time zone "Mars/Olympus_Mons"`)
+				assert.Equal(t, "spec.promotionCalendars[1].freezes[0].end", statusErr.ErrStatus.Details.Causes[1].Field)
+				assert.Contains(t, statusErr.ErrStatus.Details.Causes[1].Message,
+					"end must be after start")
+			},
+		},
 	}
 
 	for _, tt := range tests {
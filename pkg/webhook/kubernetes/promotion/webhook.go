@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
@@ -21,10 +22,12 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
 	kargoEvent "github.com/akuity/kargo/pkg/event"
+	"github.com/akuity/kargo/pkg/event/cloudevents"
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/kargo"
 	libEvent "github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion/calendar"
 	libWebhook "github.com/akuity/kargo/pkg/webhook/kubernetes"
 )
 
@@ -65,12 +68,20 @@ type webhook struct {
 		client.Object,
 	) error
 
+	checkPromotionCalendarFn func(context.Context, *kargoapi.Stage, time.Time) (string, error)
+
 	authorizeFn func(
 		ctx context.Context,
 		promo *kargoapi.Promotion,
 		action string,
 	) error
 
+	authorizeCalendarOverrideFn func(
+		ctx context.Context,
+		req admission.Request,
+		promo *kargoapi.Promotion,
+	) error
+
 	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 
 	createSubjectAccessReviewFn func(
@@ -86,12 +97,17 @@ func SetupWebhookWithManager(
 	ctx context.Context,
 	cfg libWebhook.Config,
 	mgr ctrl.Manager,
+	cloudEventsSender *cloudevents.Sender,
 ) error {
+	sender := cloudevents.WithSink(
+		k8sevent.NewEventSender(libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), "promotion-webhook")),
+		cloudEventsSender,
+	)
 	w := newWebhook(
 		cfg,
 		mgr.GetClient(),
 		admission.NewDecoder(mgr.GetScheme()),
-		k8sevent.NewEventSender(libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), "promotion-webhook")),
+		sender,
 	)
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Promotion{}).
@@ -114,7 +130,9 @@ func newWebhook(
 	w.getFreightFn = api.GetFreight
 	w.getStageFn = api.GetStage
 	w.validateProjectFn = libWebhook.ValidateProject
+	w.checkPromotionCalendarFn = w.checkPromotionCalendar
 	w.authorizeFn = w.authorize
+	w.authorizeCalendarOverrideFn = w.authorizeCalendarOverride
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
 	w.isRequestFromKargoControlplaneFn = libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
@@ -152,6 +170,28 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 		// Enrich the annotation with the actor and control plane information.
 		w.setAbortAnnotationActor(req, nil, promo)
 
+		// Record who overrode any PromotionCalendar applicable to the Stage.
+		// This is never left to the user. The Kargo API server authorizes
+		// overrides on behalf of its own users, marks them as authorized, and
+		// records those users as the actor, so only then is the recorded actor
+		// preserved.
+		if promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverride] == "" {
+			delete(promo.Annotations, kargoapi.AnnotationKeyPromotionCalendarOverrideActor)
+			delete(promo.Annotations, kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized)
+		} else {
+			authorized := w.isCalendarOverrideAuthorized(req, promo)
+			if !authorized {
+				delete(promo.Annotations, kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized)
+			}
+			if !authorized || promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor] == "" {
+				actor, ok := promo.Annotations[kargoapi.AnnotationKeyCreateActor]
+				if !ok {
+					actor = api.FormatEventKubernetesUserActor(req.UserInfo)
+				}
+				promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor] = actor
+			}
+		}
+
 		// Inflate any PromotionTasks in the Promotion's steps
 		if err = kargo.NewPromotionBuilder(w.client).InflateSteps(ctx, promo); err != nil {
 			return fmt.Errorf("failed to inflate Promotion steps: %w", err)
@@ -166,6 +206,20 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 
 		// Enrich the annotation with the actor and control plane information.
 		w.setAbortAnnotationActor(req, oldPromo, promo)
+
+		// Ensure PromotionCalendar override annotations' immutability. Overrides
+		// only have meaning at the time of creation.
+		for _, key := range []string{
+			kargoapi.AnnotationKeyPromotionCalendarOverride,
+			kargoapi.AnnotationKeyPromotionCalendarOverrideActor,
+			kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized,
+		} {
+			if oldVal, ok := oldPromo.Annotations[key]; ok {
+				promo.Annotations[key] = oldVal
+			} else {
+				delete(promo.Annotations, key)
+			}
+		}
 	}
 
 	stage, err := w.getStageFn(
@@ -278,12 +332,77 @@ func (w *webhook) ValidateCreate(
 		)
 	}
 
+	// If the Stage's ApprovalPolicy calls for a separation of duties, nobody
+	// who approved the Freight for the Stage may also promote it to the Stage.
+	// The converse is enforced when Freight is approved.
+	if policy := stage.Spec.ApprovalPolicy; policy != nil && policy.SeparationOfDuties {
+		actor := promo.Annotations[kargoapi.AnnotationKeyCreateActor]
+		if actor != "" && freight.IsApprovedBy(stage.Name, actor) {
+			return nil, apierrors.NewForbidden(
+				promotionGroupResource,
+				promo.Name,
+				fmt.Errorf(
+					"%q approved Freight %q for Stage %q and may not also promote it",
+					actor, freight.Name, stage.Name,
+				),
+			)
+		}
+	}
+
+	// Promotions are subject to any PromotionCalendar applicable to the Stage
+	// unless it has explicitly been overridden. A scheduled Promotion is
+	// checked against the calendar at the time it is scheduled to start.
+	var warnings admission.Warnings
+	at := time.Now()
+	if promo.Spec.NotBefore != nil && promo.Spec.NotBefore.After(at) {
+		at = promo.Spec.NotBefore.Time
+	}
+	reason, err := w.checkPromotionCalendarFn(ctx, stage, at)
+	if err != nil {
+		return nil, apierrors.NewInternalError(
+			fmt.Errorf("check promotion calendar: %w", err),
+		)
+	}
+	if reason != "" {
+		justification := promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverride]
+		if justification == "" {
+			return nil, apierrors.NewForbidden(
+				promotionGroupResource,
+				promo.Name,
+				fmt.Errorf(
+					"%s; to override, annotate the Promotion with %q and a justification",
+					reason,
+					kargoapi.AnnotationKeyPromotionCalendarOverride,
+				),
+			)
+		}
+		// Overrides requested through the Kargo API server have already been
+		// authorized by it for the user on whose behalf it acted.
+		if !w.isCalendarOverrideAuthorized(req, promo) {
+			if err = w.authorizeCalendarOverrideFn(ctx, req, promo); err != nil {
+				return nil, err
+			}
+		}
+		actor := promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor]
+		logging.LoggerFromContext(ctx).Info(
+			"promotion calendar overridden",
+			"namespace", promo.Namespace,
+			"stage", promo.Spec.Stage,
+			"actor", actor,
+			"justification", justification,
+		)
+		warnings = append(
+			warnings,
+			fmt.Sprintf("%s; overridden by %s: %s", reason, actor, justification),
+		)
+	}
+
 	// Record Promotion created event if the request doesn't come from Kargo controlplane
 	if !w.isRequestFromKargoControlplaneFn(req) {
 		w.recordPromotionCreatedEvent(ctx, req, promo, freight)
 	}
 
-	return nil, nil
+	return warnings, nil
 }
 
 func (w *webhook) ValidateUpdate(
@@ -385,6 +504,81 @@ func (w *webhook) authorize(
 	return nil
 }
 
+// isCalendarOverrideAuthorized returns true if the provided admission request
+// originates from the Kargo control plane and the Promotion is marked as having
+// had its PromotionCalendar override authorized by the Kargo API server.
+func (w *webhook) isCalendarOverrideAuthorized(
+	req admission.Request,
+	promo *kargoapi.Promotion,
+) bool {
+	return w.isRequestFromKargoControlplaneFn(req) &&
+		promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized] == "true"
+}
+
+// authorizeCalendarOverride returns an error if the subject of the provided
+// admission request is not permitted to override the PromotionCalendars
+// applicable to the Promotion's Stage. This requires the custom
+// override-calendar verb on the Stage.
+func (w *webhook) authorizeCalendarOverride(
+	ctx context.Context,
+	req admission.Request,
+	promo *kargoapi.Promotion,
+) error {
+	accessReview := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			ResourceAttributes: &authzv1.ResourceAttributes{
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "stages",
+				Name:      promo.Spec.Stage,
+				Verb:      "override-calendar",
+				Namespace: promo.Namespace,
+			},
+		},
+	}
+	if err := w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "")
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.New(
+				"error creating SubjectAccessReview; refusing to override "+
+					"PromotionCalendar",
+			),
+		)
+	}
+
+	if !accessReview.Status.Allowed {
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			fmt.Errorf(
+				"subject %q is not permitted to override PromotionCalendars for Stage %q",
+				req.UserInfo.Username,
+				promo.Spec.Stage,
+			),
+		)
+	}
+
+	return nil
+}
+
+// checkPromotionCalendar returns a non-empty reason if the PromotionCalendars
+// applicable to the provided Stage do not allow promotions at the provided
+// time.
+func (w *webhook) checkPromotionCalendar(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	at time.Time,
+) (string, error) {
+	cal, err := calendar.ForStage(ctx, w.client, stage)
+	if err != nil {
+		return "", err
+	}
+	return calendar.Check(cal, at)
+}
+
 func (w *webhook) recordPromotionCreatedEvent(
 	ctx context.Context,
 	req admission.Request,
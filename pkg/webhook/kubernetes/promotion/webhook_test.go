@@ -6,6 +6,7 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -39,7 +40,9 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.getFreightFn)
 	require.NotNil(t, w.getStageFn)
 	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.checkPromotionCalendarFn)
 	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.authorizeCalendarOverrideFn)
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.createSubjectAccessReviewFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
@@ -607,6 +610,158 @@ func Test_webhook_Default(t *testing.T) {
 				}).String(), v)
 			},
 		},
+		{
+			name: "record promotion calendar override actor",
+			webhook: &webhook{
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return false
+				},
+			},
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authnv1.UserInfo{
+						Username: "real-user",
+					},
+				},
+			},
+			promotion: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyPromotionCalendarOverride:           "Hotfix for incident 123",
+						kargoapi.AnnotationKeyPromotionCalendarOverrideActor:      "someone-else",
+						kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized: "true",
+					},
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					api.FormatEventKubernetesUserActor(authnv1.UserInfo{
+						Username: "real-user",
+					}),
+					promotion.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor],
+				)
+				require.NotContains(
+					t,
+					promotion.Annotations,
+					kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized,
+				)
+			},
+		},
+		{
+			name: "preserve promotion calendar override actor recorded by control plane",
+			webhook: &webhook{
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return true
+				},
+			},
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo: authnv1.UserInfo{
+						Username: "system:serviceaccount:kargo:kargo-api",
+					},
+				},
+			},
+			promotion: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyPromotionCalendarOverride:           "Hotfix for incident 123",
+						kargoapi.AnnotationKeyPromotionCalendarOverrideActor:      "email:jane@example.com",
+						kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized: "true",
+					},
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"email:jane@example.com",
+					promotion.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor],
+				)
+			},
+		},
+		{
+			name: "promotion calendar override annotations are immutable",
+			webhook: &webhook{
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{}, nil
+				},
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return false
+				},
+			},
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					UserInfo: authnv1.UserInfo{
+						Username: "real-user",
+					},
+					OldObject: runtime.RawExtension{
+						Object: &kargoapi.Promotion{},
+					},
+				},
+			},
+			promotion: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyPromotionCalendarOverride:      "After the fact",
+						kargoapi.AnnotationKeyPromotionCalendarOverrideActor: "real-user",
+					},
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, promotion *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotContains(
+					t,
+					promotion.Annotations,
+					kargoapi.AnnotationKeyPromotionCalendarOverride,
+				)
+				require.NotContains(
+					t,
+					promotion.Annotations,
+					kargoapi.AnnotationKeyPromotionCalendarOverrideActor,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -639,10 +794,12 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 	const testWarehouse = "fake-warehouse"
 
 	testCases := []struct {
-		name       string
-		webhook    *webhook
-		userInfo   *authnv1.UserInfo
-		assertions func(*testing.T, *fakeevent.EventRecorder, error)
+		name        string
+		webhook     *webhook
+		userInfo    *authnv1.UserInfo
+		annotations map[string]string
+		notBefore   *metav1.Time
+		assertions  func(*testing.T, *fakeevent.EventRecorder, admission.Warnings, error)
 	}{
 		{
 			name: "error validating project",
@@ -655,7 +812,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ admission.Warnings, err error) {
 				var statusErr *apierrors.StatusError
 				require.True(t, errors.As(err, &statusErr))
 				require.Equal(
@@ -680,7 +837,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ admission.Warnings, err error) {
 				var statusErr *apierrors.StatusError
 				require.True(t, errors.As(err, &statusErr))
 				require.Equal(
@@ -713,7 +870,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ admission.Warnings, err error) {
 				var statusErr *apierrors.StatusError
 				require.True(t, errors.As(err, &statusErr))
 				require.Equal(
@@ -753,7 +910,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ admission.Warnings, err error) {
 				var statusErr *apierrors.StatusError
 				require.True(t, errors.As(err, &statusErr))
 				require.Equal(
@@ -793,7 +950,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					return &kargoapi.Freight{}, nil
 				},
 			},
-			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ admission.Warnings, err error) {
 				var statusErr *apierrors.StatusError
 				require.True(t, errors.As(err, &statusErr))
 				require.Equal(t, metav1.StatusReasonInvalid, statusErr.ErrStatus.Reason)
@@ -847,6 +1004,9 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 						},
 					}, nil
 				},
+				checkPromotionCalendarFn: func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+					return "", nil
+				},
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -854,7 +1014,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 			userInfo: &authnv1.UserInfo{
 				Username: "fake-user",
 			},
-			assertions: func(t *testing.T, r *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
 				require.NoError(t, err)
 				require.Len(t, r.Events, 1)
 				event := <-r.Events
@@ -904,6 +1064,9 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 					}, nil
 				},
 				admissionRequestFromContextFn: admission.RequestFromContext,
+				checkPromotionCalendarFn: func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+					return "", nil
+				},
 				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
 					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
 				),
@@ -911,8 +1074,335 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 			userInfo: &authnv1.UserInfo{
 				Username: serviceaccount.ServiceAccountUsernamePrefix + "kargo:kargo-api",
 			},
-			assertions: func(t *testing.T, r *fakeevent.EventRecorder, err error) {
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Empty(t, r.Events)
+			},
+		},
+		{
+			name: "error checking promotion calendar",
+			webhook: newValidateCreateCalendarWebhook(
+				func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			),
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(
+					t,
+					metav1.StatusReasonInternalError,
+					statusErr.ErrStatus.Reason,
+				)
+				require.Contains(t, statusErr.ErrStatus.Message, "something went wrong")
+			},
+		},
+		{
+			name: "promotion calendar denies promotion",
+			webhook: newValidateCreateCalendarWebhook(
+				func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+					return "promotions are frozen", nil
+				},
+			),
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(t, metav1.StatusReasonForbidden, statusErr.ErrStatus.Reason)
+				require.Contains(t, statusErr.ErrStatus.Message, "promotions are frozen")
+				require.Contains(
+					t,
+					statusErr.ErrStatus.Message,
+					kargoapi.AnnotationKeyPromotionCalendarOverride,
+				)
+				require.Empty(t, r.Events)
+			},
+		},
+		{
+			name: "approver may not promote under separation of duties",
+			webhook: func() *webhook {
+				w := newValidateCreateCalendarWebhook(
+					func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+						return "", nil
+					},
+				)
+				w.getStageFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+						Spec: kargoapi.StageSpec{
+							RequestedFreight: []kargoapi.FreightRequest{{
+								Origin: kargoapi.FreightOrigin{
+									Kind: kargoapi.FreightOriginKindWarehouse,
+									Name: "fake-warehouse",
+								},
+								Sources: kargoapi.FreightSources{Direct: true},
+							}},
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{
+								RequiredApprovals:  1,
+								SeparationOfDuties: true,
+							},
+						},
+					}, nil
+				}
+				w.getFreightFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse",
+						},
+						Status: kargoapi.FreightStatus{
+							Approvals: map[string]kargoapi.StageApprovals{
+								"fake-stage": {
+									Approvals: []kargoapi.Approval{{Actor: "kubernetes:fake-user"}},
+								},
+							},
+						},
+					}, nil
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{
+				Username: "fake-user",
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyCreateActor: "kubernetes:fake-user",
+			},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				var statusErr *apierrors.StatusError
+				require.True(t, errors.As(err, &statusErr))
+				require.Equal(t, metav1.StatusReasonForbidden, statusErr.ErrStatus.Reason)
+				require.Contains(t, statusErr.ErrStatus.Message, "may not also promote it")
+				require.Empty(t, r.Events)
+			},
+		},
+		{
+			name: "non-approver may promote under separation of duties",
+			webhook: func() *webhook {
+				w := newValidateCreateCalendarWebhook(
+					func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+						return "", nil
+					},
+				)
+				w.getStageFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+						Spec: kargoapi.StageSpec{
+							RequestedFreight: []kargoapi.FreightRequest{{
+								Origin: kargoapi.FreightOrigin{
+									Kind: kargoapi.FreightOriginKindWarehouse,
+									Name: "fake-warehouse",
+								},
+								Sources: kargoapi.FreightSources{Direct: true},
+							}},
+							ApprovalPolicy: &kargoapi.ApprovalPolicy{
+								RequiredApprovals:  1,
+								SeparationOfDuties: true,
+							},
+						},
+					}, nil
+				}
+				w.getFreightFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-freight"},
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse",
+						},
+						Status: kargoapi.FreightStatus{
+							Approvals: map[string]kargoapi.StageApprovals{
+								"fake-stage": {
+									Approvals: []kargoapi.Approval{{Actor: "kubernetes:fake-user"}},
+								},
+							},
+						},
+					}, nil
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{
+				Username: "other-user",
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyCreateActor: "kubernetes:other-user",
+			},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Len(t, r.Events, 1)
+			},
+		},
+		{
+			name: "scheduled promotion checked against calendar at scheduled time",
+			webhook: newValidateCreateCalendarWebhook(
+				func(_ context.Context, _ *kargoapi.Stage, at time.Time) (string, error) {
+					if !at.Equal(time.Date(2099, 1, 1, 2, 0, 0, 0, time.UTC)) {
+						return "promotions are frozen", nil
+					}
+					return "", nil
+				},
+			),
+			userInfo: &authnv1.UserInfo{
+				Username: "fake-user",
+			},
+			notBefore: &metav1.Time{Time: time.Date(2099, 1, 1, 2, 0, 0, 0, time.UTC)},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Len(t, r.Events, 1)
+			},
+		},
+		{
+			name: "promotion calendar overridden",
+			webhook: newValidateCreateCalendarWebhook(
+				func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+					return "promotions are frozen", nil
+				},
+			),
+			userInfo: &authnv1.UserInfo{
+				Username: "fake-user",
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride:      "Hotfix for incident 123",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideActor: "kubernetes:fake-user",
+			},
+			assertions: func(
+				t *testing.T,
+				r *fakeevent.EventRecorder,
+				warnings admission.Warnings,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					admission.Warnings{
+						"promotions are frozen; overridden by kubernetes:fake-user: " +
+							"Hotfix for incident 123",
+					},
+					warnings,
+				)
+				require.Len(t, r.Events, 1)
+			},
+		},
+		{
+			name: "promotion calendar override not permitted",
+			webhook: func() *webhook {
+				w := newValidateCreateCalendarWebhook(
+					func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+						return "promotions are frozen", nil
+					},
+				)
+				w.authorizeCalendarOverrideFn = func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return apierrors.NewForbidden(
+						promotionGroupResource,
+						"",
+						errors.New("not permitted"),
+					)
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{
+				Username: "fake-user",
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride:      "Hotfix for incident 123",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideActor: "kubernetes:fake-user",
+			},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "not permitted")
+				require.Empty(t, r.Events)
+			},
+		},
+		{
+			name: "promotion calendar override authorized by API server",
+			webhook: func() *webhook {
+				w := newValidateCreateCalendarWebhook(
+					func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+						return "promotions are frozen", nil
+					},
+				)
+				w.authorizeCalendarOverrideFn = func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("should not be called")
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{
+				Username: "system:serviceaccount:kargo:kargo-api",
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride:           "Hotfix for incident 123",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideActor:      "email:jane@example.com",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized: "true",
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				warnings admission.Warnings,
+				err error,
+			) {
 				require.NoError(t, err)
+				require.Equal(
+					t,
+					admission.Warnings{
+						"promotions are frozen; overridden by email:jane@example.com: " +
+							"Hotfix for incident 123",
+					},
+					warnings,
+				)
+			},
+		},
+		{
+			name: "promotion calendar override by control plane not authorized by API server",
+			webhook: func() *webhook {
+				w := newValidateCreateCalendarWebhook(
+					func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+						return "promotions are frozen", nil
+					},
+				)
+				w.authorizeCalendarOverrideFn = func(
+					context.Context,
+					admission.Request,
+					*kargoapi.Promotion,
+				) error {
+					return apierrors.NewForbidden(
+						promotionGroupResource,
+						"",
+						errors.New("not permitted"),
+					)
+				}
+				return w
+			}(),
+			userInfo: &authnv1.UserInfo{
+				Username: "system:serviceaccount:kargo:kargo-api",
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride:      "Hotfix for incident 123",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideActor: "email:jane@example.com",
+			},
+			assertions: func(t *testing.T, r *fakeevent.EventRecorder, _ admission.Warnings, err error) {
+				require.True(t, apierrors.IsForbidden(err))
 				require.Empty(t, r.Events)
 			},
 		},
@@ -928,19 +1418,187 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 			}
 			ctx := admission.NewContextWithRequest(context.Background(), req)
 
-			_, err := testCase.webhook.ValidateCreate(
+			warnings, err := testCase.webhook.ValidateCreate(
 				ctx,
 				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: testCase.annotations,
+					},
 					Spec: kargoapi.PromotionSpec{
-						Freight: "fake-freight",
+						Freight:   "fake-freight",
+						NotBefore: testCase.notBefore,
 					},
 				},
 			)
-			testCase.assertions(t, recorder, err)
+			testCase.assertions(t, recorder, warnings, err)
 		})
 	}
 }
 
+func Test_webhook_calendarOverrideDefaultAndValidateCreate(t *testing.T) {
+	const apiServerUsername = "system:serviceaccount:kargo:kargo-api"
+
+	testCases := []struct {
+		name        string
+		username    string
+		annotations map[string]string
+		assertions  func(*testing.T, *kargoapi.Promotion, bool, error)
+	}{
+		{
+			name:     "control plane without actor or authorization",
+			username: apiServerUsername,
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride: "Hotfix for incident 123",
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, authzChecked bool, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.True(t, authzChecked)
+				require.Equal(
+					t,
+					api.FormatEventKubernetesUserActor(authnv1.UserInfo{Username: apiServerUsername}),
+					promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor],
+				)
+			},
+		},
+		{
+			name:     "control plane with authorization",
+			username: apiServerUsername,
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride:           "Hotfix for incident 123",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideActor:      "email:jane@example.com",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized: "true",
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, authzChecked bool, err error) {
+				require.NoError(t, err)
+				require.False(t, authzChecked)
+				require.Equal(
+					t,
+					"email:jane@example.com",
+					promo.Annotations[kargoapi.AnnotationKeyPromotionCalendarOverrideActor],
+				)
+			},
+		},
+		{
+			name:     "user claiming authorization",
+			username: "fake-user",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromotionCalendarOverride:           "Hotfix for incident 123",
+				kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized: "true",
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, authzChecked bool, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.True(t, authzChecked)
+				require.NotContains(
+					t,
+					promo.Annotations,
+					kargoapi.AnnotationKeyPromotionCalendarOverrideAuthorized,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := newValidateCreateCalendarWebhook(
+				func(context.Context, *kargoapi.Stage, time.Time) (string, error) {
+					return "promotions are frozen", nil
+				},
+			)
+			var authzChecked bool
+			w.authorizeCalendarOverrideFn = func(
+				context.Context,
+				admission.Request,
+				*kargoapi.Promotion,
+			) error {
+				authzChecked = true
+				return apierrors.NewForbidden(
+					promotionGroupResource,
+					"",
+					errors.New("not permitted"),
+				)
+			}
+			w.sender = k8sevent.NewEventSender(fakeevent.NewEventRecorder(1))
+
+			ctx := admission.NewContextWithRequest(
+				context.Background(),
+				admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						Operation: admissionv1.Create,
+						UserInfo:  authnv1.UserInfo{Username: testCase.username},
+					},
+				},
+			)
+			promo := &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: testCase.annotations,
+				},
+				Spec: kargoapi.PromotionSpec{
+					Freight: "fake-freight",
+					Steps:   []kargoapi.PromotionStep{{}},
+				},
+			}
+			require.NoError(t, w.Default(ctx, promo))
+			_, err := w.ValidateCreate(ctx, promo)
+			testCase.assertions(t, promo, authzChecked, err)
+		})
+	}
+}
+
+// newValidateCreateCalendarWebhook returns a webhook for which every check
+// preceding the PromotionCalendar check in ValidateCreate succeeds.
+func newValidateCreateCalendarWebhook(
+	checkPromotionCalendarFn func(context.Context, *kargoapi.Stage, time.Time) (string, error),
+) *webhook {
+	return &webhook{
+		validateProjectFn: func(context.Context, client.Client, client.Object) error {
+			return nil
+		},
+		authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+			return nil
+		},
+		admissionRequestFromContextFn: admission.RequestFromContext,
+		getStageFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Stage, error) {
+			return &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse",
+						},
+						Sources: kargoapi.FreightSources{Direct: true},
+					}},
+				},
+			}, nil
+		},
+		getFreightFn: func(
+			context.Context,
+			client.Client,
+			types.NamespacedName,
+		) (*kargoapi.Freight, error) {
+			return &kargoapi.Freight{
+				Origin: kargoapi.FreightOrigin{
+					Kind: kargoapi.FreightOriginKindWarehouse,
+					Name: "fake-warehouse",
+				},
+			}, nil
+		},
+		checkPromotionCalendarFn: checkPromotionCalendarFn,
+		authorizeCalendarOverrideFn: func(
+			context.Context,
+			admission.Request,
+			*kargoapi.Promotion,
+		) error {
+			return nil
+		},
+		isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
+			regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
+		),
+	}
+}
+
 func Tes_webhook_tValidateUpdate(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -1184,3 +1842,94 @@ func Test_webhook_Authorize(t *testing.T) {
 		})
 	}
 }
+
+func Test_webhook_authorizeCalendarOverride(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		createSubjectAccessReviewFn func(
+			context.Context,
+			client.Object,
+			...client.CreateOption,
+		) error
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error creating subject access review",
+			createSubjectAccessReviewFn: func(
+				context.Context,
+				client.Object,
+				...client.CreateOption,
+			) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "error creating SubjectAccessReview")
+			},
+		},
+		{
+			name: "subject is not authorized",
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = false // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(t *testing.T, err error) {
+				require.True(t, apierrors.IsForbidden(err))
+				require.ErrorContains(t, err, "is not permitted to override PromotionCalendars")
+			},
+		},
+		{
+			name: "subject is authorized",
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				attrs := review.Spec.ResourceAttributes
+				if review.Spec.User == "fake-user" &&
+					attrs.Verb == "override-calendar" &&
+					attrs.Resource == "stages" &&
+					attrs.Name == "fake-stage" &&
+					attrs.Namespace == "fake-namespace" {
+					review.Status.Allowed = true
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				createSubjectAccessReviewFn: testCase.createSubjectAccessReviewFn,
+			}
+			testCase.assertions(
+				t,
+				w.authorizeCalendarOverride(
+					context.Background(),
+					admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							UserInfo: authnv1.UserInfo{Username: "fake-user"},
+						},
+					},
+					&kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-promotion",
+							Namespace: "fake-namespace",
+						},
+						Spec: kargoapi.PromotionSpec{
+							Stage: "fake-stage",
+						},
+					},
+				),
+			)
+		})
+	}
+}
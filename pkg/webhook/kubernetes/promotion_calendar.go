@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion/calendar"
+)
+
+// ValidatePromotionWindows validates that each of the provided
+// PromotionWindows has a valid schedule, duration, and time zone.
+func ValidatePromotionWindows(
+	f *field.Path,
+	windows []kargoapi.PromotionWindow,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	for i, window := range windows {
+		if err := calendar.ValidateWindow(window); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Index(i), window.Schedule, err.Error()),
+			)
+		}
+	}
+	return errs
+}
+
+// ValidatePromotionFreezes validates that each of the provided
+// PromotionFreezes, if it has a start time, ends after it starts.
+func ValidatePromotionFreezes(
+	f *field.Path,
+	freezes []kargoapi.PromotionFreeze,
+) field.ErrorList {
+	errs := field.ErrorList{}
+	for i, freeze := range freezes {
+		if freeze.Start != nil && !freeze.End.After(freeze.Start.Time) {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i).Child("end"),
+					freeze.End,
+					"end must be after start",
+				),
+			)
+		}
+	}
+	return errs
+}
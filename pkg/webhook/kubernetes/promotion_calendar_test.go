@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestValidatePromotionWindows(t *testing.T) {
+	errs := ValidatePromotionWindows(
+		field.NewPath("windows"),
+		[]kargoapi.PromotionWindow{
+			{
+				Schedule: "0 9 * * MON-FRI",
+				Duration: metav1.Duration{Duration: 8 * time.Hour},
+				TimeZone: "Europe/Berlin",
+			},
+			{
+				Schedule: "0 9 * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			{
+				Schedule: "0 9 * * *",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "Mars/Olympus_Mons",
+			},
+		},
+	)
+	require.Len(t, errs, 2)
+	require.Equal(t, "windows[1]", errs[0].Field)
+	require.Contains(t, errs[0].Detail, "must have exactly five fields")
+	require.Equal(t, "windows[2]", errs[1].Field)
+	require.Contains(t, errs[1].Detail, "invalid time zone")
+}
+
+func TestValidatePromotionFreezes(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Hour))
+	errs := ValidatePromotionFreezes(
+		field.NewPath("freezes"),
+		[]kargoapi.PromotionFreeze{
+			{Reason: "No start", End: now},
+			{Reason: "Valid", Start: &now, End: later},
+			{Reason: "Inverted", Start: &later, End: now},
+		},
+	)
+	require.Len(t, errs, 1)
+	require.Equal(t, "freezes[2].end", errs[0].Field)
+	require.Equal(t, "end must be after start", errs[0].Detail)
+}
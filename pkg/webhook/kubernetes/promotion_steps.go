@@ -13,39 +13,69 @@ import (
 func ValidatePromotionSteps(
 	f *field.Path,
 	steps []kargoapi.PromotionStep,
+) field.ErrorList {
+	return validatePromotionStepAliases(f, steps, make(map[string]*field.Path))
+}
+
+// ValidatePromotionStepSections validates the steps, onFailure steps and
+// finally steps of the spec at the given path. As the steps of all sections
+// share the same state, their aliases must be unique across all sections.
+func ValidatePromotionStepSections(
+	f *field.Path,
+	steps []kargoapi.PromotionStep,
+	onFailure []kargoapi.PromotionStep,
+	finally []kargoapi.PromotionStep,
+) field.ErrorList {
+	pathsByAlias := make(map[string]*field.Path)
+	errs := validatePromotionStepAliases(f.Child("steps"), steps, pathsByAlias)
+	errs = append(errs, validatePromotionStepAliases(f.Child("onFailure"), onFailure, pathsByAlias)...)
+	return append(errs, validatePromotionStepAliases(f.Child("finally"), finally, pathsByAlias)...)
+}
+
+func validatePromotionStepAliases(
+	f *field.Path,
+	steps []kargoapi.PromotionStep,
+	pathsByAlias map[string]*field.Path,
 ) field.ErrorList {
 	errs := field.ErrorList{}
-	indicesByAlias := make(map[string]int)
-	for i, step := range steps {
-		stepAlias := strings.TrimSpace(step.As)
-		if stepAlias == "" {
-			continue
+	validateAlias := func(p *field.Path, alias string) {
+		alias = strings.TrimSpace(alias)
+		if alias == "" {
+			return
 		}
-		if existingIndex, exists := indicesByAlias[stepAlias]; exists {
+		if existingPath, exists := pathsByAlias[alias]; exists {
 			errs = append(
 				errs,
 				field.Invalid(
-					f.Index(i).Child("as"),
-					stepAlias,
-					fmt.Sprintf(
-						"step alias duplicates that of %s",
-						f.Index(existingIndex),
-					),
+					p.Child("as"),
+					alias,
+					fmt.Sprintf("step alias duplicates that of %s", existingPath),
 				),
 			)
 		} else {
-			indicesByAlias[stepAlias] = i
+			pathsByAlias[alias] = p
 		}
-		if promotion.ReservedStepAliasRegex.MatchString(stepAlias) {
+		if promotion.ReservedStepAliasRegex.MatchString(alias) {
 			errs = append(
 				errs,
 				field.Invalid(
-					f.Index(i).Child("as"),
-					stepAlias,
+					p.Child("as"),
+					alias,
 					"step alias is reserved",
 				),
 			)
 		}
 	}
+	for i, step := range steps {
+		validateAlias(f.Index(i), step.As)
+		// Members of a step group are expanded into steps of their own, so
+		// their aliases must be unique as well.
+		if step.Group != nil {
+			membersPath := f.Index(i).Child("group", "steps")
+			for j, member := range step.Group.Steps {
+				validateAlias(membersPath.Index(j), member.As)
+			}
+		}
+	}
 	return errs
 }
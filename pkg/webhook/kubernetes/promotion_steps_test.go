@@ -55,6 +55,41 @@ func TestValidatePromotionSteps(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "step group members are invalid",
+			steps: []kargoapi.PromotionStep{
+				{As: "update"},
+				{
+					Group: &kargoapi.PromotionStepGroup{
+						Steps: []kargoapi.PromotionStepGroupMember{
+							{As: "update"}, // Duplicate!
+							{As: "task-1"}, // This step alias matches a reserved pattern
+							{},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "steps[1].group.steps[0].as",
+							BadValue: "update",
+							Detail:   "step alias duplicates that of steps[0]",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "steps[1].group.steps[1].as",
+							BadValue: "task-1",
+							Detail:   "step alias is reserved",
+						},
+					},
+					errs,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -65,3 +100,76 @@ func TestValidatePromotionSteps(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePromotionStepSections(t *testing.T) {
+	testCases := []struct {
+		name       string
+		steps      []kargoapi.PromotionStep
+		onFailure  []kargoapi.PromotionStep
+		finally    []kargoapi.PromotionStep
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name:  "valid",
+			steps: []kargoapi.PromotionStep{{As: "update"}, {}},
+			onFailure: []kargoapi.PromotionStep{
+				{As: "revert"},
+				{},
+			},
+			finally: []kargoapi.PromotionStep{{As: "cleanup"}},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name:  "invalid",
+			steps: []kargoapi.PromotionStep{{As: "update"}},
+			onFailure: []kargoapi.PromotionStep{
+				{As: "update"},            // Duplicate of a main step!
+				{As: "on-failure-step-1"}, // This step alias matches a reserved pattern
+			},
+			finally: []kargoapi.PromotionStep{
+				{As: "finally-task-2"}, // This step alias matches a reserved pattern
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.onFailure[0].as",
+							BadValue: "update",
+							Detail:   "step alias duplicates that of spec.steps[0]",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.onFailure[1].as",
+							BadValue: "on-failure-step-1",
+							Detail:   "step alias is reserved",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.finally[0].as",
+							BadValue: "finally-task-2",
+							Detail:   "step alias is reserved",
+						},
+					},
+					errs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				ValidatePromotionStepSections(
+					field.NewPath("spec"),
+					testCase.steps,
+					testCase.onFailure,
+					testCase.finally,
+				),
+			)
+		})
+	}
+}
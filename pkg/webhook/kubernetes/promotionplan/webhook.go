@@ -0,0 +1,290 @@
+package promotionplan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/logging"
+	libWebhook "github.com/akuity/kargo/pkg/webhook/kubernetes"
+)
+
+var (
+	promotionPlanGroupKind = schema.GroupKind{
+		Group: kargoapi.GroupVersion.Group,
+		Kind:  "PromotionPlan",
+	}
+	promotionPlanGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "PromotionPlan",
+	}
+)
+
+type webhook struct {
+	client client.Client
+
+	// The following behaviors are overridable for testing purposes:
+
+	getStageFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error)
+
+	validateProjectFn func(
+		context.Context,
+		client.Client,
+		client.Object,
+	) error
+
+	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
+
+	createSubjectAccessReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+}
+
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := newWebhook(mgr.GetClient())
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kargoapi.PromotionPlan{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+func newWebhook(kubeClient client.Client) *webhook {
+	w := &webhook{
+		client: kubeClient,
+	}
+	w.getStageFn = api.GetStage
+	w.validateProjectFn = libWebhook.ValidateProject
+	w.admissionRequestFromContextFn = admission.RequestFromContext
+	w.createSubjectAccessReviewFn = w.client.Create
+	return w
+}
+
+// Default labels a new PromotionPlan with the shard of the Stages it
+// references so that it is reconciled by the same controller as those Stages
+// and their Promotions.
+func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
+	plan := obj.(*kargoapi.PromotionPlan) // nolint: forcetypeassert
+	if len(plan.Spec.Waves) == 0 || len(plan.Spec.Waves[0].Stages) == 0 {
+		return nil
+	}
+	stage, err := w.getStageFn(ctx, w.client, types.NamespacedName{
+		Namespace: plan.Namespace,
+		Name:      plan.Spec.Waves[0].Stages[0],
+	})
+	if err != nil {
+		return fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		// Validation will reject the PromotionPlan
+		return nil
+	}
+	if stage.Spec.Shard != "" {
+		if plan.Labels == nil {
+			plan.Labels = make(map[string]string, 1)
+		}
+		plan.Labels[kargoapi.LabelKeyShard] = stage.Spec.Shard
+	} else {
+		delete(plan.Labels, kargoapi.LabelKeyShard)
+	}
+	return nil
+}
+
+func (w *webhook) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	plan := obj.(*kargoapi.PromotionPlan) // nolint: forcetypeassert
+	var errs field.ErrorList
+	if err := w.validateProjectFn(ctx, w.client, plan); err != nil {
+		var statusErr *apierrors.StatusError
+		if ok := errors.As(err, &statusErr); ok {
+			return nil, statusErr
+		}
+		var fieldErr *field.Error
+		if ok := errors.As(err, &fieldErr); !ok {
+			return nil, apierrors.NewInternalError(err)
+		}
+		errs = append(errs, fieldErr)
+	}
+	if errs = append(
+		errs,
+		w.validateSpec(field.NewPath("spec"), plan.Spec)...,
+	); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(promotionPlanGroupKind, plan.Name, errs)
+	}
+	errs, err := w.validateStages(ctx, field.NewPath("spec"), plan)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if len(errs) > 0 {
+		return nil, apierrors.NewInvalid(promotionPlanGroupKind, plan.Name, errs)
+	}
+	// The controller creates the PromotionPlan's Promotions on behalf of
+	// whoever created the PromotionPlan, so they must be permitted to promote
+	// to every Stage it references.
+	if err = w.authorize(ctx, plan); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (w *webhook) ValidateUpdate(
+	context.Context,
+	runtime.Object,
+	runtime.Object,
+) (admission.Warnings, error) {
+	// No-op. The spec of a PromotionPlan is immutable.
+	return nil, nil
+}
+
+func (w *webhook) ValidateDelete(
+	context.Context,
+	runtime.Object,
+) (admission.Warnings, error) {
+	// No-op
+	return nil, nil
+}
+
+func (w *webhook) validateSpec(
+	f *field.Path,
+	spec kargoapi.PromotionPlanSpec,
+) field.ErrorList {
+	var errs field.ErrorList
+	seen := make(map[string]struct{})
+	for i, wave := range spec.Waves {
+		for j, stage := range wave.Stages {
+			if _, ok := seen[stage]; ok {
+				errs = append(errs, field.Duplicate(
+					f.Child("waves").Index(i).Child("stages").Index(j),
+					stage,
+				))
+				continue
+			}
+			seen[stage] = struct{}{}
+		}
+	}
+	return errs
+}
+
+// validateStages verifies that every Stage referenced by the provided
+// PromotionPlan exists and that all of them belong to the same shard. A
+// PromotionPlan is reconciled by a single controller, which can only observe
+// the Stages and Promotions of its own shard.
+func (w *webhook) validateStages(
+	ctx context.Context,
+	f *field.Path,
+	plan *kargoapi.PromotionPlan,
+) (field.ErrorList, error) {
+	var errs field.ErrorList
+	var shard *string
+	for i, wave := range plan.Spec.Waves {
+		for j, stageName := range wave.Stages {
+			stagePath := f.Child("waves").Index(i).Child("stages").Index(j)
+			stage, err := w.getStageFn(ctx, w.client, types.NamespacedName{
+				Namespace: plan.Namespace,
+				Name:      stageName,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("get stage: %w", err)
+			}
+			if stage == nil {
+				errs = append(errs, field.NotFound(stagePath, stageName))
+				continue
+			}
+			if shard == nil {
+				shard = &stage.Spec.Shard
+			} else if stage.Spec.Shard != *shard {
+				errs = append(errs, field.Invalid(
+					stagePath,
+					stageName,
+					fmt.Sprintf(
+						"Stage belongs to shard %q, but the PromotionPlan's other "+
+							"Stages belong to shard %q",
+						stage.Spec.Shard, *shard,
+					),
+				))
+			}
+		}
+	}
+	return errs, nil
+}
+
+func (w *webhook) authorize(
+	ctx context.Context,
+	plan *kargoapi.PromotionPlan,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		logger.Error(err, "")
+		return apierrors.NewForbidden(
+			promotionPlanGroupResource,
+			plan.Name,
+			errors.New(
+				"error retrieving admission request from context; refusing to "+
+					"create PromotionPlan",
+			),
+		)
+	}
+
+	for _, wave := range plan.Spec.Waves {
+		for _, stage := range wave.Stages {
+			accessReview := &authzv1.SubjectAccessReview{
+				Spec: authzv1.SubjectAccessReviewSpec{
+					User:   req.UserInfo.Username,
+					Groups: req.UserInfo.Groups,
+					ResourceAttributes: &authzv1.ResourceAttributes{
+						Group:     kargoapi.GroupVersion.Group,
+						Resource:  "stages",
+						Name:      stage,
+						Verb:      "promote",
+						Namespace: plan.Namespace,
+					},
+				},
+			}
+			if err = w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+				logger.Error(err, "")
+				return apierrors.NewForbidden(
+					promotionPlanGroupResource,
+					plan.Name,
+					errors.New(
+						"error creating SubjectAccessReview; refusing to create PromotionPlan",
+					),
+				)
+			}
+			if !accessReview.Status.Allowed {
+				return apierrors.NewForbidden(
+					promotionPlanGroupResource,
+					plan.Name,
+					fmt.Errorf(
+						"subject %q is not permitted to promote to Stage %q",
+						req.UserInfo.Username,
+						stage,
+					),
+				)
+			}
+		}
+	}
+
+	return nil
+}
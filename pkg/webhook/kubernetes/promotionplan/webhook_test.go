@@ -0,0 +1,304 @@
+package promotionplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_newWebhook(t *testing.T) {
+	w := newWebhook(fake.NewClientBuilder().Build())
+	require.NotNil(t, w.client)
+	require.NotNil(t, w.getStageFn)
+	require.NotNil(t, w.validateProjectFn)
+	require.NotNil(t, w.admissionRequestFromContextFn)
+	require.NotNil(t, w.createSubjectAccessReviewFn)
+}
+
+func Test_webhook_ValidateCreate(t *testing.T) {
+	allowed := func(
+		_ context.Context,
+		obj client.Object,
+		_ ...client.CreateOption,
+	) error {
+		review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+		review.Status.Allowed = review.Spec.ResourceAttributes.Name != "forbidden"
+		return nil
+	}
+
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		plan       *kargoapi.PromotionPlan
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error validating project",
+			webhook: &webhook{
+				validateProjectFn: func(context.Context, client.Client, client.Object) error {
+					return errors.New("something went wrong")
+				},
+			},
+			plan: &kargoapi.PromotionPlan{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Stage appears more than once",
+			webhook: &webhook{
+				validateProjectFn: func(context.Context, client.Client, client.Object) error {
+					return nil
+				},
+			},
+			plan: &kargoapi.PromotionPlan{
+				Spec: kargoapi.PromotionPlanSpec{
+					Waves: []kargoapi.PromotionWave{
+						{Stages: []string{"prod-us"}},
+						{Stages: []string{"prod-eu", "prod-us"}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `spec.waves[1].stages[1]: Duplicate value: "prod-us"`)
+			},
+		},
+		{
+			name: "Stages do not exist or belong to different shards",
+			webhook: &webhook{
+				validateProjectFn: func(context.Context, client.Client, client.Object) error {
+					return nil
+				},
+				getStageFn: getStage,
+			},
+			plan: &kargoapi.PromotionPlan{
+				Spec: kargoapi.PromotionPlanSpec{
+					Waves: []kargoapi.PromotionWave{
+						{Stages: []string{"prod-us"}},
+						{Stages: []string{"missing", "sharded"}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `spec.waves[1].stages[0]: Not found: "missing"`)
+				require.ErrorContains(t, err, `Stage belongs to shard "fake-shard"`)
+			},
+		},
+		{
+			name: "subject may not promote to every Stage",
+			webhook: &webhook{
+				validateProjectFn: func(context.Context, client.Client, client.Object) error {
+					return nil
+				},
+				getStageFn: getStage,
+				admissionRequestFromContextFn: func(context.Context) (admission.Request, error) {
+					return admission.Request{}, nil
+				},
+				createSubjectAccessReviewFn: allowed,
+			},
+			plan: &kargoapi.PromotionPlan{
+				Spec: kargoapi.PromotionPlanSpec{
+					Waves: []kargoapi.PromotionWave{
+						{Stages: []string{"prod-us"}},
+						{Stages: []string{"forbidden"}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `is not permitted to promote to Stage "forbidden"`)
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				validateProjectFn: func(context.Context, client.Client, client.Object) error {
+					return nil
+				},
+				getStageFn: getStage,
+				admissionRequestFromContextFn: func(context.Context) (admission.Request, error) {
+					return admission.Request{}, nil
+				},
+				createSubjectAccessReviewFn: allowed,
+			},
+			plan: &kargoapi.PromotionPlan{
+				Spec: kargoapi.PromotionPlanSpec{
+					Waves: []kargoapi.PromotionWave{
+						{Stages: []string{"prod-us"}},
+						{Stages: []string{"prod-eu", "prod-ap"}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.webhook.ValidateCreate(context.Background(), testCase.plan)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func Test_webhook_Default(t *testing.T) {
+	w := &webhook{getStageFn: getStage}
+
+	plan := &kargoapi.PromotionPlan{
+		Spec: kargoapi.PromotionPlanSpec{
+			Waves: []kargoapi.PromotionWave{{Stages: []string{"sharded"}}},
+		},
+	}
+	require.NoError(t, w.Default(context.Background(), plan))
+	require.Equal(t, "fake-shard", plan.Labels[kargoapi.LabelKeyShard])
+
+	plan = &kargoapi.PromotionPlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{kargoapi.LabelKeyShard: "bogus"},
+		},
+		Spec: kargoapi.PromotionPlanSpec{
+			Waves: []kargoapi.PromotionWave{{Stages: []string{"prod-us"}}},
+		},
+	}
+	require.NoError(t, w.Default(context.Background(), plan))
+	require.NotContains(t, plan.Labels, kargoapi.LabelKeyShard)
+
+	w.getStageFn = func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Stage, error) {
+		return nil, errors.New("something went wrong")
+	}
+	require.ErrorContains(t, w.Default(context.Background(), plan), "something went wrong")
+}
+
+func Test_webhook_validateSpec(t *testing.T) {
+	w := &webhook{}
+	require.Empty(t, w.validateSpec(
+		field.NewPath("spec"),
+		kargoapi.PromotionPlanSpec{
+			Waves: []kargoapi.PromotionWave{
+				{Stages: []string{"prod-us", "prod-eu"}},
+				{Stages: []string{"prod-ap"}},
+			},
+		},
+	))
+}
+
+func Test_webhook_authorize(t *testing.T) {
+	plan := &kargoapi.PromotionPlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-plan",
+			Namespace: "fake-namespace",
+		},
+		Spec: kargoapi.PromotionPlanSpec{
+			Waves: []kargoapi.PromotionWave{{Stages: []string{"fake-stage"}}},
+		},
+	}
+
+	testCases := []struct {
+		name                          string
+		admissionRequestFromContextFn func(
+			context.Context,
+		) (admission.Request, error)
+		createSubjectAccessReviewFn func(
+			context.Context,
+			client.Object,
+			...client.CreateOption,
+		) error
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error getting admission request bound to context",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(
+					t, err, "error retrieving admission request from context; refusing to",
+				)
+			},
+		},
+		{
+			name: "error creating subject access review",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				context.Context,
+				client.Object,
+				...client.CreateOption,
+			) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error creating SubjectAccessReview")
+			},
+		},
+		{
+			name: "subject is authorized",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				require.Equal(t, "promote", review.Spec.ResourceAttributes.Verb)
+				require.Equal(t, "stages", review.Spec.ResourceAttributes.Resource)
+				require.Equal(t, "fake-stage", review.Spec.ResourceAttributes.Name)
+				review.Status.Allowed = true
+				return nil
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				admissionRequestFromContextFn: testCase.admissionRequestFromContextFn,
+				createSubjectAccessReviewFn:   testCase.createSubjectAccessReviewFn,
+			}
+			testCase.assertions(t, w.authorize(context.Background(), plan))
+		})
+	}
+}
+
+// getStage returns a Stage without a shard for any name other than "missing"
+// or "sharded". It returns nil for "missing" and a Stage belonging to
+// "fake-shard" for "sharded".
+func getStage(
+	_ context.Context,
+	_ client.Client,
+	name types.NamespacedName,
+) (*kargoapi.Stage, error) {
+	switch name.Name {
+	case "missing":
+		return nil, nil
+	case "sharded":
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Name: name.Name},
+			Spec:       kargoapi.StageSpec{Shard: "fake-shard"},
+		}, nil
+	default:
+		return &kargoapi.Stage{ObjectMeta: metav1.ObjectMeta{Name: name.Name}}, nil
+	}
+}
@@ -204,24 +204,51 @@ func (w *webhook) validateSpec(
 ) field.ErrorList {
 	errs := w.validateRequestedFreight(f.Child("requestedFreight"), spec.RequestedFreight)
 
+	if spec.PromotionCalendar != nil {
+		errs = append(
+			errs,
+			libWebhook.ValidatePromotionWindows(
+				f.Child("promotionCalendar").Child("windows"),
+				spec.PromotionCalendar.Windows,
+			)...,
+		)
+		errs = append(
+			errs,
+			libWebhook.ValidatePromotionFreezes(
+				f.Child("promotionCalendar").Child("freezes"),
+				spec.PromotionCalendar.Freezes,
+			)...,
+		)
+	}
+
 	if spec.PromotionTemplate == nil {
 		return errs
 	}
 
+	templateSpecPath := f.Child("promotionTemplate").Child("spec")
+	templateSpec := spec.PromotionTemplate.Spec
+
 	errs = append(
 		errs,
-		libWebhook.ValidatePromotionSteps(
-			f.Child("promotionTemplate").Child("spec").Child("steps"),
-			spec.PromotionTemplate.Spec.Steps,
+		libWebhook.ValidatePromotionStepSections(
+			templateSpecPath,
+			templateSpec.Steps,
+			templateSpec.OnFailure,
+			templateSpec.Finally,
 		)...,
 	)
 
 	errs = append(
 		errs,
-		w.validatePromotionStepTaskRefsFn(
-			f.Child("promotionTemplate").Child("spec").Child("steps"),
-			spec.PromotionTemplate.Spec.Steps,
-		)...,
+		w.validatePromotionStepTaskRefsFn(templateSpecPath.Child("steps"), templateSpec.Steps)...,
+	)
+	errs = append(
+		errs,
+		w.validatePromotionStepTaskRefsFn(templateSpecPath.Child("onFailure"), templateSpec.OnFailure)...,
+	)
+	errs = append(
+		errs,
+		w.validatePromotionStepTaskRefsFn(templateSpecPath.Child("finally"), templateSpec.Finally)...,
 	)
 
 	return errs
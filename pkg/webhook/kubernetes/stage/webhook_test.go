@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -1030,6 +1031,45 @@ func Test_webhook_ValidateSpec(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "invalid promotion calendar",
+			spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+				},
+				PromotionCalendar: &kargoapi.PromotionCalendar{
+					Windows: []kargoapi.PromotionWindow{{
+						Kind:     kargoapi.PromotionWindowKindAllow,
+						Schedule: "0 9 * * MON-FRI",
+					}},
+					Freezes: []kargoapi.PromotionFreeze{{
+						Reason: "Holidays",
+						Start:  &metav1.Time{Time: time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)},
+						End:    metav1.NewTime(time.Date(2025, time.December, 20, 0, 0, 0, 0, time.UTC)),
+					}},
+				},
+			},
+			assertions: func(t *testing.T, spec kargoapi.StageSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.promotionCalendar.windows[0]",
+							BadValue: "0 9 * * MON-FRI",
+							Detail:   "duration must be greater than zero",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.promotionCalendar.freezes[0].end",
+							BadValue: spec.PromotionCalendar.Freezes[0].End,
+							Detail:   "end must be after start",
+						},
+					},
+					errs,
+				)
+			},
+		},
 		{
 			name: "valid",
 			spec: kargoapi.StageSpec{
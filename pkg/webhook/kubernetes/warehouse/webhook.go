@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -168,6 +169,14 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
 	}
+	if sub.OCI != nil {
+		repoTypes++
+		errs = append(errs, w.validateOCISub(f.Child("oci"), *sub.OCI, seen)...)
+	}
+	if sub.Bucket != nil {
+		repoTypes++
+		errs = append(errs, w.validateBucketSub(f.Child("bucket"), *sub.Bucket, seen)...)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -175,7 +184,9 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					"exactly one of %s.git, %s.image, %s.chart, %s.oci, or %s.bucket must be non-empty",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -270,6 +281,62 @@ func (w *webhook) validateChartSub(
 	return errs
 }
 
+func (w *webhook) validateOCISub(
+	f *field.Path,
+	sub kargoapi.OCISubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if sub.ArtifactSelectionStrategy == kargoapi.ImageSelectionStrategySemVer || sub.ArtifactSelectionStrategy == "" {
+		if sub.Constraint != "" {
+			if err := validateSemverConstraint(
+				f.Child("constraint"),
+				sub.Constraint,
+			); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if err := seen.addOCI(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func (w *webhook) validateBucketSub(
+	f *field.Path,
+	sub kargoapi.BucketSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if sub.ObjectSelectionStrategy == kargoapi.ObjectSelectionStrategySemVer || sub.ObjectSelectionStrategy == "" {
+		if err := validateSemverConstraint(
+			f.Child("constraint"),
+			sub.Constraint,
+		); err != nil {
+			errs = append(errs, err)
+		}
+		if sub.VersionRegex != "" {
+			if regex, err := regexp.Compile(sub.VersionRegex); err != nil {
+				errs = append(errs, field.Invalid(f.Child("versionRegex"), sub.VersionRegex, err.Error()))
+			} else if regex.NumSubexp() != 1 {
+				errs = append(
+					errs,
+					field.Invalid(
+						f.Child("versionRegex"),
+						sub.VersionRegex,
+						"must contain exactly one capture group",
+					),
+				)
+			}
+		}
+	}
+	if err := seen.addBucket(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.URL, err.Error()))
+	}
+	return errs
+}
+
 func validateSemverConstraint(
 	f *field.Path,
 	semverConstraint string,
@@ -303,6 +370,10 @@ func (s uniqueSubSet) addImage(sub kargoapi.ImageSubscription, p *field.Path) er
 	// The normalization of Helm chart repository URLs can also be used here
 	// to ensure the uniqueness of the image reference as it does the job of
 	// ensuring lower-casing, etc. without introducing unwanted side effects.
+	// Image normalization is deliberately not used: it would, for instance,
+	// treat "nginx" and "docker.io/library/nginx" as the same repository and
+	// begin rejecting existing Warehouses that were previously valid. Contrast
+	// with addOCI.
 	k := subscriptionKey{kind: "image", id: urls.NormalizeChart(sub.RepoURL)}
 	if _, exists := s[k]; exists {
 		return fmt.Errorf("subscription for image repository already exists at %q", s[k])
@@ -325,3 +396,33 @@ func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *f
 	s[k] = p
 	return nil
 }
+
+func (s uniqueSubSet) addOCI(sub kargoapi.OCISubscription, p *field.Path) error {
+	// OCI repository URLs take the same form as image repository URLs, so,
+	// unlike in addImage, image normalization is used to recognize different
+	// representations of the same repository (e.g. with or without docker.io)
+	// as duplicates. OCI subscriptions have enforced this since their
+	// introduction, so no previously valid Warehouse is rejected by it.
+	k := subscriptionKey{kind: "oci", id: urls.NormalizeImage(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for OCI repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addBucket(sub kargoapi.BucketSubscription, p *field.Path) error {
+	// Subscriptions to the same bucket must not have overlapping prefixes, as it
+	// would otherwise be ambiguous which subscription an object belongs to.
+	id := strings.TrimSuffix(sub.URL, "/") + "/" + sub.Prefix
+	for k := range s {
+		if k.kind == "bucket" && (strings.HasPrefix(k.id, id) || strings.HasPrefix(id, k.id)) {
+			return fmt.Errorf(
+				"subscription for bucket with an overlapping prefix already exists at %q",
+				s[k],
+			)
+		}
+	}
+	s[subscriptionKey{kind: "bucket", id: id}] = p
+	return nil
+}
@@ -260,8 +260,8 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].image, or spec.subscriptions[0].chart " +
-								"must be non-empty",
+								"spec.subscriptions[0].image, spec.subscriptions[0].chart, " +
+								"spec.subscriptions[0].oci, or spec.subscriptions[0].bucket must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -357,8 +357,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].image, or " +
-								"subs[0].chart must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].image, " +
+								"subs[0].chart, subs[0].oci, or subs[0].bucket must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -473,7 +473,8 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.image, or sub.chart must be non-empty",
+							Detail: "exactly one of sub.git, sub.image, sub.chart, sub.oci, " +
+								"or sub.bucket must be non-empty",
 						},
 					},
 					errs,
@@ -635,6 +636,202 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCISub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCISubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.OCISubscription{
+				RepoURL:    "bogus",
+				Constraint: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "oci",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].oci"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "oci.constraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "oci",
+							BadValue: "bogus",
+							Detail:   "subscription for OCI repository already exists at \"spec.subscriptions[0].oci\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "same repository in a different representation",
+			sub: kargoapi.OCISubscription{
+				RepoURL: "docker.io/library/nginx",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "oci",
+					id:   "nginx",
+				}: field.NewPath("spec.subscriptions[0].oci"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "oci",
+							BadValue: "docker.io/library/nginx",
+							Detail:   "subscription for OCI repository already exists at \"spec.subscriptions[0].oci\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "image subscription to the same repository",
+			sub: kargoapi.OCISubscription{
+				RepoURL: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "image",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].image"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "valid",
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateOCISub(
+					field.NewPath("oci"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateBucketSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.BucketSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.BucketSubscription{
+				URL:          "https://s3.example.com/bucket",
+				Prefix:       "builds/app/",
+				Constraint:   "bogus",
+				VersionRegex: `^app-.+$`,
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "bucket",
+					id:   "https://s3.example.com/bucket/builds/",
+				}: field.NewPath("spec.subscriptions[0].bucket"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "bucket.constraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "bucket.versionRegex",
+							BadValue: `^app-.+$`,
+							Detail:   "must contain exactly one capture group",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "bucket",
+							BadValue: "https://s3.example.com/bucket",
+							Detail: "subscription for bucket with an overlapping prefix already " +
+								"exists at \"spec.subscriptions[0].bucket\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "version regex is not validated for other strategies",
+			sub: kargoapi.BucketSubscription{
+				URL:                     "https://s3.example.com/bucket",
+				ObjectSelectionStrategy: kargoapi.ObjectSelectionStrategyLexical,
+				VersionRegex:            "(",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "same bucket with non-overlapping prefix",
+			sub: kargoapi.BucketSubscription{
+				URL:          "https://s3.example.com/bucket",
+				Prefix:       "builds/app/",
+				VersionRegex: `^app-(.+)\.tar\.gz$`,
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "bucket",
+					id:   "https://s3.example.com/bucket/builds/other/",
+				}: field.NewPath("spec.subscriptions[0].bucket"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateBucketSub(
+					field.NewPath("bucket"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
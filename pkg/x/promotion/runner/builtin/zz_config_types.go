@@ -128,6 +128,33 @@ type DeleteConfig struct {
 	Strict bool `json:"strict,omitempty"`
 }
 
+type FluxUpdateConfig struct {
+	// The name of a Secret in the Project namespace containing a kubeconfig for the cluster
+	// Flux runs in under the 'kubeconfig' key.
+	KubeconfigSecret string `json:"kubeconfigSecret"`
+	// Describes Flux resources to be updated and reconciled.
+	Resources []FluxResourceUpdate `json:"resources"`
+}
+
+type FluxResourceUpdate struct {
+	// The chart version a HelmRelease is to be pinned to.
+	ChartVersion string `json:"chartVersion,omitempty"`
+	// The commit a GitRepository is to be pinned to. Mutually exclusive with 'tag'.
+	Commit string `json:"commit,omitempty"`
+	// The digest an OCIRepository is to be pinned to. Mutually exclusive with 'tag'.
+	Digest string `json:"digest,omitempty"`
+	// The kind of the Flux resource to be updated.
+	Kind FluxResourceUpdateKind `json:"kind"`
+	// The name of the Flux resource to be updated.
+	Name string `json:"name"`
+	// The namespace of the Flux resource to be updated. If left unspecified, the namespace of
+	// the kubeconfig's current context is used, falling back to 'default'.
+	Namespace string `json:"namespace,omitempty"`
+	// The tag a GitRepository or OCIRepository is to be pinned to. Mutually exclusive with
+	// 'commit' and 'digest'.
+	Tag string `json:"tag,omitempty"`
+}
+
 type GitClearConfig struct {
 	// Path to a working directory of a local repository from which to remove all files,
 	// excluding the .git/ directory.
@@ -203,6 +230,35 @@ type GitCommitConfigAuthor struct {
 	SigningKey string `json:"signingKey,omitempty"`
 }
 
+type GitCreateReleaseConfig struct {
+	// Indicates whether the release should be created as an unpublished draft. Ignored by Git
+	// providers that do not support drafts. Default is false.
+	Draft bool `json:"draft,omitempty"`
+	// Indicates whether to skip TLS verification when interacting with the Git provider.
+	// Default is false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// The name of the release. If not specified, the Git provider's default is used, which is
+	// typically the name of the tag.
+	Name string `json:"name,omitempty"`
+	// The release notes. Kargo generates release notes from the commits in the Freight being
+	// promoted if they are not explicitly specified.
+	Notes string `json:"notes,omitempty"`
+	// Indicates whether the release should be marked as a prerelease. Ignored by Git providers
+	// that do not support prereleases. Default is false.
+	Prerelease bool `json:"prerelease,omitempty"`
+	// The name of the Git provider to use. Currently 'azure', 'bitbucket', 'gitea', 'github',
+	// and 'gitlab' are supported. Kargo will try to infer the provider if it is not explicitly
+	// specified.
+	Provider *Provider `json:"provider,omitempty"`
+	// The URL of the remote Git repository to create the release in.
+	RepoURL string `json:"repoURL"`
+	// The name of the tag to create the release for.
+	Tag string `json:"tag"`
+	// The commit the tag is created from if it does not already exist. Some Git providers also
+	// accept a branch name.
+	Target string `json:"target,omitempty"`
+}
+
 type GitMergePRConfig struct {
 	// Skip TLS verification when interacting with the Git provider. Default is false.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
@@ -275,6 +331,34 @@ type GitPushConfig struct {
 	TargetBranch string `json:"targetBranch,omitempty"`
 }
 
+type GitTagConfig struct {
+	// The ID of the commit to tag. If not specified, the commit at the head of the currently
+	// checked out branch is tagged.
+	Commit string `json:"commit,omitempty"`
+	// The message of the annotated tag. If not specified, the name of the tag is used.
+	Message string `json:"message,omitempty"`
+	// The path to a working directory of a local repository.
+	Path string `json:"path"`
+	// The name of the tag to create and push.
+	Tag string `json:"tag"`
+	// Optional information about the creator of the tag. If provided, this takes precedence
+	// over both system-level defaults and any optional, default authorship information
+	// configured in the `git-clone` step. If a signing key is configured, the tag is signed.
+	Tagger *Tagger `json:"tagger,omitempty"`
+}
+
+// Optional information about the creator of the tag. If provided, this takes precedence
+// over both system-level defaults and any optional, default authorship information
+// configured in the `git-clone` step. If a signing key is configured, the tag is signed.
+type Tagger struct {
+	// The email of the tagger.
+	Email string `json:"email"`
+	// The name of the tagger.
+	Name string `json:"name"`
+	// The GPG signing key for the tagger.
+	SigningKey string `json:"signingKey,omitempty"`
+}
+
 type GitWaitForPRConfig struct {
 	// Indicates whether to skip TLS verification when cloning the repository. Default is false.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
@@ -411,6 +495,9 @@ type HTTPDownloadConfig struct {
 	OutPath string `json:"outPath"`
 	// Query parameters to include in the HTTP request.
 	QueryParams []HTTPDownloadConfigQueryParam `json:"queryParams,omitempty"`
+	// The region used when signing a request for an object in an S3-compatible bucket for which
+	// bucket credentials exist. If not specified, the default is us-east-1.
+	Region string `json:"region,omitempty"`
 	// The maximum time to wait for the download to complete. If not specified, the default is 5
 	// minutes.
 	Timeout string `json:"timeout,omitempty"`
@@ -460,6 +547,80 @@ type JSONUpdate struct {
 	Value interface{} `json:"value"`
 }
 
+type KubernetesApplyConfig struct {
+	// Whether to submit the manifests in server-side dry-run mode without persisting any
+	// changes.
+	DryRun bool `json:"dryRun,omitempty"`
+	// The name of the field manager used for server-side apply. Defaults to 'kargo'.
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Whether to take ownership of fields that are managed by other field managers when a
+	// conflict occurs.
+	Force bool `json:"force,omitempty"`
+	// HealthCheck configures the health checks registered for the Stage on successful
+	// completion of the step. If left unspecified, the health of each applied resource is
+	// checked by name. Only resources of the kinds being applied, in the namespaces being
+	// applied to, that match the selector are checked.
+	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+	// The name of a Secret in the Project namespace containing a kubeconfig for the target
+	// cluster under the 'kubeconfig' key.
+	KubeconfigSecret string `json:"kubeconfigSecret"`
+	// The namespace to apply namespaced resources that do not specify one to. If left
+	// unspecified, the namespace of the kubeconfig's current context is used, falling back to
+	// 'default'.
+	Namespace string `json:"namespace,omitempty"`
+	// Path to a file or directory containing the manifests to apply. If a directory is
+	// specified, all YAML and JSON files within it are applied.
+	Path string `json:"path"`
+	// Prune configures the deletion of previously applied resources that are no longer present
+	// in the manifests. Only resources of the kinds being applied, in the namespaces being
+	// applied to, that match the selector are considered for deletion.
+	Prune *Prune `json:"prune,omitempty"`
+	// Whether to wait for all applied resources to be fully rolled out before the step is
+	// considered complete.
+	Wait bool `json:"wait,omitempty"`
+}
+
+// HealthCheck configures the health checks registered for the Stage on successful
+// completion of the step. If left unspecified, the health of each applied resource is
+// checked by name. Only resources of the kinds being applied, in the namespaces being
+// applied to, that match the selector are checked.
+type HealthCheck struct {
+	// Selector to match resources whose health is checked by labels. Must contain at least one
+	// selection criterion.
+	Selector HealthCheckSelector `json:"selector"`
+}
+
+// Selector to match resources whose health is checked by labels. Must contain at least one
+// selection criterion.
+type HealthCheckSelector struct {
+	// matchExpressions is a list of label selector requirements. The requirements are ANDed.
+	MatchExpressions []MatchExpression `json:"matchExpressions,omitempty"`
+	// matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is
+	// equivalent to an element of matchExpressions, whose key field is 'key', the operator is
+	// 'In', and the values array contains only 'value'. The requirements are ANDed.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
+
+// Prune configures the deletion of previously applied resources that are no longer present
+// in the manifests. Only resources of the kinds being applied, in the namespaces being
+// applied to, that match the selector are considered for deletion.
+type Prune struct {
+	// Selector to match resources that may be pruned by labels. Must contain at least one
+	// selection criterion.
+	Selector PruneSelector `json:"selector"`
+}
+
+// Selector to match resources that may be pruned by labels. Must contain at least one
+// selection criterion.
+type PruneSelector struct {
+	// matchExpressions is a list of label selector requirements. The requirements are ANDed.
+	MatchExpressions []MatchExpression `json:"matchExpressions,omitempty"`
+	// matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is
+	// equivalent to an element of matchExpressions, whose key field is 'key', the operator is
+	// 'In', and the values array contains only 'value'. The requirements are ANDed.
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+}
+
 type KustomizeBuildConfig struct {
 	// OutPath is the file path to write the built manifests to.
 	OutPath string `json:"outPath"`
@@ -602,6 +763,16 @@ const (
 	NotIn        Operator = "NotIn"
 )
 
+// The kind of the Flux resource to be updated.
+type FluxResourceUpdateKind string
+
+const (
+	GitRepository FluxResourceUpdateKind = "GitRepository"
+	HelmRelease   FluxResourceUpdateKind = "HelmRelease"
+	Kustomization FluxResourceUpdateKind = "Kustomization"
+	OCIRepository FluxResourceUpdateKind = "OCIRepository"
+)
+
 // The name of the Git provider to use. Currently 'azure', 'bitbucket', 'gitea', 'github',
 // and 'gitlab' are supported. Kargo will try to infer the provider if it is not explicitly
 // specified.
@@ -81,7 +81,7 @@ export const RuleEditor = ({
               <_Select
                 label='VERBS'
                 options={((resources || []).includes('stages')
-                  ? availableVerbs.concat(['promote'])
+                  ? availableVerbs.concat(['override-calendar', 'promote'])
                   : availableVerbs
                 ).map((v) => ({ value: v, label: v }))}
                 placeholder='create'